@@ -0,0 +1,137 @@
+// Package accesslog writes one JSON record per served request to a configurable sink,
+// kept apart from the operational logrus logs so it can feed the analytics warehouse
+package accesslog
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+const defaultBufferSize = 1024
+
+// Record represents a single access log entry
+type Record struct {
+	Time       time.Time `json:"time"`
+	Method     string    `json:"method"`
+	Path       string    `json:"path"`
+	Route      string    `json:"route,omitempty"`
+	Query      string    `json:"query,omitempty"`
+	Status     int       `json:"status"`
+	Bytes      int       `json:"bytes"`
+	DurationMS float64   `json:"durationMS"`
+	KeyID      string    `json:"keyID,omitempty"`
+	RemoteAddr string    `json:"remoteAddr"`
+	UserAgent  string    `json:"userAgent,omitempty"`
+	// TraceID is the trace of the request, Traced is true if the trace was sampled
+	TraceID string `json:"traceID,omitempty"`
+	Traced  bool   `json:"traced,omitempty"`
+}
+
+// Logger struct handler for access log operations
+type Logger struct {
+	sink    io.WriteCloser
+	records chan Record
+	done    chan struct{}
+	// closed is set once records is closed, guarded by mutex so no record is sent on it after
+	closed bool
+	mutex  sync.Mutex
+	log    *logrus.Logger
+}
+
+// NewLogger returns Logger instance writing to given sink
+// records are written in the background, bufferSize <= 0 uses the default size
+func NewLogger(sink io.WriteCloser, bufferSize int, logger *logrus.Logger) *Logger {
+	if bufferSize <= 0 {
+		bufferSize = defaultBufferSize
+	}
+
+	l := &Logger{
+		sink:    sink,
+		records: make(chan Record, bufferSize),
+		done:    make(chan struct{}),
+		log:     logger,
+	}
+
+	go l.run()
+
+	return l
+}
+
+// Log queues record to be written, the record is dropped if the buffer is full
+// so a slow sink never blocks request serving, records logged after Close are dropped
+func (l *Logger) Log(record Record) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if l.closed {
+		return
+	}
+
+	select {
+	case l.records <- record:
+	default:
+		l.log.Warn("access log buffer full, dropping record")
+	}
+}
+
+// Close flushes pending records and closes the sink
+func (l *Logger) Close() error {
+	l.mutex.Lock()
+
+	if !l.closed {
+		l.closed = true
+		close(l.records)
+	}
+
+	l.mutex.Unlock()
+
+	<-l.done
+
+	return l.sink.Close()
+}
+
+func (l *Logger) run() {
+	defer close(l.done)
+
+	for record := range l.records {
+		err := l.write(record)
+		if err != nil {
+			l.log.WithFields(logrus.Fields{
+				"err": err.Error(),
+			}).Error(err)
+		}
+	}
+}
+
+func (l *Logger) write(record Record) error {
+	rawRecord, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("access log marshal failed: %w", err)
+	}
+
+	_, err = l.sink.Write(append(rawRecord, '\n'))
+	if err != nil {
+		return fmt.Errorf("access log write failed: %w", err)
+	}
+
+	return nil
+}
+
+// KeyID returns a short non reversible identifier of an API key
+// so records can be grouped by consumer without storing the key itself
+func KeyID(apiKey string) string {
+	if apiKey == "" {
+		return ""
+	}
+
+	sum := sha256.Sum256([]byte(apiKey))
+
+	return hex.EncodeToString(sum[:])[:12]
+}
@@ -0,0 +1,247 @@
+package accesslog
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pokt-foundation/pocket-http-db/health"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+type bufferSink struct {
+	bytes.Buffer
+	mutex  sync.Mutex
+	closed bool
+}
+
+func (b *bufferSink) Write(p []byte) (int, error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	return b.Buffer.Write(p)
+}
+
+func (b *bufferSink) Close() error {
+	b.closed = true
+
+	return nil
+}
+
+func TestLogger_Log(t *testing.T) {
+	c := require.New(t)
+
+	sink := &bufferSink{}
+	logger := NewLogger(sink, 0, logrus.New())
+
+	logger.Log(Record{Method: http.MethodGet, Path: "/application", Status: http.StatusOK})
+	logger.Log(Record{Method: http.MethodPost, Path: "/blockchain", Status: http.StatusBadRequest})
+
+	c.NoError(logger.Close())
+	c.True(sink.closed)
+
+	// the requests still in flight at shutdown are not logged
+	c.NotPanics(func() {
+		logger.Log(Record{Method: http.MethodGet, Path: "/application", Status: http.StatusOK})
+	})
+
+	lines := bytes.Split(bytes.TrimSpace(sink.Bytes()), []byte("\n"))
+	c.Len(lines, 2)
+
+	var record Record
+
+	c.NoError(json.Unmarshal(lines[1], &record))
+	c.Equal("/blockchain", record.Path)
+	c.Equal(http.StatusBadRequest, record.Status)
+}
+
+func TestKeyID(t *testing.T) {
+	c := require.New(t)
+
+	c.Empty(KeyID(""))
+	c.Len(KeyID("test_api_key_6789"), 12)
+	c.NotContains(KeyID("test_api_key_6789"), "test")
+	c.Equal(KeyID("test_api_key_6789"), KeyID("test_api_key_6789"))
+}
+
+func TestFileSink_Rotate(t *testing.T) {
+	c := require.New(t)
+
+	path := filepath.Join(t.TempDir(), "access.log")
+
+	sink, err := NewFileSink(path, 1, 2)
+	c.NoError(err)
+
+	line := append(bytes.Repeat([]byte("a"), megabyte-1), '\n')
+
+	for i := 0; i < 4; i++ {
+		_, err = sink.Write(line)
+		c.NoError(err)
+	}
+
+	c.NoError(sink.Close())
+
+	for _, name := range []string{path, path + ".1", path + ".2"} {
+		info, err := os.Stat(name)
+		c.NoError(err)
+		c.Equal(int64(megabyte), info.Size())
+	}
+
+	_, err = os.Stat(path + ".3")
+	c.ErrorIs(err, os.ErrNotExist)
+}
+
+func TestFileSink_RotateFailure(t *testing.T) {
+	c := require.New(t)
+
+	path := filepath.Join(t.TempDir(), "access.log")
+
+	sink, err := NewFileSink(path, 1, 1)
+	c.NoError(err)
+
+	// the oldest backup cannot be removed while it is a directory holding a file
+	c.NoError(os.Mkdir(path+".1", 0o755))
+	c.NoError(os.WriteFile(filepath.Join(path+".1", "file"), nil, 0o644))
+
+	line := append(bytes.Repeat([]byte("a"), megabyte-1), '\n')
+
+	_, err = sink.Write(line)
+	c.NoError(err)
+
+	// the file is reopened, so the record is still written
+	_, err = sink.Write(line)
+	c.ErrorContains(err, "rotate access log file failed")
+
+	info, err := os.Stat(path)
+	c.NoError(err)
+	c.Equal(int64(2*megabyte), info.Size())
+
+	c.NoError(os.RemoveAll(path + ".1"))
+
+	_, err = sink.Write(line)
+	c.NoError(err)
+
+	c.NoError(sink.Close())
+
+	info, err = os.Stat(path)
+	c.NoError(err)
+	c.Equal(int64(megabyte), info.Size())
+
+	info, err = os.Stat(path + ".1")
+	c.NoError(err)
+	c.Equal(int64(2*megabyte), info.Size())
+}
+
+func TestKafkaSink_Write(t *testing.T) {
+	c := require.New(t)
+
+	var received kafkaRecords
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Equal("/topics/access-log", r.URL.Path)
+		c.Equal(kafkaJSONContentType, r.Header.Get("Content-Type"))
+
+		body, err := io.ReadAll(r.Body)
+		c.NoError(err)
+		c.NoError(json.Unmarshal(body, &received))
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	sink := NewKafkaSink(server.URL+"/", "access-log", time.Second, 1, 0)
+	sink.Probe = health.NewRegistry().Probe("kafka")
+
+	_, err := sink.Write([]byte(`{"path":"/application"}` + "\n"))
+	c.NoError(err)
+	c.Len(received.Records, 1)
+	c.JSONEq(`{"path":"/application"}`, string(received.Records[0].Value))
+	c.Equal(health.StateOK, sink.Probe.Status().State)
+
+	server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	_, err = sink.Write([]byte(`{}`))
+	c.ErrorIs(err, errKafkaResponseNotOK)
+	c.Equal(health.StateFailing, sink.Probe.Status().State)
+
+	registry := health.NewRegistry()
+	registry.SetErrorBudget("kafka", 1)
+	sink.Probe = registry.Probe("kafka")
+
+	_, err = sink.Write([]byte(`{}`))
+	c.ErrorIs(err, errKafkaResponseNotOK)
+	c.Equal(health.StateDisabled, sink.Probe.Status().State)
+
+	// dropped without calling the proxy once disabled
+	server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Fail("unexpected produce request")
+	})
+
+	n, err := sink.Write([]byte(`{}`))
+	c.NoError(err)
+	c.Equal(2, n)
+}
+
+func TestKafkaSink_Batch(t *testing.T) {
+	c := require.New(t)
+
+	batches := make(chan kafkaRecords, 10)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var received kafkaRecords
+
+		c.NoError(json.NewDecoder(r.Body).Decode(&received))
+
+		batches <- received
+	}))
+	defer server.Close()
+
+	sink := NewKafkaSink(server.URL, "access-log", time.Second, 3, time.Hour)
+
+	for _, path := range []string{"/application", "/blockchain"} {
+		_, err := sink.Write([]byte(`{"path":"` + path + `"}`))
+		c.NoError(err)
+	}
+
+	c.Empty(batches)
+
+	// produced once full
+	_, err := sink.Write([]byte(`{"path":"/load_balancer"}`))
+	c.NoError(err)
+
+	batch := <-batches
+	c.Len(batch.Records, 3)
+	c.JSONEq(`{"path":"/application"}`, string(batch.Records[0].Value))
+
+	// the pending records are produced on Close
+	_, err = sink.Write([]byte(`{"path":"/pay_plan"}`))
+	c.NoError(err)
+	c.NoError(sink.Close())
+
+	batch = <-batches
+	c.Len(batch.Records, 1)
+
+	// and once the flush interval is reached
+	sink = NewKafkaSink(server.URL, "access-log", time.Second, 100, 10*time.Millisecond)
+	defer sink.Close()
+
+	_, err = sink.Write([]byte(`{"path":"/application"}`))
+	c.NoError(err)
+
+	select {
+	case batch = <-batches:
+		c.Len(batch.Records, 1)
+	case <-time.After(time.Second):
+		c.Fail("batch not flushed")
+	}
+}
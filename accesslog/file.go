@@ -0,0 +1,132 @@
+package accesslog
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+)
+
+const megabyte = 1024 * 1024
+
+// FileSink is a file writer rotated by size, backups are kept as path.1 (newest) to path.N (oldest)
+type FileSink struct {
+	path       string
+	maxSize    int64
+	maxBackups int
+	file       *os.File
+	size       int64
+	mutex      sync.Mutex
+}
+
+// NewFileSink returns FileSink instance appending to the file in path
+// maxSizeMB <= 0 disables rotation
+func NewFileSink(path string, maxSizeMB int64, maxBackups int) (*FileSink, error) {
+	s := &FileSink{
+		path:       path,
+		maxSize:    maxSizeMB * megabyte,
+		maxBackups: maxBackups,
+	}
+
+	err := s.open()
+	if err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (s *FileSink) open() error {
+	file, err := os.OpenFile(s.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return fmt.Errorf("open access log file failed: %w", err)
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return fmt.Errorf("stat access log file failed: %w", err)
+	}
+
+	s.file = file
+	s.size = info.Size()
+
+	return nil
+}
+
+func (s *FileSink) backupName(n int) string {
+	return fmt.Sprintf("%s.%d", s.path, n)
+}
+
+// rotate moves the file to the first backup and opens a new one
+// the file is reopened even if moving it failed, so the records keep being appended to it, past the max size,
+// until a later rotation succeeds
+func (s *FileSink) rotate() error {
+	err := s.file.Close()
+	if err == nil {
+		err = s.moveBackups()
+	}
+
+	openErr := s.open()
+	if err != nil {
+		return err
+	}
+
+	return openErr
+}
+
+// moveBackups shifts the backups by one, dropping the oldest, and moves the closed file to the first one
+func (s *FileSink) moveBackups() error {
+	if s.maxBackups <= 0 {
+		err := os.Remove(s.path)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+
+		return nil
+	}
+
+	err := os.Remove(s.backupName(s.maxBackups))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	for i := s.maxBackups - 1; i > 0; i-- {
+		err = os.Rename(s.backupName(i), s.backupName(i+1))
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+
+	return os.Rename(s.path, s.backupName(1))
+}
+
+// Write appends p to the file, rotating it first if p would exceed the max size
+// p is still appended if the rotation fails, the rotation error being returned
+func (s *FileSink) Write(p []byte) (int, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	var rotateErr error
+
+	if s.maxSize > 0 && s.size > 0 && s.size+int64(len(p)) > s.maxSize {
+		rotateErr = s.rotate()
+	}
+
+	n, err := s.file.Write(p)
+	s.size += int64(n)
+
+	if rotateErr != nil {
+		return n, fmt.Errorf("rotate access log file failed: %w", rotateErr)
+	}
+
+	return n, err
+}
+
+// Close closes the underlying file
+func (s *FileSink) Close() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.file.Close()
+}
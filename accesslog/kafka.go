@@ -0,0 +1,205 @@
+package accesslog
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pokt-foundation/pocket-http-db/health"
+)
+
+const kafkaJSONContentType = "application/vnd.kafka.json.v2+json"
+
+var errKafkaResponseNotOK = errors.New("kafka rest proxy response not ok")
+
+// KafkaSink publishes the written records to a Kafka topic through a Kafka REST Proxy, in batches
+// a batch is produced once it holds batchSize records or flushInterval after the previous one,
+// the records of a batch that fails are dropped
+type KafkaSink struct {
+	url           string
+	client        *http.Client
+	batchSize     int
+	flushInterval time.Duration
+	// Probe records the outcome of the produce requests, nil records nothing
+	// records are dropped while it is disabled
+	Probe *health.Probe
+
+	pending []kafkaRecord
+	// flushErr is the error of the last background flush, returned by the next Write
+	flushErr  error
+	mutex     sync.Mutex
+	startOnce sync.Once
+	stopOnce  sync.Once
+	stop      chan struct{}
+	stopped   chan struct{}
+}
+
+type kafkaRecord struct {
+	Value json.RawMessage `json:"value"`
+}
+
+type kafkaRecords struct {
+	Records []kafkaRecord `json:"records"`
+}
+
+// NewKafkaSink returns KafkaSink instance producing to topic in the REST Proxy at proxyURL
+// batches of up to batchSize records, flushed every flushInterval
+func NewKafkaSink(proxyURL, topic string, timeout time.Duration, batchSize int, flushInterval time.Duration) *KafkaSink {
+	if batchSize <= 0 {
+		batchSize = 1
+	}
+
+	return &KafkaSink{
+		url:           fmt.Sprintf("%s/topics/%s", strings.TrimSuffix(proxyURL, "/"), topic),
+		client:        &http.Client{Timeout: timeout},
+		batchSize:     batchSize,
+		flushInterval: flushInterval,
+		stop:          make(chan struct{}),
+		stopped:       make(chan struct{}),
+	}
+}
+
+// start runs the periodic flush once, on the first Write, so the Probe can be set after NewKafkaSink
+func (s *KafkaSink) start() {
+	s.startOnce.Do(func() {
+		go s.run()
+	})
+}
+
+// Write adds p to the pending batch, producing it if it is full, p must be a JSON document
+func (s *KafkaSink) Write(p []byte) (int, error) {
+	// dropped without error, logging every record would flood the logs until the sink is re-enabled
+	if !s.Probe.Enabled() {
+		return len(p), nil
+	}
+
+	s.start()
+
+	// p is copied, the caller may reuse it
+	value := append(json.RawMessage(nil), bytes.TrimSpace(p)...)
+
+	s.mutex.Lock()
+
+	s.pending = append(s.pending, kafkaRecord{Value: value})
+
+	err := s.flushErr
+	s.flushErr = nil
+
+	var batch []kafkaRecord
+	if len(s.pending) >= s.batchSize {
+		batch = s.takePending()
+	}
+
+	s.mutex.Unlock()
+
+	if batch != nil {
+		err = s.produce(batch)
+	}
+
+	if err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
+// run flushes the pending batch every flushInterval until the sink is closed
+func (s *KafkaSink) run() {
+	defer close(s.stopped)
+
+	if s.flushInterval <= 0 {
+		<-s.stop
+		return
+	}
+
+	ticker := time.NewTicker(s.flushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.stop:
+			return
+		case <-ticker.C:
+			err := s.flush()
+
+			if err != nil {
+				s.mutex.Lock()
+				s.flushErr = err
+				s.mutex.Unlock()
+			}
+		}
+	}
+}
+
+// flush produces the pending batch, if any
+func (s *KafkaSink) flush() error {
+	s.mutex.Lock()
+	batch := s.takePending()
+	s.mutex.Unlock()
+
+	if len(batch) == 0 || !s.Probe.Enabled() {
+		return nil
+	}
+
+	return s.produce(batch)
+}
+
+// takePending returns the pending batch and starts a new one, the mutex must be held
+func (s *KafkaSink) takePending() []kafkaRecord {
+	batch := s.pending
+	s.pending = nil
+
+	return batch
+}
+
+// produce sends batch in a single request, recording its outcome on the Probe
+func (s *KafkaSink) produce(batch []kafkaRecord) error {
+	err := s.post(batch)
+	s.Probe.Record(err)
+
+	if err != nil {
+		return fmt.Errorf("produce %d records failed: %w", len(batch), err)
+	}
+
+	return nil
+}
+
+func (s *KafkaSink) post(batch []kafkaRecord) error {
+	body, err := json.Marshal(kafkaRecords{Records: batch})
+	if err != nil {
+		return err
+	}
+
+	resp, err := s.client.Post(s.url, kafkaJSONContentType, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%w: status %d", errKafkaResponseNotOK, resp.StatusCode)
+	}
+
+	return nil
+}
+
+// Close stops the periodic flush and produces the pending batch
+func (s *KafkaSink) Close() error {
+	s.start()
+
+	s.stopOnce.Do(func() {
+		close(s.stop)
+	})
+
+	<-s.stopped
+
+	return s.flush()
+}
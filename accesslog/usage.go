@@ -0,0 +1,156 @@
+package accesslog
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// unmatchedRoute is the route of the records of requests not matching any route template
+const unmatchedRoute = "unmatched"
+
+// RouteUsage holds the requests made to a route
+type RouteUsage struct {
+	Route    string `json:"route"`
+	Requests int    `json:"requests"`
+}
+
+// ConsumerUsage holds the requests made with an API key, identified by its KeyID
+type ConsumerUsage struct {
+	KeyID     string       `json:"keyID"`
+	Requests  int          `json:"requests"`
+	Errors    int          `json:"errors"`
+	ErrorRate float64      `json:"errorRate"`
+	TopRoutes []RouteUsage `json:"topRoutes"`
+}
+
+// Ring keeps the last records in memory so usage can be reported without the access log sink
+type Ring struct {
+	records []Record
+	next    int
+	full    bool
+	mutex   sync.RWMutex
+}
+
+// NewRing returns Ring instance keeping the last size records
+func NewRing(size int) *Ring {
+	return &Ring{
+		records: make([]Record, size),
+	}
+}
+
+// Add keeps record, replacing the oldest one if the ring is full
+func (r *Ring) Add(record Record) {
+	if len(r.records) == 0 {
+		return
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.records[r.next] = record
+	r.next = (r.next + 1) % len(r.records)
+
+	if r.next == 0 {
+		r.full = true
+	}
+}
+
+// Oldest returns the time of the oldest record kept, zero if there is none
+// usage of windows starting before it is only partially known
+func (r *Ring) Oldest() time.Time {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	if r.full {
+		return r.records[r.next].Time
+	}
+
+	if r.next == 0 {
+		return time.Time{}
+	}
+
+	return r.records[0].Time
+}
+
+// Usage returns the usage by consumer of the records made in [from, to), the most active consumer first
+// responses with status 400 and above count as errors, only the topRoutes most requested routes are returned
+func (r *Ring) Usage(from, to time.Time, topRoutes int) []ConsumerUsage {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	consumers := map[string]*ConsumerUsage{}
+	routes := map[string]map[string]int{}
+
+	count := r.next
+	if r.full {
+		count = len(r.records)
+	}
+
+	for _, record := range r.records[:count] {
+		if record.Time.Before(from) || !record.Time.Before(to) {
+			continue
+		}
+
+		consumer, ok := consumers[record.KeyID]
+		if !ok {
+			consumer = &ConsumerUsage{KeyID: record.KeyID}
+			consumers[record.KeyID] = consumer
+			routes[record.KeyID] = map[string]int{}
+		}
+
+		consumer.Requests++
+		if record.Status >= 400 {
+			consumer.Errors++
+		}
+
+		// raw paths are not used, every unmatched ID would become a route
+		route := record.Route
+		if route == "" {
+			route = unmatchedRoute
+		}
+
+		routes[record.KeyID][route]++
+	}
+
+	usage := make([]ConsumerUsage, 0, len(consumers))
+
+	for keyID, consumer := range consumers {
+		consumer.ErrorRate = float64(consumer.Errors) / float64(consumer.Requests)
+		consumer.TopRoutes = topRouteUsage(routes[keyID], topRoutes)
+
+		usage = append(usage, *consumer)
+	}
+
+	sort.Slice(usage, func(i, j int) bool {
+		if usage[i].Requests != usage[j].Requests {
+			return usage[i].Requests > usage[j].Requests
+		}
+
+		return usage[i].KeyID < usage[j].KeyID
+	})
+
+	return usage
+}
+
+// topRouteUsage returns the limit most requested routes, the most requested first
+func topRouteUsage(routes map[string]int, limit int) []RouteUsage {
+	usage := make([]RouteUsage, 0, len(routes))
+	for route, requests := range routes {
+		usage = append(usage, RouteUsage{Route: route, Requests: requests})
+	}
+
+	sort.Slice(usage, func(i, j int) bool {
+		if usage[i].Requests != usage[j].Requests {
+			return usage[i].Requests > usage[j].Requests
+		}
+
+		return usage[i].Route < usage[j].Route
+	})
+
+	if len(usage) > limit {
+		usage = usage[:limit]
+	}
+
+	return usage
+}
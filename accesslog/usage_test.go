@@ -0,0 +1,51 @@
+package accesslog
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRing_Usage(t *testing.T) {
+	c := require.New(t)
+
+	ring := NewRing(5)
+
+	start := time.Date(2022, time.June, 1, 10, 0, 0, 0, time.UTC)
+
+	c.True(ring.Oldest().IsZero())
+
+	ring.Add(Record{Time: start, KeyID: "aaa", Route: "/application", Status: http.StatusOK})
+	ring.Add(Record{Time: start.Add(time.Minute), KeyID: "aaa", Route: "/application", Status: http.StatusOK})
+	ring.Add(Record{Time: start.Add(2 * time.Minute), KeyID: "aaa", Route: "/blockchain", Status: http.StatusOK})
+	ring.Add(Record{Time: start.Add(3 * time.Minute), KeyID: "aaa", Path: "/wrong/123", Status: http.StatusNotFound})
+	ring.Add(Record{Time: start.Add(4 * time.Minute), KeyID: "bbb", Route: "/application", Status: http.StatusInternalServerError})
+
+	c.Equal(start, ring.Oldest())
+
+	usage := ring.Usage(start, start.Add(time.Hour), 2)
+	c.Len(usage, 2)
+
+	c.Equal("aaa", usage[0].KeyID)
+	c.Equal(4, usage[0].Requests)
+	c.Equal(1, usage[0].Errors)
+	c.Equal(0.25, usage[0].ErrorRate)
+	c.Equal([]RouteUsage{{Route: "/application", Requests: 2}, {Route: "/blockchain", Requests: 1}}, usage[0].TopRoutes)
+
+	c.Equal("bbb", usage[1].KeyID)
+	c.Equal(1.0, usage[1].ErrorRate)
+
+	// the oldest record is replaced once the ring is full
+	ring.Add(Record{Time: start.Add(5 * time.Minute), KeyID: "bbb", Route: "/application", Status: http.StatusOK})
+
+	c.Equal(start.Add(time.Minute), ring.Oldest())
+
+	usage = ring.Usage(start, start.Add(4*time.Minute), 5)
+	c.Len(usage, 1)
+	c.Equal(3, usage[0].Requests)
+	c.Contains(usage[0].TopRoutes, RouteUsage{Route: unmatchedRoute, Requests: 1})
+
+	c.Empty(NewRing(0).Usage(start, start.Add(time.Hour), 5))
+}
@@ -0,0 +1,221 @@
+// Package anomaly flags unusual write bursts, such as hundreds of applications created by one API key in a minute,
+// and optionally restricts the offending keys to a stricter write limit for a while
+package anomaly
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	// maxRecent is the number of anomalies kept for the status
+	maxRecent = 100
+	// warningRatio is the share of the restricted limit a key can use in a window before it is warned
+	warningRatio = 0.8
+)
+
+// Anomaly represents a write burst of an API key on a route
+type Anomaly struct {
+	KeyID string `json:"keyID"`
+	Route string `json:"route"`
+	// Count is the number of writes of the window when the burst was flagged
+	Count       int       `json:"count"`
+	WindowStart time.Time `json:"windowStart"`
+	DetectedAt  time.Time `json:"detectedAt"`
+	// RestrictedUntil is when the stricter limit of the key ends, zero if the key was not restricted
+	RestrictedUntil time.Time `json:"restrictedUntil,omitempty"`
+}
+
+// Decision is the outcome of observing a write
+type Decision struct {
+	// Allowed is false if the key is restricted and went over the restricted limit
+	Allowed bool
+	// RetryAfter is how long until the next window when the write is not allowed
+	RetryAfter time.Duration
+	// Anomaly is set on the write that made the key go over the threshold
+	Anomaly *Anomaly
+	// Warning is set on the allowed writes of a restricted key close to its limit
+	Warning *Warning
+}
+
+// Warning tells a restricted key is close to its write limit in the current window
+type Warning struct {
+	// Count is the number of writes of the key since the start of the window, Limit the restricted limit
+	Count int
+	Limit int
+	// ResetIn is how long until the next window
+	ResetIn time.Duration
+	// First is true on the write that reached the warning, once per key and window
+	First bool
+}
+
+// Restriction represents an API key restricted to the stricter write limit
+type Restriction struct {
+	KeyID string    `json:"keyID"`
+	Until time.Time `json:"until"`
+}
+
+// Status holds the recent anomalies and the current restrictions
+type Status struct {
+	Anomalies    []Anomaly     `json:"anomalies"`
+	Restrictions []Restriction `json:"restrictions"`
+}
+
+// burstKey identifies the writes of a key on a route
+type burstKey struct {
+	keyID string
+	route string
+}
+
+// Detector counts the writes of every API key on every route in fixed windows,
+// flagging the first write going over the threshold in a window
+type Detector struct {
+	threshold int
+	window    time.Duration
+	// RestrictedLimit restricts flagged keys to that many writes per window for RestrictFor, 0 does not restrict
+	RestrictedLimit int
+	RestrictFor     time.Duration
+
+	windowStart  time.Time
+	counts       map[burstKey]int
+	restricted   map[string]int
+	restrictions map[string]time.Time
+	recent       []Anomaly
+	now          func() time.Time
+	mutex        sync.Mutex
+}
+
+// NewDetector returns Detector instance flagging keys with more than threshold writes on a route in window
+func NewDetector(threshold int, window time.Duration) *Detector {
+	return &Detector{
+		threshold:    threshold,
+		window:       window,
+		counts:       map[burstKey]int{},
+		restricted:   map[string]int{},
+		restrictions: map[string]time.Time{},
+		now:          time.Now,
+	}
+}
+
+// Observe records a write of the key with given ID on route and decides whether it is allowed
+func (d *Detector) Observe(keyID, route string) Decision {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	now := d.now()
+	d.rotate(now)
+
+	var warning *Warning
+
+	if until, ok := d.restrictions[keyID]; ok {
+		if now.Before(until) {
+			d.restricted[keyID]++
+
+			if d.restricted[keyID] > d.RestrictedLimit {
+				return Decision{RetryAfter: d.windowStart.Add(d.window).Sub(now)}
+			}
+
+			warning = d.warning(keyID, now)
+		} else {
+			delete(d.restrictions, keyID)
+		}
+	}
+
+	key := burstKey{keyID: keyID, route: route}
+	d.counts[key]++
+
+	if d.counts[key] != d.threshold+1 {
+		return Decision{Allowed: true, Warning: warning}
+	}
+
+	anomaly := Anomaly{
+		KeyID:       keyID,
+		Route:       route,
+		Count:       d.counts[key],
+		WindowStart: d.windowStart,
+		DetectedAt:  now,
+	}
+
+	if d.RestrictedLimit > 0 && d.RestrictFor > 0 {
+		anomaly.RestrictedUntil = now.Add(d.RestrictFor)
+		d.restrictions[keyID] = anomaly.RestrictedUntil
+	}
+
+	d.recent = append(d.recent, anomaly)
+	if len(d.recent) > maxRecent {
+		d.recent = d.recent[len(d.recent)-maxRecent:]
+	}
+
+	return Decision{Allowed: true, Anomaly: &anomaly, Warning: warning}
+}
+
+// warning returns the warning of the restricted key with given ID, nil if it is not close to its limit
+func (d *Detector) warning(keyID string, now time.Time) *Warning {
+	warnAt := int(math.Ceil(float64(d.RestrictedLimit) * warningRatio))
+	count := d.restricted[keyID]
+
+	if count < warnAt {
+		return nil
+	}
+
+	return &Warning{
+		Count:   count,
+		Limit:   d.RestrictedLimit,
+		ResetIn: d.windowStart.Add(d.window).Sub(now),
+		First:   count == warnAt,
+	}
+}
+
+// rotate starts a new window if now is past the current one, dropping the counts of the previous one
+func (d *Detector) rotate(now time.Time) {
+	windowStart := now.Truncate(d.window)
+	if windowStart.Equal(d.windowStart) {
+		return
+	}
+
+	d.windowStart = windowStart
+	d.counts = map[burstKey]int{}
+	d.restricted = map[string]int{}
+}
+
+// Status returns the recent anomalies, latest first, and the restrictions still in place
+func (d *Detector) Status() Status {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	now := d.now()
+
+	status := Status{
+		Anomalies:    make([]Anomaly, 0, len(d.recent)),
+		Restrictions: []Restriction{},
+	}
+
+	for i := len(d.recent) - 1; i >= 0; i-- {
+		status.Anomalies = append(status.Anomalies, d.recent[i])
+	}
+
+	for keyID, until := range d.restrictions {
+		if now.Before(until) {
+			status.Restrictions = append(status.Restrictions, Restriction{KeyID: keyID, Until: until})
+		}
+	}
+
+	sort.Slice(status.Restrictions, func(i, j int) bool {
+		return status.Restrictions[i].KeyID < status.Restrictions[j].KeyID
+	})
+
+	return status
+}
+
+// Lift removes the restriction of the key with given ID, returns false if it was not restricted
+func (d *Detector) Lift(keyID string) bool {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	_, ok := d.restrictions[keyID]
+	delete(d.restrictions, keyID)
+
+	return ok
+}
@@ -0,0 +1,131 @@
+package anomaly
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetector_Observe(t *testing.T) {
+	c := require.New(t)
+
+	now := time.Date(2022, time.July, 21, 10, 0, 0, 0, time.UTC)
+
+	detector := NewDetector(3, time.Minute)
+	detector.now = func() time.Time { return now }
+
+	for i := 0; i < 3; i++ {
+		decision := detector.Observe("key1", "/application")
+		c.True(decision.Allowed)
+		c.Nil(decision.Anomaly)
+	}
+
+	// other routes and keys are counted apart
+	c.Nil(detector.Observe("key1", "/load_balancer").Anomaly)
+	c.Nil(detector.Observe("key2", "/application").Anomaly)
+
+	decision := detector.Observe("key1", "/application")
+	c.True(decision.Allowed)
+	c.NotNil(decision.Anomaly)
+	c.Equal(4, decision.Anomaly.Count)
+	c.True(decision.Anomaly.RestrictedUntil.IsZero())
+
+	// a burst is flagged once per window
+	c.Nil(detector.Observe("key1", "/application").Anomaly)
+
+	now = now.Add(time.Minute)
+
+	for i := 0; i < 3; i++ {
+		c.Nil(detector.Observe("key1", "/application").Anomaly)
+	}
+
+	status := detector.Status()
+	c.Len(status.Anomalies, 1)
+	c.Empty(status.Restrictions)
+}
+
+func TestDetector_Restrict(t *testing.T) {
+	c := require.New(t)
+
+	now := time.Date(2022, time.July, 21, 10, 0, 0, 0, time.UTC)
+
+	detector := NewDetector(2, time.Minute)
+	detector.RestrictedLimit = 1
+	detector.RestrictFor = time.Hour
+	detector.now = func() time.Time { return now }
+
+	detector.Observe("key1", "/application")
+	detector.Observe("key1", "/application")
+
+	decision := detector.Observe("key1", "/application")
+	c.True(decision.Allowed)
+	c.Equal(now.Add(time.Hour), decision.Anomaly.RestrictedUntil)
+
+	now = now.Add(40 * time.Second)
+
+	// the restricted limit applies to the writes made since the restriction, on any route
+	c.True(detector.Observe("key1", "/load_balancer").Allowed)
+
+	decision = detector.Observe("key1", "/application")
+	c.False(decision.Allowed)
+	c.Equal(20*time.Second, decision.RetryAfter)
+
+	c.True(detector.Observe("key2", "/application").Allowed)
+
+	now = now.Add(time.Minute)
+
+	c.True(detector.Observe("key1", "/application").Allowed)
+	c.False(detector.Observe("key1", "/application").Allowed)
+
+	status := detector.Status()
+	c.Len(status.Restrictions, 1)
+	c.Equal("key1", status.Restrictions[0].KeyID)
+
+	c.True(detector.Lift("key1"))
+	c.False(detector.Lift("key1"))
+	c.True(detector.Observe("key1", "/application").Allowed)
+
+	now = now.Add(2 * time.Hour)
+
+	c.Empty(detector.Status().Restrictions)
+}
+
+func TestDetector_Warning(t *testing.T) {
+	c := require.New(t)
+
+	now := time.Date(2022, time.July, 21, 10, 0, 0, 0, time.UTC)
+
+	detector := NewDetector(1, time.Minute)
+	detector.RestrictedLimit = 5
+	detector.RestrictFor = time.Hour
+	detector.now = func() time.Time { return now }
+
+	// keys not restricted are never warned
+	c.Nil(detector.Observe("key1", "/application").Warning)
+	c.Nil(detector.Observe("key1", "/application").Warning)
+
+	now = now.Add(time.Minute + 15*time.Second)
+
+	for i := 0; i < 3; i++ {
+		c.Nil(detector.Observe("key1", "/application").Warning)
+	}
+
+	// the warning starts at 80% of the restricted limit
+	decision := detector.Observe("key1", "/load_balancer")
+	c.True(decision.Allowed)
+	c.Equal(&Warning{Count: 4, Limit: 5, ResetIn: 45 * time.Second, First: true}, decision.Warning)
+
+	decision = detector.Observe("key1", "/application")
+	c.True(decision.Allowed)
+	c.Equal(5, decision.Warning.Count)
+	c.False(decision.Warning.First)
+
+	decision = detector.Observe("key1", "/application")
+	c.False(decision.Allowed)
+	c.Nil(decision.Warning)
+
+	now = now.Add(time.Minute)
+
+	c.Nil(detector.Observe("key1", "/application").Warning)
+}
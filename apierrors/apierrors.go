@@ -0,0 +1,175 @@
+// Package apierrors holds the error codes of the API responses, so Go clients can branch on error categories
+// instead of matching the messages of the response bodies
+package apierrors
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// Code represents the category of an API error, sent as the code field of error responses
+type Code string
+
+const (
+	CodeBadRequest          Code = "bad_request"
+	CodeUnauthorized        Code = "unauthorized"
+	CodeForbidden           Code = "forbidden"
+	CodeNotFound            Code = "not_found"
+	CodeMethodNotAllowed    Code = "method_not_allowed"
+	CodeConflict            Code = "conflict"
+	CodeGone                Code = "gone"
+	CodePayloadTooLarge     Code = "payload_too_large"
+	CodeUnprocessableEntity Code = "unprocessable_entity"
+	CodeTooManyRequests     Code = "too_many_requests"
+	CodeInternal            Code = "internal"
+	CodeBadGateway          Code = "bad_gateway"
+
+	// CodeNameConflict is a conflict with another load balancer of the user with the same name, see ConflictingID
+	CodeNameConflict Code = "name_conflict"
+	// CodeApplicationNameConflict is a conflict with another application of the user with the same name,
+	// see ConflictingID and Suggestions
+	CodeApplicationNameConflict Code = "application_name_conflict"
+	// CodeBlockchainReferenced is a conflict with the redirects and applications still using a blockchain,
+	// see Redirects and ApplicationIDs
+	CodeBlockchainReferenced Code = "blockchain_referenced"
+	// CodeApplicationsVersionConflict is a conflict with a concurrent change of the applications of a load balancer,
+	// see ApplicationIDs and Version
+	CodeApplicationsVersionConflict Code = "applications_version_conflict"
+	// CodeStickyOriginConflict is a conflict with another load balancer using the same sticky origin,
+	// see Origin and ConflictingID
+	CodeStickyOriginConflict Code = "sticky_origin_conflict"
+)
+
+// statusCodes maps HTTP status codes to the code of the errors without a more specific one
+var statusCodes = map[int]Code{
+	http.StatusBadRequest:            CodeBadRequest,
+	http.StatusUnauthorized:          CodeUnauthorized,
+	http.StatusForbidden:             CodeForbidden,
+	http.StatusNotFound:              CodeNotFound,
+	http.StatusMethodNotAllowed:      CodeMethodNotAllowed,
+	http.StatusConflict:              CodeConflict,
+	http.StatusGone:                  CodeGone,
+	http.StatusRequestEntityTooLarge: CodePayloadTooLarge,
+	http.StatusUnprocessableEntity:   CodeUnprocessableEntity,
+	http.StatusTooManyRequests:       CodeTooManyRequests,
+	http.StatusInternalServerError:   CodeInternal,
+	http.StatusBadGateway:            CodeBadGateway,
+}
+
+// CodeFromStatus returns the code of the errors responded with the HTTP status code
+func CodeFromStatus(status int) Code {
+	if code, ok := statusCodes[status]; ok {
+		return code
+	}
+
+	if status >= http.StatusInternalServerError {
+		return CodeInternal
+	}
+
+	return CodeBadRequest
+}
+
+// Error represents an error response of the API
+type Error struct {
+	StatusCode int    `json:"-"`
+	Message    string `json:"error"`
+	Code       Code   `json:"code"`
+	// the fields below are only set on the conflicts of the matching codes
+	ConflictingID  string   `json:"conflictingID,omitempty"`
+	Suggestions    []string `json:"suggestions,omitempty"`
+	Redirects      []string `json:"redirects,omitempty"`
+	ApplicationIDs []string `json:"applicationIDs,omitempty"`
+	Version        string   `json:"version,omitempty"`
+	Origin         string   `json:"origin,omitempty"`
+	// Details maps the invalid fields to the reason they are invalid, set on the invalid request bodies and settings
+	Details map[string]string `json:"details,omitempty"`
+}
+
+// New returns an Error with the code of status
+func New(status int, message string) *Error {
+	return &Error{
+		StatusCode: status,
+		Message:    message,
+		Code:       CodeFromStatus(status),
+	}
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// FromResponse returns the Error of resp, nil if its status is not an error
+// the body of resp is read but not closed
+func FromResponse(resp *http.Response) error {
+	if resp.StatusCode < http.StatusBadRequest {
+		return nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("read error response failed: %w", err)
+	}
+
+	apiErr := &Error{}
+
+	// bodies that are not error responses, such as the ones of proxies, keep the status code and text
+	if json.Unmarshal(body, apiErr) != nil || apiErr.Message == "" {
+		apiErr.Message = http.StatusText(resp.StatusCode)
+	}
+
+	apiErr.StatusCode = resp.StatusCode
+
+	if apiErr.Code == "" {
+		apiErr.Code = CodeFromStatus(resp.StatusCode)
+	}
+
+	return apiErr
+}
+
+// CodeOf returns the code of err, empty if it is not an API error
+func CodeOf(err error) Code {
+	var apiErr *Error
+	if errors.As(err, &apiErr) {
+		return apiErr.Code
+	}
+
+	return ""
+}
+
+// StatusOf returns the HTTP status code of err, 0 if it is not an API error
+func StatusOf(err error) int {
+	var apiErr *Error
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode
+	}
+
+	return 0
+}
+
+// IsNotFound returns true if err is an API error for a missing entity or route
+func IsNotFound(err error) bool {
+	return StatusOf(err) == http.StatusNotFound
+}
+
+// IsBadRequest returns true if err is an API error for an invalid request
+func IsBadRequest(err error) bool {
+	return StatusOf(err) == http.StatusBadRequest
+}
+
+// IsConflict returns true if err is an API error for a conflict with the current state, whatever its code
+func IsConflict(err error) bool {
+	return StatusOf(err) == http.StatusConflict
+}
+
+// IsUnauthorized returns true if err is an API error for a missing or unknown API key
+func IsUnauthorized(err error) bool {
+	return StatusOf(err) == http.StatusUnauthorized
+}
+
+// IsTooManyRequests returns true if err is an API error for a rate limited request
+func IsTooManyRequests(err error) bool {
+	return StatusOf(err) == http.StatusTooManyRequests
+}
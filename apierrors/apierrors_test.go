@@ -0,0 +1,65 @@
+package apierrors
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func response(status int, body string) *http.Response {
+	return &http.Response{StatusCode: status, Body: io.NopCloser(strings.NewReader(body))}
+}
+
+func TestFromResponse(t *testing.T) {
+	c := require.New(t)
+
+	c.NoError(FromResponse(response(http.StatusOK, `{}`)))
+
+	err := FromResponse(response(http.StatusNotFound, `{"error":"applications not found","code":"not_found"}`))
+	c.EqualError(err, "applications not found")
+	c.True(IsNotFound(err))
+	c.False(IsConflict(err))
+	c.Equal(CodeNotFound, CodeOf(err))
+
+	err = FromResponse(response(http.StatusConflict,
+		`{"error":"load balancer name already in use by user","code":"name_conflict","conflictingID":"60ecb2bf67774900350d9c42"}`))
+	c.True(IsConflict(err))
+	c.Equal(CodeNameConflict, CodeOf(err))
+
+	var apiErr *Error
+
+	c.True(errors.As(fmt.Errorf("create load balancer failed: %w", err), &apiErr))
+	c.Equal("60ecb2bf67774900350d9c42", apiErr.ConflictingID)
+
+	// responses without a code, such as the ones of older instances, get the code of their status
+	err = FromResponse(response(http.StatusTooManyRequests, `{"error":"slow down"}`))
+	c.True(IsTooManyRequests(err))
+	c.Equal(CodeTooManyRequests, CodeOf(err))
+
+	err = FromResponse(response(http.StatusBadGateway, `<html>bad gateway</html>`))
+	c.EqualError(err, "Bad Gateway")
+	c.Equal(CodeBadGateway, CodeOf(err))
+
+	err = FromResponse(response(http.StatusServiceUnavailable, ``))
+	c.Equal(CodeInternal, CodeOf(err))
+	c.Equal(http.StatusServiceUnavailable, StatusOf(err))
+
+	c.Empty(CodeOf(errors.New("dummy error")))
+	c.False(IsNotFound(errors.New("dummy error")))
+}
+
+func TestNew(t *testing.T) {
+	c := require.New(t)
+
+	err := New(http.StatusBadRequest, "invalid all")
+	c.True(IsBadRequest(err))
+	c.Equal(CodeBadRequest, err.Code)
+
+	c.Equal(CodeUnauthorized, CodeFromStatus(http.StatusUnauthorized))
+	c.Equal(CodeBadRequest, CodeFromStatus(http.StatusTeapot))
+}
@@ -0,0 +1,106 @@
+package cache
+
+import (
+	"sort"
+
+	"github.com/pokt-foundation/pocket-http-db/types"
+)
+
+// ApplicationFilterReader is implemented by readers holding named filters of applications
+// filters are not notified by the database, other instances only get filter changes on the next cache refresh
+type ApplicationFilterReader interface {
+	ReadApplicationFilters() ([]*types.ApplicationFilter, error)
+}
+
+// setApplicationFilters loads the filters of the reader, the cache has no filters if the reader does not hold them
+func (c *Cache) setApplicationFilters() error {
+	filters := make(map[string]*types.ApplicationFilter)
+
+	filterReader, ok := c.reader.(ApplicationFilterReader)
+	if !ok {
+		c.applicationFilters = filters
+		return nil
+	}
+
+	readFilters, err := filterReader.ReadApplicationFilters()
+	if err != nil {
+		return err
+	}
+
+	for _, filter := range readFilters {
+		filters[filter.Name] = copyApplicationFilter(filter)
+	}
+
+	c.applicationFilters = filters
+
+	return nil
+}
+
+// GetApplicationFilter returns a copy of the filter with given name, nil if there is none
+func (c *Cache) GetApplicationFilter(name string) *types.ApplicationFilter {
+	c.rwMutex.RLock()
+	defer c.rwMutex.RUnlock()
+
+	filter, ok := c.applicationFilters[name]
+	if !ok {
+		return nil
+	}
+
+	return copyApplicationFilter(filter)
+}
+
+// GetApplicationFilters returns a copy of every filter, sorted by name
+func (c *Cache) GetApplicationFilters() []*types.ApplicationFilter {
+	c.rwMutex.RLock()
+	defer c.rwMutex.RUnlock()
+
+	filters := make([]*types.ApplicationFilter, 0, len(c.applicationFilters))
+	for _, filter := range c.applicationFilters {
+		filters = append(filters, copyApplicationFilter(filter))
+	}
+
+	sort.Slice(filters, func(i, j int) bool {
+		return filters[i].Name < filters[j].Name
+	})
+
+	return filters
+}
+
+// SetApplicationFilter adds filter, replacing the filter with the same name
+func (c *Cache) SetApplicationFilter(filter *types.ApplicationFilter) {
+	changes := c.newChangeSet()
+	defer changes.dispatch()
+
+	c.rwMutex.Lock()
+	defer c.rwMutex.Unlock()
+
+	if c.applicationFilters == nil {
+		c.applicationFilters = make(map[string]*types.ApplicationFilter)
+	}
+
+	c.applicationFilters[filter.Name] = copyApplicationFilter(filter)
+	changes.add(types.EntityApplicationFilter, OperationUpdated, filter.Name)
+}
+
+// RemoveApplicationFilter removes the filter with given name
+func (c *Cache) RemoveApplicationFilter(name string) {
+	changes := c.newChangeSet()
+	defer changes.dispatch()
+
+	c.rwMutex.Lock()
+	defer c.rwMutex.Unlock()
+
+	if _, ok := c.applicationFilters[name]; !ok {
+		return
+	}
+
+	delete(c.applicationFilters, name)
+	changes.add(types.EntityApplicationFilter, OperationRemoved, name)
+}
+
+func copyApplicationFilter(filter *types.ApplicationFilter) *types.ApplicationFilter {
+	copied := *filter
+	copied.Labels = append([]string(nil), filter.Labels...)
+
+	return &copied
+}
@@ -0,0 +1,56 @@
+package cache
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/pokt-foundation/pocket-http-db/types"
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+func newApplicationFilterReaderMock(filters []*types.ApplicationFilter, err error) *ApplicationFilterReaderMock {
+	readerMock := &ReaderMock{}
+
+	readerMock.On("ReadApplications").Return([]*repository.Application{}, nil)
+	readerMock.On("ReadBlockchains").Return([]*repository.Blockchain{}, nil)
+	readerMock.On("ReadLoadBalancers").Return([]*repository.LoadBalancer{}, nil)
+	readerMock.On("ReadPayPlans").Return([]*repository.PayPlan{}, nil)
+	readerMock.On("ReadRedirects").Return([]*repository.Redirect{}, nil)
+	readerMock.On("ReadApplicationFilters").Return(filters, err)
+
+	return &ApplicationFilterReaderMock{ReaderMock: readerMock}
+}
+
+func TestCache_ApplicationFilters(t *testing.T) {
+	c := require.New(t)
+
+	cache := NewCache(newApplicationFilterReaderMock([]*types.ApplicationFilter{
+		{Name: "infra-apps", Labels: []string{"team:infra"}},
+	}, nil), logrus.New())
+
+	c.NoError(cache.SetCache())
+
+	filter := cache.GetApplicationFilter("infra-apps")
+	c.Equal([]string{"team:infra"}, filter.Labels)
+
+	// returned filters are copies
+	filter.Labels[0] = "team:relays"
+	c.Equal("team:infra", cache.GetApplicationFilter("infra-apps").Labels[0])
+
+	c.Nil(cache.GetApplicationFilter("wrong"))
+
+	cache.SetApplicationFilter(&types.ApplicationFilter{Name: "enterprise-apps", Status: repository.InService})
+
+	filters := cache.GetApplicationFilters()
+	c.Len(filters, 2)
+	c.Equal("enterprise-apps", filters[0].Name)
+	c.Equal("infra-apps", filters[1].Name)
+
+	cache.RemoveApplicationFilter("infra-apps")
+	c.Len(cache.GetApplicationFilters(), 1)
+
+	failingCache := NewCache(newApplicationFilterReaderMock(nil, errors.New("dummy error")), logrus.New())
+	c.Error(failingCache.SetCache())
+}
@@ -2,8 +2,13 @@ package cache
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
 
+	"github.com/pokt-foundation/pocket-http-db/types"
 	"github.com/pokt-foundation/portal-api-go/repository"
 	"github.com/sirupsen/logrus"
 )
@@ -24,23 +29,46 @@ type Cache struct {
 	rwMutex                    sync.RWMutex
 	applicationsMap            map[string]*repository.Application
 	applicationsMapByUserID    map[string][]*repository.Application
+	applicationsMapByAddress   map[string]*repository.Application
 	applications               []*repository.Application
 	blockchainsMap             map[string]*repository.Blockchain
 	blockchains                []*repository.Blockchain
 	loadBalancersMap           map[string]*repository.LoadBalancer
 	loadBalancersMapByUserID   map[string][]*repository.LoadBalancer
+	loadBalancersMapByOrigin   map[string][]*repository.LoadBalancer
 	loadBalancers              []*repository.LoadBalancer
 	payPlansMap                map[repository.PayPlanType]*repository.PayPlan
 	payPlans                   []*repository.PayPlan
 	redirectsMapByBlockchainID map[string][]*repository.Redirect
+	labels                     map[types.EntityType]map[string]map[string]string
+	applicationFilters         map[string]*types.ApplicationFilter
 	listening                  bool
+	generation                 uint64
+	refreshedAt                time.Time
+	// refreshing is the reload in flight started by Refresh, lastRefresh the last finished one
+	// refreshErrors counts the failed reloads, full or of a single entity
+	refreshMutex               sync.Mutex
+	refreshing                 *refreshCall
+	lastRefresh                *refreshCall
+	lastRefreshDuration        time.Duration
+	refreshErrors              uint64
 	pendingGatewayAAT          map[string]repository.GatewayAAT
 	pendingGatewaySettings     map[string]repository.GatewaySettings
 	pendingNotifactionSettings map[string]repository.NotificationSettings
 	pendingSyncCheckOptions    map[string]repository.SyncCheckOptions
 	pendingStickyOptions       map[string]repository.StickyOptions
 	pendingLbApps              map[string][]repository.LbApp
-	log                        *logrus.Logger
+	// readThroughMutex keeps concurrent misses of the same application from adding it twice, and guards
+	// the applications the reader did not hold along with when they can be read again
+	readThroughMutex sync.Mutex
+	misses           map[string]time.Time
+	missTTL          time.Duration
+	// hooks are the subscribers of OnChange, guarded apart from the cache so they can be called without its lock
+	hooksMutex sync.Mutex
+	hooks      []*hookEntry
+	// lookups holds the lookupObserver, read without taking the cache lock
+	lookups atomic.Value
+	log     *logrus.Logger
 }
 
 // NewCache returns cache instance from reader interface
@@ -59,18 +87,32 @@ func NewCache(reader Reader, logger *logrus.Logger) *Cache {
 
 // GetApplication returns Application from cache by applicationID
 func (c *Cache) GetApplication(applicationID string) *repository.Application {
+	defer c.observeLookup(IndexApplicationID, time.Now())
+
 	c.rwMutex.RLock()
 	defer c.rwMutex.RUnlock()
 
-	return c.applicationsMap[applicationID]
+	return copyApplication(c.applicationsMap[applicationID])
 }
 
 // GetApplicationsByUserID returns Applications from cache by userID
 func (c *Cache) GetApplicationsByUserID(userID string) []*repository.Application {
+	defer c.observeLookup(IndexApplicationUserID, time.Now())
+
 	c.rwMutex.RLock()
 	defer c.rwMutex.RUnlock()
 
-	return c.applicationsMapByUserID[userID]
+	return copyApplications(c.applicationsMapByUserID[userID])
+}
+
+// GetApplicationByAddress returns Application from cache by the address of its gateway AAT, case insensitive
+func (c *Cache) GetApplicationByAddress(address string) *repository.Application {
+	defer c.observeLookup(IndexApplicationAddress, time.Now())
+
+	c.rwMutex.RLock()
+	defer c.rwMutex.RUnlock()
+
+	return copyApplication(c.applicationsMapByAddress[strings.ToLower(address)])
 }
 
 // GetApplications returns all Applications in cache
@@ -78,15 +120,17 @@ func (c *Cache) GetApplications() []*repository.Application {
 	c.rwMutex.RLock()
 	defer c.rwMutex.RUnlock()
 
-	return c.applications
+	return copyApplications(c.applications)
 }
 
 // GetBlockchain returns Blockchain from cache by blockchainID
 func (c *Cache) GetBlockchain(blockchainID string) *repository.Blockchain {
+	defer c.observeLookup(IndexBlockchainID, time.Now())
+
 	c.rwMutex.RLock()
 	defer c.rwMutex.RUnlock()
 
-	return c.blockchainsMap[blockchainID]
+	return copyBlockchain(c.blockchainsMap[blockchainID])
 }
 
 // GetBlockchains returns all Blockchains from cache
@@ -94,15 +138,17 @@ func (c *Cache) GetBlockchains() []*repository.Blockchain {
 	c.rwMutex.RLock()
 	defer c.rwMutex.RUnlock()
 
-	return c.blockchains
+	return copyBlockchains(c.blockchains)
 }
 
 // GetLoadBalancer returns Loadbalancer by loadbalancerID
 func (c *Cache) GetLoadBalancer(loadBalancerID string) *repository.LoadBalancer {
+	defer c.observeLookup(IndexLoadBalancerID, time.Now())
+
 	c.rwMutex.RLock()
 	defer c.rwMutex.RUnlock()
 
-	return c.loadBalancersMap[loadBalancerID]
+	return copyLoadBalancer(c.loadBalancersMap[loadBalancerID])
 }
 
 // GetLoadBalancers returns all Loadbalancers on cache
@@ -110,22 +156,46 @@ func (c *Cache) GetLoadBalancers() []*repository.LoadBalancer {
 	c.rwMutex.RLock()
 	defer c.rwMutex.RUnlock()
 
-	return c.loadBalancers
+	return copyLoadBalancers(c.loadBalancers)
 }
 
 func (c *Cache) GetLoadBalancersByUserID(userID string) []*repository.LoadBalancer {
+	defer c.observeLookup(IndexLoadBalancerUserID, time.Now())
+
+	c.rwMutex.RLock()
+	defer c.rwMutex.RUnlock()
+
+	return copyLoadBalancers(c.loadBalancersMapByUserID[userID])
+}
+
+// GetLoadBalancersByStickyOrigin returns the Loadbalancers whose sticky origins include origin
+// origins are compared case insensitive and without trailing slash
+func (c *Cache) GetLoadBalancersByStickyOrigin(origin string) []*repository.LoadBalancer {
+	defer c.observeLookup(IndexLoadBalancerOrigin, time.Now())
+
+	c.rwMutex.RLock()
+	defer c.rwMutex.RUnlock()
+
+	return copyLoadBalancers(c.loadBalancersMapByOrigin[originKey(origin)])
+}
+
+// Generation returns how many times the cache has been fully loaded and when it was last loaded
+// changes received from notifications in between do not start a new generation
+func (c *Cache) Generation() (uint64, time.Time) {
 	c.rwMutex.RLock()
 	defer c.rwMutex.RUnlock()
 
-	return c.loadBalancersMapByUserID[userID]
+	return c.generation, c.refreshedAt
 }
 
 // GetPayPlan returns PayPlan from cache by planType
 func (c *Cache) GetPayPlan(planType repository.PayPlanType) *repository.PayPlan {
+	defer c.observeLookup(IndexPayPlanType, time.Now())
+
 	c.rwMutex.RLock()
 	defer c.rwMutex.RUnlock()
 
-	return c.payPlansMap[planType]
+	return copyPayPlan(c.payPlansMap[planType])
 }
 
 // GetPayPlans returns all PayPlans in cache
@@ -133,7 +203,7 @@ func (c *Cache) GetPayPlans() []*repository.PayPlan {
 	c.rwMutex.RLock()
 	defer c.rwMutex.RUnlock()
 
-	return c.payPlans
+	return copyPayPlans(c.payPlans)
 }
 
 // GetRedirects returns all Redirects from cache by blockchainID
@@ -141,7 +211,489 @@ func (c *Cache) GetRedirects(blockchainID string) []*repository.Redirect {
 	c.rwMutex.RLock()
 	defer c.rwMutex.RUnlock()
 
-	return c.redirectsMapByBlockchainID[blockchainID]
+	return copyRedirects(c.redirectsMapByBlockchainID[blockchainID])
+}
+
+// GetAllRedirects returns the Redirects of every blockchain from cache, by blockchainID
+func (c *Cache) GetAllRedirects() []*repository.Redirect {
+	c.rwMutex.RLock()
+	defer c.rwMutex.RUnlock()
+
+	blockchainIDs := make([]string, 0, len(c.redirectsMapByBlockchainID))
+	for blockchainID := range c.redirectsMapByBlockchainID {
+		blockchainIDs = append(blockchainIDs, blockchainID)
+	}
+
+	sort.Strings(blockchainIDs)
+
+	redirects := []*repository.Redirect{}
+	for _, blockchainID := range blockchainIDs {
+		redirects = append(redirects, copyRedirects(c.redirectsMapByBlockchainID[blockchainID])...)
+	}
+
+	return redirects
+}
+
+// GetRedirect returns the Redirect with given id from cache, nil if there is none
+func (c *Cache) GetRedirect(id string) *repository.Redirect {
+	c.rwMutex.RLock()
+	defer c.rwMutex.RUnlock()
+
+	if id == "" {
+		return nil
+	}
+
+	for _, redirects := range c.redirectsMapByBlockchainID {
+		for _, redirect := range redirects {
+			if redirect.ID == id {
+				redirectCopy := *redirect
+				return &redirectCopy
+			}
+		}
+	}
+
+	return nil
+}
+
+// RemoveRedirect removes the redirect with given id from cache, including from its blockchain
+func (c *Cache) RemoveRedirect(id string) {
+	changes := c.newChangeSet()
+	defer changes.dispatch()
+
+	c.rwMutex.Lock()
+	defer c.rwMutex.Unlock()
+
+	if id == "" {
+		return
+	}
+
+	for blockchainID, redirects := range c.redirectsMapByBlockchainID {
+		kept := make([]*repository.Redirect, 0, len(redirects))
+
+		for _, redirect := range redirects {
+			if redirect.ID != id {
+				kept = append(kept, redirect)
+			}
+		}
+
+		if len(kept) == len(redirects) {
+			continue
+		}
+
+		c.redirectsMapByBlockchainID[blockchainID] = kept
+
+		if blockchain := c.blockchainsMap[blockchainID]; blockchain != nil {
+			blockchainRedirects := make([]repository.Redirect, 0, len(kept))
+			for _, redirect := range blockchain.Redirects {
+				if redirect.ID != id {
+					blockchainRedirects = append(blockchainRedirects, redirect)
+				}
+			}
+
+			blockchain.Redirects = blockchainRedirects
+		}
+
+		changes.add(types.EntityRedirect, OperationRemoved, id)
+
+		return
+	}
+}
+
+// RemoveApplications removes the applications in ids from cache, including from their load balancers
+func (c *Cache) RemoveApplications(ids ...string) {
+	changes := c.newChangeSet()
+	defer changes.dispatch()
+
+	c.rwMutex.Lock()
+	defer c.rwMutex.Unlock()
+
+	removed := make(map[string]bool, len(ids))
+
+	for _, id := range ids {
+		app := c.applicationsMap[id]
+		if app == nil {
+			continue
+		}
+
+		removed[id] = true
+		changes.add(types.EntityApplication, OperationRemoved, id)
+
+		delete(c.applicationsMap, id)
+		c.removeAddressIndex(app)
+		c.applicationsMapByUserID[app.UserID] = withoutApplications(c.applicationsMapByUserID[app.UserID], removed)
+	}
+
+	if len(removed) == 0 {
+		return
+	}
+
+	c.applications = withoutApplications(c.applications, removed)
+
+	for _, lb := range c.loadBalancers {
+		apps := withoutApplications(lb.Applications, removed)
+		if len(apps) != len(lb.Applications) {
+			changes.add(types.EntityLoadBalancer, OperationUpdated, lb.ID)
+		}
+
+		lb.Applications = apps
+	}
+}
+
+// RemoveLoadBalancers removes the load balancers in ids from cache
+func (c *Cache) RemoveLoadBalancers(ids ...string) {
+	changes := c.newChangeSet()
+	defer changes.dispatch()
+
+	c.rwMutex.Lock()
+	defer c.rwMutex.Unlock()
+
+	removed := make(map[string]bool, len(ids))
+
+	for _, id := range ids {
+		lb := c.loadBalancersMap[id]
+		if lb == nil {
+			continue
+		}
+
+		removed[id] = true
+		changes.add(types.EntityLoadBalancer, OperationRemoved, id)
+
+		delete(c.loadBalancersMap, id)
+		c.removeOriginIndex(lb)
+		c.loadBalancersMapByUserID[lb.UserID] = withoutLoadBalancers(c.loadBalancersMapByUserID[lb.UserID], removed)
+	}
+
+	if len(removed) == 0 {
+		return
+	}
+
+	c.loadBalancers = withoutLoadBalancers(c.loadBalancers, removed)
+}
+
+// RemoveBlockchain removes the blockchain with given id from cache, along with its redirects
+func (c *Cache) RemoveBlockchain(id string) {
+	changes := c.newChangeSet()
+	defer changes.dispatch()
+
+	c.rwMutex.Lock()
+	defer c.rwMutex.Unlock()
+
+	if c.blockchainsMap[id] == nil {
+		return
+	}
+
+	delete(c.blockchainsMap, id)
+	delete(c.redirectsMapByBlockchainID, id)
+
+	kept := make([]*repository.Blockchain, 0, len(c.blockchains))
+
+	for _, blockchain := range c.blockchains {
+		if blockchain.ID != id {
+			kept = append(kept, blockchain)
+		}
+	}
+
+	c.blockchains = kept
+
+	changes.add(types.EntityBlockchain, OperationRemoved, id)
+}
+
+// TransferApplication moves the application with given id to the user with userID, updating the user index
+func (c *Cache) TransferApplication(appID, userID string) {
+	changes := c.newChangeSet()
+	defer changes.dispatch()
+
+	c.rwMutex.Lock()
+	defer c.rwMutex.Unlock()
+
+	app := c.applicationsMap[appID]
+	if app == nil {
+		return
+	}
+
+	c.setApplicationUserID(app, userID)
+	changes.add(types.EntityApplication, OperationUpdated, appID)
+}
+
+// TransferLoadBalancer moves the load balancer with given id to the user with userID, updating the user index
+// an empty userID leaves the load balancer without user, as load balancers are removed
+func (c *Cache) TransferLoadBalancer(lbID, userID string) {
+	changes := c.newChangeSet()
+	defer changes.dispatch()
+
+	c.rwMutex.Lock()
+	defer c.rwMutex.Unlock()
+
+	lb := c.loadBalancersMap[lbID]
+	if lb == nil {
+		return
+	}
+
+	c.setLoadBalancerUserID(lb, userID)
+	changes.add(types.EntityLoadBalancer, OperationUpdated, lbID)
+}
+
+// SetLoadBalancerApplications replaces the applications of the load balancer with given id
+// IDs of applications not in the cache are kept as nil, as load balancers are loaded
+func (c *Cache) SetLoadBalancerApplications(lbID string, appIDs []string) {
+	changes := c.newChangeSet()
+	defer changes.dispatch()
+
+	c.rwMutex.Lock()
+	defer c.rwMutex.Unlock()
+
+	lb := c.loadBalancersMap[lbID]
+	if lb == nil {
+		return
+	}
+
+	apps := make([]*repository.Application, 0, len(appIDs))
+	for _, appID := range appIDs {
+		apps = append(apps, c.applicationsMap[appID])
+	}
+
+	lb.Applications = apps
+	changes.add(types.EntityLoadBalancer, OperationUpdated, lbID)
+}
+
+// SetPayPlanDailyLimit sets the daily limit of the pay plan of planType, which applications added later get
+// the cached applications of the plan keep their limit until the next cache refresh, unless propagate is set
+// returns the applications whose limit was propagated, nil if the plan is not in the cache
+func (c *Cache) SetPayPlanDailyLimit(planType repository.PayPlanType, dailyLimit int, propagate bool) []*repository.Application {
+	changes := c.newChangeSet()
+	defer changes.dispatch()
+
+	c.rwMutex.Lock()
+	defer c.rwMutex.Unlock()
+
+	if c.payPlansMap[planType] == nil {
+		return nil
+	}
+
+	// the plan is replaced rather than modified, as readers may hold the previous one
+	plan := &repository.PayPlan{PlanType: planType, DailyLimit: dailyLimit}
+
+	payPlans := make([]*repository.PayPlan, 0, len(c.payPlans))
+	for _, payPlan := range c.payPlans {
+		if payPlan.PlanType == planType {
+			payPlan = plan
+		}
+
+		payPlans = append(payPlans, payPlan)
+	}
+
+	c.payPlans = payPlans
+	c.payPlansMap[planType] = plan
+	changes.add(types.EntityPayPlan, OperationUpdated, string(planType))
+
+	if !propagate {
+		return nil
+	}
+
+	apps := []*repository.Application{}
+
+	for _, app := range c.applications {
+		if app.Limits.PlanType == planType {
+			app.Limits.DailyLimit = dailyLimit
+			apps = append(apps, copyApplication(app))
+			changes.add(types.EntityApplication, OperationUpdated, app.ID)
+		}
+	}
+
+	return apps
+}
+
+// AddPayPlan adds the pay plan to cache, replacing the plan of the same type
+func (c *Cache) AddPayPlan(plan *repository.PayPlan) {
+	changes := c.newChangeSet()
+	defer changes.dispatch()
+
+	c.rwMutex.Lock()
+	defer c.rwMutex.Unlock()
+
+	added := copyPayPlan(plan)
+
+	payPlans := make([]*repository.PayPlan, 0, len(c.payPlans)+1)
+	for _, payPlan := range c.payPlans {
+		if payPlan.PlanType != plan.PlanType {
+			payPlans = append(payPlans, payPlan)
+		}
+	}
+
+	c.payPlans = append(payPlans, added)
+	c.payPlansMap[plan.PlanType] = added
+	changes.add(types.EntityPayPlan, OperationCreated, string(plan.PlanType))
+}
+
+// RemovePayPlan removes the pay plan of planType from cache, the cached applications keep their limits
+func (c *Cache) RemovePayPlan(planType repository.PayPlanType) {
+	changes := c.newChangeSet()
+	defer changes.dispatch()
+
+	c.rwMutex.Lock()
+	defer c.rwMutex.Unlock()
+
+	if c.payPlansMap[planType] == nil {
+		return
+	}
+
+	payPlans := make([]*repository.PayPlan, 0, len(c.payPlans))
+	for _, payPlan := range c.payPlans {
+		if payPlan.PlanType != planType {
+			payPlans = append(payPlans, payPlan)
+		}
+	}
+
+	c.payPlans = payPlans
+	delete(c.payPlansMap, planType)
+	changes.add(types.EntityPayPlan, OperationRemoved, string(planType))
+}
+
+// addressKey returns the key of aat in the address index, empty if it has no address
+func addressKey(aat repository.GatewayAAT) string {
+	return strings.ToLower(aat.Address)
+}
+
+// setAddressIndex indexes app by the address of its gateway AAT, the lock must be held
+func (c *Cache) setAddressIndex(app *repository.Application) {
+	if key := addressKey(app.GatewayAAT); key != "" {
+		c.applicationsMapByAddress[key] = app
+	}
+}
+
+// removeAddressIndex removes app from the address index, the lock must be held
+func (c *Cache) removeAddressIndex(app *repository.Application) {
+	key := addressKey(app.GatewayAAT)
+
+	if indexed := c.applicationsMapByAddress[key]; indexed != nil && indexed.ID == app.ID {
+		delete(c.applicationsMapByAddress, key)
+	}
+}
+
+// setApplicationUserID sets userID to app and moves it between the user index entries, the lock must be held
+func (c *Cache) setApplicationUserID(app *repository.Application, userID string) {
+	if app.UserID == userID {
+		return
+	}
+
+	c.applicationsMapByUserID[app.UserID] = withoutApplications(c.applicationsMapByUserID[app.UserID], map[string]bool{app.ID: true})
+	if len(c.applicationsMapByUserID[app.UserID]) == 0 {
+		delete(c.applicationsMapByUserID, app.UserID)
+	}
+
+	app.UserID = userID
+	c.applicationsMapByUserID[userID] = append(c.applicationsMapByUserID[userID], app)
+}
+
+// setLoadBalancerUserID sets userID to lb and moves it between the user index entries, the lock must be held
+func (c *Cache) setLoadBalancerUserID(lb *repository.LoadBalancer, userID string) {
+	if lb.UserID == userID {
+		return
+	}
+
+	c.loadBalancersMapByUserID[lb.UserID] = withoutLoadBalancers(c.loadBalancersMapByUserID[lb.UserID], map[string]bool{lb.ID: true})
+	if len(c.loadBalancersMapByUserID[lb.UserID]) == 0 {
+		delete(c.loadBalancersMapByUserID, lb.UserID)
+	}
+
+	lb.UserID = userID
+	c.loadBalancersMapByUserID[userID] = append(c.loadBalancersMapByUserID[userID], lb)
+}
+
+// SetLoadBalancerStickyOptions sets opts to the load balancer with given id, updating the sticky origin index
+func (c *Cache) SetLoadBalancerStickyOptions(lbID string, opts repository.StickyOptions) {
+	changes := c.newChangeSet()
+	defer changes.dispatch()
+
+	c.rwMutex.Lock()
+	defer c.rwMutex.Unlock()
+
+	lb := c.loadBalancersMap[lbID]
+	if lb == nil {
+		return
+	}
+
+	c.setStickyOptions(lb, opts)
+	changes.add(types.EntityLoadBalancer, OperationUpdated, lbID)
+}
+
+// setStickyOptions sets opts to lb and reindexes it by its sticky origins, the lock must be held
+func (c *Cache) setStickyOptions(lb *repository.LoadBalancer, opts repository.StickyOptions) {
+	c.removeOriginIndex(lb)
+	lb.StickyOptions = opts
+	c.setOriginIndex(lb)
+}
+
+// originKey returns the key of origin in the sticky origin index
+func originKey(origin string) string {
+	return strings.TrimSuffix(strings.ToLower(strings.TrimSpace(origin)), "/")
+}
+
+// originKeys returns the distinct non empty origin index keys of lb
+func originKeys(lb *repository.LoadBalancer) []string {
+	var keys []string
+
+	seen := map[string]bool{}
+
+	for _, origin := range lb.StickyOptions.StickyOrigins {
+		key := originKey(origin)
+		if key == "" || seen[key] {
+			continue
+		}
+
+		seen[key] = true
+		keys = append(keys, key)
+	}
+
+	return keys
+}
+
+// setOriginIndex indexes lb by its sticky origins, the lock must be held
+func (c *Cache) setOriginIndex(lb *repository.LoadBalancer) {
+	for _, key := range originKeys(lb) {
+		c.loadBalancersMapByOrigin[key] = append(c.loadBalancersMapByOrigin[key], lb)
+	}
+}
+
+// removeOriginIndex removes lb from the sticky origin index, the lock must be held
+func (c *Cache) removeOriginIndex(lb *repository.LoadBalancer) {
+	for _, key := range originKeys(lb) {
+		c.loadBalancersMapByOrigin[key] = withoutLoadBalancers(c.loadBalancersMapByOrigin[key], map[string]bool{lb.ID: true})
+		if len(c.loadBalancersMapByOrigin[key]) == 0 {
+			delete(c.loadBalancersMapByOrigin, key)
+		}
+	}
+}
+
+// withoutApplications returns a copy of apps without the ones in removed
+// a copy is made so slices already handed to readers are not modified
+func withoutApplications(apps []*repository.Application, removed map[string]bool) []*repository.Application {
+	kept := make([]*repository.Application, 0, len(apps))
+
+	for _, app := range apps {
+		if app != nil && removed[app.ID] {
+			continue
+		}
+
+		kept = append(kept, app)
+	}
+
+	return kept
+}
+
+// withoutLoadBalancers returns a copy of lbs without the ones in removed
+func withoutLoadBalancers(lbs []*repository.LoadBalancer, removed map[string]bool) []*repository.LoadBalancer {
+	kept := make([]*repository.LoadBalancer, 0, len(lbs))
+
+	for _, lb := range lbs {
+		if removed[lb.ID] {
+			continue
+		}
+
+		kept = append(kept, lb)
+	}
+
+	return kept
 }
 
 func (c *Cache) setApplications() error {
@@ -152,6 +704,7 @@ func (c *Cache) setApplications() error {
 
 	applicationsMap := make(map[string]*repository.Application)
 	applicationsMapByUserID := make(map[string][]*repository.Application)
+	applicationsMapByAddress := make(map[string]*repository.Application)
 
 	for i := 0; i < len(applications); i++ {
 		plan := c.payPlansMap[applications[i].PayPlanType]
@@ -167,11 +720,16 @@ func (c *Cache) setApplications() error {
 
 		applicationsMap[applications[i].ID] = applications[i]
 		applicationsMapByUserID[applications[i].UserID] = append(applicationsMapByUserID[applications[i].UserID], applications[i])
+
+		if key := addressKey(applications[i].GatewayAAT); key != "" {
+			applicationsMapByAddress[key] = applications[i]
+		}
 	}
 
 	c.applications = applications
 	c.applicationsMap = applicationsMap
 	c.applicationsMapByUserID = applicationsMapByUserID
+	c.applicationsMapByAddress = applicationsMapByAddress
 
 	return nil
 }
@@ -188,6 +746,9 @@ func (c *Cache) addApplication(app repository.Application) {
 		app.PayPlanType = "" // set to empty to avoid two sources of truth
 	}
 
+	changes := c.newChangeSet()
+	defer changes.dispatch()
+
 	c.rwMutex.Lock()
 	defer c.rwMutex.Unlock()
 
@@ -212,9 +773,15 @@ func (c *Cache) addApplication(app repository.Application) {
 	c.applications = append(c.applications, &app)
 	c.applicationsMap[app.ID] = &app
 	c.applicationsMapByUserID[app.UserID] = append(c.applicationsMapByUserID[app.UserID], &app)
+	c.setAddressIndex(&app)
+
+	changes.add(types.EntityApplication, OperationCreated, app.ID)
 }
 
 func (c *Cache) addGatewayAAT(aat repository.GatewayAAT) {
+	changes := c.newChangeSet()
+	defer changes.dispatch()
+
 	c.rwMutex.Lock()
 	defer c.rwMutex.Unlock()
 
@@ -223,7 +790,11 @@ func (c *Cache) addGatewayAAT(aat repository.GatewayAAT) {
 
 	app := c.applicationsMap[appID]
 	if app != nil {
+		c.removeAddressIndex(app)
 		app.GatewayAAT = aat
+		c.setAddressIndex(app)
+		changes.add(types.EntityApplication, OperationUpdated, appID)
+
 		return
 	}
 
@@ -231,6 +802,9 @@ func (c *Cache) addGatewayAAT(aat repository.GatewayAAT) {
 }
 
 func (c *Cache) addGatewaySettings(settings repository.GatewaySettings) {
+	changes := c.newChangeSet()
+	defer changes.dispatch()
+
 	c.rwMutex.Lock()
 	defer c.rwMutex.Unlock()
 
@@ -240,6 +814,8 @@ func (c *Cache) addGatewaySettings(settings repository.GatewaySettings) {
 	app := c.applicationsMap[appID]
 	if app != nil {
 		app.GatewaySettings = settings
+		changes.add(types.EntityApplication, OperationUpdated, appID)
+
 		return
 	}
 
@@ -247,6 +823,9 @@ func (c *Cache) addGatewaySettings(settings repository.GatewaySettings) {
 }
 
 func (c *Cache) addNotificationSettings(settings repository.NotificationSettings) {
+	changes := c.newChangeSet()
+	defer changes.dispatch()
+
 	c.rwMutex.Lock()
 	defer c.rwMutex.Unlock()
 
@@ -256,6 +835,8 @@ func (c *Cache) addNotificationSettings(settings repository.NotificationSettings
 	app := c.applicationsMap[appID]
 	if app != nil {
 		app.NotificationSettings = settings
+		changes.add(types.EntityApplication, OperationUpdated, appID)
+
 		return
 	}
 
@@ -264,6 +845,9 @@ func (c *Cache) addNotificationSettings(settings repository.NotificationSettings
 
 // updateApplication updates application saved in cache
 func (c *Cache) updateApplication(inApp repository.Application) {
+	changes := c.newChangeSet()
+	defer changes.dispatch()
+
 	c.rwMutex.Lock()
 	defer c.rwMutex.Unlock()
 
@@ -277,10 +861,16 @@ func (c *Cache) updateApplication(inApp repository.Application) {
 		}
 	}
 
+	if inApp.UserID != "" {
+		c.setApplicationUserID(app, inApp.UserID)
+	}
+
 	app.Name = inApp.Name
 	app.Status = inApp.Status
 	app.FirstDateSurpassed = inApp.FirstDateSurpassed
 	app.UpdatedAt = inApp.UpdatedAt
+
+	changes.add(types.EntityApplication, OperationUpdated, app.ID)
 }
 
 func (c *Cache) setBlockchains() error {
@@ -311,6 +901,9 @@ func (c *Cache) setBlockchains() error {
 
 // addBlockchain adds blockchain to cache
 func (c *Cache) addBlockchain(blockchain repository.Blockchain) {
+	changes := c.newChangeSet()
+	defer changes.dispatch()
+
 	c.rwMutex.Lock()
 	defer c.rwMutex.Unlock()
 
@@ -322,15 +915,22 @@ func (c *Cache) addBlockchain(blockchain repository.Blockchain) {
 
 	c.blockchains = append(c.blockchains, &blockchain)
 	c.blockchainsMap[blockchain.ID] = &blockchain
+
+	changes.add(types.EntityBlockchain, OperationCreated, blockchain.ID)
 }
 
 func (c *Cache) addSyncOptions(opts repository.SyncCheckOptions) {
+	changes := c.newChangeSet()
+	defer changes.dispatch()
+
 	c.rwMutex.Lock()
 	defer c.rwMutex.Unlock()
 
 	blockchain := c.blockchainsMap[opts.BlockchainID]
 	if blockchain != nil {
 		blockchain.SyncCheckOptions = opts
+		changes.add(types.EntityBlockchain, OperationUpdated, opts.BlockchainID)
+
 		return
 	}
 
@@ -339,12 +939,17 @@ func (c *Cache) addSyncOptions(opts repository.SyncCheckOptions) {
 
 // updateBlockchain updates blockchain saved in cache
 func (c *Cache) updateBlockchain(inBlockchain repository.Blockchain) {
+	changes := c.newChangeSet()
+	defer changes.dispatch()
+
 	c.rwMutex.Lock()
 	defer c.rwMutex.Unlock()
 
 	blockchain := c.blockchainsMap[inBlockchain.ID]
 	blockchain.Active = inBlockchain.Active
 	blockchain.UpdatedAt = inBlockchain.UpdatedAt
+
+	changes.add(types.EntityBlockchain, OperationUpdated, blockchain.ID)
 }
 
 func (c *Cache) setLoadBalancers() error {
@@ -353,11 +958,17 @@ func (c *Cache) setLoadBalancers() error {
 		return fmt.Errorf("err in ReadLoadBalancers: %w", err)
 	}
 
+	appOrders, err := c.readLoadBalancerAppOrders()
+	if err != nil {
+		return fmt.Errorf("err in ReadLoadBalancerApplicationOrders: %w", err)
+	}
+
 	loadBalancersMap := make(map[string]*repository.LoadBalancer)
 	loadBalancersMapByUserID := make(map[string][]*repository.LoadBalancer)
+	loadBalancersMapByOrigin := make(map[string][]*repository.LoadBalancer)
 
 	for i, loadBalancer := range loadBalancers {
-		for _, appID := range loadBalancer.ApplicationIDs {
+		for _, appID := range orderApplicationIDs(loadBalancer.ApplicationIDs, appOrders[loadBalancer.ID]) {
 			loadBalancer.Applications = append(loadBalancer.Applications, c.applicationsMap[appID])
 		}
 
@@ -366,17 +977,25 @@ func (c *Cache) setLoadBalancers() error {
 		loadBalancers[i] = loadBalancer
 		loadBalancersMap[loadBalancer.ID] = loadBalancer
 		loadBalancersMapByUserID[loadBalancer.UserID] = append(loadBalancersMapByUserID[loadBalancer.UserID], loadBalancer)
+
+		for _, key := range originKeys(loadBalancer) {
+			loadBalancersMapByOrigin[key] = append(loadBalancersMapByOrigin[key], loadBalancer)
+		}
 	}
 
 	c.loadBalancers = loadBalancers
 	c.loadBalancersMap = loadBalancersMap
 	c.loadBalancersMapByUserID = loadBalancersMapByUserID
+	c.loadBalancersMapByOrigin = loadBalancersMapByOrigin
 
 	return nil
 }
 
 // addLoadBalancer adds load balancer to cache
 func (c *Cache) addLoadBalancer(lb repository.LoadBalancer) {
+	changes := c.newChangeSet()
+	defer changes.dispatch()
+
 	c.rwMutex.Lock()
 	defer c.rwMutex.Unlock()
 
@@ -397,9 +1016,15 @@ func (c *Cache) addLoadBalancer(lb repository.LoadBalancer) {
 	c.loadBalancers = append(c.loadBalancers, &lb)
 	c.loadBalancersMap[lb.ID] = &lb
 	c.loadBalancersMapByUserID[lb.UserID] = append(c.loadBalancersMapByUserID[lb.UserID], &lb)
+	c.setOriginIndex(&lb)
+
+	changes.add(types.EntityLoadBalancer, OperationCreated, lb.ID)
 }
 
 func (c *Cache) addStickinessOptions(opts repository.StickyOptions) {
+	changes := c.newChangeSet()
+	defer changes.dispatch()
+
 	c.rwMutex.Lock()
 	defer c.rwMutex.Unlock()
 
@@ -408,7 +1033,9 @@ func (c *Cache) addStickinessOptions(opts repository.StickyOptions) {
 
 	lb := c.loadBalancersMap[lbID]
 	if lb != nil {
-		lb.StickyOptions = opts
+		c.setStickyOptions(lb, opts)
+		changes.add(types.EntityLoadBalancer, OperationUpdated, lbID)
+
 		return
 	}
 
@@ -416,12 +1043,24 @@ func (c *Cache) addStickinessOptions(opts repository.StickyOptions) {
 }
 
 func (c *Cache) addLbApp(lbApp repository.LbApp) {
+	changes := c.newChangeSet()
+	defer changes.dispatch()
+
 	c.rwMutex.Lock()
 	defer c.rwMutex.Unlock()
 
 	lb := c.loadBalancersMap[lbApp.LbID]
 	if lb != nil {
+		// the application is already there when the change was applied by SetLoadBalancerApplications
+		for _, app := range lb.Applications {
+			if app != nil && app.ID == lbApp.AppID {
+				return
+			}
+		}
+
 		lb.Applications = append(lb.Applications, c.applicationsMap[lbApp.AppID])
+		changes.add(types.EntityLoadBalancer, OperationUpdated, lb.ID)
+
 		return
 	}
 
@@ -430,14 +1069,20 @@ func (c *Cache) addLbApp(lbApp repository.LbApp) {
 
 // updateLoadBalancer updates load balancer saved in cache
 func (c *Cache) updateLoadBalancer(inLb repository.LoadBalancer) {
+	changes := c.newChangeSet()
+	defer changes.dispatch()
+
 	c.rwMutex.Lock()
 	defer c.rwMutex.Unlock()
 
 	lb := c.loadBalancersMap[inLb.ID]
 
+	c.setLoadBalancerUserID(lb, inLb.UserID)
+
 	lb.Name = inLb.Name
-	lb.UserID = inLb.UserID
 	lb.UpdatedAt = inLb.UpdatedAt
+
+	changes.add(types.EntityLoadBalancer, OperationUpdated, lb.ID)
 }
 
 func (c *Cache) setPayPlans() error {
@@ -475,50 +1120,158 @@ func (c *Cache) setRedirects() error {
 	return nil
 }
 
+// AddRedirects adds the saved redirects to cache, so they are found by their IDs before their notifications arrive
+// the notifications of the redirects do not hold their IDs, the ones they find in cache are kept
+func (c *Cache) AddRedirects(redirects ...*repository.Redirect) {
+	changes := c.newChangeSet()
+	defer changes.dispatch()
+
+	c.rwMutex.Lock()
+	defer c.rwMutex.Unlock()
+
+	for _, redirect := range redirects {
+		c.upsertRedirect(changes, *redirect)
+	}
+}
+
 // AddRedirects adds blockchain redirect to cache and updates cached blockchain entry
 func (c *Cache) addRedirect(redirect repository.Redirect) {
+	changes := c.newChangeSet()
+	defer changes.dispatch()
+
 	c.rwMutex.Lock()
+	defer c.rwMutex.Unlock()
+
+	c.upsertRedirect(changes, redirect)
+}
+
+// upsertRedirect adds redirect to cache, unless its domain is already cached for its blockchain
+// in which case the missing ID of either the cached redirect or redirect is filled in
+func (c *Cache) upsertRedirect(changes *changeSet, redirect repository.Redirect) {
+	if redirect.Domain != "" {
+		for _, cached := range c.redirectsMapByBlockchainID[redirect.BlockchainID] {
+			if cached.Domain != redirect.Domain || (cached.ID != "" && redirect.ID != "" && cached.ID != redirect.ID) {
+				continue
+			}
+
+			if cached.ID == "" && redirect.ID != "" {
+				cached.ID = redirect.ID
+				c.setBlockchainRedirectID(redirect)
+			}
+
+			return
+		}
+	}
+
 	c.redirectsMapByBlockchainID[redirect.BlockchainID] = append(c.redirectsMapByBlockchainID[redirect.BlockchainID], &redirect)
-	c.rwMutex.Unlock()
 
-	blockchain := c.GetBlockchain(redirect.BlockchainID)
-	blockchain.Redirects = append(blockchain.Redirects, redirect)
+	if blockchain := c.blockchainsMap[redirect.BlockchainID]; blockchain != nil {
+		blockchain.Redirects = append(blockchain.Redirects, redirect)
+	}
+
+	changes.add(types.EntityRedirect, OperationCreated, redirect.ID)
+}
+
+// setBlockchainRedirectID sets the ID of redirect on the redirect with its domain of its cached blockchain
+func (c *Cache) setBlockchainRedirectID(redirect repository.Redirect) {
+	blockchain := c.blockchainsMap[redirect.BlockchainID]
+	if blockchain == nil {
+		return
+	}
+
+	for i := range blockchain.Redirects {
+		if blockchain.Redirects[i].Domain == redirect.Domain && blockchain.Redirects[i].ID == "" {
+			blockchain.Redirects[i].ID = redirect.ID
+		}
+	}
+}
+
+// loadSteps are the steps of a cache load, in the order setCache reports them done
+var loadSteps = []string{
+	"pay_plans", "redirects", "applications", "blockchains", "load_balancers", "labels", "application_filters",
 }
 
 // SetCache gets all values from DB and stores them in cache
 func (c *Cache) SetCache() error {
+	return c.setCache(func(string) {})
+}
+
+// setCache gets all values from DB and stores them in cache, calling stepDone with each step of loadSteps once done
+func (c *Cache) setCache(stepDone func(step string)) (err error) {
+	changes := c.newChangeSet()
+	defer changes.dispatch()
+
 	c.rwMutex.Lock()
 	defer c.rwMutex.Unlock()
 
-	err := c.setPayPlans()
+	// a failed load leaves the cache as it was, instead of with the entities loaded before the failure
+	snapshot := c.snapshotEntities()
+	defer func() {
+		if err != nil {
+			c.restoreEntities(snapshot)
+		}
+	}()
+
+	err = c.setPayPlans()
 	if err != nil {
 		return fmt.Errorf("err in setPayPlans: %w", err)
 	}
 
+	stepDone("pay_plans")
+
 	err = c.setRedirects()
 	if err != nil {
 		return fmt.Errorf("err in setRedirects: %w", err)
 	}
 
+	stepDone("redirects")
+
 	// always call after setPayPlans func
 	err = c.setApplications()
 	if err != nil {
 		return fmt.Errorf("err in setApplications: %w", err)
 	}
 
+	stepDone("applications")
+
 	// always call after setRedirects func
 	err = c.setBlockchains()
 	if err != nil {
 		return fmt.Errorf("err in setBlockchains: %w", err)
 	}
 
+	stepDone("blockchains")
+
 	// always call after setApplications func
 	err = c.setLoadBalancers()
 	if err != nil {
 		return err
 	}
 
+	stepDone("load_balancers")
+
+	err = c.setLabels()
+	if err != nil {
+		return fmt.Errorf("err in setLabels: %w", err)
+	}
+
+	stepDone("labels")
+
+	err = c.setApplicationFilters()
+	if err != nil {
+		return fmt.Errorf("err in setApplicationFilters: %w", err)
+	}
+
+	stepDone("application_filters")
+
+	changes.addReloads(loadSteps)
+
+	c.generation++
+	c.refreshedAt = time.Now()
+
+	// set under the cache lock, so reloads running in other goroutines start a single listener
 	if !c.listening {
+		c.listening = true
 		go c.listen()
 	}
 
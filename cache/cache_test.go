@@ -94,6 +94,16 @@ func TestCache_SetCache(t *testing.T) {
 
 	c.NotEmpty(cache.GetRedirects("0021"))
 	c.Len(cache.GetRedirects("0021"), 1)
+
+	generation, refreshedAt := cache.Generation()
+	c.Equal(uint64(1), generation)
+	c.False(refreshedAt.IsZero())
+
+	err = cache.SetCache()
+	c.NoError(err)
+
+	generation, _ = cache.Generation()
+	c.Equal(uint64(2), generation)
 }
 
 func TestCache_SetCacheFailure(t *testing.T) {
@@ -171,6 +181,12 @@ func TestCache_SetCacheFailure(t *testing.T) {
 	err = cache.SetCache()
 	c.ErrorIs(err, errOnLoadBalancer)
 
+	// the entities loaded before the failure are not kept
+	c.Nil(cache.GetApplication("5f62b7d8be3591c4dea8566d"))
+
+	generation, _ := cache.Generation()
+	c.Zero(generation)
+
 	readerMock.On("ReadLoadBalancers").Return([]*repository.LoadBalancer{
 		{
 			ID: "60ecb2bf67774900350d9c42",
@@ -381,6 +397,52 @@ func TestCache_UpdateLoadBalancer(t *testing.T) {
 	c.Equal("papolo", cache.GetLoadBalancer("5f62b7d8be3591c4dea8566a").Name)
 }
 
+func TestCache_SetLoadBalancerApplications(t *testing.T) {
+	c := require.New(t)
+
+	readerMock := &ReaderMock{}
+
+	readerMock.On("ReadLoadBalancers").Return([]*repository.LoadBalancer{
+		{
+			ID:             "60ecb2bf67774900350d9c42",
+			UserID:         "60ecb2bf67774900350d9c43",
+			ApplicationIDs: []string{"5f62b7d8be3591c4dea8566d"},
+		},
+	}, nil)
+
+	readerMock.On("ReadApplications").Return([]*repository.Application{
+		{
+			ID:     "5f62b7d8be3591c4dea8566d",
+			UserID: "60ecb2bf67774900350d9c43",
+		},
+		{
+			ID:     "5f62b7d8be3591c4dea8566a",
+			UserID: "60ecb2bf67774900350d9c43",
+		},
+	}, nil)
+
+	cache := NewCache(readerMock, logrus.New())
+
+	err := cache.setApplications()
+	c.NoError(err)
+
+	err = cache.setLoadBalancers()
+	c.NoError(err)
+
+	cache.SetLoadBalancerApplications("60ecb2bf67774900350d9c42", []string{"5f62b7d8be3591c4dea8566a"})
+
+	apps := cache.GetLoadBalancer("60ecb2bf67774900350d9c42").Applications
+	c.Len(apps, 1)
+	c.Equal("5f62b7d8be3591c4dea8566a", apps[0].ID)
+
+	// the notification of the insert must not add the application twice
+	cache.addLbApp(repository.LbApp{LbID: "60ecb2bf67774900350d9c42", AppID: "5f62b7d8be3591c4dea8566a"})
+	c.Len(cache.GetLoadBalancer("60ecb2bf67774900350d9c42").Applications, 1)
+
+	cache.SetLoadBalancerApplications("wrong", []string{"5f62b7d8be3591c4dea8566a"})
+	c.Nil(cache.GetLoadBalancer("wrong"))
+}
+
 func TestCache_AddBlockchain(t *testing.T) {
 	c := require.New(t)
 
@@ -460,3 +522,409 @@ func TestCache_AddRedirect(t *testing.T) {
 	c.Len(cache.GetBlockchains()[0].Redirects, 3)
 	c.Len(cache.GetRedirects("0001"), 3)
 }
+
+func TestCache_Redirects(t *testing.T) {
+	c := require.New(t)
+
+	readerMock := &ReaderMock{}
+
+	readerMock.On("ReadBlockchains").Return([]*repository.Blockchain{
+		{ID: "0001", Ticker: "POKT"},
+		{ID: "0002", Ticker: "ETH"},
+	}, nil)
+
+	readerMock.On("ReadRedirects").Return([]*repository.Redirect{
+		{ID: "3", BlockchainID: "0002", Alias: "eth-mainnet", Domain: "eth-mainnet.gateway.network"},
+		{ID: "1", BlockchainID: "0001", Alias: "pokt-mainnet-1", Domain: "pokt-mainnet-1.gateway.network"},
+		{ID: "2", BlockchainID: "0001", Alias: "pokt-mainnet-2", Domain: "pokt-mainnet-2.gateway.network"},
+	}, nil)
+
+	cache := NewCache(readerMock, logrus.New())
+
+	c.NoError(cache.setRedirects())
+	c.NoError(cache.setBlockchains())
+
+	redirects := cache.GetAllRedirects()
+	c.Len(redirects, 3)
+	c.Equal("1", redirects[0].ID)
+	c.Equal("3", redirects[2].ID)
+
+	c.Equal("pokt-mainnet-2", cache.GetRedirect("2").Alias)
+	c.Nil(cache.GetRedirect("4"))
+	c.Nil(cache.GetRedirect(""))
+
+	// the notification of a saved redirect, without its ID, does not add it twice
+	cache.AddRedirects(&repository.Redirect{ID: "4", BlockchainID: "0001", Alias: "pokt-mainnet-3", Domain: "pokt-mainnet-3.gateway.network"})
+	cache.addRedirect(repository.Redirect{BlockchainID: "0001", Alias: "pokt-mainnet-3", Domain: "pokt-mainnet-3.gateway.network"})
+
+	c.Len(cache.GetRedirects("0001"), 3)
+	c.Equal("pokt-mainnet-3", cache.GetRedirect("4").Alias)
+
+	// nor does a saved redirect whose notification arrived first
+	cache.addRedirect(repository.Redirect{BlockchainID: "0002", Alias: "eth-archival", Domain: "eth-archival.gateway.network"})
+	cache.AddRedirects(&repository.Redirect{ID: "5", BlockchainID: "0002", Alias: "eth-archival", Domain: "eth-archival.gateway.network"})
+
+	c.Len(cache.GetRedirects("0002"), 2)
+	c.Equal("eth-archival", cache.GetRedirect("5").Alias)
+	c.Equal("5", cache.GetBlockchain("0002").Redirects[1].ID)
+
+	cache.RemoveRedirect("2")
+	cache.RemoveRedirect("not-an-id")
+
+	c.Nil(cache.GetRedirect("2"))
+	c.Len(cache.GetRedirects("0001"), 2)
+	c.Len(cache.GetBlockchain("0001").Redirects, 2)
+	c.Len(cache.GetAllRedirects(), 4)
+}
+
+func TestCache_RemoveApplications(t *testing.T) {
+	c := require.New(t)
+
+	readerMock := &ReaderMock{}
+
+	readerMock.On("ReadApplications").Return([]*repository.Application{
+		{
+			ID:     "5f62b7d8be3591c4dea8566d",
+			UserID: "60ecb2bf67774900350d9c43",
+		},
+		{
+			ID:     "5f62b7d8be3591c4dea8566a",
+			UserID: "60ecb2bf67774900350d9c43",
+		},
+	}, nil)
+
+	readerMock.On("ReadLoadBalancers").Return([]*repository.LoadBalancer{
+		{
+			ID:             "60ecb2bf67774900350d9c42",
+			UserID:         "60ecb2bf67774900350d9c43",
+			ApplicationIDs: []string{"5f62b7d8be3591c4dea8566d", "5f62b7d8be3591c4dea8566a"},
+		},
+	}, nil)
+
+	cache := NewCache(readerMock, logrus.New())
+
+	err := cache.setApplications()
+	c.NoError(err)
+
+	err = cache.setLoadBalancers()
+	c.NoError(err)
+
+	userApps := cache.GetApplicationsByUserID("60ecb2bf67774900350d9c43")
+
+	cache.RemoveApplications("5f62b7d8be3591c4dea8566d", "not-an-id")
+
+	c.Nil(cache.GetApplication("5f62b7d8be3591c4dea8566d"))
+	c.Len(cache.GetApplications(), 1)
+	c.Len(cache.GetApplicationsByUserID("60ecb2bf67774900350d9c43"), 1)
+	c.Len(cache.GetLoadBalancer("60ecb2bf67774900350d9c42").Applications, 1)
+	c.Len(userApps, 2)
+}
+
+func TestCache_RemoveLoadBalancers(t *testing.T) {
+	c := require.New(t)
+
+	readerMock := &ReaderMock{}
+
+	readerMock.On("ReadLoadBalancers").Return([]*repository.LoadBalancer{
+		{
+			ID:     "5f62b7d8be3591c4dea8566d",
+			UserID: "",
+		},
+		{
+			ID:     "5f62b7d8be3591c4dea8566a",
+			UserID: "60ecb2bf67774900350d9c43",
+		},
+	}, nil)
+
+	cache := NewCache(readerMock, logrus.New())
+
+	err := cache.setLoadBalancers()
+	c.NoError(err)
+
+	cache.RemoveLoadBalancers("5f62b7d8be3591c4dea8566d")
+
+	c.Nil(cache.GetLoadBalancer("5f62b7d8be3591c4dea8566d"))
+	c.Len(cache.GetLoadBalancers(), 1)
+	c.Empty(cache.GetLoadBalancersByUserID(""))
+}
+
+func TestCache_RemoveBlockchain(t *testing.T) {
+	c := require.New(t)
+
+	readerMock := &ReaderMock{}
+
+	readerMock.On("ReadRedirects").Return([]*repository.Redirect{
+		{
+			BlockchainID: "0021",
+			Alias:        "eth-mainnet",
+		},
+	}, nil)
+
+	readerMock.On("ReadBlockchains").Return([]*repository.Blockchain{
+		{ID: "0021"},
+		{ID: "0022"},
+	}, nil)
+
+	cache := NewCache(readerMock, logrus.New())
+
+	err := cache.setRedirects()
+	c.NoError(err)
+
+	err = cache.setBlockchains()
+	c.NoError(err)
+
+	cache.RemoveBlockchain("0021")
+	cache.RemoveBlockchain("not-an-id")
+
+	c.Nil(cache.GetBlockchain("0021"))
+	c.Len(cache.GetBlockchains(), 1)
+	c.Empty(cache.GetRedirects("0021"))
+}
+
+func TestCache_TransferApplication(t *testing.T) {
+	c := require.New(t)
+
+	readerMock := &ReaderMock{}
+
+	readerMock.On("ReadApplications").Return([]*repository.Application{
+		{
+			ID:     "5f62b7d8be3591c4dea8566d",
+			UserID: "60ecb2bf67774900350d9c43",
+		},
+		{
+			ID:     "5f62b7d8be3591c4dea8566a",
+			UserID: "60ecb2bf67774900350d9c43",
+		},
+	}, nil)
+
+	cache := NewCache(readerMock, logrus.New())
+
+	err := cache.setApplications()
+	c.NoError(err)
+
+	userApps := cache.GetApplicationsByUserID("60ecb2bf67774900350d9c43")
+
+	cache.TransferApplication("5f62b7d8be3591c4dea8566d", "60ecb2bf67774900350d9c44")
+
+	c.Equal("60ecb2bf67774900350d9c44", cache.GetApplication("5f62b7d8be3591c4dea8566d").UserID)
+	c.Len(cache.GetApplicationsByUserID("60ecb2bf67774900350d9c43"), 1)
+	c.Len(cache.GetApplicationsByUserID("60ecb2bf67774900350d9c44"), 1)
+	c.Len(userApps, 2)
+
+	cache.TransferApplication("5f62b7d8be3591c4dea8566d", "60ecb2bf67774900350d9c43")
+
+	c.Len(cache.GetApplicationsByUserID("60ecb2bf67774900350d9c43"), 2)
+	c.Empty(cache.GetApplicationsByUserID("60ecb2bf67774900350d9c44"))
+
+	cache.updateApplication(repository.Application{
+		ID:     "5f62b7d8be3591c4dea8566a",
+		UserID: "60ecb2bf67774900350d9c44",
+	})
+
+	c.Len(cache.GetApplicationsByUserID("60ecb2bf67774900350d9c43"), 1)
+	c.Equal("5f62b7d8be3591c4dea8566a", cache.GetApplicationsByUserID("60ecb2bf67774900350d9c44")[0].ID)
+
+	cache.TransferApplication("not-an-id", "60ecb2bf67774900350d9c44")
+
+	c.Len(cache.GetApplicationsByUserID("60ecb2bf67774900350d9c44"), 1)
+}
+
+func TestCache_TransferLoadBalancer(t *testing.T) {
+	c := require.New(t)
+
+	readerMock := &ReaderMock{}
+
+	readerMock.On("ReadLoadBalancers").Return([]*repository.LoadBalancer{
+		{
+			ID:     "5f62b7d8be3591c4dea8566d",
+			UserID: "60ecb2bf67774900350d9c43",
+		},
+		{
+			ID:     "5f62b7d8be3591c4dea8566a",
+			UserID: "60ecb2bf67774900350d9c43",
+		},
+	}, nil)
+
+	cache := NewCache(readerMock, logrus.New())
+
+	err := cache.setLoadBalancers()
+	c.NoError(err)
+
+	// removal leaves the load balancer without user
+	cache.TransferLoadBalancer("5f62b7d8be3591c4dea8566d", "")
+
+	c.Empty(cache.GetLoadBalancer("5f62b7d8be3591c4dea8566d").UserID)
+	c.Len(cache.GetLoadBalancersByUserID("60ecb2bf67774900350d9c43"), 1)
+
+	// restore
+	cache.TransferLoadBalancer("5f62b7d8be3591c4dea8566d", "60ecb2bf67774900350d9c43")
+
+	c.Len(cache.GetLoadBalancersByUserID("60ecb2bf67774900350d9c43"), 2)
+
+	cache.updateLoadBalancer(repository.LoadBalancer{
+		ID:     "5f62b7d8be3591c4dea8566a",
+		UserID: "60ecb2bf67774900350d9c44",
+		Name:   "transferred",
+	})
+
+	c.Len(cache.GetLoadBalancersByUserID("60ecb2bf67774900350d9c43"), 1)
+	c.Equal("transferred", cache.GetLoadBalancersByUserID("60ecb2bf67774900350d9c44")[0].Name)
+}
+
+func TestCache_GetApplicationByAddress(t *testing.T) {
+	c := require.New(t)
+
+	readerMock := &ReaderMock{}
+
+	readerMock.On("ReadApplications").Return([]*repository.Application{
+		{
+			ID:         "5f62b7d8be3591c4dea8566d",
+			UserID:     "60ecb2bf67774900350d9c43",
+			GatewayAAT: repository.GatewayAAT{Address: "ABCDEF"},
+		},
+		{
+			ID:     "5f62b7d8be3591c4dea8566a",
+			UserID: "60ecb2bf67774900350d9c43",
+		},
+	}, nil)
+
+	readerMock.On("ReadLoadBalancers").Return([]*repository.LoadBalancer{}, nil)
+
+	cache := NewCache(readerMock, logrus.New())
+
+	err := cache.setApplications()
+	c.NoError(err)
+
+	c.Equal("5f62b7d8be3591c4dea8566d", cache.GetApplicationByAddress("abcdef").ID)
+	c.Nil(cache.GetApplicationByAddress(""))
+
+	cache.addGatewayAAT(repository.GatewayAAT{ID: "5f62b7d8be3591c4dea8566a", Address: "123456"})
+	cache.addGatewayAAT(repository.GatewayAAT{ID: "5f62b7d8be3591c4dea8566d", Address: "654321"})
+
+	c.Equal("5f62b7d8be3591c4dea8566a", cache.GetApplicationByAddress("123456").ID)
+	c.Equal("5f62b7d8be3591c4dea8566d", cache.GetApplicationByAddress("654321").ID)
+	c.Nil(cache.GetApplicationByAddress("abcdef"))
+
+	cache.addGatewayAAT(repository.GatewayAAT{ID: "5f62b7d8be3591c4dea8566b", Address: "aaaaaa"})
+	cache.addApplication(repository.Application{ID: "5f62b7d8be3591c4dea8566b", UserID: "60ecb2bf67774900350d9c44"})
+
+	c.Equal("5f62b7d8be3591c4dea8566b", cache.GetApplicationByAddress("aaaaaa").ID)
+
+	cache.RemoveApplications("5f62b7d8be3591c4dea8566a")
+
+	c.Nil(cache.GetApplicationByAddress("123456"))
+}
+
+func TestCache_GetLoadBalancersByStickyOrigin(t *testing.T) {
+	c := require.New(t)
+
+	readerMock := &ReaderMock{}
+
+	readerMock.On("ReadLoadBalancers").Return([]*repository.LoadBalancer{
+		{
+			ID:     "5f62b7d8be3591c4dea8566d",
+			UserID: "60ecb2bf67774900350d9c43",
+			StickyOptions: repository.StickyOptions{
+				StickyOrigins: []string{"https://App.example.com/", "https://app.example.com"},
+			},
+		},
+		{
+			ID:     "5f62b7d8be3591c4dea8566a",
+			UserID: "60ecb2bf67774900350d9c43",
+		},
+	}, nil)
+
+	cache := NewCache(readerMock, logrus.New())
+
+	err := cache.setLoadBalancers()
+	c.NoError(err)
+
+	lbs := cache.GetLoadBalancersByStickyOrigin(" https://app.example.com")
+	c.Len(lbs, 1)
+	c.Equal("5f62b7d8be3591c4dea8566d", lbs[0].ID)
+	c.Empty(cache.GetLoadBalancersByStickyOrigin(""))
+
+	cache.addStickinessOptions(repository.StickyOptions{ID: "5f62b7d8be3591c4dea8566a", StickyOrigins: []string{"https://app.example.com"}})
+	cache.addStickinessOptions(repository.StickyOptions{ID: "5f62b7d8be3591c4dea8566b", StickyOrigins: []string{"https://other.example.com"}})
+	cache.addLoadBalancer(repository.LoadBalancer{ID: "5f62b7d8be3591c4dea8566b", UserID: "60ecb2bf67774900350d9c44"})
+
+	c.Len(cache.GetLoadBalancersByStickyOrigin("https://app.example.com"), 2)
+	c.Equal("5f62b7d8be3591c4dea8566b", cache.GetLoadBalancersByStickyOrigin("https://other.example.com")[0].ID)
+
+	cache.SetLoadBalancerStickyOptions("5f62b7d8be3591c4dea8566d", repository.StickyOptions{})
+	cache.RemoveLoadBalancers("5f62b7d8be3591c4dea8566b")
+
+	lbs = cache.GetLoadBalancersByStickyOrigin("https://app.example.com")
+	c.Len(lbs, 1)
+	c.Equal("5f62b7d8be3591c4dea8566a", lbs[0].ID)
+	c.Empty(cache.GetLoadBalancersByStickyOrigin("https://other.example.com"))
+}
+
+func TestCache_SetPayPlanDailyLimit(t *testing.T) {
+	c := require.New(t)
+
+	readerMock := &ReaderMock{}
+
+	readerMock.On("ReadPayPlans").Return([]*repository.PayPlan{
+		{PlanType: repository.FreetierV0, DailyLimit: 250000},
+		{PlanType: repository.PayAsYouGoV0, DailyLimit: 0},
+	}, nil)
+
+	readerMock.On("ReadApplications").Return([]*repository.Application{
+		{ID: "5f62b7d8be3591c4dea8566d", PayPlanType: repository.FreetierV0},
+		{ID: "5f62b7d8be3591c4dea8566a", PayPlanType: repository.PayAsYouGoV0},
+		{ID: "5f62b7d8be3591c4dea8566f", PayPlanType: repository.FreetierV0},
+	}, nil)
+
+	cache := NewCache(readerMock, logrus.New())
+
+	c.NoError(cache.setPayPlans())
+	c.NoError(cache.setApplications())
+
+	previousPlan := cache.GetPayPlan(repository.FreetierV0)
+
+	apps := cache.SetPayPlanDailyLimit(repository.FreetierV0, 300000, false)
+	c.Nil(apps)
+	c.Equal(300000, cache.GetPayPlan(repository.FreetierV0).DailyLimit)
+	c.Equal(300000, cache.GetPayPlans()[0].DailyLimit)
+	c.Equal(250000, previousPlan.DailyLimit)
+	c.Equal(250000, cache.GetApplication("5f62b7d8be3591c4dea8566d").Limits.DailyLimit)
+
+	apps = cache.SetPayPlanDailyLimit(repository.FreetierV0, 500000, true)
+	c.Len(apps, 2)
+	c.Equal(500000, cache.GetApplication("5f62b7d8be3591c4dea8566d").Limits.DailyLimit)
+	c.Equal(500000, cache.GetApplication("5f62b7d8be3591c4dea8566f").Limits.DailyLimit)
+	c.Equal(0, cache.GetApplication("5f62b7d8be3591c4dea8566a").Limits.DailyLimit)
+
+	c.Nil(cache.SetPayPlanDailyLimit("WRONG_V0", 1, true))
+	c.Nil(cache.GetPayPlan("WRONG_V0"))
+}
+
+func TestCache_AddRemovePayPlan(t *testing.T) {
+	c := require.New(t)
+
+	readerMock := &ReaderMock{}
+
+	readerMock.On("ReadPayPlans").Return([]*repository.PayPlan{
+		{PlanType: repository.FreetierV0, DailyLimit: 250000},
+	}, nil)
+
+	cache := NewCache(readerMock, logrus.New())
+
+	c.NoError(cache.setPayPlans())
+
+	previousPlans := cache.GetPayPlans()
+
+	cache.AddPayPlan(&repository.PayPlan{PlanType: "ENTERPRISE_V0", DailyLimit: 1000000})
+
+	c.Equal(1000000, cache.GetPayPlan("ENTERPRISE_V0").DailyLimit)
+	c.Len(cache.GetPayPlans(), 2)
+	c.Len(previousPlans, 1)
+
+	cache.RemovePayPlan(repository.FreetierV0)
+	cache.RemovePayPlan("WRONG_V0")
+
+	c.Nil(cache.GetPayPlan(repository.FreetierV0))
+	c.Len(cache.GetPayPlans(), 1)
+	c.Equal(repository.PayPlanType("ENTERPRISE_V0"), cache.GetPayPlans()[0].PlanType)
+}
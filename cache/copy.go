@@ -0,0 +1,223 @@
+package cache
+
+import (
+	"github.com/pokt-foundation/pocket-http-db/types"
+	"github.com/pokt-foundation/portal-api-go/repository"
+)
+
+// The cache hands out copies of its entities, so callers can modify what they get without racing
+// with the cache or other readers. Cached entities only change under the write lock, through the cache methods
+
+// UpdateApplication applies update to the cached application with given id under the write lock and returns a copy
+// of the result, nil if the application is not in the cache. update must not change the ID, user or AAT address
+// of the application, which the cache indexes, nor use the cache
+func (c *Cache) UpdateApplication(id string, update func(app *repository.Application)) *repository.Application {
+	changes := c.newChangeSet()
+	defer changes.dispatch()
+
+	c.rwMutex.Lock()
+	defer c.rwMutex.Unlock()
+
+	app := c.applicationsMap[id]
+	if app == nil {
+		return nil
+	}
+
+	update(app)
+	changes.add(types.EntityApplication, OperationUpdated, id)
+
+	return copyApplication(app)
+}
+
+// UpdateLoadBalancer applies update to the cached load balancer with given id under the write lock and returns
+// a copy of the result, nil if the load balancer is not in the cache. update must not change the ID, user,
+// sticky options or applications of the load balancer, which the cache indexes, nor use the cache
+func (c *Cache) UpdateLoadBalancer(id string, update func(lb *repository.LoadBalancer)) *repository.LoadBalancer {
+	changes := c.newChangeSet()
+	defer changes.dispatch()
+
+	c.rwMutex.Lock()
+	defer c.rwMutex.Unlock()
+
+	lb := c.loadBalancersMap[id]
+	if lb == nil {
+		return nil
+	}
+
+	update(lb)
+	changes.add(types.EntityLoadBalancer, OperationUpdated, id)
+
+	return copyLoadBalancer(lb)
+}
+
+// UpdateBlockchain applies update to the cached blockchain with given id under the write lock and returns a copy
+// of the result, nil if the blockchain is not in the cache. update must not change the ID of the blockchain,
+// nor use the cache
+func (c *Cache) UpdateBlockchain(id string, update func(blockchain *repository.Blockchain)) *repository.Blockchain {
+	changes := c.newChangeSet()
+	defer changes.dispatch()
+
+	c.rwMutex.Lock()
+	defer c.rwMutex.Unlock()
+
+	blockchain := c.blockchainsMap[id]
+	if blockchain == nil {
+		return nil
+	}
+
+	update(blockchain)
+	changes.add(types.EntityBlockchain, OperationUpdated, id)
+
+	return copyBlockchain(blockchain)
+}
+
+func copyStrings(values []string) []string {
+	if values == nil {
+		return nil
+	}
+
+	return append([]string{}, values...)
+}
+
+// copyApplication returns a deep copy of app, nil if app is nil
+func copyApplication(app *repository.Application) *repository.Application {
+	if app == nil {
+		return nil
+	}
+
+	copied := *app
+
+	settings := &copied.GatewaySettings
+	settings.WhitelistOrigins = copyStrings(settings.WhitelistOrigins)
+	settings.WhitelistUserAgents = copyStrings(settings.WhitelistUserAgents)
+	settings.WhitelistBlockchains = copyStrings(settings.WhitelistBlockchains)
+
+	if settings.WhitelistContracts != nil {
+		contracts := make([]repository.WhitelistContract, 0, len(settings.WhitelistContracts))
+		for _, contract := range settings.WhitelistContracts {
+			contract.Contracts = copyStrings(contract.Contracts)
+			contracts = append(contracts, contract)
+		}
+
+		settings.WhitelistContracts = contracts
+	}
+
+	if settings.WhitelistMethods != nil {
+		methods := make([]repository.WhitelistMethod, 0, len(settings.WhitelistMethods))
+		for _, method := range settings.WhitelistMethods {
+			method.Methods = copyStrings(method.Methods)
+			methods = append(methods, method)
+		}
+
+		settings.WhitelistMethods = methods
+	}
+
+	return &copied
+}
+
+func copyApplications(apps []*repository.Application) []*repository.Application {
+	if apps == nil {
+		return nil
+	}
+
+	copied := make([]*repository.Application, 0, len(apps))
+	for _, app := range apps {
+		copied = append(copied, copyApplication(app))
+	}
+
+	return copied
+}
+
+// copyLoadBalancer returns a deep copy of lb along with its applications, nil if lb is nil
+func copyLoadBalancer(lb *repository.LoadBalancer) *repository.LoadBalancer {
+	if lb == nil {
+		return nil
+	}
+
+	copied := *lb
+	copied.ApplicationIDs = copyStrings(lb.ApplicationIDs)
+	copied.StickyOptions.StickyOrigins = copyStrings(lb.StickyOptions.StickyOrigins)
+	copied.Applications = copyApplications(lb.Applications)
+
+	return &copied
+}
+
+func copyLoadBalancers(lbs []*repository.LoadBalancer) []*repository.LoadBalancer {
+	if lbs == nil {
+		return nil
+	}
+
+	copied := make([]*repository.LoadBalancer, 0, len(lbs))
+	for _, lb := range lbs {
+		copied = append(copied, copyLoadBalancer(lb))
+	}
+
+	return copied
+}
+
+// copyBlockchain returns a deep copy of blockchain, nil if blockchain is nil
+func copyBlockchain(blockchain *repository.Blockchain) *repository.Blockchain {
+	if blockchain == nil {
+		return nil
+	}
+
+	copied := *blockchain
+	copied.BlockchainAliases = copyStrings(blockchain.BlockchainAliases)
+
+	if blockchain.Redirects != nil {
+		copied.Redirects = append([]repository.Redirect{}, blockchain.Redirects...)
+	}
+
+	return &copied
+}
+
+func copyBlockchains(blockchains []*repository.Blockchain) []*repository.Blockchain {
+	if blockchains == nil {
+		return nil
+	}
+
+	copied := make([]*repository.Blockchain, 0, len(blockchains))
+	for _, blockchain := range blockchains {
+		copied = append(copied, copyBlockchain(blockchain))
+	}
+
+	return copied
+}
+
+// copyPayPlan returns a copy of plan, nil if plan is nil
+func copyPayPlan(plan *repository.PayPlan) *repository.PayPlan {
+	if plan == nil {
+		return nil
+	}
+
+	copied := *plan
+
+	return &copied
+}
+
+func copyPayPlans(plans []*repository.PayPlan) []*repository.PayPlan {
+	if plans == nil {
+		return nil
+	}
+
+	copied := make([]*repository.PayPlan, 0, len(plans))
+	for _, plan := range plans {
+		copied = append(copied, copyPayPlan(plan))
+	}
+
+	return copied
+}
+
+func copyRedirects(redirects []*repository.Redirect) []*repository.Redirect {
+	if redirects == nil {
+		return nil
+	}
+
+	copied := make([]*repository.Redirect, 0, len(redirects))
+	for _, redirect := range redirects {
+		redirectCopy := *redirect
+		copied = append(copied, &redirectCopy)
+	}
+
+	return copied
+}
@@ -0,0 +1,134 @@
+package cache
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCache_CopyOnRead(t *testing.T) {
+	c := require.New(t)
+
+	readerMock := &ReaderMock{}
+
+	readerMock.On("ReadPayPlans").Return([]*repository.PayPlan{
+		{
+			PlanType:   repository.FreetierV0,
+			DailyLimit: 250000,
+		},
+	}, nil)
+
+	readerMock.On("ReadApplications").Return([]*repository.Application{
+		{
+			ID:          "5f62b7d8be3591c4dea8566d",
+			UserID:      "60ecb2bf67774900350d9c43",
+			Name:        "pokt-app",
+			PayPlanType: repository.FreetierV0,
+			GatewaySettings: repository.GatewaySettings{
+				WhitelistOrigins: []string{"https://app.example.com"},
+			},
+		},
+	}, nil)
+
+	readerMock.On("ReadLoadBalancers").Return([]*repository.LoadBalancer{
+		{
+			ID:             "60ecb2bf67774900350d9c42",
+			UserID:         "60ecb2bf67774900350d9c43",
+			Name:           "pokt-lb",
+			ApplicationIDs: []string{"5f62b7d8be3591c4dea8566d"},
+		},
+	}, nil)
+
+	readerMock.On("ReadBlockchains").Return([]*repository.Blockchain{
+		{
+			ID:         "0021",
+			Blockchain: "pokt-mainnet",
+		},
+	}, nil)
+
+	cache := NewCache(readerMock, logrus.New())
+
+	c.NoError(cache.setPayPlans())
+	c.NoError(cache.setApplications())
+	c.NoError(cache.setLoadBalancers())
+	c.NoError(cache.setBlockchains())
+
+	// changes to what the cache returns do not reach the cache
+	app := cache.GetApplication("5f62b7d8be3591c4dea8566d")
+	app.Name = "changed"
+	app.GatewaySettings.WhitelistOrigins[0] = "https://changed.example.com"
+
+	app = cache.GetApplication("5f62b7d8be3591c4dea8566d")
+	c.Equal("pokt-app", app.Name)
+	c.Equal([]string{"https://app.example.com"}, app.GatewaySettings.WhitelistOrigins)
+
+	lb := cache.GetLoadBalancer("60ecb2bf67774900350d9c42")
+	lb.Name = "changed"
+	lb.Applications[0].Name = "changed"
+
+	lb = cache.GetLoadBalancer("60ecb2bf67774900350d9c42")
+	c.Equal("pokt-lb", lb.Name)
+	c.Equal("pokt-app", lb.Applications[0].Name)
+
+	cache.GetPayPlan(repository.FreetierV0).DailyLimit = 1
+	c.Equal(250000, cache.GetPayPlan(repository.FreetierV0).DailyLimit)
+
+	// updates reach every index of the cache
+	app = cache.UpdateApplication("5f62b7d8be3591c4dea8566d", func(app *repository.Application) {
+		app.Name = "papolo"
+	})
+	c.Equal("papolo", app.Name)
+	c.Equal("papolo", cache.GetApplication("5f62b7d8be3591c4dea8566d").Name)
+	c.Equal("papolo", cache.GetApplicationsByUserID("60ecb2bf67774900350d9c43")[0].Name)
+	c.Equal("papolo", cache.GetLoadBalancer("60ecb2bf67774900350d9c42").Applications[0].Name)
+
+	lb = cache.UpdateLoadBalancer("60ecb2bf67774900350d9c42", func(lb *repository.LoadBalancer) {
+		lb.Name = "papolo"
+	})
+	c.Equal("papolo", lb.Name)
+	c.Equal("papolo", cache.GetLoadBalancersByUserID("60ecb2bf67774900350d9c43")[0].Name)
+
+	blockchain := cache.UpdateBlockchain("0021", func(blockchain *repository.Blockchain) {
+		blockchain.LogLimitBlocks = 100000
+	})
+	c.Equal(100000, blockchain.LogLimitBlocks)
+	c.Equal(100000, cache.GetBlockchains()[0].LogLimitBlocks)
+
+	c.Nil(cache.UpdateApplication("wrong", func(app *repository.Application) {}))
+	c.Nil(cache.UpdateLoadBalancer("wrong", func(lb *repository.LoadBalancer) {}))
+	c.Nil(cache.UpdateBlockchain("wrong", func(blockchain *repository.Blockchain) {}))
+
+	// readers never see an update half applied
+	var wg sync.WaitGroup
+
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+
+		go func() {
+			defer wg.Done()
+
+			for j := 0; j < 100; j++ {
+				cache.UpdateApplication("5f62b7d8be3591c4dea8566d", func(app *repository.Application) {
+					app.Name = "first"
+					app.Description = "first"
+				})
+			}
+		}()
+
+		go func() {
+			defer wg.Done()
+
+			for j := 0; j < 100; j++ {
+				app := cache.GetApplication("5f62b7d8be3591c4dea8566d")
+				if app.Name == "first" {
+					c.Equal("first", app.Description)
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+}
@@ -0,0 +1,121 @@
+package cache
+
+import (
+	"fmt"
+
+	"github.com/pokt-foundation/pocket-http-db/types"
+)
+
+// Operation is the kind of change made to an entity of the cache
+type Operation string
+
+const (
+	OperationCreated Operation = "created"
+	OperationUpdated Operation = "updated"
+	OperationRemoved Operation = "removed"
+	// OperationReloaded is the reload of every entity of a type from the reader, sent with an empty ID
+	OperationReloaded Operation = "reloaded"
+)
+
+// ChangeHook is called with each change made to the cache, id is the ID of the entity changed
+// the ID of labels is the one of the labeled entity, the one of application filters their name
+type ChangeHook func(entityType types.EntityType, op Operation, id string)
+
+// hookEntry holds a subscribed hook, compared by address to unsubscribe it
+type hookEntry struct {
+	hook ChangeHook
+}
+
+// stepEntityTypes are the entity types reloaded by each step of a cache load
+var stepEntityTypes = map[string]types.EntityType{
+	"pay_plans":           types.EntityPayPlan,
+	"redirects":           types.EntityRedirect,
+	"applications":        types.EntityApplication,
+	"blockchains":         types.EntityBlockchain,
+	"load_balancers":      types.EntityLoadBalancer,
+	"labels":              types.EntityLabel,
+	"application_filters": types.EntityApplicationFilter,
+}
+
+// OnChange subscribes hook to the changes of the cache, so programs embedding it can react to them without polling,
+// and returns the function unsubscribing it
+// hook is called once the change is made and the cache lock released, so it can read the cache, in the goroutine
+// making the change: changes notified by the database are made concurrently, and a slow hook slows the writes
+func (c *Cache) OnChange(hook ChangeHook) (unsubscribe func()) {
+	entry := &hookEntry{hook: hook}
+
+	c.hooksMutex.Lock()
+	defer c.hooksMutex.Unlock()
+
+	c.hooks = append(c.hooks, entry)
+
+	return func() {
+		c.hooksMutex.Lock()
+		defer c.hooksMutex.Unlock()
+
+		kept := make([]*hookEntry, 0, len(c.hooks))
+		for _, subscribed := range c.hooks {
+			if subscribed != entry {
+				kept = append(kept, subscribed)
+			}
+		}
+
+		c.hooks = kept
+	}
+}
+
+type change struct {
+	entityType types.EntityType
+	op         Operation
+	id         string
+}
+
+// changeSet collects the changes made under the cache lock, to call the hooks with once it is released
+// meant to be created and its dispatch deferred before taking the lock
+type changeSet struct {
+	cache   *Cache
+	changes []change
+}
+
+func (c *Cache) newChangeSet() *changeSet {
+	return &changeSet{cache: c}
+}
+
+func (s *changeSet) add(entityType types.EntityType, op Operation, id string) {
+	s.changes = append(s.changes, change{entityType: entityType, op: op, id: id})
+}
+
+// addReloads adds the reload of the entity types of steps
+func (s *changeSet) addReloads(steps []string) {
+	for _, step := range steps {
+		s.add(stepEntityTypes[step], OperationReloaded, "")
+	}
+}
+
+// dispatch calls every hook with the changes collected, in the order they were made
+func (s *changeSet) dispatch() {
+	if len(s.changes) == 0 {
+		return
+	}
+
+	s.cache.hooksMutex.Lock()
+	hooks := append([]*hookEntry{}, s.cache.hooks...)
+	s.cache.hooksMutex.Unlock()
+
+	for _, entry := range hooks {
+		for _, change := range s.changes {
+			s.cache.callHook(entry.hook, change)
+		}
+	}
+}
+
+// callHook calls hook with change, a panicking hook is logged rather than failing the change
+func (c *Cache) callHook(hook ChangeHook, change change) {
+	defer func() {
+		if r := recover(); r != nil {
+			c.logError(fmt.Errorf("cache change hook panicked on %s %s %s: %v", change.op, change.entityType, change.id, r))
+		}
+	}()
+
+	hook(change.entityType, change.op, change.id)
+}
@@ -0,0 +1,65 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/pokt-foundation/pocket-http-db/types"
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCache_OnChange(t *testing.T) {
+	c := require.New(t)
+
+	cache := NewCache(newLoadBalancerAppOrderReaderMock(nil, nil), logrus.New())
+
+	var changes []change
+
+	unsubscribe := cache.OnChange(func(entityType types.EntityType, op Operation, id string) {
+		changes = append(changes, change{entityType: entityType, op: op, id: id})
+
+		// hooks are called without the cache lock
+		cache.GetApplication(id)
+	})
+
+	cache.OnChange(func(entityType types.EntityType, op Operation, id string) {
+		panic("dummy panic")
+	})
+
+	c.NoError(cache.SetCache())
+	c.Len(changes, len(loadSteps))
+	c.Equal(change{entityType: types.EntityPayPlan, op: OperationReloaded}, changes[0])
+	c.Equal(change{entityType: types.EntityApplicationFilter, op: OperationReloaded}, changes[len(loadSteps)-1])
+
+	changes = nil
+
+	cache.RemoveApplications("5f62b7d8be3591c4dea8566a", "5f62b7d8be3591c4dea85664")
+	// removing what the cache does not hold changes nothing
+	cache.RemoveBlockchain("0021")
+	cache.addBlockchain(repository.Blockchain{ID: "0021"})
+	cache.UpdateLoadBalancer("60ecb2bf67774900350d9c42", func(lb *repository.LoadBalancer) { lb.Name = "renamed" })
+	cache.SetLabels(types.EntityApplication, "5f62b7d8be3591c4dea8566d", map[string]string{"team": "infra"})
+
+	c.Equal([]change{
+		{entityType: types.EntityApplication, op: OperationRemoved, id: "5f62b7d8be3591c4dea8566a"},
+		{entityType: types.EntityLoadBalancer, op: OperationUpdated, id: "60ecb2bf67774900350d9c42"},
+		{entityType: types.EntityLoadBalancer, op: OperationUpdated, id: "60ecb2bf67774900350d9c43"},
+		{entityType: types.EntityBlockchain, op: OperationCreated, id: "0021"},
+		{entityType: types.EntityLoadBalancer, op: OperationUpdated, id: "60ecb2bf67774900350d9c42"},
+		{entityType: types.EntityLabel, op: OperationUpdated, id: "5f62b7d8be3591c4dea8566d"},
+	}, changes)
+
+	changes = nil
+
+	_, err := cache.RefreshEntity("applications")
+	c.NoError(err)
+	c.Equal(change{entityType: types.EntityApplication, op: OperationReloaded}, changes[0])
+
+	changes = nil
+
+	unsubscribe()
+
+	cache.RemoveLoadBalancers("60ecb2bf67774900350d9c42")
+	c.Empty(changes)
+}
@@ -0,0 +1,116 @@
+package cache
+
+import (
+	"sort"
+
+	"github.com/pokt-foundation/pocket-http-db/types"
+)
+
+// LabelReader is implemented by readers holding entity labels
+// labels are not notified by the database, other instances only get label changes on the next cache refresh
+type LabelReader interface {
+	ReadLabels() ([]*types.EntityLabels, error)
+}
+
+// setLabels loads the labels of the reader, the cache has no labels if the reader does not hold them
+func (c *Cache) setLabels() error {
+	labels := make(map[types.EntityType]map[string]map[string]string)
+
+	labelReader, ok := c.reader.(LabelReader)
+	if !ok {
+		c.labels = labels
+		return nil
+	}
+
+	entityLabels, err := labelReader.ReadLabels()
+	if err != nil {
+		return err
+	}
+
+	for _, entity := range entityLabels {
+		if len(entity.Labels) == 0 {
+			continue
+		}
+
+		if labels[entity.EntityType] == nil {
+			labels[entity.EntityType] = make(map[string]map[string]string)
+		}
+
+		labels[entity.EntityType][entity.EntityID] = copyLabels(entity.Labels)
+	}
+
+	c.labels = labels
+
+	return nil
+}
+
+// GetLabels returns a copy of the labels of the entity, empty if it has none
+func (c *Cache) GetLabels(entityType types.EntityType, entityID string) map[string]string {
+	c.rwMutex.RLock()
+	defer c.rwMutex.RUnlock()
+
+	return copyLabels(c.labels[entityType][entityID])
+}
+
+// GetAllLabels returns the labels of every labeled entity, sorted by entity type and ID
+func (c *Cache) GetAllLabels() []*types.EntityLabels {
+	c.rwMutex.RLock()
+	defer c.rwMutex.RUnlock()
+
+	var entityLabels []*types.EntityLabels
+
+	for entityType, entities := range c.labels {
+		for entityID, labels := range entities {
+			entityLabels = append(entityLabels, &types.EntityLabels{
+				EntityType: entityType,
+				EntityID:   entityID,
+				Labels:     copyLabels(labels),
+			})
+		}
+	}
+
+	sort.Slice(entityLabels, func(i, j int) bool {
+		if entityLabels[i].EntityType != entityLabels[j].EntityType {
+			return entityLabels[i].EntityType < entityLabels[j].EntityType
+		}
+
+		return entityLabels[i].EntityID < entityLabels[j].EntityID
+	})
+
+	return entityLabels
+}
+
+// SetLabels replaces the labels of the entity, removing them if labels is empty
+func (c *Cache) SetLabels(entityType types.EntityType, entityID string, labels map[string]string) {
+	changes := c.newChangeSet()
+	defer changes.dispatch()
+
+	c.rwMutex.Lock()
+	defer c.rwMutex.Unlock()
+
+	if c.labels == nil {
+		c.labels = make(map[types.EntityType]map[string]map[string]string)
+	}
+
+	changes.add(types.EntityLabel, OperationUpdated, entityID)
+
+	if len(labels) == 0 {
+		delete(c.labels[entityType], entityID)
+		return
+	}
+
+	if c.labels[entityType] == nil {
+		c.labels[entityType] = make(map[string]map[string]string)
+	}
+
+	c.labels[entityType][entityID] = copyLabels(labels)
+}
+
+func copyLabels(labels map[string]string) map[string]string {
+	copied := make(map[string]string, len(labels))
+	for key, value := range labels {
+		copied[key] = value
+	}
+
+	return copied
+}
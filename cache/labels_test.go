@@ -0,0 +1,60 @@
+package cache
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/pokt-foundation/pocket-http-db/types"
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+func newLabelReaderMock(labels []*types.EntityLabels, err error) *LabelReaderMock {
+	readerMock := &ReaderMock{}
+
+	readerMock.On("ReadApplications").Return([]*repository.Application{}, nil)
+	readerMock.On("ReadBlockchains").Return([]*repository.Blockchain{}, nil)
+	readerMock.On("ReadLoadBalancers").Return([]*repository.LoadBalancer{}, nil)
+	readerMock.On("ReadPayPlans").Return([]*repository.PayPlan{}, nil)
+	readerMock.On("ReadRedirects").Return([]*repository.Redirect{}, nil)
+	readerMock.On("ReadLabels").Return(labels, err)
+
+	return &LabelReaderMock{ReaderMock: readerMock}
+}
+
+func TestCache_Labels(t *testing.T) {
+	c := require.New(t)
+
+	cache := NewCache(newLabelReaderMock([]*types.EntityLabels{
+		{
+			EntityType: types.EntityApplication,
+			EntityID:   "5f62b7d8be3591c4dea8566d",
+			Labels:     map[string]string{"team": "infra"},
+		},
+	}, nil), logrus.New())
+
+	c.NoError(cache.SetCache())
+
+	labels := cache.GetLabels(types.EntityApplication, "5f62b7d8be3591c4dea8566d")
+	c.Equal(map[string]string{"team": "infra"}, labels)
+
+	// returned labels are copies
+	labels["team"] = "relays"
+	c.Equal("infra", cache.GetLabels(types.EntityApplication, "5f62b7d8be3591c4dea8566d")["team"])
+
+	c.Empty(cache.GetLabels(types.EntityLoadBalancer, "5f62b7d8be3591c4dea8566d"))
+
+	cache.SetLabels(types.EntityLoadBalancer, "60ecb2bf67774900350d9c42", map[string]string{"env": "prod"})
+
+	allLabels := cache.GetAllLabels()
+	c.Len(allLabels, 2)
+	c.Equal(types.EntityApplication, allLabels[0].EntityType)
+	c.Equal("60ecb2bf67774900350d9c42", allLabels[1].EntityID)
+
+	cache.SetLabels(types.EntityApplication, "5f62b7d8be3591c4dea8566d", nil)
+	c.Len(cache.GetAllLabels(), 1)
+
+	failingCache := NewCache(newLabelReaderMock(nil, errors.New("dummy error")), logrus.New())
+	c.Error(failingCache.SetCache())
+}
@@ -184,8 +184,6 @@ func (c *Cache) parseNotification(n repository.Notification) {
 }
 
 func (c *Cache) listen() {
-	c.listening = true
-
 	for {
 		n := <-c.reader.NotificationChannel()
 		go c.parseNotification(*n)
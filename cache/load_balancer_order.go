@@ -0,0 +1,48 @@
+package cache
+
+// LoadBalancerAppOrderReader is implemented by readers holding the order of the applications of load balancers
+// the gateway fails over in this order, so it must not depend on the order the database returns them in
+type LoadBalancerAppOrderReader interface {
+	ReadLoadBalancerApplicationOrders() (map[string][]string, error)
+}
+
+// readLoadBalancerAppOrders returns the order of the applications of every load balancer,
+// nil if the reader does not hold it
+func (c *Cache) readLoadBalancerAppOrders() (map[string][]string, error) {
+	orderReader, ok := c.reader.(LoadBalancerAppOrderReader)
+	if !ok {
+		return nil, nil
+	}
+
+	return orderReader.ReadLoadBalancerApplicationOrders()
+}
+
+// orderApplicationIDs returns appIDs sorted as in order, the IDs not in order are kept after in their order
+func orderApplicationIDs(appIDs, order []string) []string {
+	if len(order) == 0 {
+		return appIDs
+	}
+
+	held := make(map[string]bool, len(appIDs))
+	for _, appID := range appIDs {
+		held[appID] = true
+	}
+
+	ordered := make([]string, 0, len(appIDs))
+	inOrder := make(map[string]bool, len(order))
+
+	for _, appID := range order {
+		if held[appID] && !inOrder[appID] {
+			inOrder[appID] = true
+			ordered = append(ordered, appID)
+		}
+	}
+
+	for _, appID := range appIDs {
+		if !inOrder[appID] {
+			ordered = append(ordered, appID)
+		}
+	}
+
+	return ordered
+}
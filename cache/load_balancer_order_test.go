@@ -0,0 +1,64 @@
+package cache
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+func newLoadBalancerAppOrderReaderMock(orders map[string][]string, err error) *LoadBalancerAppOrderReaderMock {
+	readerMock := &ReaderMock{}
+
+	readerMock.On("ReadApplications").Return([]*repository.Application{
+		{ID: "5f62b7d8be3591c4dea8566d"},
+		{ID: "5f62b7d8be3591c4dea8566a"},
+		{ID: "5f62b7d8be3591c4dea8566f"},
+	}, nil)
+	readerMock.On("ReadBlockchains").Return([]*repository.Blockchain{}, nil)
+	readerMock.On("ReadLoadBalancers").Return([]*repository.LoadBalancer{
+		{
+			ID:             "60ecb2bf67774900350d9c42",
+			ApplicationIDs: []string{"5f62b7d8be3591c4dea8566d", "5f62b7d8be3591c4dea8566a", "5f62b7d8be3591c4dea8566f"},
+		},
+		{
+			ID:             "60ecb2bf67774900350d9c43",
+			ApplicationIDs: []string{"5f62b7d8be3591c4dea8566d", "5f62b7d8be3591c4dea8566a"},
+		},
+	}, nil)
+	readerMock.On("ReadPayPlans").Return([]*repository.PayPlan{}, nil)
+	readerMock.On("ReadRedirects").Return([]*repository.Redirect{}, nil)
+	readerMock.On("ReadLoadBalancerApplicationOrders").Return(orders, err)
+
+	return &LoadBalancerAppOrderReaderMock{ReaderMock: readerMock}
+}
+
+func appIDs(lb *repository.LoadBalancer) []string {
+	ids := []string{}
+	for _, app := range lb.Applications {
+		ids = append(ids, app.ID)
+	}
+
+	return ids
+}
+
+func TestCache_LoadBalancerAppOrder(t *testing.T) {
+	c := require.New(t)
+
+	cache := NewCache(newLoadBalancerAppOrderReaderMock(map[string][]string{
+		// applications the reader no longer holds are ignored, the ones missing are kept last
+		"60ecb2bf67774900350d9c42": {"5f62b7d8be3591c4dea8566f", "5f62b7d8be3591c4dea85664", "5f62b7d8be3591c4dea8566d"},
+	}, nil), logrus.New())
+
+	c.NoError(cache.SetCache())
+
+	c.Equal([]string{"5f62b7d8be3591c4dea8566f", "5f62b7d8be3591c4dea8566d", "5f62b7d8be3591c4dea8566a"},
+		appIDs(cache.GetLoadBalancer("60ecb2bf67774900350d9c42")))
+	c.Equal([]string{"5f62b7d8be3591c4dea8566d", "5f62b7d8be3591c4dea8566a"},
+		appIDs(cache.GetLoadBalancer("60ecb2bf67774900350d9c43")))
+
+	failingCache := NewCache(newLoadBalancerAppOrderReaderMock(nil, errors.New("dummy error")), logrus.New())
+	c.Error(failingCache.SetCache())
+}
@@ -0,0 +1,52 @@
+package cache
+
+import (
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Indexes of the lookups whose latency is observed
+const (
+	IndexApplicationID      = "application_id"
+	IndexApplicationUserID  = "application_user_id"
+	IndexApplicationAddress = "application_address"
+	IndexBlockchainID       = "blockchain_id"
+	IndexLoadBalancerID     = "load_balancer_id"
+	IndexLoadBalancerUserID = "load_balancer_user_id"
+	IndexLoadBalancerOrigin = "load_balancer_origin"
+	IndexPayPlanType        = "pay_plan_type"
+)
+
+// lookupObserver holds who receives the indexed lookups latencies, and from which latency they are logged
+type lookupObserver struct {
+	observe       func(index string, duration time.Duration)
+	slowThreshold time.Duration
+}
+
+// SetLookupObserver sets observe to receive the latency of every indexed lookup, lock wait included, nil observes none
+// lookups slower than slowThreshold are logged as warnings, as they hint at lock contention or GC pauses, 0 logs none
+func (c *Cache) SetLookupObserver(observe func(index string, duration time.Duration), slowThreshold time.Duration) {
+	c.lookups.Store(lookupObserver{observe: observe, slowThreshold: slowThreshold})
+}
+
+// observeLookup observes the lookup on index started at start, meant to be deferred before taking the lock
+func (c *Cache) observeLookup(index string, start time.Time) {
+	observer, ok := c.lookups.Load().(lookupObserver)
+	if !ok {
+		return
+	}
+
+	duration := time.Since(start)
+
+	if observer.observe != nil {
+		observer.observe(index, duration)
+	}
+
+	if observer.slowThreshold > 0 && duration >= observer.slowThreshold {
+		c.log.WithFields(logrus.Fields{
+			"index":      index,
+			"durationMS": float64(duration.Microseconds()) / 1000,
+		}).Warn("slow cache lookup")
+	}
+}
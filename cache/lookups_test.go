@@ -0,0 +1,53 @@
+package cache
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCache_LookupObserver(t *testing.T) {
+	c := require.New(t)
+
+	readerMock := &ReaderMock{}
+
+	readerMock.On("ReadBlockchains").Return([]*repository.Blockchain{
+		{ID: "0001", Ticker: "POKT"},
+	}, nil)
+
+	var logs bytes.Buffer
+
+	logger := logrus.New()
+	logger.SetOutput(&logs)
+
+	cache := NewCache(readerMock, logger)
+
+	c.NoError(cache.setBlockchains())
+
+	// lookups are not observed until an observer is set
+	c.NotNil(cache.GetBlockchain("0001"))
+
+	observed := map[string]int{}
+
+	cache.SetLookupObserver(func(index string, duration time.Duration) {
+		observed[index]++
+	}, 0)
+
+	cache.GetBlockchain("0001")
+	cache.GetBlockchain("0002")
+	cache.GetLoadBalancersByUserID("60ecb2bf67774900350d9c43")
+
+	c.Equal(map[string]int{IndexBlockchainID: 2, IndexLoadBalancerUserID: 1}, observed)
+	c.Empty(logs.String())
+
+	cache.SetLookupObserver(nil, time.Nanosecond)
+
+	cache.GetBlockchain("0001")
+
+	c.Contains(logs.String(), "slow cache lookup")
+	c.Contains(logs.String(), "index=blockchain_id")
+}
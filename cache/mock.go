@@ -1,6 +1,7 @@
 package cache
 
 import (
+	"github.com/pokt-foundation/pocket-http-db/types"
 	postgresdriver "github.com/pokt-foundation/portal-api-go/postgres-driver"
 	"github.com/pokt-foundation/portal-api-go/repository"
 	"github.com/stretchr/testify/mock"
@@ -57,3 +58,51 @@ func (r *ReaderMock) ReadRedirects() ([]*repository.Redirect, error) {
 func (r *ReaderMock) NotificationChannel() <-chan *repository.Notification {
 	return r.notification
 }
+
+// LabelReaderMock struct handler for mocking a reader holding labels
+type LabelReaderMock struct {
+	*ReaderMock
+}
+
+func (r *LabelReaderMock) ReadLabels() ([]*types.EntityLabels, error) {
+	args := r.Called()
+
+	return args.Get(0).([]*types.EntityLabels), args.Error(1)
+}
+
+// ApplicationFilterReaderMock struct handler for mocking a reader holding application filters
+type ApplicationFilterReaderMock struct {
+	*ReaderMock
+}
+
+func (r *ApplicationFilterReaderMock) ReadApplicationFilters() ([]*types.ApplicationFilter, error) {
+	args := r.Called()
+
+	return args.Get(0).([]*types.ApplicationFilter), args.Error(1)
+}
+
+// LoadBalancerAppOrderReaderMock struct handler for mocking a reader holding the order of load balancers applications
+type LoadBalancerAppOrderReaderMock struct {
+	*ReaderMock
+}
+
+func (r *LoadBalancerAppOrderReaderMock) ReadLoadBalancerApplicationOrders() (map[string][]string, error) {
+	args := r.Called()
+
+	orders, _ := args.Get(0).(map[string][]string)
+
+	return orders, args.Error(1)
+}
+
+// ApplicationReaderMock struct handler for mocking a reader able to read single applications
+type ApplicationReaderMock struct {
+	*ReaderMock
+}
+
+func (r *ApplicationReaderMock) ReadApplication(id string) (*repository.Application, error) {
+	args := r.Called(id)
+
+	app, _ := args.Get(0).(*repository.Application)
+
+	return app, args.Error(1)
+}
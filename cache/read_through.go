@@ -0,0 +1,117 @@
+package cache
+
+import (
+	"time"
+
+	"github.com/pokt-foundation/portal-api-go/repository"
+)
+
+// maxReadThroughMisses bounds the misses remembered, so lookups of random IDs cannot grow them without limit
+const maxReadThroughMisses = 10000
+
+// ApplicationReader is implemented by readers able to read a single application
+type ApplicationReader interface {
+	ReadApplication(id string) (*repository.Application, error)
+}
+
+// SetReadThroughMissTTL sets how long ReadApplication remembers the applications the reader does not hold,
+// so repeated lookups of the same missing ID are not read again until then, 0 remembers none
+func (c *Cache) SetReadThroughMissTTL(ttl time.Duration) {
+	c.readThroughMutex.Lock()
+	defer c.readThroughMutex.Unlock()
+
+	c.missTTL = ttl
+	c.misses = nil
+}
+
+// ReadApplication returns the application from cache, reading it from the reader on a miss
+// the application read is added to the cache, so writes made to the database outside of this
+// service are served before the next refresh. Returns nil if the reader does not hold it either
+// or cannot read single applications
+func (c *Cache) ReadApplication(applicationID string) (*repository.Application, error) {
+	app := c.GetApplication(applicationID)
+	if app != nil {
+		return app, nil
+	}
+
+	appReader, ok := c.reader.(ApplicationReader)
+	if !ok {
+		return nil, nil
+	}
+
+	if c.recentlyMissed(applicationID) {
+		return nil, nil
+	}
+
+	app, err := appReader.ReadApplication(applicationID)
+	if err != nil {
+		return nil, err
+	}
+
+	// the plan is resolved into the limits, an application of an unknown plan waits for the next refresh
+	if app == nil || app.PayPlanType != "" && c.GetPayPlan(app.PayPlanType) == nil {
+		c.recordMiss(applicationID)
+		return nil, nil
+	}
+
+	c.readThroughMutex.Lock()
+	defer c.readThroughMutex.Unlock()
+
+	// a notification or another read may have added it in the meantime
+	if cached := c.GetApplication(applicationID); cached != nil {
+		return cached, nil
+	}
+
+	c.addApplication(*app)
+
+	return c.GetApplication(applicationID), nil
+}
+
+// recentlyMissed returns true if the reader did not hold the application less than the miss TTL ago
+func (c *Cache) recentlyMissed(applicationID string) bool {
+	c.readThroughMutex.Lock()
+	defer c.readThroughMutex.Unlock()
+
+	expiresAt, ok := c.misses[applicationID]
+	if !ok {
+		return false
+	}
+
+	if time.Now().Before(expiresAt) {
+		return true
+	}
+
+	delete(c.misses, applicationID)
+
+	return false
+}
+
+// recordMiss remembers the reader did not hold the application, unless the misses are full of unexpired ones
+func (c *Cache) recordMiss(applicationID string) {
+	c.readThroughMutex.Lock()
+	defer c.readThroughMutex.Unlock()
+
+	if c.missTTL <= 0 {
+		return
+	}
+
+	now := time.Now()
+
+	if c.misses == nil {
+		c.misses = make(map[string]time.Time)
+	}
+
+	if len(c.misses) >= maxReadThroughMisses {
+		for id, expiresAt := range c.misses {
+			if !now.Before(expiresAt) {
+				delete(c.misses, id)
+			}
+		}
+
+		if len(c.misses) >= maxReadThroughMisses {
+			return
+		}
+	}
+
+	c.misses[applicationID] = now.Add(c.missTTL)
+}
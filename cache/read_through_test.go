@@ -0,0 +1,119 @@
+package cache
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCache_ReadApplication(t *testing.T) {
+	c := require.New(t)
+
+	readerMock := &ReaderMock{}
+
+	readerMock.On("ReadApplications").Return([]*repository.Application{
+		{ID: "5f62b7d8be3591c4dea8566d", UserID: "60ecb2bf67774900350d9c43"},
+	}, nil)
+	readerMock.On("ReadBlockchains").Return([]*repository.Blockchain{}, nil)
+	readerMock.On("ReadLoadBalancers").Return([]*repository.LoadBalancer{}, nil)
+	readerMock.On("ReadPayPlans").Return([]*repository.PayPlan{
+		{PlanType: repository.FreetierV0, DailyLimit: 250000},
+	}, nil)
+	readerMock.On("ReadRedirects").Return([]*repository.Redirect{}, nil)
+
+	readerMock.On("ReadApplication", "5f62b7d8be3591c4dea8566a").Return(&repository.Application{
+		ID:          "5f62b7d8be3591c4dea8566a",
+		UserID:      "60ecb2bf67774900350d9c43",
+		PayPlanType: repository.FreetierV0,
+	}, nil).Once()
+	readerMock.On("ReadApplication", "5f62b7d8be3591c4dea8566f").Return(nil, nil).Once()
+	readerMock.On("ReadApplication", "5f62b7d8be3591c4dea8566b").Return(&repository.Application{
+		ID:          "5f62b7d8be3591c4dea8566b",
+		PayPlanType: repository.PayPlanType("WRONG_V0"),
+	}, nil).Once()
+	readerMock.On("ReadApplication", "5f62b7d8be3591c4dea8566c").Return(nil, errors.New("dummy error")).Once()
+
+	cache := NewCache(&ApplicationReaderMock{ReaderMock: readerMock}, logrus.New())
+	c.NoError(cache.SetCache())
+
+	// hits do not read
+	app, err := cache.ReadApplication("5f62b7d8be3591c4dea8566d")
+	c.NoError(err)
+	c.Equal("5f62b7d8be3591c4dea8566d", app.ID)
+
+	app, err = cache.ReadApplication("5f62b7d8be3591c4dea8566a")
+	c.NoError(err)
+	c.Equal(250000, app.Limits.DailyLimit)
+	c.Empty(app.PayPlanType)
+	c.Equal(app, cache.GetApplication("5f62b7d8be3591c4dea8566a"))
+	c.Len(cache.GetApplicationsByUserID("60ecb2bf67774900350d9c43"), 2)
+
+	// now cached, so the reader is not asked again
+	_, err = cache.ReadApplication("5f62b7d8be3591c4dea8566a")
+	c.NoError(err)
+
+	app, err = cache.ReadApplication("5f62b7d8be3591c4dea8566f")
+	c.NoError(err)
+	c.Nil(app)
+
+	app, err = cache.ReadApplication("5f62b7d8be3591c4dea8566b")
+	c.NoError(err)
+	c.Nil(app)
+
+	_, err = cache.ReadApplication("5f62b7d8be3591c4dea8566c")
+	c.EqualError(err, "dummy error")
+
+	readerMock.AssertExpectations(t)
+
+	// readers unable to read single applications only serve the cache
+	cache = NewCache(readerMock, logrus.New())
+	c.NoError(cache.SetCache())
+
+	app, err = cache.ReadApplication("5f62b7d8be3591c4dea8566a")
+	c.NoError(err)
+	c.Nil(app)
+}
+
+func TestCache_ReadApplicationMissTTL(t *testing.T) {
+	c := require.New(t)
+
+	readerMock := &ReaderMock{}
+
+	readerMock.On("ReadApplications").Return([]*repository.Application{}, nil)
+	readerMock.On("ReadBlockchains").Return([]*repository.Blockchain{}, nil)
+	readerMock.On("ReadLoadBalancers").Return([]*repository.LoadBalancer{}, nil)
+	readerMock.On("ReadPayPlans").Return([]*repository.PayPlan{}, nil)
+	readerMock.On("ReadRedirects").Return([]*repository.Redirect{}, nil)
+
+	readerMock.On("ReadApplication", "5f62b7d8be3591c4dea8566a").Return(nil, nil).Twice()
+	readerMock.On("ReadApplication", "5f62b7d8be3591c4dea8566c").Return(nil, errors.New("dummy error")).Twice()
+
+	cache := NewCache(&ApplicationReaderMock{ReaderMock: readerMock}, logrus.New())
+	c.NoError(cache.SetCache())
+
+	cache.SetReadThroughMissTTL(time.Minute)
+
+	for i := 0; i < 3; i++ {
+		app, err := cache.ReadApplication("5f62b7d8be3591c4dea8566a")
+		c.NoError(err)
+		c.Nil(app)
+	}
+
+	// failed reads are not remembered
+	for i := 0; i < 2; i++ {
+		_, err := cache.ReadApplication("5f62b7d8be3591c4dea8566c")
+		c.EqualError(err, "dummy error")
+	}
+
+	// read again once expired
+	cache.misses["5f62b7d8be3591c4dea8566a"] = time.Now().Add(-time.Second)
+
+	_, err := cache.ReadApplication("5f62b7d8be3591c4dea8566a")
+	c.NoError(err)
+
+	readerMock.AssertExpectations(t)
+}
@@ -0,0 +1,131 @@
+package cache
+
+import (
+	"time"
+)
+
+// refreshCall is a cache reload in flight, err and generation are set before done is closed
+type refreshCall struct {
+	done        chan struct{}
+	triggeredBy string
+	startedAt   time.Time
+	stepsDone   int
+	lastStep    string
+	err         error
+	generation  uint64
+}
+
+// RefreshStatus describes the reloads started by Refresh and StartRefresh
+type RefreshStatus struct {
+	InProgress bool `json:"inProgress"`
+	// TriggeredBy and StartedAt are of the reload in progress, or of the last one if none is in progress
+	TriggeredBy string    `json:"triggeredBy,omitempty"`
+	StartedAt   time.Time `json:"startedAt,omitempty"`
+	// StepsDone out of TotalSteps is the progress of the reload, LastStep the last step done
+	StepsDone  int    `json:"stepsDone"`
+	TotalSteps int    `json:"totalSteps"`
+	LastStep   string `json:"lastStep,omitempty"`
+	// LastDurationSeconds is how long the last finished reload took
+	LastDurationSeconds float64 `json:"lastDurationSeconds,omitempty"`
+	LastError           string  `json:"lastError,omitempty"`
+	// Generation is the cache generation once the last reload finished
+	Generation uint64 `json:"generation"`
+	// Errors is the number of failed reloads since the cache was created, including the reloads of single entities
+	Errors uint64 `json:"errors"`
+}
+
+// Refresh reloads the cache as SetCache, concurrent calls are coalesced into a single reload
+// so a burst of refreshes queries the database once, every caller gets the error of the shared reload
+// triggeredBy identifies who asked for the reload, kept only if it starts one
+func (c *Cache) Refresh(triggeredBy string) error {
+	call, _ := c.startRefresh(triggeredBy)
+
+	<-call.done
+
+	return call.err
+}
+
+// StartRefresh starts a reload as Refresh without waiting for it, joining the reload in flight if there is one
+// returns a channel closed once the reload is done and true if this call started it
+func (c *Cache) StartRefresh(triggeredBy string) (<-chan struct{}, bool) {
+	call, started := c.startRefresh(triggeredBy)
+
+	return call.done, started
+}
+
+// RefreshStatus returns the status of the reloads started by Refresh and StartRefresh
+// it does not wait for the reload in progress, which holds the cache lock
+func (c *Cache) RefreshStatus() RefreshStatus {
+	c.refreshMutex.Lock()
+	defer c.refreshMutex.Unlock()
+
+	status := RefreshStatus{TotalSteps: len(loadSteps), Errors: c.refreshErrors}
+
+	if c.lastRefresh != nil {
+		status.TriggeredBy = c.lastRefresh.triggeredBy
+		status.StartedAt = c.lastRefresh.startedAt
+		status.StepsDone = c.lastRefresh.stepsDone
+		status.LastStep = c.lastRefresh.lastStep
+		status.Generation = c.lastRefresh.generation
+		status.LastDurationSeconds = c.lastRefreshDuration.Seconds()
+
+		if c.lastRefresh.err != nil {
+			status.LastError = c.lastRefresh.err.Error()
+		}
+	}
+
+	if c.refreshing != nil {
+		status.InProgress = true
+		status.TriggeredBy = c.refreshing.triggeredBy
+		status.StartedAt = c.refreshing.startedAt
+		status.StepsDone = c.refreshing.stepsDone
+		status.LastStep = c.refreshing.lastStep
+	}
+
+	return status
+}
+
+func (c *Cache) startRefresh(triggeredBy string) (*refreshCall, bool) {
+	c.refreshMutex.Lock()
+	defer c.refreshMutex.Unlock()
+
+	if c.refreshing != nil {
+		return c.refreshing, false
+	}
+
+	call := &refreshCall{done: make(chan struct{}), triggeredBy: triggeredBy, startedAt: time.Now()}
+	c.refreshing = call
+
+	go func() {
+		err := c.setCache(func(step string) {
+			c.refreshMutex.Lock()
+			call.stepsDone++
+			call.lastStep = step
+			c.refreshMutex.Unlock()
+		})
+		generation, _ := c.Generation()
+
+		c.refreshMutex.Lock()
+		call.err = err
+		call.generation = generation
+		c.refreshing = nil
+		c.lastRefresh = call
+		c.lastRefreshDuration = time.Since(call.startedAt)
+		if err != nil {
+			c.refreshErrors++
+		}
+		c.refreshMutex.Unlock()
+
+		close(call.done)
+	}()
+
+	return call, true
+}
+
+// countRefreshError records a failed reload that was not started by Refresh nor StartRefresh
+func (c *Cache) countRefreshError() {
+	c.refreshMutex.Lock()
+	defer c.refreshMutex.Unlock()
+
+	c.refreshErrors++
+}
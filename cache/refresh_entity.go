@@ -0,0 +1,122 @@
+package cache
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/pokt-foundation/pocket-http-db/types"
+	"github.com/pokt-foundation/portal-api-go/repository"
+)
+
+// ErrUnknownEntity is returned by RefreshEntity for entities it cannot reload on their own
+var ErrUnknownEntity = errors.New("unknown cache entity")
+
+// entitySteps are the steps reloading each entity, followed by the steps of the entities built from it
+// applications hold the limits of their pay plan, blockchains their redirects and load balancers their applications
+var entitySteps = map[string][]string{
+	"pay_plans":      {"pay_plans", "applications", "load_balancers"},
+	"redirects":      {"redirects", "blockchains"},
+	"applications":   {"applications", "load_balancers"},
+	"blockchains":    {"blockchains"},
+	"load_balancers": {"load_balancers"},
+}
+
+// entitySnapshot holds the entities of the cache as they were before a reload, to put them back if it fails
+type entitySnapshot struct {
+	applicationsMap            map[string]*repository.Application
+	applicationsMapByUserID    map[string][]*repository.Application
+	applicationsMapByAddress   map[string]*repository.Application
+	applications               []*repository.Application
+	blockchainsMap             map[string]*repository.Blockchain
+	blockchains                []*repository.Blockchain
+	loadBalancersMap           map[string]*repository.LoadBalancer
+	loadBalancersMapByUserID   map[string][]*repository.LoadBalancer
+	loadBalancersMapByOrigin   map[string][]*repository.LoadBalancer
+	loadBalancers              []*repository.LoadBalancer
+	payPlansMap                map[repository.PayPlanType]*repository.PayPlan
+	payPlans                   []*repository.PayPlan
+	redirectsMapByBlockchainID map[string][]*repository.Redirect
+	labels                     map[types.EntityType]map[string]map[string]string
+	applicationFilters         map[string]*types.ApplicationFilter
+}
+
+// snapshotEntities returns the entities of the cache, must be called holding the cache lock
+// reloads replace the maps and slices instead of changing them, so keeping them is enough to put them back
+func (c *Cache) snapshotEntities() entitySnapshot {
+	return entitySnapshot{
+		applicationsMap:            c.applicationsMap,
+		applicationsMapByUserID:    c.applicationsMapByUserID,
+		applicationsMapByAddress:   c.applicationsMapByAddress,
+		applications:               c.applications,
+		blockchainsMap:             c.blockchainsMap,
+		blockchains:                c.blockchains,
+		loadBalancersMap:           c.loadBalancersMap,
+		loadBalancersMapByUserID:   c.loadBalancersMapByUserID,
+		loadBalancersMapByOrigin:   c.loadBalancersMapByOrigin,
+		loadBalancers:              c.loadBalancers,
+		payPlansMap:                c.payPlansMap,
+		payPlans:                   c.payPlans,
+		redirectsMapByBlockchainID: c.redirectsMapByBlockchainID,
+		labels:                     c.labels,
+		applicationFilters:         c.applicationFilters,
+	}
+}
+
+// restoreEntities puts back the entities of snapshot, must be called holding the cache lock
+func (c *Cache) restoreEntities(snapshot entitySnapshot) {
+	c.applicationsMap = snapshot.applicationsMap
+	c.applicationsMapByUserID = snapshot.applicationsMapByUserID
+	c.applicationsMapByAddress = snapshot.applicationsMapByAddress
+	c.applications = snapshot.applications
+	c.blockchainsMap = snapshot.blockchainsMap
+	c.blockchains = snapshot.blockchains
+	c.loadBalancersMap = snapshot.loadBalancersMap
+	c.loadBalancersMapByUserID = snapshot.loadBalancersMapByUserID
+	c.loadBalancersMapByOrigin = snapshot.loadBalancersMapByOrigin
+	c.loadBalancers = snapshot.loadBalancers
+	c.payPlansMap = snapshot.payPlansMap
+	c.payPlans = snapshot.payPlans
+	c.redirectsMapByBlockchainID = snapshot.redirectsMapByBlockchainID
+	c.labels = snapshot.labels
+	c.applicationFilters = snapshot.applicationFilters
+}
+
+// RefreshEntity reloads entity from the reader along with the entities built from it, returning the entities reloaded
+// readers wait for the whole reload and the cache is left as it was if any of them fails, the generation is kept
+// as only a full reload starts a new one
+func (c *Cache) RefreshEntity(entity string) ([]string, error) {
+	steps, ok := entitySteps[entity]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownEntity, entity)
+	}
+
+	loads := map[string]func() error{
+		"pay_plans":      c.setPayPlans,
+		"redirects":      c.setRedirects,
+		"applications":   c.setApplications,
+		"blockchains":    c.setBlockchains,
+		"load_balancers": c.setLoadBalancers,
+	}
+
+	changes := c.newChangeSet()
+	defer changes.dispatch()
+
+	c.rwMutex.Lock()
+	defer c.rwMutex.Unlock()
+
+	snapshot := c.snapshotEntities()
+
+	for _, step := range steps {
+		err := loads[step]()
+		if err != nil {
+			c.restoreEntities(snapshot)
+			c.countRefreshError()
+
+			return nil, fmt.Errorf("err refreshing %s: %w", step, err)
+		}
+	}
+
+	changes.addReloads(steps)
+
+	return steps, nil
+}
@@ -0,0 +1,124 @@
+package cache
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCache_Refresh(t *testing.T) {
+	c := require.New(t)
+
+	release := make(chan struct{})
+
+	readerMock := &ReaderMock{}
+
+	readerMock.On("ReadPayPlans").Return([]*repository.PayPlan{}, nil).Run(func(mock.Arguments) {
+		<-release
+	}).Once()
+	readerMock.On("ReadApplications").Return([]*repository.Application{}, nil)
+	readerMock.On("ReadBlockchains").Return([]*repository.Blockchain{}, nil)
+	readerMock.On("ReadLoadBalancers").Return([]*repository.LoadBalancer{}, nil)
+	readerMock.On("ReadRedirects").Return([]*repository.Redirect{}, nil)
+
+	cache := NewCache(readerMock, logrus.New())
+
+	c.False(cache.RefreshStatus().InProgress)
+
+	done, started := cache.StartRefresh("test")
+	c.True(started)
+
+	joined, started := cache.StartRefresh("test")
+	c.False(started)
+	c.Equal(done, joined)
+	c.True(cache.RefreshStatus().InProgress)
+
+	close(release)
+
+	<-done
+
+	status := cache.RefreshStatus()
+	c.False(status.InProgress)
+	c.Equal(uint64(1), status.Generation)
+	c.Empty(status.LastError)
+	c.Equal("test", status.TriggeredBy)
+	c.Equal(len(loadSteps), status.StepsDone)
+	c.Equal("application_filters", status.LastStep)
+	readerMock.AssertNumberOfCalls(t, "ReadPayPlans", 1)
+
+	readerMock.On("ReadPayPlans").Return([]*repository.PayPlan(nil), errors.New("dummy error")).Once()
+
+	c.Equal(uint64(0), cache.RefreshStatus().Errors)
+
+	c.Error(cache.Refresh("test"))
+	c.Contains(cache.RefreshStatus().LastError, "dummy error")
+	c.Equal(uint64(1), cache.RefreshStatus().Errors)
+}
+
+func TestCache_RefreshEntity(t *testing.T) {
+	c := require.New(t)
+
+	readerMock := &ReaderMock{}
+
+	readerMock.On("ReadPayPlans").Return([]*repository.PayPlan{
+		{PlanType: repository.FreetierV0, DailyLimit: 250000},
+	}, nil).Once()
+	// the cache takes over what the reader returns, so every read returns new entities
+	for i := 0; i < 2; i++ {
+		readerMock.On("ReadApplications").Return([]*repository.Application{
+			{ID: "5f62b7d8be3591c4dea8566d", PayPlanType: repository.FreetierV0},
+		}, nil).Once()
+		readerMock.On("ReadLoadBalancers").Return([]*repository.LoadBalancer{
+			{ID: "60ecb2bf67774900350d9c42", ApplicationIDs: []string{"5f62b7d8be3591c4dea8566d"}},
+		}, nil).Once()
+	}
+
+	readerMock.On("ReadBlockchains").Return([]*repository.Blockchain{{ID: "0021"}}, nil)
+	readerMock.On("ReadRedirects").Return([]*repository.Redirect{}, nil).Once()
+
+	cache := NewCache(readerMock, logrus.New())
+	c.NoError(cache.SetCache())
+
+	// applications get the limits of the pay plans reloaded, and load balancers the applications reloaded
+	readerMock.On("ReadPayPlans").Return([]*repository.PayPlan{
+		{PlanType: repository.FreetierV0, DailyLimit: 500000},
+	}, nil).Once()
+
+	refreshed, err := cache.RefreshEntity("pay_plans")
+	c.NoError(err)
+	c.Equal([]string{"pay_plans", "applications", "load_balancers"}, refreshed)
+	c.Equal(500000, cache.GetApplication("5f62b7d8be3591c4dea8566d").Limits.DailyLimit)
+	c.Equal(500000, cache.GetLoadBalancer("60ecb2bf67774900350d9c42").Applications[0].Limits.DailyLimit)
+
+	readerMock.On("ReadRedirects").Return([]*repository.Redirect{
+		{BlockchainID: "0021", Domain: "pokt-mainnet.gateway.network"},
+	}, nil).Once()
+
+	refreshed, err = cache.RefreshEntity("redirects")
+	c.NoError(err)
+	c.Equal([]string{"redirects", "blockchains"}, refreshed)
+	c.Len(cache.GetBlockchain("0021").Redirects, 1)
+
+	// a failed reload leaves the cache as it was
+	readerMock.On("ReadPayPlans").Return([]*repository.PayPlan{
+		{PlanType: repository.FreetierV0, DailyLimit: 750000},
+	}, nil).Once()
+	readerMock.On("ReadApplications").Return([]*repository.Application(nil), errors.New("dummy error")).Once()
+
+	_, err = cache.RefreshEntity("pay_plans")
+	c.Error(err)
+	c.Equal(500000, cache.GetPayPlan(repository.FreetierV0).DailyLimit)
+	c.Equal(500000, cache.GetApplication("5f62b7d8be3591c4dea8566d").Limits.DailyLimit)
+	c.Equal(uint64(1), cache.RefreshStatus().Errors)
+
+	_, err = cache.RefreshEntity("labels")
+	c.ErrorIs(err, ErrUnknownEntity)
+
+	// only full reloads start a new generation
+	generation, _ := cache.Generation()
+	c.Equal(uint64(1), generation)
+}
@@ -0,0 +1,149 @@
+// Package casing rewrites the field names of JSON documents so consumers can pick the casing they expect,
+// the repository structs mix camelCase, snake_case and untagged fields
+package casing
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"unicode"
+)
+
+// Profile represents a response serialization profile
+type Profile string
+
+const (
+	// Default keeps the field names as serialized by the structs
+	Default Profile = "default"
+	// Camel renames all fields to camelCase, keeping initialisms uppercase as in userID
+	Camel Profile = "camel"
+	// Snake renames all fields to snake_case
+	Snake Profile = "snake"
+)
+
+// initialisms are kept uppercase on camelCase fields
+var initialisms = map[string]string{
+	"id":  "ID",
+	"ids": "IDs",
+	"url": "URL",
+	"aat": "AAT",
+	"api": "API",
+}
+
+// ParseProfile returns the profile named name
+func ParseProfile(name string) (Profile, bool) {
+	profile := Profile(strings.ToLower(strings.TrimSpace(name)))
+
+	switch profile {
+	case Default, Camel, Snake:
+		return profile, true
+	default:
+		return "", false
+	}
+}
+
+// Convert renames every object field in the JSON document data according to profile
+func Convert(data []byte, profile Profile) ([]byte, error) {
+	if profile == Default || profile == "" {
+		return data, nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var document any
+
+	err := decoder.Decode(&document)
+	if err != nil {
+		return nil, err
+	}
+
+	rename := ToCamel
+	if profile == Snake {
+		rename = ToSnake
+	}
+
+	return json.Marshal(convertValue(document, rename))
+}
+
+func convertValue(value any, rename func(string) string) any {
+	switch typed := value.(type) {
+	case map[string]any:
+		converted := make(map[string]any, len(typed))
+		for key, field := range typed {
+			converted[rename(key)] = convertValue(field, rename)
+		}
+
+		return converted
+	case []any:
+		for i, item := range typed {
+			typed[i] = convertValue(item, rename)
+		}
+
+		return typed
+	default:
+		return value
+	}
+}
+
+// words splits a field name written in any casing into lowercase words
+func words(name string) []string {
+	// plural initialisms would otherwise be split as in I_Ds
+	name = strings.ReplaceAll(name, "IDs", "Ids")
+
+	var (
+		result  []string
+		current []rune
+	)
+
+	runes := []rune(name)
+
+	flush := func() {
+		if len(current) > 0 {
+			result = append(result, strings.ToLower(string(current)))
+			current = nil
+		}
+	}
+
+	for i, r := range runes {
+		switch {
+		case r == '_' || r == '-' || r == ' ':
+			flush()
+			continue
+		case unicode.IsUpper(r) && i > 0:
+			previous := runes[i-1]
+			nextIsLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+
+			if unicode.IsLower(previous) || unicode.IsDigit(previous) || (unicode.IsUpper(previous) && nextIsLower) {
+				flush()
+			}
+		}
+
+		current = append(current, r)
+	}
+
+	flush()
+
+	return result
+}
+
+// ToSnake returns name in snake_case
+func ToSnake(name string) string {
+	return strings.Join(words(name), "_")
+}
+
+// ToCamel returns name in camelCase, with initialisms uppercase after the first word
+func ToCamel(name string) string {
+	parts := words(name)
+
+	for i := 1; i < len(parts); i++ {
+		if initialism, ok := initialisms[parts[i]]; ok {
+			parts[i] = initialism
+			continue
+		}
+
+		parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+	}
+
+	return strings.Join(parts, "")
+}
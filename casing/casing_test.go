@@ -0,0 +1,62 @@
+package casing
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestToSnake(t *testing.T) {
+	c := require.New(t)
+
+	c.Equal("user_id", ToSnake("userID"))
+	c.Equal("application_ids", ToSnake("applicationIDs"))
+	c.Equal("chain_id_check", ToSnake("chainIDCheck"))
+	c.Equal("gateway_aat", ToSnake("gatewayAAT"))
+	c.Equal("applications", ToSnake("Applications"))
+	c.Equal("lb_id", ToSnake("lb_id"))
+}
+
+func TestToCamel(t *testing.T) {
+	c := require.New(t)
+
+	c.Equal("lbID", ToCamel("lb_id"))
+	c.Equal("userID", ToCamel("userID"))
+	c.Equal("applicationIDs", ToCamel("applicationIDs"))
+	c.Equal("applications", ToCamel("Applications"))
+	c.Equal("stickinessOptions", ToCamel("stickiness_options"))
+	c.Equal("gatewayAAT", ToCamel("gateway_aat"))
+}
+
+func TestConvert(t *testing.T) {
+	c := require.New(t)
+
+	data := []byte(`{"userID":"1","Applications":[{"payPlanType":"FREETIER_V0","dailyLimit":250000}],"lb_id":"2"}`)
+
+	converted, err := Convert(data, Snake)
+	c.NoError(err)
+	c.JSONEq(`{"user_id":"1","applications":[{"pay_plan_type":"FREETIER_V0","daily_limit":250000}],"lb_id":"2"}`, string(converted))
+
+	converted, err = Convert(data, Camel)
+	c.NoError(err)
+	c.JSONEq(`{"userID":"1","applications":[{"payPlanType":"FREETIER_V0","dailyLimit":250000}],"lbID":"2"}`, string(converted))
+
+	converted, err = Convert(data, Default)
+	c.NoError(err)
+	c.Equal(data, converted)
+
+	// large numbers keep their precision
+	converted, err = Convert([]byte(`[{"relays":12345678901234567890}]`), Snake)
+	c.NoError(err)
+	c.Equal(`[{"relays":12345678901234567890}]`, string(converted))
+
+	_, err = Convert([]byte(`{`), Snake)
+	c.Error(err)
+
+	profile, ok := ParseProfile(" Snake ")
+	c.True(ok)
+	c.Equal(Snake, profile)
+
+	_, ok = ParseProfile("kebab")
+	c.False(ok)
+}
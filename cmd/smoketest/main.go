@@ -0,0 +1,67 @@
+// Command smoketest runs a read-only probe sequence against a live deployment, such as after a rollout,
+// writing a JSON report to stdout and exiting non-zero if any probe failed
+//
+//	SMOKETEST_API_KEY=key smoketest -url https://phd.example.com
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+)
+
+const (
+	exitFailed = 1
+	exitUsage  = 2
+)
+
+func main() {
+	os.Exit(run(os.Args[1:]))
+}
+
+// run runs the probes as requested by args and returns the exit code
+func run(args []string) int {
+	flags := flag.NewFlagSet("smoketest", flag.ContinueOnError)
+
+	baseURL := flags.String("url", "", "URL of the deployment to probe, required")
+	apiKey := flags.String("key", os.Getenv("SMOKETEST_API_KEY"), "API key of the reads, defaults to SMOKETEST_API_KEY")
+	timeout := flags.Duration("timeout", 10*time.Second, "timeout of every request")
+
+	err := flags.Parse(args)
+	if err != nil {
+		return exitUsage
+	}
+
+	if *baseURL == "" || *apiKey == "" {
+		fmt.Fprintln(os.Stderr, "smoketest: -url and an API key are required")
+		flags.Usage()
+
+		return exitUsage
+	}
+
+	p := &prober{
+		baseURL: *baseURL,
+		apiKey:  *apiKey,
+		client:  &http.Client{Timeout: *timeout},
+	}
+
+	report := p.run()
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+
+	err = encoder.Encode(report)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "smoketest: writing report failed: %s\n", err)
+		return exitFailed
+	}
+
+	if !report.Passed {
+		return exitFailed
+	}
+
+	return 0
+}
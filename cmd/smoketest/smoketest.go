@@ -0,0 +1,252 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+var (
+	errUnexpectedStatus = errors.New("unexpected status")
+	errMissingETag      = errors.New("missing ETag")
+	errMissingVersion   = errors.New("missing API version")
+)
+
+// check holds the outcome of a single probe
+type check struct {
+	Name       string `json:"name"`
+	Passed     bool   `json:"passed"`
+	Skipped    bool   `json:"skipped,omitempty"`
+	DurationMS int64  `json:"durationMs"`
+	Detail     string `json:"detail,omitempty"`
+	Error      string `json:"error,omitempty"`
+}
+
+// report holds the outcome of the probes against a deployment, in the order they were run
+type report struct {
+	Target    string    `json:"target"`
+	Passed    bool      `json:"passed"`
+	StartedAt time.Time `json:"startedAt"`
+	Checks    []check   `json:"checks"`
+}
+
+// prober runs the read-only probes against the deployment at baseURL
+type prober struct {
+	baseURL string
+	apiKey  string
+	client  *http.Client
+}
+
+// probe is a single read-only probe, returning the detail of its outcome
+type probe struct {
+	name string
+	run  func(p *prober) (string, error)
+}
+
+// probes are run in order, the ones after a failed health check are skipped
+var probes = []probe{
+	{"health", (*prober).health},
+	{"auth rejection", (*prober).authRejection},
+	{"version", (*prober).version},
+	{"blockchain reads", (*prober).blockchainReads},
+	{"pay plan reads", (*prober).payPlanReads},
+	{"etag revalidation", (*prober).etagRevalidation},
+}
+
+// run runs every probe and returns their report
+func (p *prober) run() *report {
+	r := &report{Target: p.baseURL, Passed: true, StartedAt: time.Now().UTC()}
+
+	healthy := true
+
+	for _, probe := range probes {
+		if !healthy {
+			r.Checks = append(r.Checks, check{Name: probe.name, Skipped: true})
+			r.Passed = false
+
+			continue
+		}
+
+		start := time.Now()
+		detail, err := probe.run(p)
+
+		c := check{Name: probe.name, Passed: err == nil, DurationMS: time.Since(start).Milliseconds(), Detail: detail}
+		if err != nil {
+			c.Error = err.Error()
+			r.Passed = false
+
+			healthy = probe.name != "health"
+		}
+
+		r.Checks = append(r.Checks, c)
+	}
+
+	return r
+}
+
+// get sends a GET request to path, with the API key if authorized, and returns the response with its body read
+func (p *prober) get(path string, authorized bool, header http.Header) (*http.Response, []byte, error) {
+	req, err := http.NewRequest(http.MethodGet, strings.TrimRight(p.baseURL, "/")+path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for name, values := range header {
+		req.Header[name] = values
+	}
+
+	if authorized {
+		req.Header.Set("Authorization", p.apiKey)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resp, body, nil
+}
+
+// getJSON reads path with the API key into value, expecting an OK response
+func (p *prober) getJSON(path string, value any) (*http.Response, error) {
+	resp, body, err := p.get(path, true, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w %d on %s", errUnexpectedStatus, resp.StatusCode, path)
+	}
+
+	err = json.Unmarshal(body, value)
+	if err != nil {
+		return nil, fmt.Errorf("decoding %s failed: %w", path, err)
+	}
+
+	return resp, nil
+}
+
+func (p *prober) health() (string, error) {
+	resp, body, err := p.get("/", false, nil)
+	if err != nil {
+		return "", err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("%w %d", errUnexpectedStatus, resp.StatusCode)
+	}
+
+	return string(body), nil
+}
+
+// authRejection checks a read without API key is unauthorized
+func (p *prober) authRejection() (string, error) {
+	resp, _, err := p.get("/blockchain", false, nil)
+	if err != nil {
+		return "", err
+	}
+
+	if resp.StatusCode != http.StatusUnauthorized {
+		return "", fmt.Errorf("%w %d, want %d", errUnexpectedStatus, resp.StatusCode, http.StatusUnauthorized)
+	}
+
+	return "", nil
+}
+
+// version reads the version of the API the deployment serves from its OpenAPI document
+func (p *prober) version() (string, error) {
+	var document struct {
+		Info struct {
+			Version string `json:"version"`
+		} `json:"info"`
+	}
+
+	_, err := p.getJSON("/openapi.json", &document)
+	if err != nil {
+		return "", err
+	}
+
+	if document.Info.Version == "" {
+		return "", errMissingVersion
+	}
+
+	return "API " + document.Info.Version, nil
+}
+
+// blockchainReads lists the blockchains and reads the first one back
+func (p *prober) blockchainReads() (string, error) {
+	var blockchains []struct {
+		ID string `json:"id"`
+	}
+
+	_, err := p.getJSON("/blockchain", &blockchains)
+	if err != nil {
+		return "", err
+	}
+
+	if len(blockchains) == 0 {
+		return "0 blockchains", nil
+	}
+
+	var blockchain struct {
+		ID string `json:"id"`
+	}
+
+	_, err = p.getJSON("/blockchain/"+blockchains[0].ID, &blockchain)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%d blockchains", len(blockchains)), nil
+}
+
+func (p *prober) payPlanReads() (string, error) {
+	var payPlans []json.RawMessage
+
+	_, err := p.getJSON("/pay_plan", &payPlans)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%d pay plans", len(payPlans)), nil
+}
+
+// etagRevalidation checks a read sets an ETag and answers not modified when the client already holds it
+// the limits are read a single one per page to keep the probe light
+func (p *prober) etagRevalidation() (string, error) {
+	const path = "/application/limits?limit=1"
+
+	var limits []json.RawMessage
+
+	resp, err := p.getJSON(path, &limits)
+	if err != nil {
+		return "", err
+	}
+
+	etag := resp.Header.Get("ETag")
+	if etag == "" {
+		return "", errMissingETag
+	}
+
+	resp, _, err = p.get(path, true, http.Header{"If-None-Match": {etag}})
+	if err != nil {
+		return "", err
+	}
+
+	if resp.StatusCode != http.StatusNotModified {
+		return "", fmt.Errorf("%w %d, want %d", errUnexpectedStatus, resp.StatusCode, http.StatusNotModified)
+	}
+
+	return etag, nil
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pokt-foundation/pocket-http-db/cache"
+	"github.com/pokt-foundation/pocket-http-db/router"
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestServer(t *testing.T) *httptest.Server {
+	readerMock := &cache.ReaderMock{}
+
+	readerMock.On("ReadPayPlans").Return([]*repository.PayPlan{
+		{PlanType: repository.FreetierV0, DailyLimit: 250000},
+	}, nil)
+	readerMock.On("ReadRedirects").Return([]*repository.Redirect{}, nil)
+	readerMock.On("ReadApplications").Return([]*repository.Application{
+		{ID: "5f62b7d8be3591c4dea8566d", UserID: "60ecb2bf67774900350d9c43", PayPlanType: repository.FreetierV0},
+	}, nil)
+	readerMock.On("ReadBlockchains").Return([]*repository.Blockchain{{ID: "0021"}}, nil)
+	readerMock.On("ReadLoadBalancers").Return([]*repository.LoadBalancer{}, nil)
+
+	log := logrus.New()
+	log.SetOutput(io.Discard)
+
+	rt, err := router.NewRouter(readerMock, nil, map[string]bool{"key": true}, log)
+	require.NoError(t, err)
+
+	server := httptest.NewServer(rt.Router)
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestProber_Run(t *testing.T) {
+	c := require.New(t)
+
+	server := newTestServer(t)
+
+	report := (&prober{baseURL: server.URL, apiKey: "key", client: server.Client()}).run()
+	c.True(report.Passed, report.Checks)
+	c.Len(report.Checks, len(probes))
+	c.Equal("API 1.0.0", report.Checks[2].Detail)
+	c.Equal("1 blockchains", report.Checks[3].Detail)
+
+	// a wrong key fails the authorized reads only
+	report = (&prober{baseURL: server.URL, apiKey: "wrong", client: server.Client()}).run()
+	c.False(report.Passed)
+	c.True(report.Checks[0].Passed)
+	c.True(report.Checks[1].Passed)
+	c.False(report.Checks[2].Passed)
+	c.Contains(report.Checks[2].Error, "unexpected status 401")
+}
+
+func TestProber_RunUnhealthy(t *testing.T) {
+	c := require.New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	report := (&prober{baseURL: server.URL, apiKey: "key", client: server.Client()}).run()
+	c.False(report.Passed)
+	c.False(report.Checks[0].Passed)
+
+	// the probes after a failed health check are skipped
+	for _, check := range report.Checks[1:] {
+		c.True(check.Skipped, check.Name)
+	}
+}
+
+func TestRun_Usage(t *testing.T) {
+	c := require.New(t)
+
+	t.Setenv("SMOKETEST_API_KEY", "")
+
+	c.Equal(exitUsage, run([]string{"-url", "http://localhost"}))
+	c.Equal(exitUsage, run([]string{"-wrong"}))
+}
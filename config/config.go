@@ -0,0 +1,104 @@
+// Package config loads the configuration from environment variables into a struct,
+// recording every variable so the effective configuration of a running instance can be inspected
+package config
+
+import (
+	"os"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Redacted replaces the value of secret settings that were set
+const Redacted = "[REDACTED]"
+
+// Setting holds an environment variable as loaded by the instance
+type Setting struct {
+	Name string `json:"name"`
+	// Value is the effective value, redacted for secrets
+	Value   string `json:"value"`
+	Default string `json:"default"`
+	// Set is false when the variable was unset or empty and the default was applied
+	Set bool `json:"set"`
+	// Invalid is true when the variable was set to a value that could not be parsed and the default was applied
+	Invalid bool `json:"invalid,omitempty"`
+	Secret  bool `json:"secret,omitempty"`
+}
+
+// Registry struct handler for the loaded settings
+type Registry struct {
+	settings map[string]Setting
+	mutex    sync.RWMutex
+}
+
+// NewRegistry returns Registry instance
+func NewRegistry() *Registry {
+	return &Registry{
+		settings: map[string]Setting{},
+	}
+}
+
+// Settings returns the loaded settings sorted by name, with the values of secrets redacted
+func (r *Registry) Settings() []Setting {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	settings := make([]Setting, 0, len(r.settings))
+
+	for _, setting := range r.settings {
+		if setting.Secret {
+			setting.Default = redact(setting.Default)
+			setting.Value = redact(setting.Value)
+		}
+
+		settings = append(settings, setting)
+	}
+
+	sort.Slice(settings, func(i, j int) bool {
+		return settings[i].Name < settings[j].Name
+	})
+
+	return settings
+}
+
+// GetDuration returns the name variable as a duration such as 30s or 5m, defaultValue if unset, empty or invalid
+// unlike Load, an invalid value does not fail, it is only recorded as invalid in the settings
+func (r *Registry) GetDuration(name string, defaultValue time.Duration) time.Duration {
+	value := defaultValue
+
+	parsed, err := time.ParseDuration(os.Getenv(name))
+	if err == nil {
+		value = parsed
+	}
+
+	r.record(Setting{
+		Name:    name,
+		Value:   value.String(),
+		Default: defaultValue.String(),
+		Set:     isSet(name),
+		Invalid: isSet(name) && err != nil,
+	})
+
+	return value
+}
+
+func (r *Registry) record(setting Setting) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.settings[setting.Name] = setting
+}
+
+// isSet returns true if the name variable is set to a non empty value, as the environment package considers it
+func isSet(name string) bool {
+	return os.Getenv(name) != ""
+}
+
+// redact returns Redacted in place of non empty values
+func redact(value string) string {
+	if value == "" {
+		return ""
+	}
+
+	return Redacted
+}
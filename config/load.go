@@ -0,0 +1,203 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var errNotStruct = errors.New("configuration must be a pointer to a struct")
+
+// supportedTypes are the types of the fields Load can set
+var supportedTypes = map[reflect.Type]bool{
+	reflect.TypeOf(""):                true,
+	reflect.TypeOf(false):             true,
+	reflect.TypeOf(0):                 true,
+	reflect.TypeOf(int64(0)):          true,
+	reflect.TypeOf(float64(0)):        true,
+	reflect.TypeOf(time.Duration(0)):  true,
+	reflect.TypeOf(map[string]bool{}): true,
+}
+
+// LoadError lists every variable Load could not load, so they can all be fixed before the next start
+type LoadError struct {
+	// Missing are the required variables unset or empty
+	Missing []string
+	// Invalid are the variables set to a value that could not be parsed, with the reason
+	Invalid []string
+}
+
+func (e *LoadError) Error() string {
+	var problems []string
+
+	if len(e.Missing) > 0 {
+		problems = append(problems, "missing required variables: "+strings.Join(e.Missing, ", "))
+	}
+
+	if len(e.Invalid) > 0 {
+		problems = append(problems, "invalid variables: "+strings.Join(e.Invalid, ", "))
+	}
+
+	return "loading configuration failed: " + strings.Join(problems, "; ")
+}
+
+// Load loads the configuration cfg points to in a new registry, and returns the registry
+func Load(cfg any) (*Registry, error) {
+	registry := NewRegistry()
+
+	return registry, registry.Load(cfg)
+}
+
+// Load sets the fields of the struct cfg points to from the environment variables named by their env tag,
+// recording them in the settings
+// the env tag can add the required and secret options, as `env:"API_KEYS,required,secret"`
+// fields default to their default tag, or to their value if they have none, set but invalid variables are not applied
+// string, bool, int, int64, float64, time.Duration and map[string]bool fields are supported,
+// the values of maps being split by their separator tag, "," if they have none
+// returns a *LoadError listing every missing and invalid variable
+func (r *Registry) Load(cfg any) error {
+	value := reflect.ValueOf(cfg)
+	if value.Kind() != reflect.Pointer || value.Elem().Kind() != reflect.Struct {
+		return errNotStruct
+	}
+
+	value = value.Elem()
+	loadErr := &LoadError{}
+
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+
+		tag, ok := field.Tag.Lookup("env")
+		if !ok {
+			continue
+		}
+
+		name, options, _ := strings.Cut(tag, ",")
+
+		var required, secret bool
+
+		for _, option := range strings.Split(options, ",") {
+			switch option {
+			case "required":
+				required = true
+			case "secret":
+				secret = true
+			}
+		}
+
+		if !supportedTypes[field.Type] {
+			return fmt.Errorf("unsupported type %s of %s", field.Type, name)
+		}
+
+		fieldValue := value.Field(i)
+
+		if defaultValue, ok := field.Tag.Lookup("default"); ok {
+			err := parseField(fieldValue, field, defaultValue)
+			if err != nil {
+				return fmt.Errorf("invalid default of %s: %w", name, err)
+			}
+		}
+
+		setting := Setting{Name: name, Default: formatField(fieldValue), Set: isSet(name), Secret: secret}
+
+		switch {
+		case !setting.Set && required:
+			loadErr.Missing = append(loadErr.Missing, name)
+		case setting.Set:
+			err := parseField(fieldValue, field, os.Getenv(name))
+			if err != nil {
+				setting.Invalid = true
+				loadErr.Invalid = append(loadErr.Invalid, fmt.Sprintf("%s %s", name, err))
+			}
+		}
+
+		setting.Value = formatField(fieldValue)
+
+		r.record(setting)
+	}
+
+	if len(loadErr.Missing) > 0 || len(loadErr.Invalid) > 0 {
+		return loadErr
+	}
+
+	return nil
+}
+
+// parseField sets fieldValue, of one of supportedTypes, to rawValue parsed as its type
+// it is left untouched if rawValue is invalid
+func parseField(fieldValue reflect.Value, field reflect.StructField, rawValue string) error {
+	if field.Type == reflect.TypeOf(time.Duration(0)) {
+		parsed, err := time.ParseDuration(rawValue)
+		if err != nil {
+			return errors.New("must be a duration such as 30s or 5m")
+		}
+
+		fieldValue.SetInt(int64(parsed))
+
+		return nil
+	}
+
+	switch fieldValue.Kind() {
+	case reflect.String:
+		fieldValue.SetString(rawValue)
+	case reflect.Bool:
+		parsed, err := strconv.ParseBool(rawValue)
+		if err != nil {
+			return errors.New("must be a boolean")
+		}
+
+		fieldValue.SetBool(parsed)
+	case reflect.Int, reflect.Int64:
+		parsed, err := strconv.ParseInt(rawValue, 10, 64)
+		if err != nil {
+			return errors.New("must be an integer")
+		}
+
+		fieldValue.SetInt(parsed)
+	case reflect.Float64:
+		parsed, err := strconv.ParseFloat(rawValue, 64)
+		if err != nil {
+			return errors.New("must be a number")
+		}
+
+		fieldValue.SetFloat(parsed)
+	case reflect.Map:
+		separator, ok := field.Tag.Lookup("separator")
+		if !ok {
+			separator = ","
+		}
+
+		values := map[string]bool{}
+		for _, value := range strings.Split(rawValue, separator) {
+			values[value] = true
+		}
+
+		fieldValue.Set(reflect.ValueOf(values))
+	}
+
+	return nil
+}
+
+// formatField returns the value of fieldValue as recorded in the settings, maps as their sorted values
+func formatField(fieldValue reflect.Value) string {
+	switch fieldValue.Kind() {
+	case reflect.Map:
+		values := make([]string, 0, fieldValue.Len())
+		for _, key := range fieldValue.MapKeys() {
+			values = append(values, key.String())
+		}
+
+		sort.Strings(values)
+
+		return strings.Join(values, ",")
+	case reflect.Float64:
+		return strconv.FormatFloat(fieldValue.Float(), 'g', -1, 64)
+	default:
+		return fmt.Sprint(fieldValue.Interface())
+	}
+}
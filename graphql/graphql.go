@@ -0,0 +1,424 @@
+// Package graphql executes GraphQL queries over a schema of objects whose fields are resolved by functions,
+// so consumers can fetch the fields and relations they need in a single request
+//
+// Only queries are supported, with aliases, arguments, variables, named and inline fragments. Directives are ignored
+// and the types of the variables are not checked, the resolvers check their arguments. There is no introspection
+// beyond __typename.
+package graphql
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+const (
+	// MaxLength is the longest query parsed
+	MaxLength = 16384
+	// MaxDepth is the deepest nesting of selection sets executed, so relations cannot be followed endlessly
+	MaxDepth = 10
+)
+
+var (
+	ErrTooLong              = fmt.Errorf("query longer than %d characters", MaxLength)
+	ErrTooDeep              = fmt.Errorf("query deeper than %d levels", MaxDepth)
+	ErrSyntax               = errors.New("syntax error")
+	ErrUnsupportedOperation = errors.New("only queries are supported")
+	ErrUnknownOperation     = errors.New("unknown operation")
+	ErrUnknownField         = errors.New("unknown field")
+	ErrUnknownFragment      = errors.New("unknown fragment")
+	ErrInvalidArgument      = errors.New("invalid argument")
+)
+
+// Args holds the arguments of a field, with the variables replaced by their values
+type Args map[string]any
+
+// String returns the string argument name, empty if it is not set
+func (a Args) String(name string) (string, error) {
+	switch value := a[name].(type) {
+	case nil:
+		return "", nil
+	case string:
+		return value, nil
+	default:
+		return "", fmt.Errorf("%w: %s must be a string", ErrInvalidArgument, name)
+	}
+}
+
+// Int returns the int argument name, 0 if it is not set
+func (a Args) Int(name string) (int, error) {
+	switch value := a[name].(type) {
+	case nil:
+		return 0, nil
+	case int:
+		return value, nil
+	case float64:
+		// JSON variables are decoded as floats
+		if value == float64(int(value)) {
+			return int(value), nil
+		}
+	}
+
+	return 0, fmt.Errorf("%w: %s must be an int", ErrInvalidArgument, name)
+}
+
+// Field is a field of an object, Type is the object of its values, nil for leaf values such as strings
+// values that are slices are lists of Type
+type Field struct {
+	Type *Object
+	// Resolve returns the value of the field on source, the value of the parent object
+	Resolve func(source any, args Args) (any, error)
+}
+
+// Object is an object type of a schema
+type Object struct {
+	Name   string
+	Fields map[string]*Field
+}
+
+// NewObject returns an Object with a leaf field for every JSON field of the struct type of sample,
+// resolved from the struct fields, and the fields of relations
+func NewObject(name string, sample any, relations map[string]*Field) *Object {
+	object := &Object{Name: name, Fields: map[string]*Field{}}
+
+	t := reflect.TypeOf(sample)
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		structField := t.Field(i)
+
+		jsonName, _, _ := strings.Cut(structField.Tag.Get("json"), ",")
+		if !structField.IsExported() || jsonName == "-" {
+			continue
+		}
+
+		if jsonName == "" {
+			jsonName = structField.Name
+		}
+
+		index := i
+
+		object.Fields[jsonName] = &Field{
+			Resolve: func(source any, args Args) (any, error) {
+				value := reflect.ValueOf(source)
+				for value.Kind() == reflect.Pointer {
+					value = value.Elem()
+				}
+
+				return value.Field(index).Interface(), nil
+			},
+		}
+	}
+
+	for name, relation := range relations {
+		object.Fields[name] = relation
+	}
+
+	return object
+}
+
+// Schema is the schema queries are executed against
+type Schema struct {
+	Query *Object
+}
+
+// Request is a GraphQL request, as sent in the body of POST requests
+type Request struct {
+	Query         string         `json:"query"`
+	Variables     map[string]any `json:"variables"`
+	OperationName string         `json:"operationName"`
+}
+
+// Error is an error of a response, with the path of the field it was raised on if any
+type Error struct {
+	Message string `json:"message"`
+	Path    []any  `json:"path,omitempty"`
+}
+
+// Response is the response to a request, Data is nil if the query could not be executed at all
+type Response struct {
+	Data   *Result `json:"data,omitempty"`
+	Errors []Error `json:"errors,omitempty"`
+}
+
+// Result is the result of a selection set, keeping the order the fields were selected in
+type Result struct {
+	keys   []string
+	values map[string]any
+}
+
+// set sets the value of key, keeping the position of the key if it was already set
+func (r *Result) set(key string, value any) {
+	if _, ok := r.values[key]; !ok {
+		r.keys = append(r.keys, key)
+	}
+
+	r.values[key] = value
+}
+
+// Get returns the value of key
+func (r *Result) Get(key string) any {
+	return r.values[key]
+}
+
+// MarshalJSON writes the result as a JSON object with the fields in the order they were selected
+func (r *Result) MarshalJSON() ([]byte, error) {
+	var b bytes.Buffer
+
+	b.WriteByte('{')
+
+	for i, key := range r.keys {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+
+		rawKey, err := json.Marshal(key)
+		if err != nil {
+			return nil, err
+		}
+
+		rawValue, err := json.Marshal(r.values[key])
+		if err != nil {
+			return nil, err
+		}
+
+		b.Write(rawKey)
+		b.WriteByte(':')
+		b.Write(rawValue)
+	}
+
+	b.WriteByte('}')
+
+	return b.Bytes(), nil
+}
+
+// Execute executes the query of request against s
+// errors of the query itself are returned as the only error of a response without data, errors of the fields are
+// returned along with the data, the fields raising them being null
+func (s *Schema) Execute(request Request) Response {
+	doc, err := parse(request.Query)
+	if err != nil {
+		return Response{Errors: []Error{{Message: err.Error()}}}
+	}
+
+	op, err := doc.operation(request.OperationName)
+	if err != nil {
+		return Response{Errors: []Error{{Message: err.Error()}}}
+	}
+
+	variables := map[string]any{}
+	for name, value := range op.defaults {
+		variables[name] = value
+	}
+
+	for name, value := range request.Variables {
+		variables[name] = value
+	}
+
+	e := &executor{fragments: doc.fragments, variables: variables}
+
+	data := e.executeSelections(s.Query, nil, op.selections, nil, 1)
+
+	return Response{Data: data, Errors: e.errors}
+}
+
+// operation returns the operation of d to execute, the one named name or the only one if name is empty
+func (d *document) operation(name string) (*operation, error) {
+	var op *operation
+
+	switch {
+	case name == "" && len(d.operations) > 1:
+		return nil, fmt.Errorf("%w: operationName is required with several operations", ErrUnknownOperation)
+	case name == "":
+		op = d.operations[0]
+	default:
+		for _, candidate := range d.operations {
+			if candidate.name == name {
+				op = candidate
+			}
+		}
+	}
+
+	if op == nil {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownOperation, name)
+	}
+
+	if op.kind != "query" {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedOperation, op.kind)
+	}
+
+	return op, nil
+}
+
+// executor executes the operation of a document, collecting the errors of its fields
+type executor struct {
+	fragments map[string]*fragmentDefinition
+	variables map[string]any
+	errors    []Error
+}
+
+// fail records the error of the field at path
+func (e *executor) fail(path []any, err error) {
+	e.errors = append(e.errors, Error{Message: err.Error(), Path: path})
+}
+
+// executeSelections returns the result of selections on source, an object of type object
+func (e *executor) executeSelections(object *Object, source any, selections []selection, path []any, depth int) *Result {
+	result := &Result{values: map[string]any{}}
+
+	fields, err := e.collectFields(object, selections, map[string]bool{})
+	if err != nil {
+		e.fail(path, err)
+		return result
+	}
+
+	for _, f := range fields {
+		fieldPath := append(append([]any{}, path...), f.key())
+
+		if f.name == "__typename" {
+			result.set(f.key(), object.Name)
+			continue
+		}
+
+		result.set(f.key(), e.executeField(object, source, f, fieldPath, depth))
+	}
+
+	return result
+}
+
+// collectFields returns the fields of selections, including the ones of the fragments matching object
+// visited holds the fragments spread on the way, so fragments spreading each other are rejected
+func (e *executor) collectFields(object *Object, selections []selection, visited map[string]bool) ([]*field, error) {
+	fields := []*field{}
+
+	for _, s := range selections {
+		switch {
+		case s.field != nil:
+			fields = append(fields, s.field)
+		case s.fragment != "":
+			fragment, ok := e.fragments[s.fragment]
+			if !ok {
+				return nil, fmt.Errorf("%w: %s", ErrUnknownFragment, s.fragment)
+			}
+
+			if visited[s.fragment] {
+				return nil, fmt.Errorf("%w: fragment %s spreads itself", ErrSyntax, s.fragment)
+			}
+
+			if fragment.typeCondition != object.Name {
+				continue
+			}
+
+			visited[s.fragment] = true
+
+			fragmentFields, err := e.collectFields(object, fragment.selections, visited)
+			if err != nil {
+				return nil, err
+			}
+
+			delete(visited, s.fragment)
+
+			fields = append(fields, fragmentFields...)
+		default:
+			if s.typeCondition != "" && s.typeCondition != object.Name {
+				continue
+			}
+
+			fragmentFields, err := e.collectFields(object, s.selections, visited)
+			if err != nil {
+				return nil, err
+			}
+
+			fields = append(fields, fragmentFields...)
+		}
+	}
+
+	return fields, nil
+}
+
+// executeField returns the value of f on source, nil recording the error if it cannot be resolved
+func (e *executor) executeField(object *Object, source any, f *field, path []any, depth int) any {
+	definition, ok := object.Fields[f.name]
+	if !ok {
+		e.fail(path, fmt.Errorf("%w: %s on %s", ErrUnknownField, f.name, object.Name))
+		return nil
+	}
+
+	if definition.Type == nil && len(f.selections) > 0 {
+		e.fail(path, fmt.Errorf("%w: %s on %s has no fields to select", ErrSyntax, f.name, object.Name))
+		return nil
+	}
+
+	if definition.Type != nil && len(f.selections) == 0 {
+		e.fail(path, fmt.Errorf("%w: %s on %s requires a selection of fields", ErrSyntax, f.name, object.Name))
+		return nil
+	}
+
+	if definition.Type != nil && depth >= MaxDepth {
+		e.fail(path, ErrTooDeep)
+		return nil
+	}
+
+	value, err := definition.Resolve(source, e.args(f.args).(map[string]any))
+	if err != nil {
+		e.fail(path, err)
+		return nil
+	}
+
+	if definition.Type == nil {
+		return value
+	}
+
+	return e.complete(definition.Type, value, f.selections, path, depth+1)
+}
+
+// complete returns the results of selections on value, an object of type object or a list of them
+func (e *executor) complete(object *Object, value any, selections []selection, path []any, depth int) any {
+	reflected := reflect.ValueOf(value)
+	if !reflected.IsValid() || reflected.Kind() == reflect.Pointer && reflected.IsNil() {
+		return nil
+	}
+
+	if reflected.Kind() != reflect.Slice {
+		return e.executeSelections(object, value, selections, path, depth)
+	}
+
+	results := make([]any, 0, reflected.Len())
+
+	for i := 0; i < reflected.Len(); i++ {
+		itemPath := append(append([]any{}, path...), i)
+
+		results = append(results, e.complete(object, reflected.Index(i).Interface(), selections, itemPath, depth))
+	}
+
+	return results
+}
+
+// args returns value with its variables replaced by their values
+func (e *executor) args(value any) any {
+	switch typed := value.(type) {
+	case variable:
+		return e.variables[string(typed)]
+	case []any:
+		values := make([]any, 0, len(typed))
+		for _, item := range typed {
+			values = append(values, e.args(item))
+		}
+
+		return values
+	case map[string]any:
+		object := make(map[string]any, len(typed))
+		for name, item := range typed {
+			object[name] = e.args(item)
+		}
+
+		return object
+	default:
+		return value
+	}
+}
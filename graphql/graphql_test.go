@@ -0,0 +1,176 @@
+package graphql
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testApp struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	UserID string `json:"userID"`
+	Secret string `json:"-"`
+}
+
+type testLB struct {
+	ID     string     `json:"id"`
+	AppIDs []string   `json:"appIDs"`
+	Apps   []*testApp `json:"-"`
+}
+
+func testSchema() *Schema {
+	apps := map[string]*testApp{
+		"a1": {ID: "a1", Name: "first", UserID: "u1", Secret: "s"},
+		"a2": {ID: "a2", Name: "second", UserID: "u1"},
+	}
+
+	app := NewObject("Application", testApp{}, nil)
+
+	lb := NewObject("LoadBalancer", testLB{}, map[string]*Field{
+		"applications": {Type: app, Resolve: func(source any, args Args) (any, error) {
+			return source.(*testLB).Apps, nil
+		}},
+	})
+
+	return &Schema{Query: &Object{Name: "Query", Fields: map[string]*Field{
+		"application": {Type: app, Resolve: func(source any, args Args) (any, error) {
+			id, err := args.String("id")
+			if err != nil {
+				return nil, err
+			}
+
+			found, ok := apps[id]
+			if !ok {
+				return nil, errors.New("application not found")
+			}
+
+			return found, nil
+		}},
+		"loadBalancer": {Type: lb, Resolve: func(source any, args Args) (any, error) {
+			return &testLB{ID: "lb1", AppIDs: []string{"a1", "a2"}, Apps: []*testApp{apps["a1"], nil, apps["a2"]}}, nil
+		}},
+		"count": {Resolve: func(source any, args Args) (any, error) {
+			return args.Int("n")
+		}},
+	}}}
+}
+
+// execute executes query with variables and returns the response as JSON
+func execute(c *require.Assertions, query string, variables map[string]any) string {
+	response := testSchema().Execute(Request{Query: query, Variables: variables})
+
+	rawResponse, err := json.Marshal(response)
+	c.NoError(err)
+
+	return string(rawResponse)
+}
+
+func TestSchema_Execute(t *testing.T) {
+	c := require.New(t)
+
+	tests := []struct {
+		name      string
+		query     string
+		variables map[string]any
+		response  string
+	}{
+		{
+			name:     "fields in selection order",
+			query:    `{ application(id: "a1") { userID, name id } }`,
+			response: `{"data":{"application":{"userID":"u1","name":"first","id":"a1"}}}`,
+		},
+		{
+			name:     "aliases and __typename",
+			query:    `query { first: application(id: "a1") { __typename id } second: application(id: "a2") { name } }`,
+			response: `{"data":{"first":{"__typename":"Application","id":"a1"},"second":{"name":"second"}}}`,
+		},
+		{
+			name:      "variables with defaults",
+			query:     `query App($id: ID! = "a1", $n: Int) { application(id: $id) { id } count(n: $n) }`,
+			variables: map[string]any{"n": float64(3)},
+			response:  `{"data":{"application":{"id":"a1"},"count":3}}`,
+		},
+		{
+			name: "relations, fragments and lists",
+			query: `query { loadBalancer { id appIDs applications { ...appFields ... on Application { userID } } } }
+				fragment appFields on Application { id }
+				# comments are ignored
+				fragment lbFields on LoadBalancer { id }`,
+			response: `{"data":{"loadBalancer":{"id":"lb1","appIDs":["a1","a2"],` +
+				`"applications":[{"id":"a1","userID":"u1"},null,{"id":"a2","userID":"u1"}]}}}`,
+		},
+		{
+			name:  "field errors keep the other fields",
+			query: `{ application(id: "a3") { id } other: application(id: "a2") { id } count(n: "x") }`,
+			response: `{"data":{"application":null,"other":{"id":"a2"},"count":null},"errors":[` +
+				`{"message":"application not found","path":["application"]},` +
+				`{"message":"invalid argument: n must be an int","path":["count"]}]}`,
+		},
+		{
+			name:  "unknown fields",
+			query: `{ application(id: "a1") { id secret } }`,
+			response: `{"data":{"application":{"id":"a1","secret":null}},"errors":[` +
+				`{"message":"unknown field: secret on Application","path":["application","secret"]}]}`,
+		},
+		{
+			name:  "selections",
+			query: `{ application(id: "a1") count(n: 1) { id } }`,
+			response: `{"data":{"application":null,"count":null},"errors":[` +
+				`{"message":"syntax error: application on Query requires a selection of fields","path":["application"]},` +
+				`{"message":"syntax error: count on Query has no fields to select","path":["count"]}]}`,
+		},
+	}
+
+	for _, tt := range tests {
+		c.JSONEq(tt.response, execute(c, tt.query, tt.variables), tt.name)
+	}
+
+	// the fields keep the order they were selected in
+	c.Equal(`{"data":{"application":{"userID":"u1","name":"first","id":"a1"}}}`,
+		execute(c, `{ application(id: "a1") { userID, name id } }`, nil))
+}
+
+func TestSchema_ExecuteErrors(t *testing.T) {
+	c := require.New(t)
+
+	schema := testSchema()
+
+	tests := []struct {
+		request Request
+		err     error
+	}{
+		{Request{Query: `{ application(id: "a1") { id }`}, ErrSyntax},
+		{Request{Query: `{ application(id: "a1) { id } }`}, ErrSyntax},
+		{Request{Query: `{ }`}, ErrSyntax},
+		{Request{Query: ``}, ErrSyntax},
+		{Request{Query: `fragment f on Query { count }`}, ErrSyntax},
+		{Request{Query: `mutation { application(id: "a1") { id } }`}, ErrUnsupportedOperation},
+		{Request{Query: `query a { count } query b { count }`}, ErrUnknownOperation},
+		{Request{Query: `query a { count }`, OperationName: "b"}, ErrUnknownOperation},
+		{Request{Query: strings.Repeat(" ", MaxLength+1)}, ErrTooLong},
+		{Request{Query: strings.Repeat("{ a ", MaxDepth+1) + strings.Repeat("}", MaxDepth+1)}, ErrTooDeep},
+	}
+
+	for _, tt := range tests {
+		response := schema.Execute(tt.request)
+		c.Nil(response.Data, tt.request.Query)
+		c.Len(response.Errors, 1, tt.request.Query)
+		c.Contains(response.Errors[0].Message, tt.err.Error(), tt.request.Query)
+	}
+
+	response := schema.Execute(Request{Query: `query a { count(n: 1) } query b { count(n: 2) }`, OperationName: "b"})
+	c.Empty(response.Errors)
+	c.Equal(2, response.Data.Get("count"))
+
+	response = schema.Execute(Request{Query: `{ loadBalancer { ...a } } fragment a on LoadBalancer { ...b } ` +
+		`fragment b on LoadBalancer { ...a }`})
+	c.Len(response.Errors, 1)
+	c.Equal("syntax error: fragment a spreads itself", response.Errors[0].Message)
+
+	response = schema.Execute(Request{Query: `{ loadBalancer { ...missing } }`})
+	c.Equal("unknown fragment: missing", response.Errors[0].Message)
+}
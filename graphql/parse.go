@@ -0,0 +1,627 @@
+package graphql
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type tokenKind int
+
+const (
+	tokenName tokenKind = iota
+	tokenString
+	tokenInt
+	tokenFloat
+	tokenPunctuator
+)
+
+type token struct {
+	kind  tokenKind
+	text  string
+	start int
+}
+
+// punctuators are the punctuator tokens, the spread first so it is not read as dots
+var punctuators = []string{"...", "{", "}", "(", ")", "[", "]", ":", "$", "!", "=", "@"}
+
+// tokenize splits query into names, strings, numbers and punctuators, skipping commas and comments
+func tokenize(query string) ([]token, error) {
+	tokens := []token{}
+
+	for i := 0; i < len(query); {
+		char := query[i]
+
+		switch {
+		case char == ' ' || char == '\t' || char == '\n' || char == '\r' || char == ',':
+			i++
+		case char == '#':
+			for i < len(query) && query[i] != '\n' {
+				i++
+			}
+		case char == '"':
+			value, end, err := readString(query, i)
+			if err != nil {
+				return nil, err
+			}
+
+			tokens = append(tokens, token{kind: tokenString, text: value, start: i})
+			i = end
+		case isNameStart(char):
+			start := i
+			for i < len(query) && (isNameStart(query[i]) || isDigit(query[i])) {
+				i++
+			}
+
+			tokens = append(tokens, token{kind: tokenName, text: query[start:i], start: start})
+		case char == '-' || isDigit(char):
+			start := i
+			kind := tokenInt
+
+			i++
+			for i < len(query) && (isDigit(query[i]) || query[i] == '.' || query[i] == 'e' || query[i] == 'E' ||
+				query[i] == '+' || query[i] == '-') {
+				if !isDigit(query[i]) {
+					kind = tokenFloat
+				}
+
+				i++
+			}
+
+			tokens = append(tokens, token{kind: kind, text: query[start:i], start: start})
+		default:
+			punctuator := ""
+			for _, p := range punctuators {
+				if strings.HasPrefix(query[i:], p) {
+					punctuator = p
+					break
+				}
+			}
+
+			if punctuator == "" {
+				return nil, fmt.Errorf("%w: unexpected %q at %d", ErrSyntax, char, i)
+			}
+
+			tokens = append(tokens, token{kind: tokenPunctuator, text: punctuator, start: i})
+			i += len(punctuator)
+		}
+	}
+
+	return tokens, nil
+}
+
+// escapes are the characters of the escape sequences of strings, other than unicode ones
+var escapes = map[byte]byte{'"': '"', '\\': '\\', '/': '/', 'b': '\b', 'f': '\f', 'n': '\n', 'r': '\r', 't': '\t'}
+
+// readString reads the double-quoted string starting at start, returning its value and the index after it
+func readString(query string, start int) (string, int, error) {
+	var value strings.Builder
+
+	for i := start + 1; i < len(query); i++ {
+		switch query[i] {
+		case '"':
+			return value.String(), i + 1, nil
+		case '\n':
+			return "", 0, fmt.Errorf("%w: unterminated string at %d", ErrSyntax, start)
+		case '\\':
+			if i+1 >= len(query) {
+				return "", 0, fmt.Errorf("%w: invalid escape at %d", ErrSyntax, i)
+			}
+
+			if escaped, ok := escapes[query[i+1]]; ok {
+				value.WriteByte(escaped)
+				i++
+
+				continue
+			}
+
+			if query[i+1] == 'u' && i+6 <= len(query) {
+				code, err := strconv.ParseUint(query[i+2:i+6], 16, 32)
+				if err == nil {
+					value.WriteRune(rune(code))
+					i += 5
+
+					continue
+				}
+			}
+
+			return "", 0, fmt.Errorf("%w: invalid escape at %d", ErrSyntax, i)
+		default:
+			value.WriteByte(query[i])
+		}
+	}
+
+	return "", 0, fmt.Errorf("%w: unterminated string at %d", ErrSyntax, start)
+}
+
+func isNameStart(char byte) bool {
+	return char >= 'a' && char <= 'z' || char >= 'A' && char <= 'Z' || char == '_'
+}
+
+func isDigit(char byte) bool {
+	return char >= '0' && char <= '9'
+}
+
+// variable is a reference to a variable of the operation in the arguments of a field
+type variable string
+
+// field is a field selected in a query, with its arguments and the fields selected on its value
+type field struct {
+	alias      string
+	name       string
+	args       map[string]any
+	selections []selection
+}
+
+// key returns the key of the field in the response, its alias if it has one
+func (f *field) key() string {
+	if f.alias != "" {
+		return f.alias
+	}
+
+	return f.name
+}
+
+// selection is a field or a fragment selected in a selection set
+// fragment spreads only have a fragment name, inline fragments a type condition and selections
+type selection struct {
+	field         *field
+	fragment      string
+	typeCondition string
+	selections    []selection
+}
+
+// fragmentDefinition is a named fragment, selected on objects of its type condition
+type fragmentDefinition struct {
+	typeCondition string
+	selections    []selection
+}
+
+// operation is an operation of a document, with the default values of its variables
+type operation struct {
+	kind       string
+	name       string
+	defaults   map[string]any
+	selections []selection
+}
+
+// document is a parsed query document
+type document struct {
+	operations []*operation
+	fragments  map[string]*fragmentDefinition
+}
+
+// parse parses the query document query
+func parse(query string) (*document, error) {
+	if len(query) > MaxLength {
+		return nil, ErrTooLong
+	}
+
+	tokens, err := tokenize(query)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens}
+	doc := &document{fragments: map[string]*fragmentDefinition{}}
+
+	for p.pos < len(p.tokens) {
+		switch {
+		case p.peek(tokenPunctuator, "{"):
+			selections, err := p.parseSelectionSet(1)
+			if err != nil {
+				return nil, err
+			}
+
+			doc.operations = append(doc.operations, &operation{kind: "query", selections: selections})
+		case p.peek(tokenName, "fragment"):
+			name, fragment, err := p.parseFragmentDefinition()
+			if err != nil {
+				return nil, err
+			}
+
+			doc.fragments[name] = fragment
+		case p.peek(tokenName, ""):
+			op, err := p.parseOperation()
+			if err != nil {
+				return nil, err
+			}
+
+			doc.operations = append(doc.operations, op)
+		default:
+			return nil, p.unexpected()
+		}
+	}
+
+	if len(doc.operations) == 0 {
+		return nil, fmt.Errorf("%w: no operation", ErrSyntax)
+	}
+
+	return doc, nil
+}
+
+// parser is a recursive descent parser over the tokens of a query document
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+// parseOperation parses an operation with its type, such as query Name($id: ID!) { ... }
+func (p *parser) parseOperation() (*operation, error) {
+	kind, _ := p.next(tokenName)
+
+	op := &operation{kind: kind.text, defaults: map[string]any{}}
+
+	if name, ok := p.next(tokenName); ok {
+		op.name = name.text
+	}
+
+	if p.accept("(") {
+		for !p.accept(")") {
+			if !p.accept("$") {
+				return nil, p.unexpected()
+			}
+
+			name, ok := p.next(tokenName)
+			if !ok || !p.accept(":") {
+				return nil, p.unexpected()
+			}
+
+			err := p.skipType()
+			if err != nil {
+				return nil, err
+			}
+
+			if p.accept("=") {
+				value, err := p.parseValue()
+				if err != nil {
+					return nil, err
+				}
+
+				op.defaults[name.text] = value
+			}
+		}
+	}
+
+	err := p.skipDirectives()
+	if err != nil {
+		return nil, err
+	}
+
+	op.selections, err = p.parseSelectionSet(1)
+	if err != nil {
+		return nil, err
+	}
+
+	return op, nil
+}
+
+// parseFragmentDefinition parses fragment Name on Type { ... }
+func (p *parser) parseFragmentDefinition() (string, *fragmentDefinition, error) {
+	p.pos++
+
+	name, ok := p.next(tokenName)
+	if !ok || !p.peek(tokenName, "on") {
+		return "", nil, p.unexpected()
+	}
+
+	p.pos++
+
+	typeCondition, ok := p.next(tokenName)
+	if !ok {
+		return "", nil, p.unexpected()
+	}
+
+	selections, err := p.parseSelectionSet(1)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return name.text, &fragmentDefinition{typeCondition: typeCondition.text, selections: selections}, nil
+}
+
+// skipType skips a variable type, such as [ID!]!, the arguments are checked by the fields resolving them
+func (p *parser) skipType() error {
+	if p.accept("[") {
+		err := p.skipType()
+		if err != nil {
+			return err
+		}
+
+		if !p.accept("]") {
+			return p.unexpected()
+		}
+	} else if _, ok := p.next(tokenName); !ok {
+		return p.unexpected()
+	}
+
+	p.accept("!")
+
+	return nil
+}
+
+// skipDirectives skips the directives, such as @deprecated(reason: "..."), none of them is supported
+func (p *parser) skipDirectives() error {
+	for p.accept("@") {
+		if _, ok := p.next(tokenName); !ok {
+			return p.unexpected()
+		}
+
+		if p.peek(tokenPunctuator, "(") {
+			_, err := p.parseArguments()
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// parseSelectionSet parses the selections between braces, depth is the nesting of the selection set
+func (p *parser) parseSelectionSet(depth int) ([]selection, error) {
+	if depth > MaxDepth {
+		return nil, ErrTooDeep
+	}
+
+	if !p.accept("{") {
+		return nil, p.unexpected()
+	}
+
+	selections := []selection{}
+
+	for !p.accept("}") {
+		if p.accept("...") {
+			fragment, err := p.parseFragment(depth)
+			if err != nil {
+				return nil, err
+			}
+
+			selections = append(selections, fragment)
+
+			continue
+		}
+
+		f, err := p.parseField(depth)
+		if err != nil {
+			return nil, err
+		}
+
+		selections = append(selections, selection{field: f})
+	}
+
+	if len(selections) == 0 {
+		return nil, fmt.Errorf("%w: empty selection set", ErrSyntax)
+	}
+
+	return selections, nil
+}
+
+// parseFragment parses the fragment after a spread, either a fragment name or an inline fragment
+func (p *parser) parseFragment(depth int) (selection, error) {
+	if p.peek(tokenName, "on") {
+		p.pos++
+
+		typeCondition, ok := p.next(tokenName)
+		if !ok {
+			return selection{}, p.unexpected()
+		}
+
+		selections, err := p.parseSelectionSet(depth)
+		if err != nil {
+			return selection{}, err
+		}
+
+		return selection{typeCondition: typeCondition.text, selections: selections}, nil
+	}
+
+	if p.peek(tokenPunctuator, "{") {
+		selections, err := p.parseSelectionSet(depth)
+		if err != nil {
+			return selection{}, err
+		}
+
+		return selection{selections: selections}, nil
+	}
+
+	name, ok := p.next(tokenName)
+	if !ok {
+		return selection{}, p.unexpected()
+	}
+
+	return selection{fragment: name.text}, p.skipDirectives()
+}
+
+// parseField parses a field with its alias, arguments and selections, such as apps: applications(first: 10) { id }
+func (p *parser) parseField(depth int) (*field, error) {
+	name, ok := p.next(tokenName)
+	if !ok {
+		return nil, p.unexpected()
+	}
+
+	f := &field{name: name.text, args: map[string]any{}}
+
+	if p.accept(":") {
+		name, ok = p.next(tokenName)
+		if !ok {
+			return nil, p.unexpected()
+		}
+
+		f.alias = f.name
+		f.name = name.text
+	}
+
+	if p.peek(tokenPunctuator, "(") {
+		args, err := p.parseArguments()
+		if err != nil {
+			return nil, err
+		}
+
+		f.args = args
+	}
+
+	err := p.skipDirectives()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.peek(tokenPunctuator, "{") {
+		f.selections, err = p.parseSelectionSet(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return f, nil
+}
+
+// parseArguments parses the arguments between parentheses
+func (p *parser) parseArguments() (map[string]any, error) {
+	p.pos++
+
+	args := map[string]any{}
+
+	for !p.accept(")") {
+		name, ok := p.next(tokenName)
+		if !ok || !p.accept(":") {
+			return nil, p.unexpected()
+		}
+
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+
+		args[name.text] = value
+	}
+
+	return args, nil
+}
+
+// parseValue parses an argument value, enum values are read as strings and variables as variable
+func (p *parser) parseValue() (any, error) {
+	if p.accept("$") {
+		name, ok := p.next(tokenName)
+		if !ok {
+			return nil, p.unexpected()
+		}
+
+		return variable(name.text), nil
+	}
+
+	if p.accept("[") {
+		values := []any{}
+
+		for !p.accept("]") {
+			value, err := p.parseValue()
+			if err != nil {
+				return nil, err
+			}
+
+			values = append(values, value)
+		}
+
+		return values, nil
+	}
+
+	if p.accept("{") {
+		object := map[string]any{}
+
+		for !p.accept("}") {
+			name, ok := p.next(tokenName)
+			if !ok || !p.accept(":") {
+				return nil, p.unexpected()
+			}
+
+			value, err := p.parseValue()
+			if err != nil {
+				return nil, err
+			}
+
+			object[name.text] = value
+		}
+
+		return object, nil
+	}
+
+	if p.pos >= len(p.tokens) {
+		return nil, p.unexpected()
+	}
+
+	tok := p.tokens[p.pos]
+
+	switch tok.kind {
+	case tokenString:
+		p.pos++
+		return tok.text, nil
+	case tokenInt:
+		p.pos++
+
+		value, err := strconv.Atoi(tok.text)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid int %q at %d", ErrSyntax, tok.text, tok.start)
+		}
+
+		return value, nil
+	case tokenFloat:
+		p.pos++
+
+		value, err := strconv.ParseFloat(tok.text, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid float %q at %d", ErrSyntax, tok.text, tok.start)
+		}
+
+		return value, nil
+	case tokenName:
+		p.pos++
+
+		switch tok.text {
+		case "true":
+			return true, nil
+		case "false":
+			return false, nil
+		case "null":
+			return nil, nil
+		default:
+			return tok.text, nil
+		}
+	}
+
+	return nil, p.unexpected()
+}
+
+// accept consumes the next token if it is the punctuator punctuator
+func (p *parser) accept(punctuator string) bool {
+	if p.peek(tokenPunctuator, punctuator) {
+		p.pos++
+		return true
+	}
+
+	return false
+}
+
+// peek returns true if the next token is of given kind and text, of any text if text is empty
+func (p *parser) peek(kind tokenKind, text string) bool {
+	return p.pos < len(p.tokens) && p.tokens[p.pos].kind == kind && (text == "" || p.tokens[p.pos].text == text)
+}
+
+// next consumes the next token if it is of given kind
+func (p *parser) next(kind tokenKind) (token, bool) {
+	if p.peek(kind, "") {
+		p.pos++
+		return p.tokens[p.pos-1], true
+	}
+
+	return token{}, false
+}
+
+// unexpected returns the syntax error of the current token
+func (p *parser) unexpected() error {
+	if p.pos >= len(p.tokens) {
+		return fmt.Errorf("%w: unexpected end of query", ErrSyntax)
+	}
+
+	tok := p.tokens[p.pos]
+
+	return fmt.Errorf("%w: unexpected %q at %d", ErrSyntax, tok.text, tok.start)
+}
@@ -0,0 +1,346 @@
+package grpcapi
+
+import (
+	"time"
+
+	"github.com/pokt-foundation/portal-api-go/repository"
+)
+
+// The functions below encode and decode the messages of pocket_http_db.proto, the field numbers must match it
+
+// unixMS returns t in milliseconds since the Unix epoch, 0 if t is zero
+func unixMS(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+
+	return t.UnixMilli()
+}
+
+// fromUnixMS returns the time of ms milliseconds since the Unix epoch, zero if ms is 0
+func fromUnixMS(ms int64) time.Time {
+	if ms == 0 {
+		return time.Time{}
+	}
+
+	return time.UnixMilli(ms).UTC()
+}
+
+// getRequest is a GetRequest, or a UserRequest which has the same single field
+type getRequest struct {
+	id string
+}
+
+func decodeGetRequest(data []byte) (*getRequest, error) {
+	request := &getRequest{}
+
+	err := decode(data, func(f field) error {
+		if f.number == 1 {
+			request.id = f.string()
+		}
+
+		return nil
+	})
+
+	return request, err
+}
+
+func encodeApplication(e *encoder, app *repository.Application) {
+	e.string(1, app.ID)
+	e.string(2, app.UserID)
+	e.string(3, app.Name)
+	e.string(4, app.ContactEmail)
+	e.string(5, app.Description)
+	e.string(6, app.Owner)
+	e.string(7, app.URL)
+	e.string(8, string(app.Status))
+	e.bool(9, app.Dummy)
+	e.string(10, string(app.Limits.PlanType))
+	e.int64(11, int64(app.Limits.DailyLimit))
+	e.int64(12, unixMS(app.FirstDateSurpassed))
+	e.message(13, func(e *encoder) {
+		aat := app.GatewayAAT
+		e.string(1, aat.Address)
+		e.string(2, aat.ApplicationPublicKey)
+		e.string(3, aat.ApplicationSignature)
+		e.string(4, aat.ClientPublicKey)
+		e.string(5, aat.PrivateKey)
+		e.string(6, aat.Version)
+	})
+	e.message(14, func(e *encoder) {
+		settings := app.GatewaySettings
+		e.string(1, settings.SecretKey)
+		e.bool(2, settings.SecretKeyRequired)
+		e.strings(3, settings.WhitelistOrigins)
+		e.strings(4, settings.WhitelistUserAgents)
+		e.strings(5, settings.WhitelistBlockchains)
+	})
+	e.message(15, func(e *encoder) {
+		settings := app.NotificationSettings
+		e.bool(1, settings.SignedUp)
+		e.bool(2, settings.Quarter)
+		e.bool(3, settings.Half)
+		e.bool(4, settings.ThreeQuarters)
+		e.bool(5, settings.Full)
+	})
+	e.int64(16, unixMS(app.CreatedAt))
+	e.int64(17, unixMS(app.UpdatedAt))
+}
+
+// decodeApplication decodes an Application to write, its pay plan type is set to PayPlanType as writes expect it
+func decodeApplication(data []byte) (*repository.Application, error) {
+	app := &repository.Application{}
+
+	err := decode(data, func(f field) error {
+		switch f.number {
+		case 1:
+			app.ID = f.string()
+		case 2:
+			app.UserID = f.string()
+		case 3:
+			app.Name = f.string()
+		case 4:
+			app.ContactEmail = f.string()
+		case 5:
+			app.Description = f.string()
+		case 6:
+			app.Owner = f.string()
+		case 7:
+			app.URL = f.string()
+		case 8:
+			app.Status = repository.AppStatus(f.string())
+		case 9:
+			app.Dummy = f.bool()
+		case 10:
+			app.PayPlanType = repository.PayPlanType(f.string())
+		case 12:
+			app.FirstDateSurpassed = fromUnixMS(f.int64())
+		case 13:
+			return decodeGatewayAAT(f.bytes, &app.GatewayAAT)
+		case 14:
+			return decodeGatewaySettings(f.bytes, &app.GatewaySettings)
+		case 15:
+			return decodeNotificationSettings(f.bytes, &app.NotificationSettings)
+		}
+
+		return nil
+	})
+
+	return app, err
+}
+
+func decodeGatewayAAT(data []byte, aat *repository.GatewayAAT) error {
+	return decode(data, func(f field) error {
+		switch f.number {
+		case 1:
+			aat.Address = f.string()
+		case 2:
+			aat.ApplicationPublicKey = f.string()
+		case 3:
+			aat.ApplicationSignature = f.string()
+		case 4:
+			aat.ClientPublicKey = f.string()
+		case 5:
+			aat.PrivateKey = f.string()
+		case 6:
+			aat.Version = f.string()
+		}
+
+		return nil
+	})
+}
+
+func decodeGatewaySettings(data []byte, settings *repository.GatewaySettings) error {
+	return decode(data, func(f field) error {
+		switch f.number {
+		case 1:
+			settings.SecretKey = f.string()
+		case 2:
+			settings.SecretKeyRequired = f.bool()
+		case 3:
+			settings.WhitelistOrigins = append(settings.WhitelistOrigins, f.string())
+		case 4:
+			settings.WhitelistUserAgents = append(settings.WhitelistUserAgents, f.string())
+		case 5:
+			settings.WhitelistBlockchains = append(settings.WhitelistBlockchains, f.string())
+		}
+
+		return nil
+	})
+}
+
+func decodeNotificationSettings(data []byte, settings *repository.NotificationSettings) error {
+	return decode(data, func(f field) error {
+		switch f.number {
+		case 1:
+			settings.SignedUp = f.bool()
+		case 2:
+			settings.Quarter = f.bool()
+		case 3:
+			settings.Half = f.bool()
+		case 4:
+			settings.ThreeQuarters = f.bool()
+		case 5:
+			settings.Full = f.bool()
+		}
+
+		return nil
+	})
+}
+
+func encodeApplications(e *encoder, apps []*repository.Application) {
+	for _, app := range apps {
+		e.repeatedMessage(1, func(e *encoder) { encodeApplication(e, app) })
+	}
+}
+
+// loadBalancerAppIDs returns the IDs of the applications of lb in their order
+func loadBalancerAppIDs(lb *repository.LoadBalancer) []string {
+	if len(lb.Applications) == 0 {
+		return lb.ApplicationIDs
+	}
+
+	appIDs := make([]string, 0, len(lb.Applications))
+	for _, app := range lb.Applications {
+		if app != nil {
+			appIDs = append(appIDs, app.ID)
+		}
+	}
+
+	return appIDs
+}
+
+func encodeLoadBalancer(e *encoder, lb *repository.LoadBalancer) {
+	e.string(1, lb.ID)
+	e.string(2, lb.Name)
+	e.string(3, lb.UserID)
+	e.strings(4, loadBalancerAppIDs(lb))
+	e.int64(5, int64(lb.RequestTimeout))
+	e.bool(6, lb.Gigastake)
+	e.bool(7, lb.GigastakeRedirect)
+	e.message(8, func(e *encoder) {
+		opts := lb.StickyOptions
+		e.string(1, opts.Duration)
+		e.strings(2, opts.StickyOrigins)
+		e.int64(3, int64(opts.StickyMax))
+		e.bool(4, opts.Stickiness)
+	})
+	e.int64(9, unixMS(lb.CreatedAt))
+	e.int64(10, unixMS(lb.UpdatedAt))
+}
+
+func decodeLoadBalancer(data []byte) (*repository.LoadBalancer, error) {
+	lb := &repository.LoadBalancer{}
+
+	err := decode(data, func(f field) error {
+		switch f.number {
+		case 1:
+			lb.ID = f.string()
+		case 2:
+			lb.Name = f.string()
+		case 3:
+			lb.UserID = f.string()
+		case 4:
+			lb.ApplicationIDs = append(lb.ApplicationIDs, f.string())
+		case 5:
+			lb.RequestTimeout = f.int()
+		case 6:
+			lb.Gigastake = f.bool()
+		case 7:
+			lb.GigastakeRedirect = f.bool()
+		case 8:
+			return decode(f.bytes, func(f field) error {
+				switch f.number {
+				case 1:
+					lb.StickyOptions.Duration = f.string()
+				case 2:
+					lb.StickyOptions.StickyOrigins = append(lb.StickyOptions.StickyOrigins, f.string())
+				case 3:
+					lb.StickyOptions.StickyMax = f.int()
+				case 4:
+					lb.StickyOptions.Stickiness = f.bool()
+				}
+
+				return nil
+			})
+		}
+
+		return nil
+	})
+
+	return lb, err
+}
+
+func encodeLoadBalancers(e *encoder, lbs []*repository.LoadBalancer) {
+	for _, lb := range lbs {
+		e.repeatedMessage(1, func(e *encoder) { encodeLoadBalancer(e, lb) })
+	}
+}
+
+// loadBalancerApps is a LoadBalancerApplications message
+type loadBalancerApps struct {
+	id      string
+	appIDs  []string
+	version string
+}
+
+func encodeLoadBalancerApps(e *encoder, apps *loadBalancerApps) {
+	e.string(1, apps.id)
+	e.strings(2, apps.appIDs)
+	e.string(3, apps.version)
+}
+
+func decodeLoadBalancerApps(data []byte) (*loadBalancerApps, error) {
+	apps := &loadBalancerApps{appIDs: []string{}}
+
+	err := decode(data, func(f field) error {
+		switch f.number {
+		case 1:
+			apps.id = f.string()
+		case 2:
+			apps.appIDs = append(apps.appIDs, f.string())
+		case 3:
+			apps.version = f.string()
+		}
+
+		return nil
+	})
+
+	return apps, err
+}
+
+func encodeBlockchain(e *encoder, blockchain *repository.Blockchain) {
+	e.string(1, blockchain.ID)
+	e.string(2, blockchain.Altruist)
+	e.string(3, blockchain.Blockchain)
+	e.string(4, blockchain.ChainID)
+	e.string(5, blockchain.ChainIDCheck)
+	e.string(6, blockchain.Description)
+	e.string(7, blockchain.EnforceResult)
+	e.string(8, blockchain.Network)
+	e.string(9, blockchain.Path)
+	e.string(10, blockchain.SyncCheck)
+	e.string(11, blockchain.Ticker)
+	e.strings(12, blockchain.BlockchainAliases)
+	e.int64(13, int64(blockchain.LogLimitBlocks))
+	e.int64(14, int64(blockchain.RequestTimeout))
+	e.int64(15, int64(blockchain.SyncAllowance))
+	e.bool(16, blockchain.Active)
+	e.int64(17, unixMS(blockchain.CreatedAt))
+	e.int64(18, unixMS(blockchain.UpdatedAt))
+}
+
+func encodeBlockchains(e *encoder, blockchains []*repository.Blockchain) {
+	for _, blockchain := range blockchains {
+		e.repeatedMessage(1, func(e *encoder) { encodeBlockchain(e, blockchain) })
+	}
+}
+
+func encodePayPlans(e *encoder, plans []*repository.PayPlan) {
+	for _, plan := range plans {
+		e.repeatedMessage(1, func(e *encoder) {
+			e.string(1, string(plan.PlanType))
+			e.int64(2, int64(plan.DailyLimit))
+		})
+	}
+}
@@ -0,0 +1,572 @@
+// Package grpcapi serves the reads and writes of the HTTP API as the unary gRPC service of pocket_http_db.proto,
+// over the same services, cache and writer, for internal services speaking gRPC rather than JSON
+// it is served by net/http over HTTP/2, so it needs TLS, and encodes its messages by hand
+package grpcapi
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pokt-foundation/pocket-http-db/accesslog"
+	"github.com/pokt-foundation/pocket-http-db/service"
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/sirupsen/logrus"
+)
+
+// ServicePath is the path prefix of the methods of the service, followed by the method name
+const ServicePath = "/pockethttpdb.v1.PocketHTTPDB/"
+
+// maxMessageSize is the largest request message accepted, the default of gRPC servers
+const maxMessageSize = 4 << 20
+
+// Code is a gRPC status code
+type Code int
+
+const (
+	CodeOK                 Code = 0
+	CodeInvalidArgument    Code = 3
+	CodeDeadlineExceeded   Code = 4
+	CodeNotFound           Code = 5
+	CodeFailedPrecondition Code = 9
+	CodeAborted            Code = 10
+	CodeUnimplemented      Code = 12
+	CodeInternal           Code = 13
+	CodeUnauthenticated    Code = 16
+)
+
+// statusError is an error responded with its code rather than mapped from its HTTP status
+type statusError struct {
+	code    Code
+	message string
+}
+
+func (e *statusError) Error() string {
+	return e.message
+}
+
+// Server serves the gRPC service, it is an http.Handler to be served over HTTP/2
+type Server struct {
+	// Applications, LoadBalancers, Blockchains and PayPlans return the services of each call, configured as the HTTP API ones
+	Applications  func() *service.ApplicationService
+	LoadBalancers func() *service.LoadBalancerService
+	Blockchains   func() *service.BlockchainService
+	PayPlans      func() *service.PayPlanService
+	// ErrorStatus returns the HTTP status of the errors returned by the services, mapped to their gRPC code
+	ErrorStatus func(err error) int
+	// APIKeys are the keys accepted in the authorization metadata, as on the HTTP API
+	APIKeys map[string]bool
+	// RedactedKeys are the API key IDs whose responses never hold emails, secret keys nor AAT private keys
+	RedactedKeys map[string]bool
+	// ReadOnly rejects writes, set on follower instances
+	ReadOnly bool
+	// Disabled reports whether the reads, or the writes, of the route group of a method are disabled on the HTTP API,
+	// nil if none are
+	Disabled func(group string, write bool) bool
+	// Deadline returns the deadline set on the headers of a call, as the HTTP API reads it, nil if calls have none
+	Deadline func(r *http.Request, now time.Time) (deadline time.Time, ok bool, err error)
+	// WritesRejected is why writes are rejected, when the HTTP API protects them in ways calls cannot carry,
+	// such as request nonces, empty if they are served
+	WritesRejected string
+	Log            *logrus.Logger
+}
+
+// call is a call to a method of the service
+type call struct {
+	ctx      context.Context
+	request  []byte
+	redacted bool
+}
+
+type method struct {
+	// group is the route group of the HTTP API serving the same entity
+	group  string
+	write  bool
+	handle func(s *Server, c *call) (*encoder, error)
+}
+
+var methods = map[string]method{
+	"GetApplication":              {group: "application", handle: (*Server).getApplication},
+	"GetApplicationByAddress":     {group: "application", handle: (*Server).getApplicationByAddress},
+	"GetApplicationsByUser":       {group: "application", handle: (*Server).getApplicationsByUser},
+	"WriteApplication":            {group: "application", write: true, handle: (*Server).writeApplication},
+	"GetLoadBalancer":             {group: "load_balancer", handle: (*Server).getLoadBalancer},
+	"GetLoadBalancersByUser":      {group: "load_balancer", handle: (*Server).getLoadBalancersByUser},
+	"WriteLoadBalancer":           {group: "load_balancer", write: true, handle: (*Server).writeLoadBalancer},
+	"SetLoadBalancerApplications": {group: "load_balancer", write: true, handle: (*Server).setLoadBalancerApplications},
+	"GetBlockchain":               {group: "blockchain", handle: (*Server).getBlockchain},
+	"GetBlockchains":              {group: "blockchain", handle: (*Server).getBlockchains},
+	"GetPayPlans":                 {group: "pay_plan", handle: (*Server).getPayPlans},
+}
+
+// ServeHTTP serves the unary call of r, its status is sent in the grpc-status and grpc-message trailers
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost || !strings.HasPrefix(r.Header.Get("Content-Type"), "application/grpc") {
+		w.WriteHeader(http.StatusUnsupportedMediaType)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/grpc+proto")
+	w.Header().Set("Trailer", "Grpc-Status, Grpc-Message")
+
+	response, err := s.serveCall(r)
+	if err != nil {
+		s.respondWithStatus(w, err)
+		return
+	}
+
+	frame := make([]byte, 5, 5+len(response.buf))
+	binary.BigEndian.PutUint32(frame[1:], uint32(len(response.buf)))
+
+	w.WriteHeader(http.StatusOK)
+
+	_, err = w.Write(append(frame, response.buf...))
+	if err != nil {
+		s.logError(fmt.Errorf("writing gRPC response failed: %w", err))
+		return
+	}
+
+	w.Header().Set("Grpc-Status", strconv.Itoa(int(CodeOK)))
+}
+
+func (s *Server) serveCall(r *http.Request) (*encoder, error) {
+	name := strings.TrimPrefix(r.URL.Path, ServicePath)
+
+	m, ok := methods[name]
+	if !ok || name == r.URL.Path {
+		return nil, &statusError{code: CodeUnimplemented, message: "unknown method: " + r.URL.Path}
+	}
+
+	authorization := r.Header.Get("Authorization")
+	if !s.APIKeys[authorization] {
+		return nil, &statusError{code: CodeUnauthenticated, message: "Unauthorized"}
+	}
+
+	if s.Disabled != nil && s.Disabled(m.group, m.write) {
+		return nil, &statusError{code: CodeUnimplemented, message: "method disabled: " + r.URL.Path}
+	}
+
+	if m.write && s.ReadOnly {
+		return nil, &statusError{code: CodeFailedPrecondition, message: "writes are disabled on read-only instances"}
+	}
+
+	if m.write && s.WritesRejected != "" {
+		return nil, &statusError{code: CodeFailedPrecondition, message: s.WritesRejected}
+	}
+
+	request, err := readMessage(r.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &call{
+		ctx:      r.Context(),
+		request:  request,
+		redacted: s.RedactedKeys[accesslog.KeyID(authorization)],
+	}
+
+	if s.Deadline == nil {
+		return m.handle(s, c)
+	}
+
+	deadline, ok, err := s.Deadline(r, time.Now())
+	if err != nil {
+		return nil, &statusError{code: CodeInvalidArgument, message: err.Error()}
+	}
+
+	if !ok {
+		return m.handle(s, c)
+	}
+
+	return s.handleBefore(deadline, m, c)
+}
+
+var errDeadlineExceeded = &statusError{code: CodeDeadlineExceeded, message: "request deadline exceeded"}
+
+// callResult is the response or the error of a call
+type callResult struct {
+	response *encoder
+	err      error
+}
+
+// handleBefore handles c with m, responding with a deadline exceeded status if it does not finish before deadline
+// the handler keeps running in the background after the deadline, writes already sent to the database are not undone
+func (s *Server) handleBefore(deadline time.Time, m method, c *call) (*encoder, error) {
+	ctx, cancel := context.WithDeadline(c.ctx, deadline)
+	defer cancel()
+
+	if ctx.Err() != nil {
+		return nil, errDeadlineExceeded
+	}
+
+	c.ctx = ctx
+
+	done := make(chan callResult, 1)
+	panicked := make(chan any, 1)
+
+	go func() {
+		defer func() {
+			if p := recover(); p != nil {
+				panicked <- p
+			}
+		}()
+
+		response, err := m.handle(s, c)
+		done <- callResult{response: response, err: err}
+	}()
+
+	select {
+	case p := <-panicked:
+		panic(p)
+	case result := <-done:
+		return result.response, result.err
+	case <-ctx.Done():
+		return nil, errDeadlineExceeded
+	}
+}
+
+// readMessage reads the single length-prefixed message of a unary call
+func readMessage(body io.Reader) ([]byte, error) {
+	var prefix [5]byte
+
+	_, err := io.ReadFull(body, prefix[:])
+	if err != nil {
+		return nil, &statusError{code: CodeInvalidArgument, message: "missing request message"}
+	}
+
+	if prefix[0] != 0 {
+		return nil, &statusError{code: CodeUnimplemented, message: "compressed messages are not supported"}
+	}
+
+	length := binary.BigEndian.Uint32(prefix[1:])
+	if length > maxMessageSize {
+		return nil, &statusError{code: CodeInvalidArgument, message: "request message too large"}
+	}
+
+	message := make([]byte, length)
+
+	_, err = io.ReadFull(body, message)
+	if err != nil {
+		return nil, &statusError{code: CodeInvalidArgument, message: "truncated request message"}
+	}
+
+	return message, nil
+}
+
+// respondWithStatus responds the status of err, logging the errors that are not the caller's
+func (s *Server) respondWithStatus(w http.ResponseWriter, err error) {
+	code := s.errorCode(err)
+	if code == CodeInternal {
+		s.logError(fmt.Errorf("gRPC call failed: %w", err))
+	}
+
+	w.WriteHeader(http.StatusOK)
+
+	w.Header().Set("Grpc-Status", strconv.Itoa(int(code)))
+	w.Header().Set("Grpc-Message", percentEncode(err.Error()))
+}
+
+// errorCode returns the gRPC code of err, from the HTTP status the API would respond with
+func (s *Server) errorCode(err error) Code {
+	var status *statusError
+	if errors.As(err, &status) {
+		return status.code
+	}
+
+	if errors.Is(err, errMalformedMessage) {
+		return CodeInvalidArgument
+	}
+
+	if s.ErrorStatus == nil {
+		return CodeInternal
+	}
+
+	switch s.ErrorStatus(err) {
+	case http.StatusNotFound:
+		return CodeNotFound
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		return CodeInvalidArgument
+	case http.StatusConflict, http.StatusGone:
+		return CodeAborted
+	default:
+		return CodeInternal
+	}
+}
+
+// percentEncode encodes message as grpc-message values are, escaping the percent sign and non printable ASCII
+func percentEncode(message string) string {
+	var encoded strings.Builder
+
+	for i := 0; i < len(message); i++ {
+		c := message[i]
+		if c < ' ' || c > '~' || c == '%' {
+			fmt.Fprintf(&encoded, "%%%02X", c)
+			continue
+		}
+
+		encoded.WriteByte(c)
+	}
+
+	return encoded.String()
+}
+
+func (s *Server) logError(err error) {
+	if s.Log == nil {
+		return
+	}
+
+	s.Log.WithFields(logrus.Fields{
+		"err": err.Error(),
+	}).Error(err)
+}
+
+// redactApplication returns a copy of app without its contact email, secret key and AAT private key if redacted
+// the cached application is never modified
+func redactApplication(app *repository.Application, redacted bool) *repository.Application {
+	if !redacted {
+		return app
+	}
+
+	redactedApp := *app
+	redactedApp.ContactEmail = ""
+	redactedApp.GatewaySettings.SecretKey = ""
+	redactedApp.GatewayAAT.PrivateKey = ""
+
+	return &redactedApp
+}
+
+func (s *Server) respondApplication(app *repository.Application, redacted bool) *encoder {
+	response := &encoder{}
+	encodeApplication(response, redactApplication(app, redacted))
+
+	return response
+}
+
+func (s *Server) getApplication(c *call) (*encoder, error) {
+	request, err := decodeGetRequest(c.request)
+	if err != nil {
+		return nil, err
+	}
+
+	app, err := s.Applications().Get(request.id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.respondApplication(app, c.redacted), nil
+}
+
+func (s *Server) getApplicationByAddress(c *call) (*encoder, error) {
+	request, err := decodeGetRequest(c.request)
+	if err != nil {
+		return nil, err
+	}
+
+	app, err := s.Applications().GetByAddress(request.id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.respondApplication(app, c.redacted), nil
+}
+
+func (s *Server) getApplicationsByUser(c *call) (*encoder, error) {
+	request, err := decodeGetRequest(c.request)
+	if err != nil {
+		return nil, err
+	}
+
+	apps, err := s.Applications().GetByUserID(request.id)
+	if err != nil {
+		return nil, err
+	}
+
+	redactedApps := make([]*repository.Application, 0, len(apps))
+	for _, app := range apps {
+		redactedApps = append(redactedApps, redactApplication(app, c.redacted))
+	}
+
+	response := &encoder{}
+	encodeApplications(response, redactedApps)
+
+	return response, nil
+}
+
+// hasGatewaySettings returns whether a written application holds gateway settings, which are left as they are otherwise
+func hasGatewaySettings(settings repository.GatewaySettings) bool {
+	return settings.SecretKey != "" || settings.SecretKeyRequired || len(settings.WhitelistOrigins) > 0 ||
+		len(settings.WhitelistUserAgents) > 0 || len(settings.WhitelistBlockchains) > 0
+}
+
+// writeApplication creates the application if it has no ID, otherwise updates its name, status, pay plan,
+// first date surpassed and the settings it holds, as PUT /application/{id} does
+func (s *Server) writeApplication(c *call) (*encoder, error) {
+	input, err := decodeApplication(c.request)
+	if err != nil {
+		return nil, err
+	}
+
+	apps := s.Applications()
+
+	var app *repository.Application
+
+	if input.ID == "" {
+		app, err = apps.Create(c.ctx, input)
+	} else {
+		update := &repository.UpdateApplication{
+			Name:               input.Name,
+			Status:             input.Status,
+			PayPlanType:        input.PayPlanType,
+			FirstDateSurpassed: input.FirstDateSurpassed,
+		}
+
+		if hasGatewaySettings(input.GatewaySettings) {
+			update.GatewaySettings = &input.GatewaySettings
+		}
+		if input.NotificationSettings != (repository.NotificationSettings{}) {
+			update.NotificationSettings = &input.NotificationSettings
+		}
+
+		app, err = apps.Update(c.ctx, input.ID, update)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return s.respondApplication(app, c.redacted), nil
+}
+
+func (s *Server) getLoadBalancer(c *call) (*encoder, error) {
+	request, err := decodeGetRequest(c.request)
+	if err != nil {
+		return nil, err
+	}
+
+	lb, err := s.LoadBalancers().Get(request.id)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &encoder{}
+	encodeLoadBalancer(response, lb)
+
+	return response, nil
+}
+
+func (s *Server) getLoadBalancersByUser(c *call) (*encoder, error) {
+	request, err := decodeGetRequest(c.request)
+	if err != nil {
+		return nil, err
+	}
+
+	lbs, err := s.LoadBalancers().GetByUserID(request.id)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &encoder{}
+	encodeLoadBalancers(response, lbs)
+
+	return response, nil
+}
+
+// hasStickyOptions returns whether a written load balancer holds sticky options, which are left as they are otherwise
+func hasStickyOptions(opts repository.StickyOptions) bool {
+	return opts.Duration != "" || len(opts.StickyOrigins) > 0 || opts.StickyMax != 0 || opts.Stickiness
+}
+
+// writeLoadBalancer creates the load balancer if it has no ID, otherwise updates its name and sticky options,
+// as PUT /load_balancer/{id} does, its applications are set with SetLoadBalancerApplications
+func (s *Server) writeLoadBalancer(c *call) (*encoder, error) {
+	input, err := decodeLoadBalancer(c.request)
+	if err != nil {
+		return nil, err
+	}
+
+	lbs := s.LoadBalancers()
+
+	var lb *repository.LoadBalancer
+
+	if input.ID == "" {
+		lb, err = lbs.Create(c.ctx, input)
+	} else {
+		update := &repository.UpdateLoadBalancer{Name: input.Name}
+
+		if hasStickyOptions(input.StickyOptions) {
+			update.StickyOptions = &input.StickyOptions
+		}
+
+		lb, err = lbs.Update(c.ctx, input.ID, update)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	response := &encoder{}
+	encodeLoadBalancer(response, lb)
+
+	return response, nil
+}
+
+// setLoadBalancerApplications replaces the applications of the load balancer if they are still at the version
+// of the request, and responds them with their new version
+func (s *Server) setLoadBalancerApplications(c *call) (*encoder, error) {
+	request, err := decodeLoadBalancerApps(c.request)
+	if err != nil {
+		return nil, err
+	}
+
+	lbs := s.LoadBalancers()
+
+	err = lbs.SetApplications(c.ctx, request.id, request.appIDs, request.version)
+	if err != nil {
+		return nil, err
+	}
+
+	appIDs, version, err := lbs.GetApplications(request.id)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &encoder{}
+	encodeLoadBalancerApps(response, &loadBalancerApps{id: request.id, appIDs: appIDs, version: version})
+
+	return response, nil
+}
+
+func (s *Server) getBlockchain(c *call) (*encoder, error) {
+	request, err := decodeGetRequest(c.request)
+	if err != nil {
+		return nil, err
+	}
+
+	blockchain, err := s.Blockchains().Get(request.id)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &encoder{}
+	encodeBlockchain(response, blockchain)
+
+	return response, nil
+}
+
+func (s *Server) getBlockchains(c *call) (*encoder, error) {
+	response := &encoder{}
+	encodeBlockchains(response, s.Blockchains().GetAll())
+
+	return response, nil
+}
+
+func (s *Server) getPayPlans(c *call) (*encoder, error) {
+	response := &encoder{}
+	encodePayPlans(response, s.PayPlans().GetAll())
+
+	return response, nil
+}
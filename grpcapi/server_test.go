@@ -0,0 +1,241 @@
+package grpcapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/pokt-foundation/pocket-http-db/accesslog"
+	"github.com/pokt-foundation/pocket-http-db/cache"
+	"github.com/pokt-foundation/pocket-http-db/service"
+	"github.com/pokt-foundation/pocket-http-db/types"
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+// writerStub records the applications set on load balancers, the other writes are not called by the tests
+type writerStub struct {
+	service.Writer
+	appIDs []string
+}
+
+func (w *writerStub) SetLoadBalancerApplications(ctx context.Context, lbID string, appIDs []string, version string) error {
+	w.appIDs = appIDs
+	return nil
+}
+
+func newTestServer(t *testing.T) (*Server, *writerStub) {
+	readerMock := &cache.ReaderMock{}
+
+	readerMock.On("ReadPayPlans").Return([]*repository.PayPlan{
+		{PlanType: repository.FreetierV0, DailyLimit: 250000},
+	}, nil)
+	readerMock.On("ReadRedirects").Return([]*repository.Redirect{}, nil)
+	readerMock.On("ReadApplications").Return([]*repository.Application{
+		{
+			ID:           "5f62b7d8be3591c4dea8566d",
+			UserID:       "60ecb2bf67774900350d9c43",
+			ContactEmail: "owner@example.com",
+			PayPlanType:  repository.FreetierV0,
+			GatewayAAT:   repository.GatewayAAT{Address: "e2a7a3d0b9c2ec11d9cd6d6ab8b1b2c6b8f4a7ee", PrivateKey: "private"},
+		},
+		{
+			ID:     "5f62b7d8be3591c4dea8566a",
+			UserID: "60ecb2bf67774900350d9c43",
+		},
+	}, nil)
+	readerMock.On("ReadBlockchains").Return([]*repository.Blockchain{{ID: "0021"}}, nil)
+	readerMock.On("ReadLoadBalancers").Return([]*repository.LoadBalancer{
+		{
+			ID:             "60ecb2bf67774900350d9c42",
+			UserID:         "60ecb2bf67774900350d9c43",
+			ApplicationIDs: []string{"5f62b7d8be3591c4dea8566d", "5f62b7d8be3591c4dea8566a"},
+		},
+	}, nil)
+
+	log := logrus.New()
+	log.SetOutput(io.Discard)
+
+	c := cache.NewCache(readerMock, log)
+	require.NoError(t, c.SetCache())
+
+	writer := &writerStub{}
+
+	return &Server{
+		Applications:  func() *service.ApplicationService { return service.NewApplicationService(c, writer, log) },
+		LoadBalancers: func() *service.LoadBalancerService { return service.NewLoadBalancerService(c, writer) },
+		Blockchains:   func() *service.BlockchainService { return service.NewBlockchainService(c, writer, log) },
+		PayPlans:      func() *service.PayPlanService { return service.NewPayPlanService(c) },
+		ErrorStatus: func(err error) int {
+			if errors.Is(err, service.ErrApplicationNotFound) {
+				return http.StatusNotFound
+			}
+
+			return http.StatusInternalServerError
+		},
+		APIKeys:      map[string]bool{"key": true, "redacted": true},
+		RedactedKeys: map[string]bool{accesslog.KeyID("redacted"): true},
+		Log:          log,
+	}, writer
+}
+
+// invoke calls method over HTTP/2 as a gRPC client does, returning the response message and the grpc-status
+func invoke(t *testing.T, client *http.Client, url, key, method string, request *encoder) ([]byte, string) {
+	frame := make([]byte, 5, 5+len(request.buf))
+	binary.BigEndian.PutUint32(frame[1:], uint32(len(request.buf)))
+
+	req, err := http.NewRequest(http.MethodPost, url+ServicePath+method, bytes.NewReader(append(frame, request.buf...)))
+	require.NoError(t, err)
+
+	req.Header.Set("Content-Type", "application/grpc")
+	req.Header.Set("Authorization", key)
+
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+
+	defer resp.Body.Close()
+
+	require.Equal(t, 2, resp.ProtoMajor)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	if len(body) == 0 {
+		return nil, resp.Trailer.Get("Grpc-Status")
+	}
+
+	require.Equal(t, len(body)-5, int(binary.BigEndian.Uint32(body[1:5])))
+
+	return body[5:], resp.Trailer.Get("Grpc-Status")
+}
+
+func getRequestMessage(id string) *encoder {
+	e := &encoder{}
+	e.string(1, id)
+
+	return e
+}
+
+func TestServer(t *testing.T) {
+	c := require.New(t)
+
+	grpcServer, writer := newTestServer(t)
+
+	server := httptest.NewUnstartedServer(grpcServer)
+	server.EnableHTTP2 = true
+	server.StartTLS()
+	defer server.Close()
+
+	client := server.Client()
+
+	response, status := invoke(t, client, server.URL, "key", "GetApplication", getRequestMessage("5f62b7d8be3591c4dea8566d"))
+	c.Equal("0", status)
+
+	app, err := decodeApplication(response)
+	c.NoError(err)
+	c.Equal("60ecb2bf67774900350d9c43", app.UserID)
+	c.Equal("owner@example.com", app.ContactEmail)
+	c.Equal(repository.FreetierV0, app.PayPlanType)
+
+	response, status = invoke(t, client, server.URL, "redacted", "GetApplicationByAddress", getRequestMessage("e2a7a3d0b9c2ec11d9cd6d6ab8b1b2c6b8f4a7ee"))
+	c.Equal("0", status)
+
+	app, err = decodeApplication(response)
+	c.NoError(err)
+	c.Equal("5f62b7d8be3591c4dea8566d", app.ID)
+	c.Empty(app.ContactEmail)
+	c.Empty(app.GatewayAAT.PrivateKey)
+
+	cached, err := grpcServer.Applications().Get("5f62b7d8be3591c4dea8566d")
+	c.NoError(err)
+	c.Equal("private", cached.GatewayAAT.PrivateKey)
+
+	response, status = invoke(t, client, server.URL, "key", "GetLoadBalancer", getRequestMessage("60ecb2bf67774900350d9c42"))
+	c.Equal("0", status)
+
+	lb, err := decodeLoadBalancer(response)
+	c.NoError(err)
+	c.Equal([]string{"5f62b7d8be3591c4dea8566d", "5f62b7d8be3591c4dea8566a"}, lb.ApplicationIDs)
+
+	reordered := []string{"5f62b7d8be3591c4dea8566a", "5f62b7d8be3591c4dea8566d"}
+
+	setRequest := &encoder{}
+	encodeLoadBalancerApps(setRequest, &loadBalancerApps{
+		id:      "60ecb2bf67774900350d9c42",
+		appIDs:  reordered,
+		version: types.LoadBalancerAppsVersion(lb.ApplicationIDs),
+	})
+
+	response, status = invoke(t, client, server.URL, "key", "SetLoadBalancerApplications", setRequest)
+	c.Equal("0", status)
+	c.Equal(reordered, writer.appIDs)
+
+	apps, err := decodeLoadBalancerApps(response)
+	c.NoError(err)
+	c.Equal(reordered, apps.appIDs)
+	c.Equal(types.LoadBalancerAppsVersion(reordered), apps.version)
+
+	_, status = invoke(t, client, server.URL, "key", "GetApplication", getRequestMessage("wrong"))
+	c.Equal("5", status)
+
+	_, status = invoke(t, client, server.URL, "wrong", "GetApplication", getRequestMessage("5f62b7d8be3591c4dea8566d"))
+	c.Equal("16", status)
+
+	_, status = invoke(t, client, server.URL, "key", "RemoveApplication", getRequestMessage("5f62b7d8be3591c4dea8566d"))
+	c.Equal("12", status)
+
+	// the route groups disabled on the HTTP API are disabled on gRPC
+	grpcServer.Disabled = func(group string, write bool) bool {
+		return group == "load_balancer" && write
+	}
+
+	_, status = invoke(t, client, server.URL, "key", "SetLoadBalancerApplications", setRequest)
+	c.Equal("12", status)
+
+	_, status = invoke(t, client, server.URL, "key", "GetLoadBalancer", getRequestMessage("60ecb2bf67774900350d9c42"))
+	c.Equal("0", status)
+
+	grpcServer.Disabled = nil
+
+	grpcServer.Deadline = func(r *http.Request, now time.Time) (time.Time, bool, error) {
+		return now.Add(-time.Second), true, nil
+	}
+
+	_, status = invoke(t, client, server.URL, "key", "GetLoadBalancer", getRequestMessage("60ecb2bf67774900350d9c42"))
+	c.Equal("4", status)
+
+	grpcServer.Deadline = func(r *http.Request, now time.Time) (time.Time, bool, error) {
+		return now.Add(time.Minute), true, nil
+	}
+
+	_, status = invoke(t, client, server.URL, "key", "GetLoadBalancer", getRequestMessage("60ecb2bf67774900350d9c42"))
+	c.Equal("0", status)
+
+	grpcServer.Deadline = func(r *http.Request, now time.Time) (time.Time, bool, error) {
+		return time.Time{}, false, errors.New("invalid request deadline")
+	}
+
+	_, status = invoke(t, client, server.URL, "key", "GetLoadBalancer", getRequestMessage("60ecb2bf67774900350d9c42"))
+	c.Equal("3", status)
+
+	grpcServer.Deadline = nil
+
+	grpcServer.WritesRejected = "writes are disabled on gRPC while replay protection is enabled"
+
+	_, status = invoke(t, client, server.URL, "key", "SetLoadBalancerApplications", setRequest)
+	c.Equal("9", status)
+
+	grpcServer.WritesRejected = ""
+	grpcServer.ReadOnly = true
+
+	_, status = invoke(t, client, server.URL, "key", "SetLoadBalancerApplications", setRequest)
+	c.Equal("9", status)
+}
@@ -0,0 +1,182 @@
+package grpcapi
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+)
+
+// The messages are encoded in the protobuf wire format by hand, as the service is built without code generation.
+// Only the wire types the messages of pocket_http_db.proto use are supported: varints and length-delimited values.
+
+const (
+	wireVarint = 0
+	wire64Bit  = 1
+	wireBytes  = 2
+	wire32Bit  = 5
+)
+
+var errMalformedMessage = errors.New("malformed protobuf message")
+
+// encoder appends the fields of a message, fields holding their zero value are skipped as proto3 does
+type encoder struct {
+	buf []byte
+}
+
+// appendVarint appends the varint encoding of value to buf
+func appendVarint(buf []byte, value uint64) []byte {
+	for value >= 0x80 {
+		buf = append(buf, byte(value)|0x80)
+		value >>= 7
+	}
+
+	return append(buf, byte(value))
+}
+
+func (e *encoder) tag(field int, wireType int) {
+	e.buf = appendVarint(e.buf, uint64(field)<<3|uint64(wireType))
+}
+
+func (e *encoder) string(field int, value string) {
+	if value == "" {
+		return
+	}
+
+	e.bytesField(field, []byte(value))
+}
+
+// strings appends every value of a repeated field, empty ones included
+func (e *encoder) strings(field int, values []string) {
+	for _, value := range values {
+		e.bytesField(field, []byte(value))
+	}
+}
+
+func (e *encoder) int64(field int, value int64) {
+	if value == 0 {
+		return
+	}
+
+	e.tag(field, wireVarint)
+	e.buf = appendVarint(e.buf, uint64(value))
+}
+
+func (e *encoder) bool(field int, value bool) {
+	if !value {
+		return
+	}
+
+	e.tag(field, wireVarint)
+	e.buf = append(e.buf, 1)
+}
+
+// message appends the message encoded by encode, skipped if it has no field set
+func (e *encoder) message(field int, encode func(e *encoder)) {
+	var nested encoder
+	encode(&nested)
+
+	if len(nested.buf) == 0 {
+		return
+	}
+
+	e.bytesField(field, nested.buf)
+}
+
+// repeatedMessage appends a message encoded by encode, even if it has no field set, as an element of a repeated field
+func (e *encoder) repeatedMessage(field int, encode func(e *encoder)) {
+	var nested encoder
+	encode(&nested)
+
+	e.bytesField(field, nested.buf)
+}
+
+func (e *encoder) bytesField(field int, value []byte) {
+	e.tag(field, wireBytes)
+	e.buf = appendVarint(e.buf, uint64(len(value)))
+	e.buf = append(e.buf, value...)
+}
+
+// field is a field read by decode, value holds the varint of varint fields
+type field struct {
+	number int
+	value  uint64
+	bytes  []byte
+}
+
+func (f field) string() string {
+	return string(f.bytes)
+}
+
+func (f field) int64() int64 {
+	return int64(f.value)
+}
+
+func (f field) int() int {
+	value := int64(f.value)
+	if value > math.MaxInt32 || value < math.MinInt32 {
+		return 0
+	}
+
+	return int(value)
+}
+
+func (f field) bool() bool {
+	return f.value != 0
+}
+
+// decode calls read with every field of the message data, in order, skipping the fixed size ones
+func decode(data []byte, read func(f field) error) error {
+	for len(data) > 0 {
+		key, n := binary.Uvarint(data)
+		if n <= 0 {
+			return errMalformedMessage
+		}
+
+		data = data[n:]
+
+		f := field{number: int(key >> 3)}
+
+		switch key & 7 {
+		case wireVarint:
+			f.value, n = binary.Uvarint(data)
+			if n <= 0 {
+				return errMalformedMessage
+			}
+
+			data = data[n:]
+		case wireBytes:
+			length, n := binary.Uvarint(data)
+			if n <= 0 || length > uint64(len(data)-n) {
+				return errMalformedMessage
+			}
+
+			f.bytes = data[n : n+int(length)]
+			data = data[n+int(length):]
+		case wire64Bit:
+			if len(data) < 8 {
+				return errMalformedMessage
+			}
+
+			data = data[8:]
+
+			continue
+		case wire32Bit:
+			if len(data) < 4 {
+				return errMalformedMessage
+			}
+
+			data = data[4:]
+
+			continue
+		default:
+			return errMalformedMessage
+		}
+
+		err := read(f)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
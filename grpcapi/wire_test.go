@@ -0,0 +1,99 @@
+package grpcapi
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplication_RoundTrip(t *testing.T) {
+	c := require.New(t)
+
+	app := &repository.Application{
+		ID:                 "5f62b7d8be3591c4dea8566d",
+		UserID:             "60ecb2bf67774900350d9c43",
+		Name:               "pokt",
+		Status:             repository.InService,
+		Limits:             repository.AppLimits{PlanType: repository.FreetierV0, DailyLimit: 250000},
+		FirstDateSurpassed: time.Date(2022, time.July, 21, 0, 0, 0, 0, time.UTC),
+		GatewayAAT:         repository.GatewayAAT{Address: "e2a7a3d0b9c2ec11d9cd6d6ab8b1b2c6b8f4a7ee", PrivateKey: "private"},
+		GatewaySettings: repository.GatewaySettings{
+			SecretKeyRequired: true,
+			WhitelistOrigins:  []string{"https://app.example.com", ""},
+		},
+		NotificationSettings: repository.NotificationSettings{SignedUp: true, Full: true},
+	}
+
+	e := &encoder{}
+	encodeApplication(e, app)
+
+	decoded, err := decodeApplication(e.buf)
+	c.NoError(err)
+
+	c.Equal(app.ID, decoded.ID)
+	c.Equal(app.UserID, decoded.UserID)
+	c.Equal(app.Name, decoded.Name)
+	c.Equal(app.Status, decoded.Status)
+	c.Equal(repository.FreetierV0, decoded.PayPlanType)
+	c.Equal(app.FirstDateSurpassed, decoded.FirstDateSurpassed)
+	c.Equal(app.GatewayAAT, decoded.GatewayAAT)
+	c.Equal(app.GatewaySettings, decoded.GatewaySettings)
+	c.Equal(app.NotificationSettings, decoded.NotificationSettings)
+	c.True(decoded.CreatedAt.IsZero())
+}
+
+func TestLoadBalancer_RoundTrip(t *testing.T) {
+	c := require.New(t)
+
+	lb := &repository.LoadBalancer{
+		ID:             "60ecb2bf67774900350d9c42",
+		Name:           "pokt",
+		UserID:         "60ecb2bf67774900350d9c43",
+		Applications:   []*repository.Application{{ID: "5f62b7d8be3591c4dea8566d"}, nil, {ID: "5f62b7d8be3591c4dea8566a"}},
+		RequestTimeout: 2000,
+		StickyOptions:  repository.StickyOptions{StickyOrigins: []string{"https://app.example.com"}, StickyMax: 300, Stickiness: true},
+	}
+
+	e := &encoder{}
+	encodeLoadBalancer(e, lb)
+
+	decoded, err := decodeLoadBalancer(e.buf)
+	c.NoError(err)
+
+	c.Equal(lb.ID, decoded.ID)
+	c.Equal(lb.Name, decoded.Name)
+	c.Equal(lb.UserID, decoded.UserID)
+	c.Equal([]string{"5f62b7d8be3591c4dea8566d", "5f62b7d8be3591c4dea8566a"}, decoded.ApplicationIDs)
+	c.Equal(lb.RequestTimeout, decoded.RequestTimeout)
+	c.Equal(lb.StickyOptions, decoded.StickyOptions)
+}
+
+func TestDecode(t *testing.T) {
+	c := require.New(t)
+
+	// id = "a", then a fixed64 and a fixed32 field, skipped as unknown
+	data := []byte{0x0a, 0x01, 'a', 0x11, 1, 2, 3, 4, 5, 6, 7, 8, 0x1d, 1, 2, 3, 4}
+
+	request, err := decodeGetRequest(data)
+	c.NoError(err)
+	c.Equal("a", request.id)
+
+	for _, malformed := range [][]byte{
+		{0x0a, 0x05, 'a'},
+		{0x08},
+		{0x11, 1, 2},
+		{0x0b},
+	} {
+		_, err = decodeGetRequest(malformed)
+		c.ErrorIs(err, errMalformedMessage, malformed)
+	}
+}
+
+func TestPercentEncode(t *testing.T) {
+	c := require.New(t)
+
+	c.Equal("application not found", percentEncode("application not found"))
+	c.Equal("100%25 d%C3%A9j%C3%A0%0A", percentEncode("100% déjà\n"))
+}
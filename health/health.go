@@ -0,0 +1,218 @@
+// Package health keeps the connectivity status of the optional external integrations, such as webhooks,
+// from the outcome of their last calls, so a broken integration is visible before it is needed
+// integrations failing as many calls in a row as their error budget are disabled until re-enabled by an operator
+package health
+
+import (
+	"errors"
+	"sort"
+	"sync"
+	"time"
+)
+
+// State is the connectivity state of an integration
+type State string
+
+const (
+	// StateUnknown is the state of integrations not called yet
+	StateUnknown State = "unknown"
+	StateOK      State = "ok"
+	// StateFailing is the state of integrations whose last call failed
+	StateFailing State = "failing"
+	// StateDisabled is the state of integrations disabled after exhausting their error budget
+	StateDisabled State = "disabled"
+)
+
+// ErrUnknownIntegration is returned when enabling an integration without a probe
+var ErrUnknownIntegration = errors.New("unknown integration")
+
+// Status is the connectivity status of an integration
+type Status struct {
+	Name        string     `json:"name"`
+	State       State      `json:"state"`
+	LastSuccess *time.Time `json:"lastSuccess,omitempty"`
+	LastError   string     `json:"lastError,omitempty"`
+	LastErrorAt *time.Time `json:"lastErrorAt,omitempty"`
+	// ConsecutiveFailures is the number of calls failed since the last success
+	ConsecutiveFailures int        `json:"consecutiveFailures,omitempty"`
+	DisabledAt          *time.Time `json:"disabledAt,omitempty"`
+}
+
+// Probe records the outcome of the calls to an integration, a nil Probe records nothing
+type Probe struct {
+	name        string
+	mutex       sync.Mutex
+	lastSuccess time.Time
+	lastError   string
+	lastErrorAt time.Time
+	// failures is the number of calls failed in a row, the integration is disabled once it reaches budget
+	failures   int
+	budget     int
+	disabledAt time.Time
+	now        func() time.Time
+}
+
+// Record records the outcome of a call, a success if err is nil
+func (p *Probe) Record(err error) {
+	if p == nil {
+		return
+	}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if err == nil {
+		p.lastSuccess = p.now()
+		p.failures = 0
+
+		return
+	}
+
+	p.lastError = err.Error()
+	p.lastErrorAt = p.now()
+	p.failures++
+
+	if p.budget > 0 && p.failures >= p.budget && p.disabledAt.IsZero() {
+		p.disabledAt = p.lastErrorAt
+	}
+}
+
+// Enabled returns false if the integration exhausted its error budget, callers skip its calls until it is re-enabled
+// a nil Probe is always enabled
+func (p *Probe) Enabled() bool {
+	if p == nil {
+		return true
+	}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	return p.disabledAt.IsZero()
+}
+
+// enable re-enables the integration with its whole error budget
+func (p *Probe) enable() {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.disabledAt = time.Time{}
+	p.failures = 0
+}
+
+// setBudget sets the number of calls failed in a row disabling the integration, 0 never disables it
+func (p *Probe) setBudget(budget int) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.budget = budget
+}
+
+// Status returns the status of the integration, failing if its last call failed, disabled if it exhausted its error budget
+func (p *Probe) Status() Status {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	status := Status{Name: p.name, State: StateUnknown, ConsecutiveFailures: p.failures}
+
+	if !p.lastSuccess.IsZero() {
+		lastSuccess := p.lastSuccess
+		status.LastSuccess = &lastSuccess
+		status.State = StateOK
+	}
+
+	if !p.lastErrorAt.IsZero() {
+		lastErrorAt := p.lastErrorAt
+		status.LastError = p.lastError
+		status.LastErrorAt = &lastErrorAt
+
+		if lastErrorAt.After(p.lastSuccess) {
+			status.State = StateFailing
+		}
+	}
+
+	if !p.disabledAt.IsZero() {
+		disabledAt := p.disabledAt
+		status.DisabledAt = &disabledAt
+		status.State = StateDisabled
+	}
+
+	return status
+}
+
+// Registry holds the probes of the enabled integrations
+type Registry struct {
+	mutex  sync.Mutex
+	probes map[string]*Probe
+}
+
+// NewRegistry returns Registry instance without probes
+func NewRegistry() *Registry {
+	return &Registry{probes: map[string]*Probe{}}
+}
+
+// Probe returns the probe of the integration name, registering it on its first call
+func (r *Registry) Probe(name string) *Probe {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if probe, ok := r.probes[name]; ok {
+		return probe
+	}
+
+	probe := &Probe{name: name, now: time.Now}
+	r.probes[name] = probe
+
+	return probe
+}
+
+// SetErrorBudget sets the number of calls failed in a row disabling the integration name, 0 never disables it
+// only optional integrations should have one, the calls of the others are never skipped
+func (r *Registry) SetErrorBudget(name string, failures int) {
+	r.Probe(name).setBudget(failures)
+}
+
+// Enable re-enables the integration name with its whole error budget and returns its status
+// returns ErrUnknownIntegration if there is no probe for it
+func (r *Registry) Enable(name string) (Status, error) {
+	r.mutex.Lock()
+	probe, ok := r.probes[name]
+	r.mutex.Unlock()
+
+	if !ok {
+		return Status{}, ErrUnknownIntegration
+	}
+
+	probe.enable()
+
+	return probe.Status(), nil
+}
+
+// Statuses returns the status of every registered integration, sorted by name
+func (r *Registry) Statuses() []Status {
+	r.mutex.Lock()
+	probes := make([]*Probe, 0, len(r.probes))
+	for _, probe := range r.probes {
+		probes = append(probes, probe)
+	}
+	r.mutex.Unlock()
+
+	statuses := make([]Status, 0, len(probes))
+	for _, probe := range probes {
+		statuses = append(statuses, probe.Status())
+	}
+
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Name < statuses[j].Name })
+
+	return statuses
+}
+
+// Healthy returns false if any registered integration is failing or disabled
+func (r *Registry) Healthy() bool {
+	for _, status := range r.Statuses() {
+		if status.State == StateFailing || status.State == StateDisabled {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,106 @@
+package health
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistry(t *testing.T) {
+	c := require.New(t)
+
+	registry := NewRegistry()
+
+	webhooks := registry.Probe("webhooks")
+	c.Same(webhooks, registry.Probe("webhooks"))
+
+	kms := registry.Probe("kms")
+
+	now := time.Date(2022, time.July, 1, 0, 0, 0, 0, time.UTC)
+	webhooks.now = func() time.Time { return now }
+
+	c.Equal([]Status{{Name: "kms", State: StateUnknown}, {Name: "webhooks", State: StateUnknown}}, registry.Statuses())
+	c.True(registry.Healthy())
+
+	webhooks.Record(nil)
+
+	status := webhooks.Status()
+	c.Equal(StateOK, status.State)
+	c.Equal(now, *status.LastSuccess)
+
+	now = now.Add(time.Minute)
+	webhooks.Record(errors.New("connection refused"))
+
+	status = webhooks.Status()
+	c.Equal(StateFailing, status.State)
+	c.Equal("connection refused", status.LastError)
+	c.Equal(now, *status.LastErrorAt)
+	c.False(registry.Healthy())
+
+	// the last error is kept once recovered
+	now = now.Add(time.Minute)
+	webhooks.Record(nil)
+
+	status = webhooks.Status()
+	c.Equal(StateOK, status.State)
+	c.Equal("connection refused", status.LastError)
+	c.True(registry.Healthy())
+
+	kms.Record(nil)
+	c.Equal(StateOK, kms.Status().State)
+
+	var probe *Probe
+	probe.Record(nil)
+}
+
+func TestRegistry_ErrorBudget(t *testing.T) {
+	c := require.New(t)
+
+	registry := NewRegistry()
+	registry.SetErrorBudget("webhooks", 3)
+
+	webhooks := registry.Probe("webhooks")
+	kms := registry.Probe("kms")
+
+	for i := 0; i < 2; i++ {
+		webhooks.Record(errors.New("connection refused"))
+		kms.Record(errors.New("access denied"))
+	}
+
+	// a success restores the whole budget
+	webhooks.Record(nil)
+	c.Zero(webhooks.Status().ConsecutiveFailures)
+
+	for i := 0; i < 3; i++ {
+		c.True(webhooks.Enabled())
+		webhooks.Record(errors.New("connection refused"))
+		kms.Record(errors.New("access denied"))
+	}
+
+	status := webhooks.Status()
+	c.False(webhooks.Enabled())
+	c.Equal(StateDisabled, status.State)
+	c.Equal(3, status.ConsecutiveFailures)
+	c.NotNil(status.DisabledAt)
+	c.False(registry.Healthy())
+
+	// integrations without a budget are never disabled
+	c.True(kms.Enabled())
+	c.Equal(StateFailing, kms.Status().State)
+	c.Equal(5, kms.Status().ConsecutiveFailures)
+
+	status, err := registry.Enable("webhooks")
+	c.NoError(err)
+	c.Equal(StateFailing, status.State)
+	c.Zero(status.ConsecutiveFailures)
+	c.Nil(status.DisabledAt)
+	c.True(webhooks.Enabled())
+
+	_, err = registry.Enable("kafka")
+	c.ErrorIs(err, ErrUnknownIntegration)
+
+	var probe *Probe
+	c.True(probe.Enabled())
+}
@@ -0,0 +1,81 @@
+// Package instance keeps a registry of the running instances of the service and their cache generations,
+// each instance reporting a heartbeat to a shared store
+package instance
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"os"
+	"time"
+
+	"github.com/pokt-foundation/pocket-http-db/cache"
+	"github.com/pokt-foundation/pocket-http-db/types"
+)
+
+// heartbeatsToExpire is how many missed heartbeats make an instance no longer listed
+const heartbeatsToExpire = 3
+
+// Store represents the implementation of the store shared by the instances
+type Store interface {
+	WriteInstance(instance *types.Instance) error
+	ReadInstances(since time.Time) ([]*types.Instance, error)
+}
+
+// Registry struct handler for the heartbeats of an instance and the listing of the known ones
+type Registry struct {
+	id       string
+	cache    *cache.Cache
+	store    Store
+	interval time.Duration
+}
+
+// NewRegistry returns Registry instance reporting the cache generation of the instance with given id
+// every interval to store
+func NewRegistry(id string, cache *cache.Cache, store Store, interval time.Duration) *Registry {
+	return &Registry{
+		id:       id,
+		cache:    cache,
+		store:    store,
+		interval: interval,
+	}
+}
+
+// ID returns the ID of the instance
+func (r *Registry) ID() string {
+	return r.id
+}
+
+// Heartbeat reports the instance and its cache generation to the store
+func (r *Registry) Heartbeat() error {
+	generation, refreshedAt := r.cache.Generation()
+
+	return r.store.WriteInstance(&types.Instance{
+		ID:               r.id,
+		CacheGeneration:  generation,
+		CacheRefreshedAt: refreshedAt,
+		LastSeen:         time.Now(),
+	})
+}
+
+// Instances returns the instances that reported a heartbeat recently
+func (r *Registry) Instances() ([]*types.Instance, error) {
+	return r.store.ReadInstances(time.Now().Add(-heartbeatsToExpire * r.interval))
+}
+
+// NewID returns an ID for the instance, the hostname followed by a random suffix
+// so restarted containers with the same hostname are told apart
+func NewID() string {
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		hostname = "instance"
+	}
+
+	suffix := make([]byte, 4)
+
+	_, err = rand.Read(suffix)
+	if err != nil {
+		return hostname
+	}
+
+	return hostname + "-" + hex.EncodeToString(suffix)
+}
@@ -0,0 +1,82 @@
+package instance
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pokt-foundation/pocket-http-db/cache"
+	"github.com/pokt-foundation/pocket-http-db/types"
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+// storeMock keeps the instances in memory
+type storeMock struct {
+	instances map[string]*types.Instance
+}
+
+func (s *storeMock) WriteInstance(instance *types.Instance) error {
+	s.instances[instance.ID] = instance
+
+	return nil
+}
+
+func (s *storeMock) ReadInstances(since time.Time) ([]*types.Instance, error) {
+	var instances []*types.Instance
+
+	for _, instance := range s.instances {
+		if !instance.LastSeen.Before(since) {
+			instances = append(instances, instance)
+		}
+	}
+
+	return instances, nil
+}
+
+func newTestCache(t *testing.T) *cache.Cache {
+	readerMock := &cache.ReaderMock{}
+
+	readerMock.On("ReadPayPlans").Return([]*repository.PayPlan{}, nil)
+	readerMock.On("ReadRedirects").Return([]*repository.Redirect{}, nil)
+	readerMock.On("ReadApplications").Return([]*repository.Application{}, nil)
+	readerMock.On("ReadBlockchains").Return([]*repository.Blockchain{}, nil)
+	readerMock.On("ReadLoadBalancers").Return([]*repository.LoadBalancer{}, nil)
+
+	c := cache.NewCache(readerMock, logrus.New())
+
+	require.NoError(t, c.SetCache())
+
+	return c
+}
+
+func TestRegistry_Heartbeat(t *testing.T) {
+	c := require.New(t)
+
+	store := &storeMock{instances: map[string]*types.Instance{
+		"stale": {ID: "stale", LastSeen: time.Now().Add(-time.Hour)},
+	}}
+
+	registry := NewRegistry("pocket-http-db-1", newTestCache(t), store, time.Second)
+	c.Equal("pocket-http-db-1", registry.ID())
+
+	err := registry.Heartbeat()
+	c.NoError(err)
+
+	instances, err := registry.Instances()
+	c.NoError(err)
+	c.Len(instances, 1)
+	c.Equal("pocket-http-db-1", instances[0].ID)
+	c.Equal(uint64(1), instances[0].CacheGeneration)
+	c.False(instances[0].CacheRefreshedAt.IsZero())
+}
+
+func TestNewID(t *testing.T) {
+	c := require.New(t)
+
+	id := NewID()
+	c.NotEqual(id, NewID())
+	c.Contains(id, "-")
+	c.False(strings.HasPrefix(id, "-"))
+}
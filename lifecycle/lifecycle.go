@@ -0,0 +1,244 @@
+// Package lifecycle runs the background subsystems of an instance, such as the cache refresher and the HTTP server,
+// starting them in order and stopping them in reverse order, each in its own goroutine so a panic only fails itself
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// State is the state of a subsystem
+type State string
+
+const (
+	// StatePending is the state of subsystems not started yet
+	StatePending State = "pending"
+	// StateRunning is the state of subsystems started and not stopped yet
+	StateRunning State = "running"
+	// StateStopped is the state of subsystems whose Run returned without error
+	StateStopped State = "stopped"
+	// StateFailed is the state of subsystems whose Run returned an error or panicked
+	StateFailed State = "failed"
+)
+
+// ErrPanicked is wrapped by the errors of the subsystems that panicked
+var ErrPanicked = errors.New("subsystem panicked")
+
+// Subsystem is a background part of the instance
+type Subsystem struct {
+	Name string
+	// Run runs the subsystem until ctx is done, returning nil once it stopped
+	Run func(ctx context.Context) error
+	// Stop releases the resources of the subsystem once Run returned, such as flushing buffers, it can be nil
+	Stop func(ctx context.Context) error
+	// Critical subsystems failing are reported by Failures, so the instance can stop instead of running without them
+	Critical bool
+}
+
+// Status is the status of a subsystem
+type Status struct {
+	Name      string     `json:"name"`
+	State     State      `json:"state"`
+	StartedAt *time.Time `json:"startedAt,omitempty"`
+	StoppedAt *time.Time `json:"stoppedAt,omitempty"`
+	LastError string     `json:"lastError,omitempty"`
+}
+
+// entry is a subsystem added to a manager along with its status
+type entry struct {
+	subsystem Subsystem
+	state     State
+	startedAt time.Time
+	stoppedAt time.Time
+	err       error
+	cancel    context.CancelFunc
+	done      chan struct{}
+}
+
+// Manager starts and stops the subsystems added to it
+type Manager struct {
+	entries  []*entry
+	failures chan error
+	log      *logrus.Logger
+	now      func() time.Time
+	mutex    sync.Mutex
+}
+
+// NewManager returns Manager instance without subsystems
+func NewManager(logger *logrus.Logger) *Manager {
+	return &Manager{failures: make(chan error, 1), log: logger, now: time.Now}
+}
+
+// Failures receives the error of the first critical subsystem failing
+func (m *Manager) Failures() <-chan error {
+	return m.failures
+}
+
+// Add adds subsystem to be started after the subsystems added before it and stopped before them
+func (m *Manager) Add(subsystem Subsystem) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.entries = append(m.entries, &entry{subsystem: subsystem, state: StatePending})
+}
+
+// Start starts every subsystem not started yet, in the order they were added
+// subsystems run until Shutdown is called or ctx is done
+func (m *Manager) Start(ctx context.Context) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	for _, e := range m.entries {
+		if e.state != StatePending {
+			continue
+		}
+
+		runCtx, cancel := context.WithCancel(ctx)
+
+		e.state = StateRunning
+		e.startedAt = m.now()
+		e.cancel = cancel
+		e.done = make(chan struct{})
+
+		go m.run(runCtx, e)
+	}
+}
+
+// run runs the subsystem of e, recording how it stopped
+func (m *Manager) run(ctx context.Context, e *entry) {
+	defer close(e.done)
+
+	err := runIsolated(ctx, e.subsystem.Run)
+
+	m.mutex.Lock()
+	e.stoppedAt = m.now()
+	e.state = StateStopped
+	if err != nil {
+		e.state = StateFailed
+		e.err = err
+	}
+	m.mutex.Unlock()
+
+	if err == nil {
+		return
+	}
+
+	err = fmt.Errorf("subsystem %s failed: %w", e.subsystem.Name, err)
+
+	m.log.WithFields(logrus.Fields{
+		"subsystem": e.subsystem.Name,
+		"err":       err.Error(),
+	}).Error(err)
+
+	if e.subsystem.Critical {
+		select {
+		case m.failures <- err:
+		default:
+		}
+	}
+}
+
+// runIsolated calls run, returning its panic as an error wrapping ErrPanicked
+func runIsolated(ctx context.Context, run func(ctx context.Context) error) (err error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			err = fmt.Errorf("%w: %v", ErrPanicked, recovered)
+		}
+	}()
+
+	return run(ctx)
+}
+
+// Shutdown stops the started subsystems in the reverse order they were added, waiting for each Run to return
+// before calling its Stop, every subsystem is stopped even if the Stop of another fails
+// returns the first Stop error, or ctx.Err() if ctx is done before every subsystem stopped
+func (m *Manager) Shutdown(ctx context.Context) error {
+	m.mutex.Lock()
+	started := []*entry{}
+	for _, e := range m.entries {
+		if e.done != nil {
+			started = append(started, e)
+		}
+	}
+	m.mutex.Unlock()
+
+	var firstErr error
+
+	for i := len(started) - 1; i >= 0; i-- {
+		e := started[i]
+
+		e.cancel()
+
+		select {
+		case <-e.done:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+
+		if e.subsystem.Stop == nil {
+			continue
+		}
+
+		err := runIsolated(ctx, e.subsystem.Stop)
+		if err != nil {
+			err = fmt.Errorf("stop %s: %w", e.subsystem.Name, err)
+
+			m.log.WithFields(logrus.Fields{
+				"subsystem": e.subsystem.Name,
+				"err":       err.Error(),
+			}).Error(err)
+
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+
+	return firstErr
+}
+
+// Statuses returns the status of every subsystem, in the order they were added
+func (m *Manager) Statuses() []Status {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	statuses := make([]Status, 0, len(m.entries))
+
+	for _, e := range m.entries {
+		status := Status{Name: e.subsystem.Name, State: e.state}
+
+		if !e.startedAt.IsZero() {
+			startedAt := e.startedAt
+			status.StartedAt = &startedAt
+		}
+
+		if !e.stoppedAt.IsZero() {
+			stoppedAt := e.stoppedAt
+			status.StoppedAt = &stoppedAt
+		}
+
+		if e.err != nil {
+			status.LastError = e.err.Error()
+		}
+
+		statuses = append(statuses, status)
+	}
+
+	return statuses
+}
+
+// Healthy returns false if any subsystem failed
+func (m *Manager) Healthy() bool {
+	for _, status := range m.Statuses() {
+		if status.State == StateFailed {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,193 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+// waitDone returns a Run waiting for ctx to be done
+func waitDone(ctx context.Context) error {
+	<-ctx.Done()
+	return nil
+}
+
+func TestManager_StartShutdown(t *testing.T) {
+	c := require.New(t)
+
+	manager := NewManager(logrus.New())
+
+	var (
+		events []string
+		mutex  sync.Mutex
+	)
+
+	record := func(event string) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		events = append(events, event)
+	}
+
+	for _, name := range []string{"access_log", "cache_refresher", "http_server"} {
+		name := name
+
+		manager.Add(Subsystem{
+			Name: name,
+			Run: func(ctx context.Context) error {
+				<-ctx.Done()
+				record("run " + name)
+				return nil
+			},
+			Stop: func(ctx context.Context) error {
+				record("stop " + name)
+				return nil
+			},
+		})
+	}
+
+	c.Equal(StatePending, manager.Statuses()[0].State)
+
+	manager.Start(context.Background())
+
+	for _, status := range manager.Statuses() {
+		c.Equal(StateRunning, status.State)
+		c.NotNil(status.StartedAt)
+		c.Nil(status.StoppedAt)
+	}
+
+	c.NoError(manager.Shutdown(context.Background()))
+
+	c.Equal([]string{
+		"run http_server", "stop http_server",
+		"run cache_refresher", "stop cache_refresher",
+		"run access_log", "stop access_log",
+	}, events)
+
+	for _, status := range manager.Statuses() {
+		c.Equal(StateStopped, status.State)
+		c.NotNil(status.StoppedAt)
+	}
+
+	c.True(manager.Healthy())
+}
+
+func TestManager_Panic(t *testing.T) {
+	c := require.New(t)
+
+	manager := NewManager(logrus.New())
+
+	manager.Add(Subsystem{Name: "cache_refresher", Run: waitDone})
+	manager.Add(Subsystem{
+		Name: "retention",
+		Run: func(ctx context.Context) error {
+			panic("nil map")
+		},
+	})
+
+	manager.Start(context.Background())
+
+	c.Eventually(func() bool {
+		return !manager.Healthy()
+	}, time.Second, 10*time.Millisecond)
+
+	statuses := manager.Statuses()
+	c.Equal(StateRunning, statuses[0].State)
+	c.Equal(StateFailed, statuses[1].State)
+	c.Equal("subsystem panicked: nil map", statuses[1].LastError)
+
+	select {
+	case err := <-manager.Failures():
+		c.Fail("unexpected failure", err)
+	default:
+	}
+
+	c.NoError(manager.Shutdown(context.Background()))
+}
+
+func TestManager_CriticalFailure(t *testing.T) {
+	c := require.New(t)
+
+	manager := NewManager(logrus.New())
+
+	manager.Add(Subsystem{
+		Name: "http_server",
+		Run: func(ctx context.Context) error {
+			return errors.New("address already in use")
+		},
+		Critical: true,
+	})
+
+	manager.Start(context.Background())
+
+	select {
+	case err := <-manager.Failures():
+		c.EqualError(err, "subsystem http_server failed: address already in use")
+	case <-time.After(time.Second):
+		c.Fail("critical failure not reported")
+	}
+
+	c.NoError(manager.Shutdown(context.Background()))
+}
+
+func TestManager_ShutdownErrors(t *testing.T) {
+	c := require.New(t)
+
+	manager := NewManager(logrus.New())
+
+	stopped := []string{}
+
+	manager.Add(Subsystem{
+		Name: "access_log",
+		Run:  waitDone,
+		Stop: func(ctx context.Context) error {
+			stopped = append(stopped, "access_log")
+			return errors.New("flush failed")
+		},
+	})
+	manager.Add(Subsystem{
+		Name: "cache_refresher",
+		Run:  waitDone,
+		Stop: func(ctx context.Context) error {
+			stopped = append(stopped, "cache_refresher")
+			panic("closed twice")
+		},
+	})
+
+	manager.Start(context.Background())
+
+	// subsystems added after Start are not started, so not stopped either
+	manager.Add(Subsystem{
+		Name: "added_late",
+		Run:  waitDone,
+		Stop: func(ctx context.Context) error {
+			stopped = append(stopped, "added_late")
+			return nil
+		},
+	})
+
+	err := manager.Shutdown(context.Background())
+	c.ErrorIs(err, ErrPanicked)
+	c.Equal([]string{"cache_refresher", "access_log"}, stopped)
+	c.Equal(StatePending, manager.Statuses()[2].State)
+
+	blocked := NewManager(logrus.New())
+	blocked.Add(Subsystem{
+		Name: "http_server",
+		Run: func(ctx context.Context) error {
+			time.Sleep(time.Second)
+			return nil
+		},
+	})
+	blocked.Start(context.Background())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	c.ErrorIs(blocked.Shutdown(ctx), context.DeadlineExceeded)
+}
@@ -1,28 +1,281 @@
 package main
 
 import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"flag"
 	"fmt"
+	"io"
 	"net/http"
-	"sync"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
 	"time"
 
 	"github.com/lib/pq"
+	"github.com/pokt-foundation/pocket-http-db/accesslog"
+	"github.com/pokt-foundation/pocket-http-db/anomaly"
+	"github.com/pokt-foundation/pocket-http-db/cache"
+	"github.com/pokt-foundation/pocket-http-db/casing"
+	"github.com/pokt-foundation/pocket-http-db/config"
+	"github.com/pokt-foundation/pocket-http-db/health"
+	"github.com/pokt-foundation/pocket-http-db/instance"
+	"github.com/pokt-foundation/pocket-http-db/lifecycle"
+	"github.com/pokt-foundation/pocket-http-db/metrics"
+	"github.com/pokt-foundation/pocket-http-db/nonce"
+	"github.com/pokt-foundation/pocket-http-db/notifier"
+	"github.com/pokt-foundation/pocket-http-db/postgres"
+	"github.com/pokt-foundation/pocket-http-db/relaymeter"
+	"github.com/pokt-foundation/pocket-http-db/replica"
+	"github.com/pokt-foundation/pocket-http-db/retention"
 	"github.com/pokt-foundation/pocket-http-db/router"
-	postgresdriver "github.com/pokt-foundation/portal-api-go/postgres-driver"
-	"github.com/pokt-foundation/utils-go/environment"
+	"github.com/pokt-foundation/pocket-http-db/secrets"
+	"github.com/pokt-foundation/pocket-http-db/selftest"
+	"github.com/pokt-foundation/pocket-http-db/service"
+	"github.com/pokt-foundation/pocket-http-db/snapshot"
+	"github.com/pokt-foundation/pocket-http-db/tracing"
+	"github.com/pokt-foundation/pocket-http-db/webhook"
+	"github.com/pokt-foundation/portal-api-go/repository"
 	"github.com/sirupsen/logrus"
 )
 
-var (
-	connectionString = environment.MustGetString("CONNECTION_STRING")
-	apiKeys          = environment.MustGetStringMap("API_KEYS", ",")
+// Config holds the environment variables of the instance, loaded once at startup
+type Config struct {
+	// ConnectionString is required unless following a primary instance
+	ConnectionString string          `env:"CONNECTION_STRING,secret"`
+	APIKeys          map[string]bool `env:"API_KEYS,required,secret"`
+
+	// ChangesFeed records the changes applied to the cache for follower instances
+	ChangesFeed     bool  `env:"CHANGES_FEED"`
+	ChangesFeedSize int64 `env:"CHANGES_FEED_SIZE" default:"10000"`
+
+	// FollowPrimaryURL turns the instance into a read-only follower of the primary instance in the URL
+	FollowPrimaryURL    string `env:"FOLLOW_PRIMARY_URL"`
+	FollowPrimaryAPIKey string `env:"FOLLOW_PRIMARY_API_KEY,secret"`
+	FollowPollInterval  int64  `env:"FOLLOW_POLL_INTERVAL_SECONDS" default:"5"`
+
+	// CacheRefresh is how often the cache is refreshed from the database, as a duration such as 10m
+	// it is loaded with GetDuration, as are the server timeouts, so an invalid value falls back to the default
+	CacheRefresh time.Duration
+	// CacheRefreshWait is how long POST /cache/refresh waits for the refresh before accepting it
+	CacheRefreshWait int64  `env:"CACHE_REFRESH_WAIT_SECONDS" default:"10"`
+	Port             string `env:"PORT" default:"8080"`
+	// ServerReadTimeout and ServerWriteTimeout bound the reading of requests and the writing of responses
+	// of the API and the public mirror, as durations such as 30s or 5m, 0 disables them
+	// the admin server is left without them, so long pprof profiles can be taken
+	ServerReadTimeout  time.Duration
+	ServerWriteTimeout time.Duration
+	// GRPCPort serves the gRPC API on that port, 0 disables it, HTTP/2 needing TLS it requires a certificate
+	GRPCPort        int64  `env:"GRPC_PORT"`
+	GRPCTLSCertFile string `env:"GRPC_TLS_CERT_FILE"`
+	GRPCTLSKeyFile  string `env:"GRPC_TLS_KEY_FILE"`
+	// PublicMirrorPort serves the active blockchains and the pay plan catalog without authentication on that port,
+	// for the docs site and the status page, empty disables it
+	PublicMirrorPort string `env:"PUBLIC_MIRROR_PORT"`
+	// AdminAddress serves the admin routes and the pprof profiles on that address only, such as 127.0.0.1:8081,
+	// instead of on PORT, empty keeps the admin routes on PORT without profiles
+	AdminAddress string `env:"ADMIN_ADDRESS"`
+
+	UniqueLoadBalancerNames bool `env:"UNIQUE_LB_NAMES"`
+	UniqueApplicationNames  bool `env:"UNIQUE_APP_NAMES"`
+	// UniqueStickyOrigins rejects sticky origins already used by other load balancers: "global", "user" or empty to allow them
+	UniqueStickyOrigins string `env:"UNIQUE_STICKY_ORIGINS"`
+	// ReadThroughApplications reads the applications missing from cache from the database before responding 404,
+	// covering the writes made to the database outside of this service until the next refresh
+	ReadThroughApplications bool `env:"READ_THROUGH_APPLICATIONS"`
+	// ReadThroughMissTTL is how long the applications missing from the database are not read again, 0 reads them every time
+	ReadThroughMissTTL int64 `env:"READ_THROUGH_MISS_TTL_SECONDS" default:"5"`
+	GracePeriodDays    int64 `env:"APP_GRACE_PERIOD_DAYS" default:"30"`
+
+	WebhookURLs   string `env:"WEBHOOK_URLS"`
+	WebhookSecret string `env:"WEBHOOK_SECRET,secret"`
+	// WebhookMaxRetries is how many times a failed webhook delivery is retried, waiting twice as long each time
+	WebhookMaxRetries     int64 `env:"WEBHOOK_MAX_RETRIES" default:"3"`
+	WebhookRetryBackoffMS int64 `env:"WEBHOOK_RETRY_BACKOFF_MS" default:"1000"`
+	// WebhookDeadLetterFile keeps the events failing every delivery attempt as JSON lines, empty logs them instead
+	WebhookDeadLetterFile string `env:"WEBHOOK_DEAD_LETTER_FILE"`
+
+	// IntegrationErrorBudget disables the webhooks, notifications and kafka access log after that many failed calls
+	// in a row, until re-enabled on POST /admin/health/{integration}/enable, 0 never disables them
+	IntegrationErrorBudget int64 `env:"INTEGRATION_ERROR_BUDGET"`
+
+	RelayMeterPushURL    string `env:"RELAY_METER_PUSH_URL"`
+	RelayMeterPushAPIKey string `env:"RELAY_METER_PUSH_API_KEY,secret"`
+
+	NotifyEvents       string `env:"NOTIFY_EVENTS"`
+	NotifySlackURL     string `env:"NOTIFY_SLACK_WEBHOOK_URL,secret"`
+	NotifySMTPAddr     string `env:"NOTIFY_SMTP_ADDR"`
+	NotifySMTPUsername string `env:"NOTIFY_SMTP_USERNAME"`
+	NotifySMTPPassword string `env:"NOTIFY_SMTP_PASSWORD,secret"`
+	NotifySMTPFrom     string `env:"NOTIFY_SMTP_FROM"`
+	NotifySMTPTo       string `env:"NOTIFY_SMTP_TO"`
+
+	// RetentionInterval is how often removed entities are purged, 0 disables the retention job
+	RetentionInterval int64 `env:"RETENTION_INTERVAL_MINUTES"`
+	// RetentionApplicationsDays is how long removed applications are kept, 0 keeps them for their grace period
+	RetentionApplicationsDays  int64 `env:"RETENTION_APPLICATIONS_DAYS"`
+	RetentionLoadBalancersDays int64 `env:"RETENTION_LOAD_BALANCERS_DAYS" default:"30"`
+	RetentionDryRun            bool  `env:"RETENTION_DRY_RUN"`
+
+	// BackupSnapshotStore is where the last snapshot is kept: "local", "s3" or empty to disable backup verification
+	BackupSnapshotStore string `env:"BACKUP_SNAPSHOT_STORE"`
+	BackupSnapshotPath  string `env:"BACKUP_SNAPSHOT_PATH" default:"snapshot.json"`
+	BackupS3Bucket      string `env:"BACKUP_S3_BUCKET"`
+	BackupS3Key         string `env:"BACKUP_S3_KEY" default:"snapshot.json"`
+	BackupS3Region      string `env:"BACKUP_S3_REGION" default:"us-east-2"`
+	BackupS3Endpoint    string `env:"BACKUP_S3_ENDPOINT"`
+	AWSAccessKeyID      string `env:"AWS_ACCESS_KEY_ID,secret"`
+	AWSSecretAccessKey  string `env:"AWS_SECRET_ACCESS_KEY,secret"`
+	AWSSessionToken     string `env:"AWS_SESSION_TOKEN,secret"`
+
+	// ResponseProfiles sets the response casing of API keys, as "keyID:profile,..." with the key IDs of the access log
+	ResponseProfiles string `env:"RESPONSE_PROFILES"`
+
+	// RedactedKeys strips emails, secret keys and AAT private keys from the responses of API keys, as "keyID,..."
+	RedactedKeys string `env:"REDACTED_KEYS"`
+
+	// EnvelopedKeys wraps the responses of API keys in {data, meta}, as "keyID,...", clients can opt in or out by header
+	EnvelopedKeys string `env:"ENVELOPED_KEYS"`
+
+	// KeyScopes grants scopes to API keys, as "keyID:scope,..." with the key IDs of the access log
+	KeyScopes string `env:"KEY_SCOPES"`
+	// KeyUsers restricts API keys to reading the applications and load balancers of a user, as "keyID:userID,..."
+	KeyUsers string `env:"KEY_USERS"`
+	// MaxListSize limits full lists of applications and load balancers unless a bulk scoped key passes all=true,
+	// 0 disables the limit
+	MaxListSize int64 `env:"MAX_LIST_SIZE"`
+
+	// DisabledRouteGroups disables route groups, as "group,group:read,group:write,..." where no suffix disables both
+	DisabledRouteGroups string `env:"DISABLED_ROUTE_GROUPS"`
+
+	BillingWebhookSecret string `env:"BILLING_WEBHOOK_SECRET,secret"`
+	// BillingTolerance is how far from the server time the timestamp of a billing event can be
+	BillingTolerance time.Duration `env:"BILLING_TOLERANCE" default:"5m"`
+	// BillingPlanCodes maps billing provider plan codes to pay plan types, as "code:PLAN_TYPE,..."
+	BillingPlanCodes string `env:"BILLING_PLAN_CODES"`
+
+	// ReplayProtection rejects write requests reusing a nonce, nonces are shared through the database when connected
+	ReplayProtection     bool   `env:"REPLAY_PROTECTION"`
+	RequireNonce         bool   `env:"REQUIRE_NONCE"`
+	RequestSigningSecret string `env:"REQUEST_SIGNING_SECRET,secret"`
+	NonceWindowSeconds   int64  `env:"NONCE_WINDOW_SECONDS" default:"300"`
+
+	// SecretsMasterKey seals gateway secret keys and AAT signatures at rest when set, as a base64 AES-256 key
+	// API keys with the secrets scope get them opened, the others get them sealed
+	SecretsMasterKey string `env:"SECRETS_MASTER_KEY,secret"`
+
+	// BackfillBatchSize is the number of entities persisted at once by the backfills of /admin/backfill/{field}
+	BackfillBatchSize int64 `env:"BACKFILL_BATCH_SIZE"`
+
+	// PageSnapshotTTLSeconds is how long the sorted lists of paginated traversals are held, so their next pages
+	// survive cache refreshes, 0 answers them with gone once the cache refreshes
+	PageSnapshotTTLSeconds int64 `env:"PAGE_SNAPSHOT_TTL_SECONDS" default:"120"`
+	PageSnapshotMax        int64 `env:"PAGE_SNAPSHOT_MAX"`
+
+	// LimitsDeltaVersions is the number of /application/limits versions held to answer the deltas since them,
+	// 0 answers every delta with the full limits
+	LimitsDeltaVersions int64 `env:"LIMITS_DELTA_VERSIONS"`
+
+	// WriteAnomalyThreshold flags API keys making more writes than that on a route in a window, 0 disables the detection
+	WriteAnomalyThreshold     int64 `env:"WRITE_ANOMALY_THRESHOLD"`
+	WriteAnomalyWindowSeconds int64 `env:"WRITE_ANOMALY_WINDOW_SECONDS" default:"60"`
+	// WriteAnomalyRestrictedLimit restricts flagged keys to that many writes per window, 0 only flags them
+	WriteAnomalyRestrictedLimit int64 `env:"WRITE_ANOMALY_RESTRICTED_LIMIT"`
+	WriteAnomalyRestrictSeconds int64 `env:"WRITE_ANOMALY_RESTRICT_SECONDS" default:"3600"`
+
+	// TracingEnabled propagates the traceparent header, sampling writes and reads by their rate from 0 to 1
+	TracingEnabled    bool    `env:"TRACING"`
+	TraceSampleReads  float64 `env:"TRACE_SAMPLE_READS" default:"0.01"`
+	TraceSampleWrites float64 `env:"TRACE_SAMPLE_WRITES" default:"1"`
+	// TraceSampleRoutes overrides the rate of route templates, as "/application/limits=0.001,..."
+	TraceSampleRoutes string `env:"TRACE_SAMPLE_ROUTES"`
 
-	cacheRefresh = environment.GetInt64("CACHE_REFRESH", 10)
-	port         = environment.GetString("PORT", "8080")
+	// PlanDeprecations sets the deprecation date of pay plans, as "PLAN_TYPE:2006-01-02,..."
+	PlanDeprecations string `env:"PAY_PLAN_DEPRECATIONS"`
+
+	// DeprecatedRoutes is a JSON array of router.RouteDeprecation, sent as deprecation headers on their routes
+	DeprecatedRoutes string `env:"DEPRECATED_ROUTES"`
+
+	// Environment and Region are sent on every response as the X-Environment header, not sent when Environment is empty
+	Environment string `env:"ENVIRONMENT"`
+	Region      string `env:"REGION"`
+
+	// InstanceID identifies the instance in responses and in the instance registry, generated when empty
+	InstanceID string `env:"INSTANCE_ID"`
+	// InstanceHeartbeatInterval is how often the instance reports to the registry, 0 disables the registry
+	// instances without a database connection, such as followers, are not registered
+	InstanceHeartbeatInterval int64 `env:"INSTANCE_HEARTBEAT_SECONDS" default:"30"`
+
+	// SelfTest runs the deployment pre-flight checks and exits, non-zero on failure, as the -selftest flag
+	SelfTest           bool  `env:"SELFTEST"`
+	SelfTestSampleSize int64 `env:"SELFTEST_SAMPLE_SIZE" default:"100"`
+
+	// OpenAPICheck fails the startup when the registered routes and the OpenAPI document differ, as the -check-openapi flag
+	OpenAPICheck bool `env:"OPENAPI_CHECK"`
+	// OpenAPIValidation rejects the requests whose parameters or body don't follow the schemas of the OpenAPI document
+	OpenAPIValidation bool `env:"OPENAPI_VALIDATION"`
+
+	// HTTPCacheMaxAge lets proxies and CDNs cache blockchain and pay plan reads, 0 disables the caching headers
+	// the responses are marked public, so shared caches serve them regardless of the Authorization header
+	HTTPCacheMaxAge int64 `env:"HTTP_CACHE_MAX_AGE_SECONDS"`
+	// CoalesceReads encodes the identical blockchain and pay plan reads in flight once, for all of them
+	CoalesceReads bool `env:"COALESCE_READS"`
+	// CacheSerializedReads serves the full lists of blockchains, pay plans and applications from their cached
+	// responses until the cache changes, keeping a response per API key and casing profile
+	CacheSerializedReads bool `env:"CACHE_SERIALIZED_READS"`
+
+	// MetricsEnabled serves request metrics in the Prometheus text format on /metrics
+	MetricsEnabled   bool  `env:"METRICS_ENABLED"`
+	MetricsMaxSeries int64 `env:"METRICS_MAX_SERIES" default:"1000"`
+	// CacheSlowLookupMS logs the cache lookups slower than that as warnings, 0 logs none
+	CacheSlowLookupMS int64 `env:"CACHE_SLOW_LOOKUP_MS" default:"50"`
+
+	AccessLogSink          string `env:"ACCESS_LOG_SINK"`
+	AccessLogBufferSize    int64  `env:"ACCESS_LOG_BUFFER_SIZE" default:"1024"`
+	AccessLogFile          string `env:"ACCESS_LOG_FILE" default:"access.log"`
+	AccessLogFileMaxSize   int64  `env:"ACCESS_LOG_FILE_MAX_SIZE_MB" default:"100"`
+	AccessLogFileBackups   int64  `env:"ACCESS_LOG_FILE_MAX_BACKUPS" default:"5"`
+	AccessLogKafkaProxyURL string `env:"ACCESS_LOG_KAFKA_PROXY_URL"`
+	AccessLogKafkaTopic    string `env:"ACCESS_LOG_KAFKA_TOPIC" default:"pocket-http-db-access-log"`
+	// AccessLogKafkaBatchSize and AccessLogKafkaFlushMS bound how many records are produced at once and how long
+	// they wait for their batch to be produced
+	AccessLogKafkaBatchSize int64 `env:"ACCESS_LOG_KAFKA_BATCH_SIZE" default:"100"`
+	AccessLogKafkaFlushMS   int64 `env:"ACCESS_LOG_KAFKA_FLUSH_INTERVAL_MS" default:"1000"`
+
+	// UsageRecords is the number of requests kept in memory for GET /admin/usage, 0 disables the report
+	UsageRecords int64 `env:"USAGE_REPORT_RECORDS"`
+
+	// ShutdownTimeout is how long the subsystems are given to stop on SIGTERM, in seconds, draining the requests in flight
+	ShutdownTimeout int64 `env:"SHUTDOWN_TIMEOUT_SECONDS" default:"30"`
+}
+
+var (
+	// cfg is loaded from the environment by main before anything else runs
+	cfg = Config{
+		BackfillBatchSize:   service.DefaultBackfillBatchSize,
+		PageSnapshotMax:     service.DefaultMaxPageSnapshots,
+		LimitsDeltaVersions: service.DefaultMaxLimitsVersions,
+	}
+	// settings records the environment variables loaded, served by /admin/config with secrets redacted
+	settings *config.Registry
 
 	log = logrus.New()
 )
 
+var (
+	selfTestFlag     = flag.Bool("selftest", false, "run the deployment pre-flight checks and exit, non-zero on failure")
+	openAPICheckFlag = flag.Bool("check-openapi", false, "fail the startup if the routes and the OpenAPI document differ")
+)
+
+var (
+	errMissingConnectionString = errors.New("CONNECTION_STRING is required unless FOLLOW_PRIMARY_URL is set")
+	errMissingGRPCCertificate  = errors.New("GRPC_TLS_CERT_FILE and GRPC_TLS_KEY_FILE are required when GRPC_PORT is set")
+)
+
 func init() {
 	// log as JSON instead of the default ASCII formatter.
 	log.SetFormatter(&logrus.JSONFormatter{})
@@ -36,49 +289,856 @@ func logError(msg string, err error) {
 	log.WithFields(fields).Error(err)
 }
 
-func cacheHandler(router *router.Router) {
-	for {
-		time.Sleep(time.Duration(cacheRefresh) * time.Minute)
+// sleep waits for d, returns false if ctx is done before
+func sleep(ctx context.Context, d time.Duration) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case <-time.After(d):
+		return true
+	}
+}
 
-		err := router.Cache.SetCache()
+func cacheHandler(ctx context.Context, router *router.Router) error {
+	for sleep(ctx, cfg.CacheRefresh) {
+		// joins the refresh in flight if one was requested on POST /cache/refresh
+		err := router.Cache.Refresh("schedule")
 		if err != nil {
 			logError("Cache refresh failed", err)
 		}
 	}
+
+	return nil
 }
 
-func httpHandler(router *router.Router) {
-	http.Handle("/", router.Router)
+// newAccessLog returns the access log for the configured sink, nil if access logging is disabled
+// the connectivity of external sinks is recorded on integrations
+func newAccessLog(integrations *health.Registry) (*accesslog.Logger, error) {
+	var sink io.WriteCloser
+
+	switch cfg.AccessLogSink {
+	case "":
+		return nil, nil
+	case "file":
+		fileSink, err := accesslog.NewFileSink(cfg.AccessLogFile, cfg.AccessLogFileMaxSize, int(cfg.AccessLogFileBackups))
+		if err != nil {
+			return nil, err
+		}
+
+		sink = fileSink
+	case "kafka":
+		if cfg.AccessLogKafkaProxyURL == "" {
+			return nil, fmt.Errorf("ACCESS_LOG_KAFKA_PROXY_URL is required for the kafka access log sink")
+		}
+
+		kafkaSink := accesslog.NewKafkaSink(cfg.AccessLogKafkaProxyURL, cfg.AccessLogKafkaTopic, 5*time.Second,
+			int(cfg.AccessLogKafkaBatchSize), time.Duration(cfg.AccessLogKafkaFlushMS)*time.Millisecond)
+		kafkaSink.Probe = integrations.Probe("kafka")
+		integrations.SetErrorBudget("kafka", int(cfg.IntegrationErrorBudget))
+
+		sink = kafkaSink
+	default:
+		return nil, fmt.Errorf("unknown access log sink: %s", cfg.AccessLogSink)
+	}
 
-	log.Printf("Postgres API running in port: %s\n", port)
-	log.Fatal(http.ListenAndServe(":"+port, nil))
+	return accesslog.NewLogger(sink, int(cfg.AccessLogBufferSize), log), nil
 }
 
-func main() {
+func retentionHandler(ctx context.Context, job *retention.Job) error {
+	for sleep(ctx, time.Duration(cfg.RetentionInterval)*time.Minute) {
+		report, err := job.Run(time.Now())
+		if err != nil {
+			logError("Retention run failed", err)
+			continue
+		}
+
+		log.WithFields(logrus.Fields{
+			"dryRun":        report.DryRun,
+			"applications":  report.Applications,
+			"loadBalancers": report.LoadBalancers,
+		}).Info("Retention run finished")
+	}
+
+	return nil
+}
+
+// httpHandler serves the API until ctx is done, then waits for the requests in flight up to ShutdownTimeout
+func httpHandler(ctx context.Context, router *router.Router) error {
+	mux := http.NewServeMux()
+	mux.Handle("/", router.Router)
+
+	if router.Metrics != nil {
+		mux.Handle("/metrics", router.Metrics)
+	}
+
+	server := &http.Server{
+		Addr:         ":" + cfg.Port,
+		Handler:      mux,
+		ReadTimeout:  cfg.ServerReadTimeout,
+		WriteTimeout: cfg.ServerWriteTimeout,
+	}
+
+	log.Printf("Postgres API running in port: %s\n", cfg.Port)
+
+	return serve(ctx, server, server.ListenAndServe)
+}
+
+// grpcHandler serves the gRPC API until ctx is done, then waits for the calls in flight up to ShutdownTimeout
+func grpcHandler(ctx context.Context, router *router.Router) error {
+	server := &http.Server{Addr: ":" + strconv.FormatInt(cfg.GRPCPort, 10), Handler: router.GRPCServer()}
+
+	log.Printf("gRPC API running in port: %d\n", cfg.GRPCPort)
+
+	return serve(ctx, server, func() error {
+		return server.ListenAndServeTLS(cfg.GRPCTLSCertFile, cfg.GRPCTLSKeyFile)
+	})
+}
+
+// publicMirrorHandler serves the public mirror until ctx is done, then waits for the requests in flight up to ShutdownTimeout
+func publicMirrorHandler(ctx context.Context, router *router.Router) error {
+	server := &http.Server{
+		Addr:         ":" + cfg.PublicMirrorPort,
+		Handler:      router.PublicMirror(),
+		ReadTimeout:  cfg.ServerReadTimeout,
+		WriteTimeout: cfg.ServerWriteTimeout,
+	}
+
+	log.Printf("Public mirror running in port: %s\n", cfg.PublicMirrorPort)
+
+	return serve(ctx, server, server.ListenAndServe)
+}
+
+// adminHandler serves the admin routes until ctx is done, then waits for the requests in flight up to ShutdownTimeout
+func adminHandler(ctx context.Context, router *router.Router) error {
+	server := &http.Server{Addr: cfg.AdminAddress, Handler: router.Admin()}
+
+	log.Printf("Admin API running in address: %s\n", cfg.AdminAddress)
+
+	return serve(ctx, server, server.ListenAndServe)
+}
+
+// serve runs listen until it fails or ctx is done, then shuts server down waiting up to ShutdownTimeout
+func serve(ctx context.Context, server *http.Server, listen func() error) error {
+	served := make(chan error, 1)
+
+	go func() {
+		served <- listen()
+	}()
+
+	select {
+	case err := <-served:
+		return err
+	case <-ctx.Done():
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), time.Duration(cfg.ShutdownTimeout)*time.Second)
+	defer cancel()
+
+	return server.Shutdown(shutdownCtx)
+}
+
+func instanceHandler(ctx context.Context, registry *instance.Registry) error {
+	for {
+		err := registry.Heartbeat()
+		if err != nil {
+			logError("Instance heartbeat failed", err)
+		}
+
+		if !sleep(ctx, time.Duration(cfg.InstanceHeartbeatInterval)*time.Second) {
+			return nil
+		}
+	}
+}
+
+// runSelfTest runs the pre-flight checks against the database and prints the report, returns the exit code
+func runSelfTest() int {
+	driver, err := newDriver()
+	if err != nil {
+		fmt.Printf("FAIL database connectivity: %s\nself test FAILED\n", err)
+		return 1
+	}
+
+	report := selftest.Run(driver, int(cfg.SelfTestSampleSize), log)
+
+	err = report.Write(os.Stdout)
+	if err != nil || report.Failed() {
+		return 1
+	}
+
+	return 0
+}
+
+// newNotifier returns a dispatcher for the configured notification channels, nil when there are none
+func newNotifier() *notifier.Dispatcher {
+	var notifiers []notifier.Notifier
+
+	if cfg.NotifySlackURL != "" {
+		notifiers = append(notifiers, notifier.NewSlack(cfg.NotifySlackURL, 10*time.Second))
+	}
+
+	if cfg.NotifySMTPAddr != "" {
+		notifiers = append(notifiers, notifier.NewSMTP(cfg.NotifySMTPAddr, cfg.NotifySMTPUsername, cfg.NotifySMTPPassword,
+			cfg.NotifySMTPFrom, strings.Split(cfg.NotifySMTPTo, ",")))
+	}
+
+	if len(notifiers) == 0 {
+		return nil
+	}
+
+	var events []notifier.EventType
+
+	if cfg.NotifyEvents != "" {
+		for _, event := range strings.Split(cfg.NotifyEvents, ",") {
+			events = append(events, notifier.EventType(strings.TrimSpace(event)))
+		}
+	}
+
+	return notifier.NewDispatcher(events, notifiers, log)
+}
+
+// newSnapshotStore returns the configured snapshot store, nil when backup verification is disabled
+func newSnapshotStore() (snapshot.Store, error) {
+	switch cfg.BackupSnapshotStore {
+	case "":
+		return nil, nil
+	case "local":
+		return snapshot.NewLocalStore(cfg.BackupSnapshotPath), nil
+	case "s3":
+		return snapshot.NewS3Store(snapshot.S3Config{
+			Bucket:          cfg.BackupS3Bucket,
+			Key:             cfg.BackupS3Key,
+			Region:          cfg.BackupS3Region,
+			AccessKeyID:     cfg.AWSAccessKeyID,
+			SecretAccessKey: cfg.AWSSecretAccessKey,
+			SessionToken:    cfg.AWSSessionToken,
+			Endpoint:        cfg.BackupS3Endpoint,
+		}, 30*time.Second), nil
+	default:
+		return nil, fmt.Errorf("invalid BACKUP_SNAPSHOT_STORE: %s", cfg.BackupSnapshotStore)
+	}
+}
+
+// parsePlanCodes parses a "code:PLAN_TYPE,..." list into a map from code to pay plan type
+func parsePlanCodes(rawCodes string) (map[string]repository.PayPlanType, error) {
+	planCodes := make(map[string]repository.PayPlanType)
+
+	if rawCodes == "" {
+		return planCodes, nil
+	}
+
+	for _, pair := range strings.Split(rawCodes, ",") {
+		code, planType, ok := strings.Cut(pair, ":")
+		if !ok || code == "" || planType == "" {
+			return nil, fmt.Errorf("invalid plan code pair: %q", pair)
+		}
+
+		planCodes[strings.TrimSpace(code)] = repository.PayPlanType(strings.TrimSpace(planType))
+	}
+
+	return planCodes, nil
+}
+
+// parsePlanDeprecations parses a "PLAN_TYPE:2006-01-02,..." list into a map from pay plan type to deprecation date
+func parsePlanDeprecations(rawDeprecations string) (map[repository.PayPlanType]time.Time, error) {
+	deprecations := make(map[repository.PayPlanType]time.Time)
+
+	if rawDeprecations == "" {
+		return deprecations, nil
+	}
+
+	for _, pair := range strings.Split(rawDeprecations, ",") {
+		planType, rawDate, ok := strings.Cut(pair, ":")
+		if !ok || planType == "" || rawDate == "" {
+			return nil, fmt.Errorf("invalid plan deprecation pair: %q", pair)
+		}
+
+		date, err := time.Parse("2006-01-02", strings.TrimSpace(rawDate))
+		if err != nil {
+			return nil, fmt.Errorf("invalid plan deprecation date: %q", pair)
+		}
+
+		deprecations[repository.PayPlanType(strings.TrimSpace(planType))] = date
+	}
+
+	return deprecations, nil
+}
+
+// parseDeprecatedRoutes parses the JSON array of route deprecations
+func parseDeprecatedRoutes(rawRoutes string) ([]router.RouteDeprecation, error) {
+	if rawRoutes == "" {
+		return nil, nil
+	}
+
+	var routes []router.RouteDeprecation
+
+	err := json.Unmarshal([]byte(rawRoutes), &routes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid deprecated routes: %w", err)
+	}
+
+	for _, route := range routes {
+		if route.Method == "" || route.Pattern == "" {
+			return nil, fmt.Errorf("invalid deprecated route: method and pattern are required")
+		}
+	}
+
+	return routes, nil
+}
+
+// newSecretsEnvelope returns the envelope sealing secret fields with the master key, nil if it is not set
+func newSecretsEnvelope() (*secrets.Envelope, error) {
+	if cfg.SecretsMasterKey == "" {
+		return nil, nil
+	}
+
+	masterKey, err := base64.StdEncoding.DecodeString(cfg.SecretsMasterKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid SECRETS_MASTER_KEY: %w", err)
+	}
+
+	kms, err := secrets.NewLocalKMS(masterKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid SECRETS_MASTER_KEY: %w", err)
+	}
+
+	return secrets.NewEnvelope(kms), nil
+}
+
+// newDriver returns the postgres driver, listening to the database notifications
+func newDriver() (*postgres.Driver, error) {
+	if cfg.ConnectionString == "" {
+		return nil, errMissingConnectionString
+	}
+
 	reportProblem := func(ev pq.ListenerEventType, err error) {
 		if err != nil {
 			fmt.Printf("Problem with listener, error: %s, event type: %d", err.Error(), ev)
 		}
 	}
 
-	listener := pq.NewListener(connectionString, 10*time.Second, time.Minute, reportProblem)
+	listener := pq.NewListener(cfg.ConnectionString, 10*time.Second, time.Minute, reportProblem)
+
+	return postgres.NewDriverFromConnectionString(cfg.ConnectionString, listener)
+}
+
+// parseResponseProfiles parses a "keyID:profile,..." list into a map from key ID to casing profile
+func parseResponseProfiles(rawProfiles string) (map[string]casing.Profile, error) {
+	profiles := make(map[string]casing.Profile)
+
+	if rawProfiles == "" {
+		return profiles, nil
+	}
+
+	for _, pair := range strings.Split(rawProfiles, ",") {
+		keyID, name, _ := strings.Cut(pair, ":")
+
+		profile, ok := casing.ParseProfile(name)
+		if !ok || strings.TrimSpace(keyID) == "" {
+			return nil, fmt.Errorf("invalid response profile pair: %q", pair)
+		}
+
+		profiles[strings.TrimSpace(keyID)] = profile
+	}
+
+	return profiles, nil
+}
+
+// parseKeyIDs parses a "keyID,..." list into a set of key IDs
+func parseKeyIDs(rawKeys string) map[string]bool {
+	keys := make(map[string]bool)
+
+	for _, keyID := range strings.Split(rawKeys, ",") {
+		if keyID = strings.TrimSpace(keyID); keyID != "" {
+			keys[keyID] = true
+		}
+	}
+
+	return keys
+}
 
-	driver, err := postgresdriver.NewPostgresDriverFromConnectionString(connectionString, listener)
+// parseTraceSampleRoutes parses a "route=rate,..." list into a map from route template to sampling rate
+func parseTraceSampleRoutes(rawRoutes string) (map[string]float64, error) {
+	rates := make(map[string]float64)
+
+	if rawRoutes == "" {
+		return rates, nil
+	}
+
+	for _, pair := range strings.Split(rawRoutes, ",") {
+		route, rawRate, ok := strings.Cut(pair, "=")
+		if !ok || strings.TrimSpace(route) == "" {
+			return nil, fmt.Errorf("invalid trace sample route pair: %q", pair)
+		}
+
+		rate, err := strconv.ParseFloat(strings.TrimSpace(rawRate), 64)
+		if err != nil || rate < 0 || rate > 1 {
+			return nil, fmt.Errorf("invalid trace sample rate: %q", pair)
+		}
+
+		rates[strings.TrimSpace(route)] = rate
+	}
+
+	return rates, nil
+}
+
+// parseKeyScopes parses a "keyID:scope,..." list into a map from key ID to scopes, a key ID can be listed once per scope
+func parseKeyScopes(rawScopes string) (map[string]map[string]bool, error) {
+	scopes := make(map[string]map[string]bool)
+
+	if rawScopes == "" {
+		return scopes, nil
+	}
+
+	for _, pair := range strings.Split(rawScopes, ",") {
+		keyID, scope, _ := strings.Cut(pair, ":")
+
+		keyID, scope = strings.TrimSpace(keyID), strings.TrimSpace(scope)
+		if keyID == "" || scope == "" {
+			return nil, fmt.Errorf("invalid key scope pair: %q", pair)
+		}
+
+		if scopes[keyID] == nil {
+			scopes[keyID] = make(map[string]bool)
+		}
+
+		scopes[keyID][scope] = true
+	}
+
+	return scopes, nil
+}
+
+// parseKeyUsers parses a "keyID:userID,..." list into a map from key ID to the user it is restricted to
+func parseKeyUsers(rawUsers string) (map[string]string, error) {
+	users := make(map[string]string)
+
+	if rawUsers == "" {
+		return users, nil
+	}
+
+	for _, pair := range strings.Split(rawUsers, ",") {
+		keyID, userID, _ := strings.Cut(pair, ":")
+
+		keyID, userID = strings.TrimSpace(keyID), strings.TrimSpace(userID)
+		if keyID == "" || userID == "" {
+			return nil, fmt.Errorf("invalid key user pair: %q", pair)
+		}
+
+		users[keyID] = userID
+	}
+
+	return users, nil
+}
+
+// parseDisabledRouteGroups parses a "group[:read|:write],..." list into the disabled reads and writes
+func parseDisabledRouteGroups(rawGroups string) (map[router.RouteGroup]bool, map[router.RouteGroup]bool, error) {
+	reads, writes := make(map[router.RouteGroup]bool), make(map[router.RouteGroup]bool)
+
+	if rawGroups == "" {
+		return reads, writes, nil
+	}
+
+	known := make(map[router.RouteGroup]bool, len(router.RouteGroups))
+	for _, group := range router.RouteGroups {
+		known[group] = true
+	}
+
+	for _, entry := range strings.Split(rawGroups, ",") {
+		name, access, _ := strings.Cut(strings.TrimSpace(entry), ":")
+
+		group := router.RouteGroup(name)
+		if !known[group] {
+			return nil, nil, fmt.Errorf("invalid route group: %q", entry)
+		}
+
+		switch access {
+		case "":
+			reads[group], writes[group] = true, true
+		case "read":
+			reads[group] = true
+		case "write":
+			writes[group] = true
+		default:
+			return nil, nil, fmt.Errorf("invalid route group access: %q", entry)
+		}
+	}
+
+	return reads, writes, nil
+}
+
+func main() {
+	flag.Parse()
+
+	var err error
+
+	settings, err = config.Load(&cfg)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	cfg.CacheRefresh = settings.GetDuration("CACHE_REFRESH", 10*time.Minute)
+	cfg.ServerReadTimeout = settings.GetDuration("SERVER_READ_TIMEOUT", 30*time.Second)
+	cfg.ServerWriteTimeout = settings.GetDuration("SERVER_WRITE_TIMEOUT", time.Minute)
+
+	for _, setting := range settings.Settings() {
+		if setting.Invalid {
+			log.Warnf("invalid %s, using its default %s", setting.Name, setting.Default)
+		}
+	}
+
+	if *selfTestFlag || cfg.SelfTest {
+		os.Exit(runSelfTest())
+	}
+
+	var (
+		reader   cache.Reader
+		writer   router.Writer
+		driver   *postgres.Driver
+		follower *replica.Follower
+		changes  *replica.Feed
+	)
+
+	integrations := health.NewRegistry()
+
+	envelope, err := newSecretsEnvelope()
 	if err != nil {
 		panic(err)
 	}
 
-	router, err := router.NewRouter(driver, driver, apiKeys, log)
+	if envelope != nil {
+		envelope.Probe = integrations.Probe("kms")
+	}
+
+	if cfg.FollowPrimaryURL != "" {
+		follower = replica.NewFollower(cfg.FollowPrimaryURL, cfg.FollowPrimaryAPIKey, 30*time.Second, log)
+		reader = follower
+	} else {
+		driver, err = newDriver()
+		if err != nil {
+			panic(err)
+		}
+
+		reader, writer = driver, driver
+
+		if envelope != nil {
+			writer = service.NewSealingWriter(driver, envelope, driver.ReadApplication)
+		}
+
+		if cfg.ChangesFeed {
+			changes = replica.NewFeed(int(cfg.ChangesFeedSize), log)
+			reader = changes.Record(driver)
+		}
+	}
+
+	router, err := router.NewRouter(reader, writer, cfg.APIKeys, log)
 	if err != nil {
 		panic(err)
 	}
 
-	var wg sync.WaitGroup
+	if *openAPICheckFlag || cfg.OpenAPICheck {
+		err = router.CheckOpenAPI()
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	router.ValidateRequests = cfg.OpenAPIValidation
+	router.Changes = changes
+	router.ReadOnly = follower != nil
+	router.Secrets = envelope
+	router.SeparateAdmin = cfg.AdminAddress != ""
+
+	router.Config = settings
+	router.RefreshWait = time.Duration(cfg.CacheRefreshWait) * time.Second
+	router.HTTPCacheMaxAge = time.Duration(cfg.HTTPCacheMaxAge) * time.Second
+	router.CoalesceReads = cfg.CoalesceReads
+	router.CacheSerializedReads = cfg.CacheSerializedReads
 
-	wg.Add(1)
+	router.Environment = cfg.Environment
+	router.Region = cfg.Region
 
-	go httpHandler(router)
-	go cacheHandler(router)
+	router.InstanceID = cfg.InstanceID
+	if router.InstanceID == "" {
+		router.InstanceID = instance.NewID()
+	}
+
+	if cfg.InstanceHeartbeatInterval > 0 && driver != nil {
+		router.Instances = instance.NewRegistry(router.InstanceID, router.Cache, driver,
+			time.Duration(cfg.InstanceHeartbeatInterval)*time.Second)
+	}
 
-	wg.Wait()
+	if cfg.ReplayProtection {
+		router.Nonces = nonce.NewMemoryStore()
+		if driver != nil {
+			router.Nonces = driver
+		}
+
+		router.RequireNonce = cfg.RequireNonce
+		router.RequestSigningSecret = cfg.RequestSigningSecret
+		router.NonceWindow = time.Duration(cfg.NonceWindowSeconds) * time.Second
+	}
+
+	if writer != nil {
+		router.Backfills = service.NewBackfiller(router.Cache, writer, log)
+		router.Backfills.BatchSize = int(cfg.BackfillBatchSize)
+	}
+
+	if cfg.PageSnapshotTTLSeconds > 0 {
+		router.PageSnapshots = service.NewPageSnapshots(time.Duration(cfg.PageSnapshotTTLSeconds)*time.Second, int(cfg.PageSnapshotMax))
+	}
+
+	if cfg.LimitsDeltaVersions > 0 {
+		router.LimitsVersions = service.NewLimitsVersions(int(cfg.LimitsDeltaVersions))
+	}
+
+	if cfg.WriteAnomalyThreshold > 0 {
+		router.WriteAnomalies = anomaly.NewDetector(int(cfg.WriteAnomalyThreshold), time.Duration(cfg.WriteAnomalyWindowSeconds)*time.Second)
+		router.WriteAnomalies.RestrictedLimit = int(cfg.WriteAnomalyRestrictedLimit)
+		router.WriteAnomalies.RestrictFor = time.Duration(cfg.WriteAnomalyRestrictSeconds) * time.Second
+	}
+
+	if cfg.TracingEnabled {
+		router.Tracing = tracing.NewSampler(cfg.TraceSampleReads, cfg.TraceSampleWrites)
+
+		router.Tracing.Routes, err = parseTraceSampleRoutes(cfg.TraceSampleRoutes)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	router.UniqueLoadBalancerNames = cfg.UniqueLoadBalancerNames
+	router.UniqueApplicationNames = cfg.UniqueApplicationNames
+	router.ReadThroughApplications = cfg.ReadThroughApplications
+	router.GracePeriod = time.Duration(cfg.GracePeriodDays) * 24 * time.Hour
+
+	stickyOriginScope, ok := service.ParseStickyOriginScope(cfg.UniqueStickyOrigins)
+	if !ok {
+		panic(fmt.Errorf("invalid UNIQUE_STICKY_ORIGINS: %s", cfg.UniqueStickyOrigins))
+	}
+
+	router.UniqueStickyOrigins = stickyOriginScope
+
+	if cfg.WebhookURLs != "" {
+		router.Webhooks = webhook.NewDispatcher(strings.Split(cfg.WebhookURLs, ","), cfg.WebhookSecret, 10*time.Second, log)
+		router.Webhooks.Probe = integrations.Probe("webhooks")
+		router.Webhooks.MaxRetries = int(cfg.WebhookMaxRetries)
+		router.Webhooks.RetryBackoff = time.Duration(cfg.WebhookRetryBackoffMS) * time.Millisecond
+		integrations.SetErrorBudget("webhooks", int(cfg.IntegrationErrorBudget))
+
+		if cfg.WebhookDeadLetterFile != "" {
+			deadLetters, err := os.OpenFile(cfg.WebhookDeadLetterFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+			if err != nil {
+				panic(err)
+			}
+
+			router.Webhooks.DeadLetters = deadLetters
+		}
+	}
+
+	if cfg.RelayMeterPushURL != "" {
+		router.RelayMeter = relaymeter.NewPusher(cfg.RelayMeterPushURL, cfg.RelayMeterPushAPIKey, 10*time.Second, log)
+	}
+
+	router.Notifier = newNotifier()
+
+	if router.Notifier != nil {
+		router.Notifier.Probe = integrations.Probe("notifications")
+		integrations.SetErrorBudget("notifications", int(cfg.IntegrationErrorBudget))
+	}
+
+	var observeLookup func(index string, duration time.Duration)
+
+	if cfg.MetricsEnabled {
+		router.Metrics = metrics.NewRegistry(metrics.DefaultBuckets, int(cfg.MetricsMaxSeries))
+		router.Metrics.SetEntityCounter(router.EntityCounts)
+		router.Metrics.SetCacheStatus(router.CacheStatus)
+		router.Metrics.SetIntegrationStatuses(router.IntegrationStatuses)
+		observeLookup = router.Metrics.ObserveLookup
+	}
+
+	router.Cache.SetLookupObserver(observeLookup, time.Duration(cfg.CacheSlowLookupMS)*time.Millisecond)
+	router.Cache.SetReadThroughMissTTL(time.Duration(cfg.ReadThroughMissTTL) * time.Second)
+
+	router.Snapshots, err = newSnapshotStore()
+	if err != nil {
+		panic(err)
+	}
+
+	router.DisabledReads, router.DisabledWrites, err = parseDisabledRouteGroups(cfg.DisabledRouteGroups)
+	if err != nil {
+		panic(err)
+	}
+
+	router.ResponseProfiles, err = parseResponseProfiles(cfg.ResponseProfiles)
+	if err != nil {
+		panic(err)
+	}
+
+	router.RedactedKeys = parseKeyIDs(cfg.RedactedKeys)
+	router.EnvelopedKeys = parseKeyIDs(cfg.EnvelopedKeys)
+
+	router.KeyScopes, err = parseKeyScopes(cfg.KeyScopes)
+	if err != nil {
+		panic(err)
+	}
+
+	router.KeyUsers, err = parseKeyUsers(cfg.KeyUsers)
+	if err != nil {
+		panic(err)
+	}
+
+	router.MaxListSize = int(cfg.MaxListSize)
+
+	router.BillingWebhookSecret = cfg.BillingWebhookSecret
+	router.BillingTolerance = cfg.BillingTolerance
+
+	router.BillingPlanCodes, err = parsePlanCodes(cfg.BillingPlanCodes)
+	if err != nil {
+		panic(err)
+	}
+
+	router.PlanDeprecations, err = parsePlanDeprecations(cfg.PlanDeprecations)
+	if err != nil {
+		panic(err)
+	}
+
+	router.DeprecatedRoutes, err = parseDeprecatedRoutes(cfg.DeprecatedRoutes)
+	if err != nil {
+		panic(err)
+	}
+
+	router.Health = integrations
+
+	router.AccessLog, err = newAccessLog(integrations)
+	if err != nil {
+		panic(err)
+	}
+
+	if cfg.UsageRecords > 0 {
+		router.Usage = accesslog.NewRing(int(cfg.UsageRecords))
+	}
+
+	subsystems := lifecycle.NewManager(log)
+	router.Subsystems = subsystems
+
+	if router.AccessLog != nil {
+		subsystems.Add(lifecycle.Subsystem{
+			Name: "access_log",
+			Run: func(ctx context.Context) error {
+				<-ctx.Done()
+				return nil
+			},
+			// stopped after the HTTP server so the entries of the requests drained are flushed
+			Stop: func(ctx context.Context) error {
+				return router.AccessLog.Close()
+			},
+		})
+	}
+
+	subsystems.Add(lifecycle.Subsystem{
+		Name: "cache_refresher",
+		Run: func(ctx context.Context) error {
+			return cacheHandler(ctx, router)
+		},
+	})
+
+	if router.Instances != nil {
+		subsystems.Add(lifecycle.Subsystem{
+			Name: "instance_heartbeat",
+			Run: func(ctx context.Context) error {
+				return instanceHandler(ctx, router.Instances)
+			},
+		})
+	}
+
+	if follower != nil {
+		subsystems.Add(lifecycle.Subsystem{
+			Name: "follower",
+			Run: func(ctx context.Context) error {
+				follower.Follow(ctx, router.Cache, time.Duration(cfg.FollowPollInterval)*time.Second)
+				return nil
+			},
+		})
+	}
+
+	if cfg.RetentionInterval > 0 && driver != nil {
+		retentionApplicationsDays := cfg.RetentionApplicationsDays
+		if retentionApplicationsDays == 0 {
+			retentionApplicationsDays = cfg.GracePeriodDays
+		}
+
+		job := retention.NewJob(router.Cache, driver, retention.Policy{
+			Applications:  time.Duration(retentionApplicationsDays) * 24 * time.Hour,
+			LoadBalancers: time.Duration(cfg.RetentionLoadBalancersDays) * 24 * time.Hour,
+		}, cfg.RetentionDryRun, log)
+
+		subsystems.Add(lifecycle.Subsystem{
+			Name: "retention",
+			Run: func(ctx context.Context) error {
+				return retentionHandler(ctx, job)
+			},
+		})
+	}
+
+	if cfg.GRPCPort > 0 {
+		if cfg.GRPCTLSCertFile == "" || cfg.GRPCTLSKeyFile == "" {
+			panic(errMissingGRPCCertificate)
+		}
+
+		subsystems.Add(lifecycle.Subsystem{
+			Name: "grpc_server",
+			Run: func(ctx context.Context) error {
+				return grpcHandler(ctx, router)
+			},
+			Critical: true,
+		})
+	}
+
+	if cfg.PublicMirrorPort != "" {
+		subsystems.Add(lifecycle.Subsystem{
+			Name: "public_mirror",
+			Run: func(ctx context.Context) error {
+				return publicMirrorHandler(ctx, router)
+			},
+			Critical: true,
+		})
+	}
+
+	if cfg.AdminAddress != "" {
+		subsystems.Add(lifecycle.Subsystem{
+			Name: "admin_server",
+			Run: func(ctx context.Context) error {
+				return adminHandler(ctx, router)
+			},
+			Critical: true,
+		})
+	}
+
+	// added last so it is the first stopped, no request reaches the subsystems stopped after it
+	subsystems.Add(lifecycle.Subsystem{
+		Name: "http_server",
+		Run: func(ctx context.Context) error {
+			return httpHandler(ctx, router)
+		},
+		Critical: true,
+	})
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	subsystems.Start(context.Background())
+
+	select {
+	case <-ctx.Done():
+		log.Info("Shutting down")
+	case err := <-subsystems.Failures():
+		logError("Critical subsystem failed, shutting down", err)
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), time.Duration(cfg.ShutdownTimeout)*time.Second)
+	defer cancel()
+
+	err = subsystems.Shutdown(shutdownCtx)
+	if err != nil {
+		logError("Shutdown failed", err)
+	}
 }
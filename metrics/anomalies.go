@@ -0,0 +1,83 @@
+package metrics
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	writeAnomaliesName    = "pocket_http_db_write_anomalies_total"
+	rateLimitWarningsName = "pocket_http_db_rate_limit_warnings_total"
+)
+
+// anomalyLabels are the labels of a write anomalies series
+type anomalyLabels struct {
+	key   string
+	route string
+}
+
+// ObserveWriteAnomaly records a write burst flagged for the API key with given ID on route
+func (r *Registry) ObserveWriteAnomaly(keyID, route string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.anomalies[anomalyLabels{key: keyID, route: route}]++
+}
+
+// ObserveRateLimitWarning records a restricted API key with given ID getting close to its write limit in a window
+func (r *Registry) ObserveRateLimitWarning(keyID string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.rateLimitWarnings[keyID]++
+}
+
+// writeAnomalies writes the write anomalies to b, nothing if none was observed
+func (r *Registry) writeAnomalies(b *strings.Builder) {
+	if len(r.anomalies) == 0 {
+		return
+	}
+
+	labelsList := make([]anomalyLabels, 0, len(r.anomalies))
+	for labels := range r.anomalies {
+		labelsList = append(labelsList, labels)
+	}
+
+	sort.Slice(labelsList, func(i, j int) bool {
+		a, b := labelsList[i], labelsList[j]
+		if a.key != b.key {
+			return a.key < b.key
+		}
+
+		return a.route < b.route
+	})
+
+	fmt.Fprintf(b, "# HELP %s Write bursts flagged, by API key ID and route template.\n", writeAnomaliesName)
+	fmt.Fprintf(b, "# TYPE %s counter\n", writeAnomaliesName)
+
+	for _, labels := range labelsList {
+		fmt.Fprintf(b, "%s{key=%s,route=%s} %d\n", writeAnomaliesName, quote(labels.key), quote(labels.route), r.anomalies[labels])
+	}
+}
+
+// writeRateLimitWarnings writes the rate limit warnings to b, nothing if none was observed
+func (r *Registry) writeRateLimitWarnings(b *strings.Builder) {
+	if len(r.rateLimitWarnings) == 0 {
+		return
+	}
+
+	keyIDs := make([]string, 0, len(r.rateLimitWarnings))
+	for keyID := range r.rateLimitWarnings {
+		keyIDs = append(keyIDs, keyID)
+	}
+
+	sort.Strings(keyIDs)
+
+	fmt.Fprintf(b, "# HELP %s Windows where a restricted API key got close to its write limit, by API key ID.\n", rateLimitWarningsName)
+	fmt.Fprintf(b, "# TYPE %s counter\n", rateLimitWarningsName)
+
+	for _, keyID := range keyIDs {
+		fmt.Fprintf(b, "%s{key=%s} %d\n", rateLimitWarningsName, quote(keyID), r.rateLimitWarnings[keyID])
+	}
+}
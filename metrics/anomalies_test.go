@@ -0,0 +1,52 @@
+package metrics
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistry_WriteAnomalies(t *testing.T) {
+	c := require.New(t)
+
+	registry := NewRegistry(DefaultBuckets, 0)
+
+	var b strings.Builder
+	c.NoError(registry.Write(&b))
+	c.NotContains(b.String(), "pocket_http_db_write_anomalies_total")
+
+	registry.ObserveWriteAnomaly("key2", "/application")
+	registry.ObserveWriteAnomaly("key1", "/application")
+	registry.ObserveWriteAnomaly("key1", "/application")
+
+	b.Reset()
+	c.NoError(registry.Write(&b))
+
+	c.Contains(b.String(), `# TYPE pocket_http_db_write_anomalies_total counter
+pocket_http_db_write_anomalies_total{key="key1",route="/application"} 2
+pocket_http_db_write_anomalies_total{key="key2",route="/application"} 1
+`)
+}
+
+func TestRegistry_RateLimitWarnings(t *testing.T) {
+	c := require.New(t)
+
+	registry := NewRegistry(DefaultBuckets, 0)
+
+	var b strings.Builder
+	c.NoError(registry.Write(&b))
+	c.NotContains(b.String(), "pocket_http_db_rate_limit_warnings_total")
+
+	registry.ObserveRateLimitWarning("key2")
+	registry.ObserveRateLimitWarning("key1")
+	registry.ObserveRateLimitWarning("key2")
+
+	b.Reset()
+	c.NoError(registry.Write(&b))
+
+	c.Contains(b.String(), `# TYPE pocket_http_db_rate_limit_warnings_total counter
+pocket_http_db_rate_limit_warnings_total{key="key1"} 1
+pocket_http_db_rate_limit_warnings_total{key="key2"} 2
+`)
+}
@@ -0,0 +1,46 @@
+package metrics
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	cacheLastRefreshName   = "pocket_http_db_cache_last_refresh_timestamp_seconds"
+	cacheRefreshErrorsName = "pocket_http_db_cache_refresh_errors_total"
+)
+
+// CacheStatus is the refresh status of the cache
+type CacheStatus struct {
+	// RefreshedAt is when the cache was last fully loaded, zero if it never was
+	RefreshedAt   time.Time
+	RefreshErrors uint64
+}
+
+// SetCacheStatus sets the function returning the current refresh status of the cache, exported as gauges
+func (r *Registry) SetCacheStatus(status func() CacheStatus) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.cacheStatus = status
+}
+
+// writeCache writes the cache refresh metrics to b, nothing if there is no cache status
+func (r *Registry) writeCache(b *strings.Builder) {
+	if r.cacheStatus == nil {
+		return
+	}
+
+	status := r.cacheStatus()
+
+	if !status.RefreshedAt.IsZero() {
+		fmt.Fprintf(b, "# HELP %s Unix time the cache was last fully loaded.\n", cacheLastRefreshName)
+		fmt.Fprintf(b, "# TYPE %s gauge\n", cacheLastRefreshName)
+		fmt.Fprintf(b, "%s %d\n", cacheLastRefreshName, status.RefreshedAt.Unix())
+	}
+
+	fmt.Fprintf(b, "# HELP %s Failed cache reloads.\n", cacheRefreshErrorsName)
+	fmt.Fprintf(b, "# TYPE %s counter\n", cacheRefreshErrorsName)
+	fmt.Fprintf(b, "%s %d\n", cacheRefreshErrorsName, status.RefreshErrors)
+}
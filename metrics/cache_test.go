@@ -0,0 +1,36 @@
+package metrics
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistry_CacheStatus(t *testing.T) {
+	c := require.New(t)
+
+	registry := NewRegistry(DefaultBuckets, 0)
+
+	var b strings.Builder
+	c.NoError(registry.Write(&b))
+	c.NotContains(b.String(), "pocket_http_db_cache_")
+
+	status := CacheStatus{RefreshErrors: 2}
+
+	registry.SetCacheStatus(func() CacheStatus { return status })
+
+	b.Reset()
+	c.NoError(registry.Write(&b))
+	c.NotContains(b.String(), "pocket_http_db_cache_last_refresh_timestamp_seconds")
+	c.Contains(b.String(), "pocket_http_db_cache_refresh_errors_total 2\n")
+
+	status.RefreshedAt = time.Date(2022, time.June, 1, 10, 0, 0, 0, time.UTC)
+
+	b.Reset()
+	c.NoError(registry.Write(&b))
+	c.Contains(b.String(), `# TYPE pocket_http_db_cache_last_refresh_timestamp_seconds gauge
+pocket_http_db_cache_last_refresh_timestamp_seconds 1654077600
+`)
+}
@@ -0,0 +1,157 @@
+package metrics
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	entityChangesName         = "pocket_http_db_entity_changes_total"
+	entityChangesLastHourName = "pocket_http_db_entity_changes_last_hour"
+	entitiesName              = "pocket_http_db_entities"
+
+	// churnWindowMinutes is the window of the last hour changes, kept in one minute slots
+	churnWindowMinutes = 60
+)
+
+// Entities whose changes are observed
+const (
+	EntityApplication  = "application"
+	EntityLoadBalancer = "load_balancer"
+	EntityBlockchain   = "blockchain"
+)
+
+// Operation is a kind of entity change
+type Operation string
+
+const (
+	OperationCreated Operation = "created"
+	OperationUpdated Operation = "updated"
+	OperationRemoved Operation = "removed"
+)
+
+// changeLabels are the labels of an entity changes series
+type changeLabels struct {
+	entity    string
+	operation Operation
+}
+
+// churn holds the changes of an entity changes series
+// slots hold the changes of the minute in the same position of minutes, so stale slots are told apart
+type churn struct {
+	total   uint64
+	slots   [churnWindowMinutes]uint64
+	minutes [churnWindowMinutes]int64
+}
+
+// add records count changes made at minute
+func (c *churn) add(minute int64, count uint64) {
+	i := minute % churnWindowMinutes
+
+	if c.minutes[i] != minute {
+		c.minutes[i] = minute
+		c.slots[i] = 0
+	}
+
+	c.slots[i] += count
+	c.total += count
+}
+
+// lastHour returns the changes made in the hour before minute, included
+func (c *churn) lastHour(minute int64) uint64 {
+	var changes uint64
+
+	for i, slotMinute := range c.minutes {
+		if slotMinute > minute-churnWindowMinutes && slotMinute <= minute {
+			changes += c.slots[i]
+		}
+	}
+
+	return changes
+}
+
+// ObserveEntityChange records count changes of entity, such as the applications moved to a status in a batch
+func (r *Registry) ObserveEntityChange(entity string, operation Operation, count int) {
+	if count <= 0 {
+		return
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	labels := changeLabels{entity: entity, operation: operation}
+
+	series, ok := r.changes[labels]
+	if !ok {
+		series = &churn{}
+		r.changes[labels] = series
+	}
+
+	series.add(r.now().Unix()/60, uint64(count))
+}
+
+// SetEntityCounter sets the function returning the current number of entities by entity, exported as gauges
+func (r *Registry) SetEntityCounter(counter func() map[string]int) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.entityCounter = counter
+}
+
+// writeEntities writes the entity metrics to b, nothing if no change was observed and there is no entity counter
+func (r *Registry) writeEntities(b *strings.Builder) {
+	if len(r.changes) > 0 {
+		labelsList := make([]changeLabels, 0, len(r.changes))
+		for labels := range r.changes {
+			labelsList = append(labelsList, labels)
+		}
+
+		sort.Slice(labelsList, func(i, j int) bool {
+			a, b := labelsList[i], labelsList[j]
+			if a.entity != b.entity {
+				return a.entity < b.entity
+			}
+
+			return a.operation < b.operation
+		})
+
+		fmt.Fprintf(b, "# HELP %s Entities changed, by entity and operation.\n", entityChangesName)
+		fmt.Fprintf(b, "# TYPE %s counter\n", entityChangesName)
+
+		for _, labels := range labelsList {
+			fmt.Fprintf(b, "%s{entity=%s,operation=%s} %d\n",
+				entityChangesName, quote(labels.entity), quote(string(labels.operation)), r.changes[labels].total)
+		}
+
+		minute := r.now().Unix() / 60
+
+		fmt.Fprintf(b, "# HELP %s Entities changed in the last hour, by entity and operation.\n", entityChangesLastHourName)
+		fmt.Fprintf(b, "# TYPE %s gauge\n", entityChangesLastHourName)
+
+		for _, labels := range labelsList {
+			fmt.Fprintf(b, "%s{entity=%s,operation=%s} %d\n",
+				entityChangesLastHourName, quote(labels.entity), quote(string(labels.operation)), r.changes[labels].lastHour(minute))
+		}
+	}
+
+	if r.entityCounter == nil {
+		return
+	}
+
+	counts := r.entityCounter()
+
+	entities := make([]string, 0, len(counts))
+	for entity := range counts {
+		entities = append(entities, entity)
+	}
+
+	sort.Strings(entities)
+
+	fmt.Fprintf(b, "# HELP %s Entities held, by entity.\n", entitiesName)
+	fmt.Fprintf(b, "# TYPE %s gauge\n", entitiesName)
+
+	for _, entity := range entities {
+		fmt.Fprintf(b, "%s{entity=%s} %d\n", entitiesName, quote(entity), counts[entity])
+	}
+}
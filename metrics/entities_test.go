@@ -0,0 +1,59 @@
+package metrics
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistry_EntityChanges(t *testing.T) {
+	c := require.New(t)
+
+	registry := NewRegistry(DefaultBuckets, 0)
+
+	now := time.Date(2022, time.June, 1, 10, 0, 0, 0, time.UTC)
+	registry.now = func() time.Time { return now }
+
+	registry.ObserveEntityChange(EntityApplication, OperationCreated, 3)
+	registry.ObserveEntityChange(EntityApplication, OperationUpdated, 0)
+
+	now = now.Add(30 * time.Minute)
+
+	registry.ObserveEntityChange(EntityApplication, OperationCreated, 2)
+	registry.ObserveEntityChange(EntityLoadBalancer, OperationRemoved, 1)
+
+	registry.SetEntityCounter(func() map[string]int {
+		return map[string]int{EntityLoadBalancer: 4, EntityApplication: 10}
+	})
+
+	var b strings.Builder
+	c.NoError(registry.Write(&b))
+
+	c.Contains(b.String(), `# TYPE pocket_http_db_entity_changes_total counter
+pocket_http_db_entity_changes_total{entity="application",operation="created"} 5
+pocket_http_db_entity_changes_total{entity="load_balancer",operation="removed"} 1
+# HELP pocket_http_db_entity_changes_last_hour Entities changed in the last hour, by entity and operation.
+# TYPE pocket_http_db_entity_changes_last_hour gauge
+pocket_http_db_entity_changes_last_hour{entity="application",operation="created"} 5
+pocket_http_db_entity_changes_last_hour{entity="load_balancer",operation="removed"} 1
+# HELP pocket_http_db_entities Entities held, by entity.
+# TYPE pocket_http_db_entities gauge
+pocket_http_db_entities{entity="application"} 10
+pocket_http_db_entities{entity="load_balancer"} 4
+`)
+	c.NotContains(b.String(), `operation="updated"`)
+
+	// the changes of the first observation are now older than an hour
+	now = now.Add(31 * time.Minute)
+
+	registry.ObserveEntityChange(EntityApplication, OperationCreated, 1)
+
+	b.Reset()
+	c.NoError(registry.Write(&b))
+
+	c.Contains(b.String(), `pocket_http_db_entity_changes_total{entity="application",operation="created"} 6`)
+	c.Contains(b.String(), `pocket_http_db_entity_changes_last_hour{entity="application",operation="created"} 3`)
+	c.Contains(b.String(), `pocket_http_db_entity_changes_last_hour{entity="load_balancer",operation="removed"} 1`)
+}
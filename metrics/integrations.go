@@ -0,0 +1,58 @@
+package metrics
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	integrationDisabledName = "pocket_http_db_integration_disabled"
+	integrationFailuresName = "pocket_http_db_integration_consecutive_failures"
+)
+
+// IntegrationStatus is the status of an optional external integration, such as webhooks
+type IntegrationStatus struct {
+	Name                string
+	Disabled            bool
+	ConsecutiveFailures int
+}
+
+// SetIntegrationStatuses sets the function returning the current status of the integrations, exported as gauges
+func (r *Registry) SetIntegrationStatuses(statuses func() []IntegrationStatus) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.integrationStatuses = statuses
+}
+
+// writeIntegrations writes the integration metrics to b, nothing if there are no integration statuses
+func (r *Registry) writeIntegrations(b *strings.Builder) {
+	if r.integrationStatuses == nil {
+		return
+	}
+
+	statuses := r.integrationStatuses()
+	if len(statuses) == 0 {
+		return
+	}
+
+	fmt.Fprintf(b, "# HELP %s Whether the integration is disabled after exhausting its error budget.\n",
+		integrationDisabledName)
+	fmt.Fprintf(b, "# TYPE %s gauge\n", integrationDisabledName)
+
+	for _, status := range statuses {
+		disabled := 0
+		if status.Disabled {
+			disabled = 1
+		}
+
+		fmt.Fprintf(b, "%s{integration=%s} %d\n", integrationDisabledName, quote(status.Name), disabled)
+	}
+
+	fmt.Fprintf(b, "# HELP %s Calls to the integration failed since its last success.\n", integrationFailuresName)
+	fmt.Fprintf(b, "# TYPE %s gauge\n", integrationFailuresName)
+
+	for _, status := range statuses {
+		fmt.Fprintf(b, "%s{integration=%s} %d\n", integrationFailuresName, quote(status.Name), status.ConsecutiveFailures)
+	}
+}
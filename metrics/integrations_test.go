@@ -0,0 +1,33 @@
+package metrics
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistry_IntegrationStatuses(t *testing.T) {
+	c := require.New(t)
+
+	registry := NewRegistry(DefaultBuckets, 0)
+
+	var b strings.Builder
+	c.NoError(registry.Write(&b))
+	c.NotContains(b.String(), "pocket_http_db_integration_")
+
+	registry.SetIntegrationStatuses(func() []IntegrationStatus {
+		return []IntegrationStatus{
+			{Name: "kafka"},
+			{Name: "webhooks", Disabled: true, ConsecutiveFailures: 5},
+		}
+	})
+
+	b.Reset()
+	c.NoError(registry.Write(&b))
+	c.Contains(b.String(), `# TYPE pocket_http_db_integration_disabled gauge
+pocket_http_db_integration_disabled{integration="kafka"} 0
+pocket_http_db_integration_disabled{integration="webhooks"} 1
+`)
+	c.Contains(b.String(), `pocket_http_db_integration_consecutive_failures{integration="webhooks"} 5`)
+}
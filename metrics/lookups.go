@@ -0,0 +1,68 @@
+package metrics
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const lookupDurationName = "pocket_http_db_cache_lookup_duration_seconds"
+
+// LookupBuckets are the cache lookup duration buckets in seconds, lookups take microseconds unless contended
+var LookupBuckets = []float64{.00001, .00005, .0001, .0005, .001, .005, .01, .05, .1}
+
+// ObserveLookup records an indexed cache lookup, index being such as application_id
+func (r *Registry) ObserveLookup(index string, duration time.Duration) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	hist, ok := r.lookups[index]
+	if !ok {
+		hist = &histogram{counts: make([]uint64, len(LookupBuckets))}
+		r.lookups[index] = hist
+	}
+
+	seconds := duration.Seconds()
+
+	for i, bucket := range LookupBuckets {
+		if seconds <= bucket {
+			hist.counts[i]++
+		}
+	}
+
+	hist.sum += seconds
+	hist.count++
+}
+
+// writeLookups writes the cache lookup durations to b, nothing if none was observed
+func (r *Registry) writeLookups(b *strings.Builder) {
+	if len(r.lookups) == 0 {
+		return
+	}
+
+	indexes := make([]string, 0, len(r.lookups))
+	for index := range r.lookups {
+		indexes = append(indexes, index)
+	}
+
+	sort.Strings(indexes)
+
+	fmt.Fprintf(b, "# HELP %s Cache lookup duration, lock wait included, by index.\n", lookupDurationName)
+	fmt.Fprintf(b, "# TYPE %s histogram\n", lookupDurationName)
+
+	for _, index := range indexes {
+		hist := r.lookups[index]
+		seriesLabels := fmt.Sprintf("index=%s", quote(index))
+
+		for i, bucket := range LookupBuckets {
+			fmt.Fprintf(b, "%s_bucket{%s,le=\"%s\"} %d\n",
+				lookupDurationName, seriesLabels, strconv.FormatFloat(bucket, 'g', -1, 64), hist.counts[i])
+		}
+
+		fmt.Fprintf(b, "%s_bucket{%s,le=\"+Inf\"} %d\n", lookupDurationName, seriesLabels, hist.count)
+		fmt.Fprintf(b, "%s_sum{%s} %s\n", lookupDurationName, seriesLabels, strconv.FormatFloat(hist.sum, 'g', -1, 64))
+		fmt.Fprintf(b, "%s_count{%s} %d\n", lookupDurationName, seriesLabels, hist.count)
+	}
+}
@@ -0,0 +1,34 @@
+package metrics
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistry_WriteLookups(t *testing.T) {
+	c := require.New(t)
+
+	registry := NewRegistry(DefaultBuckets, 0)
+
+	var b strings.Builder
+	c.NoError(registry.Write(&b))
+	c.NotContains(b.String(), "pocket_http_db_cache_lookup_duration_seconds")
+
+	registry.ObserveLookup("application_id", 20*time.Microsecond)
+	registry.ObserveLookup("application_id", 2*time.Millisecond)
+
+	b.Reset()
+	c.NoError(registry.Write(&b))
+
+	c.Contains(b.String(), `# TYPE pocket_http_db_cache_lookup_duration_seconds histogram
+pocket_http_db_cache_lookup_duration_seconds_bucket{index="application_id",le="1e-05"} 0
+pocket_http_db_cache_lookup_duration_seconds_bucket{index="application_id",le="5e-05"} 1
+`)
+	c.Contains(b.String(), `pocket_http_db_cache_lookup_duration_seconds_bucket{index="application_id",le="0.005"} 2
+`)
+	c.Contains(b.String(), `pocket_http_db_cache_lookup_duration_seconds_count{index="application_id"} 2
+`)
+}
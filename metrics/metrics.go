@@ -0,0 +1,222 @@
+// Package metrics keeps request metrics and exposes them in the Prometheus text format
+package metrics
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	requestsName = "pocket_http_db_requests_total"
+	durationName = "pocket_http_db_request_duration_seconds"
+
+	// UnmatchedRoute is the route label of requests not matching any route template
+	UnmatchedRoute = "unmatched"
+	// OverflowRoute is the route label of requests observed once the series limit is reached
+	OverflowRoute = "overflow"
+
+	defaultMaxSeries = 1000
+)
+
+// DefaultBuckets are the request duration buckets in seconds, the same as the Prometheus client defaults
+var DefaultBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+// requestLabels are the labels of a request series
+type requestLabels struct {
+	method string
+	route  string
+	status int
+}
+
+// durationLabels are the labels of a request duration series
+type durationLabels struct {
+	method string
+	route  string
+}
+
+// histogram holds the observations of a duration series
+type histogram struct {
+	counts []uint64
+	sum    float64
+	count  uint64
+}
+
+// Registry holds the request metrics, labeled by method, route template and status
+// raw paths must never be used as route, every ID would become a new series
+type Registry struct {
+	buckets   []float64
+	maxSeries int
+	requests  map[requestLabels]uint64
+	durations map[durationLabels]*histogram
+	// changes holds the entity changes, entityCounter returns the current number of entities
+	changes       map[changeLabels]*churn
+	entityCounter func() map[string]int
+	// cacheStatus returns the current refresh status of the cache
+	cacheStatus func() CacheStatus
+	// integrationStatuses returns the current status of the optional integrations
+	integrationStatuses func() []IntegrationStatus
+	// anomalies holds the write bursts flagged
+	anomalies map[anomalyLabels]uint64
+	// rateLimitWarnings holds the writes of restricted keys close to their limit, by API key ID
+	rateLimitWarnings map[string]uint64
+	// lookups holds the cache lookup durations, by index
+	lookups map[string]*histogram
+	now     func() time.Time
+	mutex   sync.Mutex
+}
+
+// NewRegistry returns Registry instance with given duration buckets
+// maxSeries <= 0 uses the default limit of request series
+func NewRegistry(buckets []float64, maxSeries int) *Registry {
+	if maxSeries <= 0 {
+		maxSeries = defaultMaxSeries
+	}
+
+	sortedBuckets := append([]float64{}, buckets...)
+	sort.Float64s(sortedBuckets)
+
+	return &Registry{
+		buckets:   sortedBuckets,
+		maxSeries: maxSeries,
+		requests:  map[requestLabels]uint64{},
+		durations: map[durationLabels]*histogram{},
+		changes:   map[changeLabels]*churn{},
+		anomalies: map[anomalyLabels]uint64{},
+		lookups:   map[string]*histogram{},
+		now:       time.Now,
+
+		rateLimitWarnings: map[string]uint64{},
+	}
+}
+
+// ObserveRequest records a served request, route must be the route template such as /application/{id}
+func (r *Registry) ObserveRequest(method, route string, status int, duration time.Duration) {
+	if route == "" {
+		route = UnmatchedRoute
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	labels := requestLabels{method: method, route: route, status: status}
+
+	if _, ok := r.requests[labels]; !ok && len(r.requests) >= r.maxSeries {
+		labels.route = OverflowRoute
+	}
+
+	r.requests[labels]++
+
+	durationKey := durationLabels{method: labels.method, route: labels.route}
+
+	hist, ok := r.durations[durationKey]
+	if !ok {
+		hist = &histogram{counts: make([]uint64, len(r.buckets))}
+		r.durations[durationKey] = hist
+	}
+
+	seconds := duration.Seconds()
+
+	for i, bucket := range r.buckets {
+		if seconds <= bucket {
+			hist.counts[i]++
+		}
+	}
+
+	hist.sum += seconds
+	hist.count++
+}
+
+// ServeHTTP writes the metrics in the Prometheus text format
+func (r *Registry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+
+	_ = r.Write(w)
+}
+
+// Write writes the metrics in the Prometheus text format to w, series sorted by labels
+func (r *Registry) Write(w io.Writer) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "# HELP %s Requests served, by method, route template and status.\n", requestsName)
+	fmt.Fprintf(&b, "# TYPE %s counter\n", requestsName)
+
+	requestKeys := make([]requestLabels, 0, len(r.requests))
+	for labels := range r.requests {
+		requestKeys = append(requestKeys, labels)
+	}
+
+	sort.Slice(requestKeys, func(i, j int) bool {
+		a, b := requestKeys[i], requestKeys[j]
+		if a.route != b.route {
+			return a.route < b.route
+		}
+		if a.method != b.method {
+			return a.method < b.method
+		}
+
+		return a.status < b.status
+	})
+
+	for _, labels := range requestKeys {
+		fmt.Fprintf(&b, "%s{method=%s,route=%s,status=\"%d\"} %d\n",
+			requestsName, quote(labels.method), quote(labels.route), labels.status, r.requests[labels])
+	}
+
+	fmt.Fprintf(&b, "# HELP %s Request duration, by method and route template.\n", durationName)
+	fmt.Fprintf(&b, "# TYPE %s histogram\n", durationName)
+
+	durationKeys := make([]durationLabels, 0, len(r.durations))
+	for labels := range r.durations {
+		durationKeys = append(durationKeys, labels)
+	}
+
+	sort.Slice(durationKeys, func(i, j int) bool {
+		a, b := durationKeys[i], durationKeys[j]
+		if a.route != b.route {
+			return a.route < b.route
+		}
+
+		return a.method < b.method
+	})
+
+	for _, labels := range durationKeys {
+		hist := r.durations[labels]
+		seriesLabels := fmt.Sprintf("method=%s,route=%s", quote(labels.method), quote(labels.route))
+
+		for i, bucket := range r.buckets {
+			fmt.Fprintf(&b, "%s_bucket{%s,le=\"%s\"} %d\n",
+				durationName, seriesLabels, strconv.FormatFloat(bucket, 'g', -1, 64), hist.counts[i])
+		}
+
+		fmt.Fprintf(&b, "%s_bucket{%s,le=\"+Inf\"} %d\n", durationName, seriesLabels, hist.count)
+		fmt.Fprintf(&b, "%s_sum{%s} %s\n", durationName, seriesLabels, strconv.FormatFloat(hist.sum, 'g', -1, 64))
+		fmt.Fprintf(&b, "%s_count{%s} %d\n", durationName, seriesLabels, hist.count)
+	}
+
+	r.writeEntities(&b)
+	r.writeCache(&b)
+	r.writeIntegrations(&b)
+	r.writeAnomalies(&b)
+	r.writeRateLimitWarnings(&b)
+	r.writeLookups(&b)
+
+	_, err := io.WriteString(w, b.String())
+
+	return err
+}
+
+// quote returns value as an escaped label value
+func quote(value string) string {
+	value = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(value)
+
+	return `"` + value + `"`
+}
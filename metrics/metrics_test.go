@@ -0,0 +1,64 @@
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistry_Write(t *testing.T) {
+	c := require.New(t)
+
+	registry := NewRegistry([]float64{0.1, 0.01}, 0)
+
+	registry.ObserveRequest(http.MethodGet, "/application/{id}", http.StatusOK, 5*time.Millisecond)
+	registry.ObserveRequest(http.MethodGet, "/application/{id}", http.StatusOK, 50*time.Millisecond)
+	registry.ObserveRequest(http.MethodPost, "", http.StatusNotFound, time.Millisecond)
+
+	rr := httptest.NewRecorder()
+	registry.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	c.Equal(`# HELP pocket_http_db_requests_total Requests served, by method, route template and status.
+# TYPE pocket_http_db_requests_total counter
+pocket_http_db_requests_total{method="GET",route="/application/{id}",status="200"} 2
+pocket_http_db_requests_total{method="POST",route="unmatched",status="404"} 1
+# HELP pocket_http_db_request_duration_seconds Request duration, by method and route template.
+# TYPE pocket_http_db_request_duration_seconds histogram
+pocket_http_db_request_duration_seconds_bucket{method="GET",route="/application/{id}",le="0.01"} 1
+pocket_http_db_request_duration_seconds_bucket{method="GET",route="/application/{id}",le="0.1"} 2
+pocket_http_db_request_duration_seconds_bucket{method="GET",route="/application/{id}",le="+Inf"} 2
+pocket_http_db_request_duration_seconds_sum{method="GET",route="/application/{id}"} 0.055
+pocket_http_db_request_duration_seconds_count{method="GET",route="/application/{id}"} 2
+pocket_http_db_request_duration_seconds_bucket{method="POST",route="unmatched",le="0.01"} 1
+pocket_http_db_request_duration_seconds_bucket{method="POST",route="unmatched",le="0.1"} 1
+pocket_http_db_request_duration_seconds_bucket{method="POST",route="unmatched",le="+Inf"} 1
+pocket_http_db_request_duration_seconds_sum{method="POST",route="unmatched"} 0.001
+pocket_http_db_request_duration_seconds_count{method="POST",route="unmatched"} 1
+`, rr.Body.String())
+}
+
+func TestRegistry_MaxSeries(t *testing.T) {
+	c := require.New(t)
+
+	registry := NewRegistry(DefaultBuckets, 2)
+
+	for _, route := range []string{"/a", "/b", "/c", "/d", "/a"} {
+		registry.ObserveRequest(http.MethodGet, route, http.StatusOK, time.Millisecond)
+	}
+
+	c.Len(registry.requests, 3)
+	c.Equal(uint64(2), registry.requests[requestLabels{method: http.MethodGet, route: "/a", status: http.StatusOK}])
+	c.Equal(uint64(2), registry.requests[requestLabels{method: http.MethodGet, route: OverflowRoute, status: http.StatusOK}])
+	c.Len(registry.durations, 3)
+}
+
+func TestQuote(t *testing.T) {
+	c := require.New(t)
+
+	c.Equal(`"a\"b\\c\nd"`, quote("a\"b\\c\nd"))
+	c.False(strings.ContainsRune(quote("a\nb"), '\n'))
+}
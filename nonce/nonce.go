@@ -0,0 +1,101 @@
+// Package nonce keeps the nonces of write requests until they expire, so a replayed request is rejected
+package nonce
+
+import (
+	"container/heap"
+	"context"
+	"sync"
+	"time"
+)
+
+// Store keeps the used nonces
+type Store interface {
+	// UseNonce marks nonce as used until expiresAt, returns false if it was already in use
+	UseNonce(ctx context.Context, nonce string, expiresAt time.Time) (bool, error)
+	// ReleaseNonce marks nonce as unused, so a request that failed can be sent again with it
+	ReleaseNonce(ctx context.Context, nonce string) error
+}
+
+// expiry is the time a nonce expires at
+type expiry struct {
+	nonce     string
+	expiresAt time.Time
+}
+
+// expiryQueue orders the expiries of the nonces by time, the next one first
+type expiryQueue []expiry
+
+func (q expiryQueue) Len() int           { return len(q) }
+func (q expiryQueue) Less(i, j int) bool { return q[i].expiresAt.Before(q[j].expiresAt) }
+func (q expiryQueue) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+
+func (q *expiryQueue) Push(x any) {
+	*q = append(*q, x.(expiry))
+}
+
+func (q *expiryQueue) Pop() any {
+	old := *q
+	last := old[len(old)-1]
+	*q = old[:len(old)-1]
+
+	return last
+}
+
+// MemoryStore keeps the nonces in memory, only suited to a single writing instance
+// the postgres driver is a Store shared by every instance
+type MemoryStore struct {
+	nonces   map[string]time.Time
+	expiries expiryQueue
+	now      func() time.Time
+	mutex    sync.Mutex
+}
+
+// NewMemoryStore returns MemoryStore instance
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		nonces: map[string]time.Time{},
+		now:    time.Now,
+	}
+}
+
+// UseNonce marks nonce as used until expiresAt, returns false if it was already in use
+// the nonces expired are dropped first, in the order they expire
+func (s *MemoryStore) UseNonce(ctx context.Context, nonce string, expiresAt time.Time) (bool, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.dropExpired()
+
+	if _, ok := s.nonces[nonce]; ok {
+		return false, nil
+	}
+
+	s.nonces[nonce] = expiresAt
+	heap.Push(&s.expiries, expiry{nonce: nonce, expiresAt: expiresAt})
+
+	return true, nil
+}
+
+// ReleaseNonce marks nonce as unused, its expiry is dropped once it is reached
+func (s *MemoryStore) ReleaseNonce(ctx context.Context, nonce string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	delete(s.nonces, nonce)
+
+	return nil
+}
+
+// dropExpired drops the nonces whose expiry is reached, the expiries of released nonces
+// used again since are skipped
+func (s *MemoryStore) dropExpired() {
+	now := s.now()
+
+	for len(s.expiries) > 0 && !s.expiries[0].expiresAt.After(now) {
+		next := heap.Pop(&s.expiries).(expiry)
+
+		if expiresAt, ok := s.nonces[next.nonce]; ok && expiresAt.Equal(next.expiresAt) {
+			delete(s.nonces, next.nonce)
+		}
+	}
+}
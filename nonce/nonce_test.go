@@ -0,0 +1,72 @@
+package nonce
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryStore_UseNonce(t *testing.T) {
+	c := require.New(t)
+
+	store := NewMemoryStore()
+
+	now := time.Date(2022, time.June, 1, 10, 0, 0, 0, time.UTC)
+	store.now = func() time.Time { return now }
+
+	ok, err := store.UseNonce(context.Background(), "a1b2c3d4", now.Add(5*time.Minute))
+	c.NoError(err)
+	c.True(ok)
+
+	ok, err = store.UseNonce(context.Background(), "a1b2c3d4", now.Add(5*time.Minute))
+	c.NoError(err)
+	c.False(ok)
+
+	ok, err = store.UseNonce(context.Background(), "e5f6a7b8", now.Add(10*time.Minute))
+	c.NoError(err)
+	c.True(ok)
+
+	now = now.Add(5 * time.Minute)
+
+	ok, err = store.UseNonce(context.Background(), "a1b2c3d4", now.Add(5*time.Minute))
+	c.NoError(err)
+	c.True(ok)
+	c.Len(store.nonces, 2)
+}
+
+func TestMemoryStore_ReleaseNonce(t *testing.T) {
+	c := require.New(t)
+
+	store := NewMemoryStore()
+
+	now := time.Date(2022, time.June, 1, 10, 0, 0, 0, time.UTC)
+	store.now = func() time.Time { return now }
+
+	ok, err := store.UseNonce(context.Background(), "a1b2c3d4", now.Add(5*time.Minute))
+	c.NoError(err)
+	c.True(ok)
+
+	c.NoError(store.ReleaseNonce(context.Background(), "a1b2c3d4"))
+
+	ok, err = store.UseNonce(context.Background(), "a1b2c3d4", now.Add(10*time.Minute))
+	c.NoError(err)
+	c.True(ok)
+
+	// the expiry of the released use does not drop the nonce used again
+	now = now.Add(5 * time.Minute)
+
+	ok, err = store.UseNonce(context.Background(), "a1b2c3d4", now.Add(5*time.Minute))
+	c.NoError(err)
+	c.False(ok)
+	c.Len(store.expiries, 1)
+
+	now = now.Add(5 * time.Minute)
+
+	ok, err = store.UseNonce(context.Background(), "e5f6a7b8", now.Add(5*time.Minute))
+	c.NoError(err)
+	c.True(ok)
+	c.Len(store.nonces, 1)
+	c.Len(store.expiries, 1)
+}
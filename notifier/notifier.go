@@ -0,0 +1,104 @@
+// Package notifier tells operators about critical mutations through human channels such as email and Slack
+package notifier
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pokt-foundation/pocket-http-db/health"
+	"github.com/sirupsen/logrus"
+)
+
+// EventType represents the kind of mutation operators are notified about
+type EventType string
+
+const (
+	EventBlockchainDeactivated EventType = "blockchain.deactivated"
+	EventBlockchainRemoved     EventType = "blockchain.removed"
+	EventApplicationRemoved    EventType = "application.removed"
+	EventPlanMigrationExecuted EventType = "plan_migration.executed"
+	EventWriteAnomaly          EventType = "write.anomaly"
+)
+
+// AllEvents are the events notified when none are configured
+var AllEvents = []EventType{
+	EventBlockchainDeactivated, EventBlockchainRemoved, EventApplicationRemoved, EventPlanMigrationExecuted,
+	EventWriteAnomaly,
+}
+
+// Notification represents a single message sent to operators
+type Notification struct {
+	Event   EventType
+	Subject string
+	Text    string
+	Time    time.Time
+}
+
+// Notifier represents a channel notifications are delivered to
+type Notifier interface {
+	Notify(notification Notification) error
+}
+
+// Dispatcher struct handler for sending notifications of the configured events to all notifiers
+type Dispatcher struct {
+	events    map[EventType]bool
+	notifiers []Notifier
+	log       *logrus.Logger
+	// Probe records the outcome of the notifications, nil records nothing
+	// no notification is sent while it is disabled
+	Probe *health.Probe
+}
+
+// NewDispatcher returns Dispatcher instance notifying events to notifiers, empty events notifies all of them
+func NewDispatcher(events []EventType, notifiers []Notifier, logger *logrus.Logger) *Dispatcher {
+	if len(events) == 0 {
+		events = AllEvents
+	}
+
+	enabled := make(map[EventType]bool, len(events))
+
+	for _, event := range events {
+		enabled[event] = true
+	}
+
+	return &Dispatcher{
+		events:    enabled,
+		notifiers: notifiers,
+		log:       logger,
+	}
+}
+
+// Enabled reports whether event is notified
+func (d *Dispatcher) Enabled(event EventType) bool {
+	return d.events[event]
+}
+
+// Notify sends notification to every notifier in the background, unless its event is not enabled
+// or the notifications are disabled
+func (d *Dispatcher) Notify(notification Notification) {
+	if !d.Enabled(notification.Event) || !d.Probe.Enabled() {
+		return
+	}
+
+	if notification.Time.IsZero() {
+		notification.Time = time.Now().UTC()
+	}
+
+	for _, notifier := range d.notifiers {
+		go func(notifier Notifier) {
+			err := notifier.Notify(notification)
+			d.Probe.Record(err)
+			if err != nil {
+				d.logError(fmt.Errorf("notification %s failed: %w", notification.Event, err))
+			}
+		}(notifier)
+	}
+}
+
+func (d *Dispatcher) logError(err error) {
+	fields := logrus.Fields{
+		"err": err.Error(),
+	}
+
+	d.log.WithFields(fields).Error(err)
+}
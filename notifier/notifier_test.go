@@ -0,0 +1,112 @@
+package notifier
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/smtp"
+	"testing"
+	"time"
+
+	"github.com/pokt-foundation/pocket-http-db/health"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+type notifierMock struct {
+	notifications chan Notification
+}
+
+func (n *notifierMock) Notify(notification Notification) error {
+	n.notifications <- notification
+
+	return nil
+}
+
+func TestDispatcher_Notify(t *testing.T) {
+	c := require.New(t)
+
+	mock := &notifierMock{notifications: make(chan Notification, 2)}
+
+	dispatcher := NewDispatcher([]EventType{EventApplicationRemoved}, []Notifier{mock}, logrus.New())
+
+	c.True(dispatcher.Enabled(EventApplicationRemoved))
+	c.False(dispatcher.Enabled(EventBlockchainDeactivated))
+
+	dispatcher.Notify(Notification{Event: EventBlockchainDeactivated, Subject: "ignored"})
+	dispatcher.Notify(Notification{Event: EventApplicationRemoved, Subject: "sent"})
+
+	notification := <-mock.notifications
+	c.Equal("sent", notification.Subject)
+	c.False(notification.Time.IsZero())
+	c.Empty(mock.notifications)
+
+	c.True(NewDispatcher(nil, nil, logrus.New()).Enabled(EventPlanMigrationExecuted))
+
+	// the probe is set before the first Notify, as the deliveries read it in the background
+	registry := health.NewRegistry()
+	registry.SetErrorBudget("notifications", 1)
+
+	dispatcher = NewDispatcher([]EventType{EventApplicationRemoved}, []Notifier{mock}, logrus.New())
+	dispatcher.Probe = registry.Probe("notifications")
+	dispatcher.Probe.Record(errors.New("smtp timeout"))
+
+	dispatcher.Notify(Notification{Event: EventApplicationRemoved, Subject: "dropped"})
+
+	_, err := registry.Enable("notifications")
+	c.NoError(err)
+
+	dispatcher.Notify(Notification{Event: EventApplicationRemoved, Subject: "sent again"})
+
+	notification = <-mock.notifications
+	c.Equal("sent again", notification.Subject)
+}
+
+func TestSlack_Notify(t *testing.T) {
+	c := require.New(t)
+
+	var message slackMessage
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.NoError(json.NewDecoder(r.Body).Decode(&message))
+	}))
+	defer server.Close()
+
+	err := NewSlack(server.URL, time.Second).Notify(Notification{Subject: "Blockchain deactivated", Text: "0021"})
+	c.NoError(err)
+	c.Equal("*Blockchain deactivated*\n0021", message.Text)
+
+	server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	err = NewSlack(server.URL, time.Second).Notify(Notification{})
+	c.ErrorIs(err, errSlackResponseNotOK)
+}
+
+func TestSMTP_Notify(t *testing.T) {
+	c := require.New(t)
+
+	notifier := NewSMTP("smtp.example.com:587", "user", "pass", "phd@example.com", []string{"ops@example.com", "chain@example.com"})
+	c.NotNil(notifier.auth)
+
+	var sentMsg string
+
+	notifier.sendMail = func(addr string, a smtp.Auth, from string, to []string, msg []byte) error {
+		c.Equal("smtp.example.com:587", addr)
+		c.Equal("phd@example.com", from)
+		c.Len(to, 2)
+
+		sentMsg = string(msg)
+
+		return nil
+	}
+
+	c.NoError(notifier.Notify(Notification{Subject: "Application removed", Text: "5f62b7d8be3591c4dea8566d", Time: time.Now()}))
+	c.Contains(sentMsg, "Subject: Application removed\r\n")
+	c.Contains(sentMsg, "To: ops@example.com, chain@example.com\r\n")
+	c.Contains(sentMsg, "\r\n\r\n5f62b7d8be3591c4dea8566d")
+
+	c.Nil(NewSMTP("localhost:25", "", "", "phd@example.com", nil).auth)
+}
@@ -0,0 +1,55 @@
+package notifier
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+var errSlackResponseNotOK = errors.New("slack response not ok")
+
+// Slack struct handler for notifications posted to a Slack incoming webhook
+type Slack struct {
+	url    string
+	client *http.Client
+}
+
+type slackMessage struct {
+	Text string `json:"text"`
+}
+
+// NewSlack returns Slack instance posting to the incoming webhook in url
+func NewSlack(url string, timeout time.Duration) *Slack {
+	return &Slack{
+		url:    url,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+// Notify posts notification as a single Slack message
+func (s *Slack) Notify(notification Notification) error {
+	body, err := json.Marshal(slackMessage{
+		Text: fmt.Sprintf("*%s*\n%s", notification.Subject, notification.Text),
+	})
+	if err != nil {
+		return err
+	}
+
+	resp, err := s.client.Post(s.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%w: status %d", errSlackResponseNotOK, resp.StatusCode)
+	}
+
+	return nil
+}
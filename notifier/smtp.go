@@ -0,0 +1,52 @@
+package notifier
+
+import (
+	"fmt"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+// SMTP struct handler for notifications sent by email
+type SMTP struct {
+	addr     string
+	auth     smtp.Auth
+	from     string
+	to       []string
+	sendMail func(addr string, a smtp.Auth, from string, to []string, msg []byte) error
+}
+
+// NewSMTP returns SMTP instance sending mails through the server in addr (host:port)
+// PLAIN auth is used when username is set
+func NewSMTP(addr, username, password, from string, to []string) *SMTP {
+	var auth smtp.Auth
+
+	if username != "" {
+		host, _, _ := net.SplitHostPort(addr)
+		auth = smtp.PlainAuth("", username, password, host)
+	}
+
+	return &SMTP{
+		addr:     addr,
+		auth:     auth,
+		from:     from,
+		to:       to,
+		sendMail: smtp.SendMail,
+	}
+}
+
+// Notify sends notification as a plain text email to all recipients
+func (s *SMTP) Notify(notification Notification) error {
+	msg := strings.Join([]string{
+		fmt.Sprintf("From: %s", s.from),
+		fmt.Sprintf("To: %s", strings.Join(s.to, ", ")),
+		fmt.Sprintf("Subject: %s", notification.Subject),
+		fmt.Sprintf("Date: %s", notification.Time.Format(time.RFC1123Z)),
+		"Content-Type: text/plain; charset=UTF-8",
+		"",
+		notification.Text,
+	}, "\r\n")
+
+	return s.sendMail(s.addr, s.auth, s.from, s.to, []byte(msg))
+}
@@ -0,0 +1,42 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/pokt-foundation/pocket-http-db/types"
+	postgresdriver "github.com/pokt-foundation/portal-api-go/postgres-driver"
+	"github.com/pokt-foundation/portal-api-go/repository"
+)
+
+const (
+	updateApplicationsStatus = `
+	UPDATE applications
+	SET status = $1, updated_at = $2
+	WHERE application_id = ANY($3)`
+)
+
+// UpdateApplicationsStatus sets status to all the applications in ids in a single transaction
+func (d *Driver) UpdateApplicationsStatus(ctx context.Context, ids []string, status repository.AppStatus) error {
+	if len(ids) == 0 {
+		return ErrMissingID
+	}
+
+	if status == "" || !types.ValidAppStatus(status) {
+		return postgresdriver.ErrInvalidAppStatus
+	}
+
+	tx, err := d.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, updateApplicationsStatus, string(status), time.Now(), pq.StringArray(ids))
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,91 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/pokt-foundation/pocket-http-db/types"
+	"github.com/pokt-foundation/portal-api-go/repository"
+)
+
+const (
+	selectApplicationFiltersScript = `
+	SELECT name, status, pay_plan_type, label_selectors
+	FROM application_filters
+	ORDER BY name`
+	upsertApplicationFilterScript = `
+	INSERT into application_filters (name, status, pay_plan_type, label_selectors, updated_at)
+	VALUES ($1, $2, $3, $4, $5)
+	ON CONFLICT (name) DO UPDATE SET
+	status = EXCLUDED.status,
+	pay_plan_type = EXCLUDED.pay_plan_type,
+	label_selectors = EXCLUDED.label_selectors,
+	updated_at = EXCLUDED.updated_at`
+	deleteApplicationFilterScript = `DELETE FROM application_filters WHERE name = $1`
+)
+
+var (
+	// ErrMissingFilterName error when the application filter has no name
+	ErrMissingFilterName = errors.New("missing filter name")
+)
+
+type dbApplicationFilter struct {
+	Name           string         `db:"name"`
+	Status         sql.NullString `db:"status"`
+	PayPlanType    sql.NullString `db:"pay_plan_type"`
+	LabelSelectors pq.StringArray `db:"label_selectors"`
+}
+
+// ReadApplicationFilters returns every named filter of applications
+func (d *Driver) ReadApplicationFilters() ([]*types.ApplicationFilter, error) {
+	var dbFilters []*dbApplicationFilter
+
+	err := d.Select(&dbFilters, selectApplicationFiltersScript)
+	if err != nil {
+		return nil, err
+	}
+
+	filters := make([]*types.ApplicationFilter, 0, len(dbFilters))
+
+	for _, dbFilter := range dbFilters {
+		filter := &types.ApplicationFilter{
+			Name:        dbFilter.Name,
+			Status:      repository.AppStatus(dbFilter.Status.String),
+			PayPlanType: repository.PayPlanType(dbFilter.PayPlanType.String),
+		}
+
+		if len(dbFilter.LabelSelectors) > 0 {
+			filter.Labels = []string(dbFilter.LabelSelectors)
+		}
+
+		filters = append(filters, filter)
+	}
+
+	return filters, nil
+}
+
+// WriteApplicationFilter saves filter, replacing the filter with the same name
+func (d *Driver) WriteApplicationFilter(ctx context.Context, filter *types.ApplicationFilter) error {
+	if filter.Name == "" {
+		return ErrMissingFilterName
+	}
+
+	_, err := d.ExecContext(ctx, upsertApplicationFilterScript, filter.Name, newSQLNullString(string(filter.Status)),
+		newSQLNullString(string(filter.PayPlanType)), pq.StringArray(filter.Labels), time.Now())
+
+	return err
+}
+
+// RemoveApplicationFilter removes the filter with given name
+func (d *Driver) RemoveApplicationFilter(ctx context.Context, name string) error {
+	if name == "" {
+		return ErrMissingFilterName
+	}
+
+	_, err := d.ExecContext(ctx, deleteApplicationFilterScript, name)
+
+	return err
+}
@@ -0,0 +1,73 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/pokt-foundation/pocket-http-db/types"
+	postgresdriver "github.com/pokt-foundation/portal-api-go/postgres-driver"
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDriver_ReadApplicationFilters(t *testing.T) {
+	c := require.New(t)
+
+	db, mock, err := sqlmock.New()
+	c.NoError(err)
+
+	defer db.Close()
+
+	driver := NewDriverFromSQLDBInstance(db, postgresdriver.NewListenerMock())
+
+	rows := sqlmock.NewRows([]string{"name", "status", "pay_plan_type", "label_selectors"}).
+		AddRow("enterprise-apps", "IN_SERVICE", "ENTERPRISE", nil).
+		AddRow("infra-apps", nil, nil, "{team:infra,env}")
+
+	mock.ExpectQuery("SELECT name, status, pay_plan_type, label_selectors").WillReturnRows(rows)
+
+	filters, err := driver.ReadApplicationFilters()
+	c.NoError(err)
+	c.Equal([]*types.ApplicationFilter{
+		{Name: "enterprise-apps", Status: repository.InService, PayPlanType: "ENTERPRISE"},
+		{Name: "infra-apps", Labels: []string{"team:infra", "env"}},
+	}, filters)
+
+	mock.ExpectQuery("SELECT name, status, pay_plan_type, label_selectors").WillReturnError(errors.New("dummy error"))
+
+	_, err = driver.ReadApplicationFilters()
+	c.EqualError(err, "dummy error")
+
+	c.NoError(mock.ExpectationsWereMet())
+}
+
+func TestDriver_WriteApplicationFilter(t *testing.T) {
+	c := require.New(t)
+
+	db, mock, err := sqlmock.New()
+	c.NoError(err)
+
+	defer db.Close()
+
+	driver := NewDriverFromSQLDBInstance(db, postgresdriver.NewListenerMock())
+
+	mock.ExpectExec("INSERT into application_filters").
+		WithArgs("enterprise-apps", "IN_SERVICE", nil, sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	err = driver.WriteApplicationFilter(context.Background(), &types.ApplicationFilter{Name: "enterprise-apps", Status: repository.InService})
+	c.NoError(err)
+
+	err = driver.WriteApplicationFilter(context.Background(), &types.ApplicationFilter{})
+	c.ErrorIs(err, ErrMissingFilterName)
+
+	mock.ExpectExec("DELETE FROM application_filters").WithArgs("enterprise-apps").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = driver.RemoveApplicationFilter(context.Background(), "enterprise-apps")
+	c.NoError(err)
+
+	c.NoError(mock.ExpectationsWereMet())
+}
@@ -0,0 +1,158 @@
+package postgres
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/lib/pq"
+	"github.com/pokt-foundation/portal-api-go/repository"
+)
+
+const (
+	selectApplicationScript = `
+	SELECT a.application_id, a.contact_email, a.created_at, a.description, a.dummy, a.name, a.owner, a.status, a.updated_at, a.url, a.user_id, a.pay_plan_type, a.first_date_surpassed,
+	ga.address AS ga_address, ga.client_public_key AS ga_client_public_key, ga.private_key AS ga_private_key, ga.public_key AS ga_public_key, ga.signature AS ga_signature, ga.version AS ga_version,
+	gs.secret_key, gs.secret_key_required, gs.whitelist_blockchains, gs.whitelist_contracts, gs.whitelist_methods, gs.whitelist_origins, gs.whitelist_user_agents,
+	ns.signed_up, ns.on_quarter, ns.on_half, ns.on_three_quarters, ns.on_full
+	FROM applications AS a
+	LEFT JOIN gateway_aat AS ga ON a.application_id=ga.application_id
+	LEFT JOIN gateway_settings AS gs ON a.application_id=gs.application_id
+	LEFT JOIN notification_settings AS ns ON a.application_id=ns.application_id
+	WHERE a.application_id = $1`
+)
+
+// dbApplication mirrors the application rows read by the upstream driver
+type dbApplication struct {
+	ApplicationID        string         `db:"application_id"`
+	UserID               sql.NullString `db:"user_id"`
+	Name                 sql.NullString `db:"name"`
+	Status               sql.NullString `db:"status"`
+	ContactEmail         sql.NullString `db:"contact_email"`
+	Description          sql.NullString `db:"description"`
+	GAAddress            sql.NullString `db:"ga_address"`
+	GAClientPublicKey    sql.NullString `db:"ga_client_public_key"`
+	GAPrivateKey         sql.NullString `db:"ga_private_key"`
+	GAPublicKey          sql.NullString `db:"ga_public_key"`
+	GASignature          sql.NullString `db:"ga_signature"`
+	GAVersion            sql.NullString `db:"ga_version"`
+	Owner                sql.NullString `db:"owner"`
+	SecretKey            sql.NullString `db:"secret_key"`
+	URL                  sql.NullString `db:"url"`
+	PayPlanType          sql.NullString `db:"pay_plan_type"`
+	FirstDateSurpassed   sql.NullTime   `db:"first_date_surpassed"`
+	WhitelistContracts   sql.NullString `db:"whitelist_contracts"`
+	WhitelistMethods     sql.NullString `db:"whitelist_methods"`
+	WhitelistOrigins     pq.StringArray `db:"whitelist_origins"`
+	WhitelistUserAgents  pq.StringArray `db:"whitelist_user_agents"`
+	WhitelistBlockchains pq.StringArray `db:"whitelist_blockchains"`
+	Dummy                sql.NullBool   `db:"dummy"`
+	SecretKeyRequired    sql.NullBool   `db:"secret_key_required"`
+	SignedUp             sql.NullBool   `db:"signed_up"`
+	Quarter              sql.NullBool   `db:"on_quarter"`
+	Half                 sql.NullBool   `db:"on_half"`
+	ThreeQuarters        sql.NullBool   `db:"on_three_quarters"`
+	Full                 sql.NullBool   `db:"on_full"`
+	CreatedAt            sql.NullTime   `db:"created_at"`
+	UpdatedAt            sql.NullTime   `db:"updated_at"`
+}
+
+func (a *dbApplication) toApplication() *repository.Application {
+	return &repository.Application{
+		ID:                 a.ApplicationID,
+		UserID:             a.UserID.String,
+		Name:               a.Name.String,
+		Status:             repository.AppStatus(a.Status.String),
+		ContactEmail:       a.ContactEmail.String,
+		Description:        a.Description.String,
+		Owner:              a.Owner.String,
+		URL:                a.URL.String,
+		PayPlanType:        repository.PayPlanType(a.PayPlanType.String),
+		FirstDateSurpassed: a.FirstDateSurpassed.Time,
+		Dummy:              a.Dummy.Bool,
+		CreatedAt:          a.CreatedAt.Time,
+		UpdatedAt:          a.UpdatedAt.Time,
+		GatewayAAT: repository.GatewayAAT{
+			Address:              a.GAAddress.String,
+			ApplicationPublicKey: a.GAPublicKey.String,
+			ApplicationSignature: a.GASignature.String,
+			ClientPublicKey:      a.GAClientPublicKey.String,
+			PrivateKey:           a.GAPrivateKey.String,
+			Version:              a.GAVersion.String,
+		},
+		GatewaySettings: repository.GatewaySettings{
+			SecretKey:            a.SecretKey.String,
+			SecretKeyRequired:    a.SecretKeyRequired.Bool,
+			WhitelistBlockchains: a.WhitelistBlockchains,
+			WhitelistContracts:   whitelistContracts(a.WhitelistContracts),
+			WhitelistMethods:     whitelistMethods(a.WhitelistMethods),
+			WhitelistOrigins:     a.WhitelistOrigins,
+			WhitelistUserAgents:  a.WhitelistUserAgents,
+		},
+		NotificationSettings: repository.NotificationSettings{
+			SignedUp:      a.SignedUp.Bool,
+			Quarter:       a.Quarter.Bool,
+			Half:          a.Half.Bool,
+			ThreeQuarters: a.ThreeQuarters.Bool,
+			Full:          a.Full.Bool,
+		},
+	}
+}
+
+// whitelistContracts parses the contracts whitelist the same way the upstream driver does
+func whitelistContracts(raw sql.NullString) []repository.WhitelistContract {
+	if !raw.Valid {
+		return nil
+	}
+
+	contracts := []repository.WhitelistContract{}
+
+	_ = json.Unmarshal([]byte(raw.String), &contracts)
+
+	for i, contract := range contracts {
+		for j, inContract := range contract.Contracts {
+			contracts[i].Contracts[j] = strings.TrimSpace(inContract)
+		}
+	}
+
+	return contracts
+}
+
+// whitelistMethods parses the methods whitelist the same way the upstream driver does
+func whitelistMethods(raw sql.NullString) []repository.WhitelistMethod {
+	if !raw.Valid {
+		return nil
+	}
+
+	methods := []repository.WhitelistMethod{}
+
+	_ = json.Unmarshal([]byte(raw.String), &methods)
+
+	for i, method := range methods {
+		for j, inMethod := range method.Methods {
+			methods[i].Methods[j] = strings.TrimSpace(inMethod)
+		}
+	}
+
+	return methods
+}
+
+// ReadApplication returns the application with given id from the database, nil if there is none
+func (d *Driver) ReadApplication(id string) (*repository.Application, error) {
+	if id == "" {
+		return nil, ErrMissingID
+	}
+
+	var app dbApplication
+
+	err := d.Get(&app, selectApplicationScript, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return app.toApplication(), nil
+}
@@ -0,0 +1,56 @@
+package postgres
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	postgresdriver "github.com/pokt-foundation/portal-api-go/postgres-driver"
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDriver_ReadApplication(t *testing.T) {
+	c := require.New(t)
+
+	db, mock, err := sqlmock.New()
+	c.NoError(err)
+
+	defer db.Close()
+
+	driver := NewDriverFromSQLDBInstance(db, postgresdriver.NewListenerMock())
+
+	rows := sqlmock.NewRows([]string{"application_id", "user_id", "name", "status", "pay_plan_type", "ga_address",
+		"whitelist_contracts", "whitelist_origins", "signed_up"}).
+		AddRow("5f62b7d8be3591c4dea8566d", "60ecb2bf67774900350d9c43", "pablo", "IN_SERVICE", "FREETIER_V0", "1f32488b1db60fe528ab21e3cc26c96696be3faa",
+			`[{"blockchainID":"0021","contracts":[" 0x1234 "]}]`, "{https://portal.pokt.network}", true)
+
+	mock.ExpectQuery("WHERE a.application_id = ").WithArgs("5f62b7d8be3591c4dea8566d").WillReturnRows(rows)
+
+	app, err := driver.ReadApplication("5f62b7d8be3591c4dea8566d")
+	c.NoError(err)
+	c.Equal("pablo", app.Name)
+	c.Equal(repository.InService, app.Status)
+	c.Equal(repository.FreetierV0, app.PayPlanType)
+	c.Equal("1f32488b1db60fe528ab21e3cc26c96696be3faa", app.GatewayAAT.Address)
+	c.Equal([]repository.WhitelistContract{{BlockchainID: "0021", Contracts: []string{"0x1234"}}}, app.GatewaySettings.WhitelistContracts)
+	c.Equal([]string{"https://portal.pokt.network"}, app.GatewaySettings.WhitelistOrigins)
+	c.True(app.NotificationSettings.SignedUp)
+
+	mock.ExpectQuery("WHERE a.application_id = ").WithArgs("5f62b7d8be3591c4dea8566a").
+		WillReturnRows(sqlmock.NewRows([]string{"application_id"}))
+
+	app, err = driver.ReadApplication("5f62b7d8be3591c4dea8566a")
+	c.NoError(err)
+	c.Nil(app)
+
+	mock.ExpectQuery("WHERE a.application_id = ").WithArgs("5f62b7d8be3591c4dea8566f").WillReturnError(errors.New("dummy error"))
+
+	_, err = driver.ReadApplication("5f62b7d8be3591c4dea8566f")
+	c.EqualError(err, "dummy error")
+
+	_, err = driver.ReadApplication("")
+	c.ErrorIs(err, ErrMissingID)
+
+	c.NoError(mock.ExpectationsWereMet())
+}
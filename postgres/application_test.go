@@ -0,0 +1,47 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	postgresdriver "github.com/pokt-foundation/portal-api-go/postgres-driver"
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDriver_UpdateApplicationsStatus(t *testing.T) {
+	c := require.New(t)
+
+	db, mock, err := sqlmock.New()
+	c.NoError(err)
+
+	defer db.Close()
+
+	driver := NewDriverFromSQLDBInstance(db, postgresdriver.NewListenerMock())
+
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE applications").WithArgs("ORPHANED", sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(2, 2))
+	mock.ExpectCommit()
+
+	err = driver.UpdateApplicationsStatus(context.Background(), []string{"60ddc61b6e2936fhtrns63h2", "60ddc61b6e2936fhtrns63h3"}, repository.Orphaned)
+	c.NoError(err)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE applications").WithArgs("ORPHANED", sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnError(errors.New("dummy error"))
+	mock.ExpectRollback()
+
+	err = driver.UpdateApplicationsStatus(context.Background(), []string{"not-an-id"}, repository.Orphaned)
+	c.EqualError(err, "dummy error")
+
+	err = driver.UpdateApplicationsStatus(context.Background(), nil, repository.Orphaned)
+	c.Equal(ErrMissingID, err)
+
+	err = driver.UpdateApplicationsStatus(context.Background(), []string{"60ddc61b6e2936fhtrns63h2"}, "wrong")
+	c.Equal(postgresdriver.ErrInvalidAppStatus, err)
+
+	c.NoError(mock.ExpectationsWereMet())
+}
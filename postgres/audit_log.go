@@ -0,0 +1,80 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pokt-foundation/pocket-http-db/types"
+)
+
+const (
+	insertAuditLogEntryScript = `
+	INSERT into audit_log (entity_type, entity_id, action, actor, reason, data, created_at)
+	VALUES (:entity_type, :entity_id, :action, :actor, :reason, :data, :created_at)`
+)
+
+var (
+	// ErrMissingAuditAction error when the audit log entry has no action
+	ErrMissingAuditAction = errors.New("missing audit action")
+)
+
+type insertAuditLogEntry struct {
+	EntityType string         `db:"entity_type"`
+	EntityID   string         `db:"entity_id"`
+	Action     string         `db:"action"`
+	Actor      sql.NullString `db:"actor"`
+	Reason     sql.NullString `db:"reason"`
+	Data       sql.NullString `db:"data"`
+	CreatedAt  time.Time      `db:"created_at"`
+}
+
+// WriteAuditLogEntry saves input entry in the audit log
+func (d *Driver) WriteAuditLogEntry(ctx context.Context, entry *types.AuditLogEntry) error {
+	insert, err := newInsertAuditLogEntry(entry)
+	if err != nil {
+		return err
+	}
+
+	_, err = d.NamedExecContext(ctx, insertAuditLogEntryScript, insert)
+
+	return err
+}
+
+func writeAuditLogEntryInTx(tx *sqlx.Tx, entry *types.AuditLogEntry) error {
+	insert, err := newInsertAuditLogEntry(entry)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.NamedExec(insertAuditLogEntryScript, insert)
+
+	return err
+}
+
+// newInsertAuditLogEntry returns the row of entry, stamped with the current time if it has no creation time
+func newInsertAuditLogEntry(entry *types.AuditLogEntry) (*insertAuditLogEntry, error) {
+	if entry.EntityID == "" {
+		return nil, ErrMissingID
+	}
+
+	if entry.Action == "" {
+		return nil, ErrMissingAuditAction
+	}
+
+	if entry.CreatedAt.IsZero() {
+		entry.CreatedAt = time.Now()
+	}
+
+	return &insertAuditLogEntry{
+		EntityType: string(entry.EntityType),
+		EntityID:   entry.EntityID,
+		Action:     string(entry.Action),
+		Actor:      newSQLNullString(entry.Actor),
+		Reason:     newSQLNullString(entry.Reason),
+		Data:       newSQLNullString(string(entry.Data)),
+		CreatedAt:  entry.CreatedAt,
+	}, nil
+}
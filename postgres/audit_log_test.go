@@ -0,0 +1,54 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/pokt-foundation/pocket-http-db/types"
+	postgresdriver "github.com/pokt-foundation/portal-api-go/postgres-driver"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDriver_WriteAuditLogEntry(t *testing.T) {
+	c := require.New(t)
+
+	db, mock, err := sqlmock.New()
+	c.NoError(err)
+
+	defer db.Close()
+
+	driver := NewDriverFromSQLDBInstance(db, postgresdriver.NewListenerMock())
+
+	mock.ExpectExec("INSERT into audit_log").
+		WithArgs("application", "60ddc61b6e2936fhtrns63h2", "suspend", "abuse-team", "spam", `{"status":"SUSPENDED"}`, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	entry := &types.AuditLogEntry{
+		EntityType: types.EntityApplication,
+		EntityID:   "60ddc61b6e2936fhtrns63h2",
+		Action:     types.AuditActionSuspend,
+		Actor:      "abuse-team",
+		Reason:     "spam",
+		Data:       json.RawMessage(`{"status":"SUSPENDED"}`),
+	}
+
+	err = driver.WriteAuditLogEntry(context.Background(), entry)
+	c.NoError(err)
+	c.False(entry.CreatedAt.IsZero())
+
+	mock.ExpectExec("INSERT into audit_log").WillReturnError(errors.New("dummy error"))
+
+	err = driver.WriteAuditLogEntry(context.Background(), entry)
+	c.EqualError(err, "dummy error")
+
+	err = driver.WriteAuditLogEntry(context.Background(), &types.AuditLogEntry{Action: types.AuditActionSuspend})
+	c.Equal(ErrMissingID, err)
+
+	err = driver.WriteAuditLogEntry(context.Background(), &types.AuditLogEntry{EntityID: "60ddc61b6e2936fhtrns63h2"})
+	c.Equal(ErrMissingAuditAction, err)
+
+	c.NoError(mock.ExpectationsWereMet())
+}
@@ -0,0 +1,50 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/pokt-foundation/pocket-http-db/types"
+)
+
+const (
+	backfillApplicationsUpdatedAt = `
+	UPDATE applications SET updated_at = COALESCE(created_at, $2)
+	WHERE application_id = ANY($1) AND updated_at IS NULL`
+	backfillLoadBalancersUpdatedAt = `
+	UPDATE loadbalancers SET updated_at = COALESCE(created_at, $2)
+	WHERE lb_id = ANY($1) AND updated_at IS NULL`
+)
+
+var (
+	// ErrUnsupportedEntity error when an operation is not supported for the entity type
+	ErrUnsupportedEntity = errors.New("unsupported entity type")
+)
+
+// backfillUpdatedAtScripts are the updated_at backfill scripts by entity type
+var backfillUpdatedAtScripts = map[types.EntityType]string{
+	types.EntityApplication:  backfillApplicationsUpdatedAt,
+	types.EntityLoadBalancer: backfillLoadBalancersUpdatedAt,
+}
+
+// BackfillUpdatedAt sets the missing updated_at of the entities in ids to their created_at, or now if missing too
+// returns the number of entities updated
+func (d *Driver) BackfillUpdatedAt(ctx context.Context, entityType types.EntityType, ids []string) (int64, error) {
+	if len(ids) == 0 {
+		return 0, ErrMissingID
+	}
+
+	script, ok := backfillUpdatedAtScripts[entityType]
+	if !ok {
+		return 0, ErrUnsupportedEntity
+	}
+
+	result, err := d.ExecContext(ctx, script, pq.StringArray(ids), time.Now())
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
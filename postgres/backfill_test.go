@@ -0,0 +1,44 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/pokt-foundation/pocket-http-db/types"
+	postgresdriver "github.com/pokt-foundation/portal-api-go/postgres-driver"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDriver_BackfillUpdatedAt(t *testing.T) {
+	c := require.New(t)
+
+	db, mock, err := sqlmock.New()
+	c.NoError(err)
+
+	defer db.Close()
+
+	driver := NewDriverFromSQLDBInstance(db, postgresdriver.NewListenerMock())
+
+	mock.ExpectExec("UPDATE applications SET updated_at").WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	updated, err := driver.BackfillUpdatedAt(context.Background(), types.EntityApplication, []string{"5f62b7d8be3591c4dea8566d", "5f62b7d8be3591c4dea8566a"})
+	c.NoError(err)
+	c.Equal(int64(2), updated)
+
+	mock.ExpectExec("UPDATE loadbalancers SET updated_at").WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	updated, err = driver.BackfillUpdatedAt(context.Background(), types.EntityLoadBalancer, []string{"60ecb2bf67774900350d9c42"})
+	c.NoError(err)
+	c.Equal(int64(1), updated)
+
+	_, err = driver.BackfillUpdatedAt(context.Background(), types.EntityBlockchain, []string{"0021"})
+	c.Equal(ErrUnsupportedEntity, err)
+
+	_, err = driver.BackfillUpdatedAt(context.Background(), types.EntityApplication, nil)
+	c.Equal(ErrMissingID, err)
+
+	c.NoError(mock.ExpectationsWereMet())
+}
@@ -0,0 +1,69 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pokt-foundation/pocket-http-db/types"
+	postgresdriver "github.com/pokt-foundation/portal-api-go/postgres-driver"
+)
+
+// blockchainRemovals deletes the rows of a blockchain, children before the blockchains table
+// application whitelists keep the blockchain ID, they have no foreign key to the blockchain
+var blockchainRemovals = []string{
+	`DELETE FROM redirects WHERE blockchain_id = $1`,
+	`DELETE FROM sync_check_options WHERE blockchain_id = $1`,
+	`DELETE FROM blockchains WHERE blockchain_id = $1`,
+}
+
+// RemoveBlockchain permanently deletes the blockchain with given id along with its redirects and sync check options
+func (d *Driver) RemoveBlockchain(ctx context.Context, id string) error {
+	if id == "" {
+		return ErrMissingID
+	}
+
+	tx, err := d.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	err = removeBlockchainInTx(tx, id)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func removeBlockchainInTx(tx *sqlx.Tx, id string) error {
+	for _, query := range blockchainRemovals {
+		_, err := tx.Exec(query, id)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+const updateBlockchainSettingsScript = `
+	UPDATE blockchains SET log_limit_blocks = $1, request_timeout = $2, enforce_result = $3, updated_at = $4
+	WHERE blockchain_id = $5`
+
+// UpdateBlockchainSettings sets the operational settings of the blockchain with given id
+func (d *Driver) UpdateBlockchainSettings(ctx context.Context, id string, settings *types.BlockchainSettings) error {
+	if id == "" {
+		return ErrMissingID
+	}
+
+	if settings == nil {
+		return postgresdriver.ErrNoFieldsToUpdate
+	}
+
+	_, err := d.ExecContext(ctx, updateBlockchainSettingsScript, settings.LogLimitBlocks, settings.RequestTimeout,
+		newSQLNullString(settings.EnforceResult), time.Now(), id)
+
+	return err
+}
@@ -0,0 +1,86 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/pokt-foundation/pocket-http-db/types"
+	postgresdriver "github.com/pokt-foundation/portal-api-go/postgres-driver"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDriver_RemoveBlockchain(t *testing.T) {
+	c := require.New(t)
+
+	db, mock, err := sqlmock.New()
+	c.NoError(err)
+
+	defer db.Close()
+
+	driver := NewDriverFromSQLDBInstance(db, postgresdriver.NewListenerMock())
+
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM redirects").WithArgs("0021").WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec("DELETE FROM sync_check_options").WithArgs("0021").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("DELETE FROM blockchains").WithArgs("0021").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err = driver.RemoveBlockchain(context.Background(), "0021")
+	c.NoError(err)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM redirects").WithArgs("0021").WillReturnError(errors.New("dummy error"))
+	mock.ExpectRollback()
+
+	err = driver.RemoveBlockchain(context.Background(), "0021")
+	c.EqualError(err, "dummy error")
+
+	err = driver.RemoveBlockchain(context.Background(), "")
+	c.ErrorIs(err, ErrMissingID)
+
+	c.NoError(mock.ExpectationsWereMet())
+}
+
+func TestDriver_UpdateBlockchainSettings(t *testing.T) {
+	c := require.New(t)
+
+	db, mock, err := sqlmock.New()
+	c.NoError(err)
+
+	defer db.Close()
+
+	driver := NewDriverFromSQLDBInstance(db, postgresdriver.NewListenerMock())
+
+	settings := &types.BlockchainSettings{LogLimitBlocks: 100000, RequestTimeout: 5000, EnforceResult: "JSON"}
+
+	mock.ExpectExec("UPDATE blockchains").
+		WithArgs(100000, 5000, sql.NullString{String: "JSON", Valid: true}, sqlmock.AnyArg(), "0021").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = driver.UpdateBlockchainSettings(context.Background(), "0021", settings)
+	c.NoError(err)
+
+	// an empty enforced result is saved as NULL, as on blockchain creation
+	mock.ExpectExec("UPDATE blockchains").
+		WithArgs(0, 0, sql.NullString{}, sqlmock.AnyArg(), "0021").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = driver.UpdateBlockchainSettings(context.Background(), "0021", &types.BlockchainSettings{})
+	c.NoError(err)
+
+	mock.ExpectExec("UPDATE blockchains").WillReturnError(errors.New("dummy error"))
+
+	err = driver.UpdateBlockchainSettings(context.Background(), "0021", settings)
+	c.EqualError(err, "dummy error")
+
+	err = driver.UpdateBlockchainSettings(context.Background(), "", settings)
+	c.ErrorIs(err, ErrMissingID)
+
+	err = driver.UpdateBlockchainSettings(context.Background(), "0021", nil)
+	c.ErrorIs(err, postgresdriver.ErrNoFieldsToUpdate)
+
+	c.NoError(mock.ExpectationsWereMet())
+}
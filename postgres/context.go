@@ -0,0 +1,101 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/pokt-foundation/portal-api-go/repository"
+)
+
+// The upstream driver writes below do not take a context, so they are only skipped when ctx is already done
+// and cannot be cancelled once started
+
+// WriteLoadBalancer saves input load balancer in the database
+func (d *Driver) WriteLoadBalancer(ctx context.Context, loadBalancer *repository.LoadBalancer) (*repository.LoadBalancer, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return d.PostgresDriver.WriteLoadBalancer(loadBalancer)
+}
+
+// UpdateLoadBalancer updates fields available in options in db
+func (d *Driver) UpdateLoadBalancer(ctx context.Context, id string, options *repository.UpdateLoadBalancer) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	return d.PostgresDriver.UpdateLoadBalancer(id, options)
+}
+
+// RemoveLoadBalancer removes the load balancer from its user in db
+func (d *Driver) RemoveLoadBalancer(ctx context.Context, id string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	return d.PostgresDriver.RemoveLoadBalancer(id)
+}
+
+// WriteApplication saves input application in the database
+func (d *Driver) WriteApplication(ctx context.Context, app *repository.Application) (*repository.Application, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return d.PostgresDriver.WriteApplication(app)
+}
+
+// UpdateApplication updates fields available in options in db
+func (d *Driver) UpdateApplication(ctx context.Context, id string, options *repository.UpdateApplication) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	return d.PostgresDriver.UpdateApplication(id, options)
+}
+
+// UpdateFirstDateSurpassed sets the first date surpassed of the applications in db
+func (d *Driver) UpdateFirstDateSurpassed(ctx context.Context, firstDateSurpassed *repository.UpdateFirstDateSurpassed) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	return d.PostgresDriver.UpdateFirstDateSurpassed(firstDateSurpassed)
+}
+
+// RemoveApplication sets the application as awaiting grace period in db
+func (d *Driver) RemoveApplication(ctx context.Context, id string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	return d.PostgresDriver.RemoveApplication(id)
+}
+
+// WriteBlockchain saves input blockchain in the database
+func (d *Driver) WriteBlockchain(ctx context.Context, blockchain *repository.Blockchain) (*repository.Blockchain, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return d.PostgresDriver.WriteBlockchain(blockchain)
+}
+
+// ActivateBlockchain sets the active state of the blockchain in db
+func (d *Driver) ActivateBlockchain(ctx context.Context, id string, active bool) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	return d.PostgresDriver.ActivateBlockchain(id, active)
+}
+
+// WriteRedirect saves input redirect in the database, with the ID given by the database
+func (d *Driver) WriteRedirect(ctx context.Context, redirect *repository.Redirect) (*repository.Redirect, error) {
+	saved, err := d.WriteRedirects(ctx, []*repository.Redirect{redirect})
+	if err != nil {
+		return nil, err
+	}
+
+	return saved[0], nil
+}
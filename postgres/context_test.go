@@ -0,0 +1,36 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	postgresdriver "github.com/pokt-foundation/portal-api-go/postgres-driver"
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDriver_CancelledContext(t *testing.T) {
+	c := require.New(t)
+
+	db, mock, err := sqlmock.New()
+	c.NoError(err)
+
+	defer db.Close()
+
+	driver := NewDriverFromSQLDBInstance(db, postgresdriver.NewListenerMock())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// upstream writes are skipped
+	_, err = driver.WriteApplication(ctx, &repository.Application{Status: repository.InService, PayPlanType: repository.FreetierV0})
+	c.ErrorIs(err, context.Canceled)
+
+	c.ErrorIs(driver.UpdateApplication(ctx, "5f62b7d8be3591c4dea8566d", &repository.UpdateApplication{Name: "pablo"}), context.Canceled)
+
+	// transactions are not started
+	c.ErrorIs(driver.UpdateApplicationsStatus(ctx, []string{"5f62b7d8be3591c4dea8566d"}, repository.InService), context.Canceled)
+
+	c.NoError(mock.ExpectationsWereMet())
+}
@@ -0,0 +1,73 @@
+package postgres
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/pokt-foundation/pocket-http-db/types"
+)
+
+const (
+	upsertInstanceScript = `
+	INSERT into instances (instance_id, cache_generation, cache_refreshed_at, last_seen)
+	VALUES (:instance_id, :cache_generation, :cache_refreshed_at, :last_seen)
+	ON CONFLICT (instance_id) DO UPDATE SET
+	cache_generation = EXCLUDED.cache_generation,
+	cache_refreshed_at = EXCLUDED.cache_refreshed_at,
+	last_seen = EXCLUDED.last_seen`
+	selectInstancesScript = `
+	SELECT instance_id, cache_generation, cache_refreshed_at, last_seen
+	FROM instances
+	WHERE last_seen >= $1
+	ORDER BY instance_id`
+)
+
+type dbInstance struct {
+	InstanceID       string       `db:"instance_id"`
+	CacheGeneration  int64        `db:"cache_generation"`
+	CacheRefreshedAt sql.NullTime `db:"cache_refreshed_at"`
+	LastSeen         time.Time    `db:"last_seen"`
+}
+
+// WriteInstance saves the heartbeat of instance, replacing its previous one
+func (d *Driver) WriteInstance(instance *types.Instance) error {
+	if instance.ID == "" {
+		return ErrMissingID
+	}
+
+	if instance.LastSeen.IsZero() {
+		instance.LastSeen = time.Now()
+	}
+
+	_, err := d.NamedExec(upsertInstanceScript, &dbInstance{
+		InstanceID:       instance.ID,
+		CacheGeneration:  int64(instance.CacheGeneration),
+		CacheRefreshedAt: sql.NullTime{Time: instance.CacheRefreshedAt, Valid: !instance.CacheRefreshedAt.IsZero()},
+		LastSeen:         instance.LastSeen,
+	})
+
+	return err
+}
+
+// ReadInstances returns the instances whose last heartbeat is not older than since
+func (d *Driver) ReadInstances(since time.Time) ([]*types.Instance, error) {
+	var dbInstances []*dbInstance
+
+	err := d.Select(&dbInstances, selectInstancesScript, since)
+	if err != nil {
+		return nil, err
+	}
+
+	instances := make([]*types.Instance, 0, len(dbInstances))
+
+	for _, dbInstance := range dbInstances {
+		instances = append(instances, &types.Instance{
+			ID:               dbInstance.InstanceID,
+			CacheGeneration:  uint64(dbInstance.CacheGeneration),
+			CacheRefreshedAt: dbInstance.CacheRefreshedAt.Time,
+			LastSeen:         dbInstance.LastSeen,
+		})
+	}
+
+	return instances, nil
+}
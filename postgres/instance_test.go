@@ -0,0 +1,77 @@
+package postgres
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/pokt-foundation/pocket-http-db/types"
+	postgresdriver "github.com/pokt-foundation/portal-api-go/postgres-driver"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDriver_WriteInstance(t *testing.T) {
+	c := require.New(t)
+
+	db, mock, err := sqlmock.New()
+	c.NoError(err)
+
+	defer db.Close()
+
+	driver := NewDriverFromSQLDBInstance(db, postgresdriver.NewListenerMock())
+
+	mock.ExpectExec("INSERT into instances").
+		WithArgs("pocket-http-db-1", 3, sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	instance := &types.Instance{ID: "pocket-http-db-1", CacheGeneration: 3}
+
+	err = driver.WriteInstance(instance)
+	c.NoError(err)
+	c.False(instance.LastSeen.IsZero())
+
+	mock.ExpectExec("INSERT into instances").WillReturnError(errors.New("dummy error"))
+
+	err = driver.WriteInstance(instance)
+	c.EqualError(err, "dummy error")
+
+	err = driver.WriteInstance(&types.Instance{})
+	c.Equal(ErrMissingID, err)
+
+	c.NoError(mock.ExpectationsWereMet())
+}
+
+func TestDriver_ReadInstances(t *testing.T) {
+	c := require.New(t)
+
+	db, mock, err := sqlmock.New()
+	c.NoError(err)
+
+	defer db.Close()
+
+	driver := NewDriverFromSQLDBInstance(db, postgresdriver.NewListenerMock())
+
+	since := time.Now().Add(-time.Minute)
+	lastSeen := time.Now()
+
+	rows := sqlmock.NewRows([]string{"instance_id", "cache_generation", "cache_refreshed_at", "last_seen"}).
+		AddRow("pocket-http-db-1", 3, lastSeen, lastSeen).
+		AddRow("pocket-http-db-2", 0, nil, lastSeen)
+
+	mock.ExpectQuery("SELECT instance_id, cache_generation").WithArgs(since).WillReturnRows(rows)
+
+	instances, err := driver.ReadInstances(since)
+	c.NoError(err)
+	c.Len(instances, 2)
+	c.Equal("pocket-http-db-1", instances[0].ID)
+	c.Equal(uint64(3), instances[0].CacheGeneration)
+	c.True(instances[1].CacheRefreshedAt.IsZero())
+
+	mock.ExpectQuery("SELECT instance_id, cache_generation").WillReturnError(errors.New("dummy error"))
+
+	_, err = driver.ReadInstances(since)
+	c.EqualError(err, "dummy error")
+
+	c.NoError(mock.ExpectationsWereMet())
+}
@@ -0,0 +1,101 @@
+package postgres
+
+import (
+	"context"
+	"sort"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pokt-foundation/pocket-http-db/types"
+)
+
+const (
+	selectLabelsScript = `
+	SELECT entity_type, entity_id, label_key, label_value
+	FROM entity_labels
+	ORDER BY entity_type, entity_id, label_key`
+	deleteEntityLabelsScript = `DELETE FROM entity_labels WHERE entity_type = $1 AND entity_id = $2`
+	insertLabelScript        = `
+	INSERT into entity_labels (entity_type, entity_id, label_key, label_value)
+	VALUES ($1, $2, $3, $4)`
+)
+
+type dbLabel struct {
+	EntityType string `db:"entity_type"`
+	EntityID   string `db:"entity_id"`
+	Key        string `db:"label_key"`
+	Value      string `db:"label_value"`
+}
+
+// ReadLabels returns the labels of every labeled entity
+func (d *Driver) ReadLabels() ([]*types.EntityLabels, error) {
+	var dbLabels []*dbLabel
+
+	err := d.Select(&dbLabels, selectLabelsScript)
+	if err != nil {
+		return nil, err
+	}
+
+	var entityLabels []*types.EntityLabels
+
+	for _, label := range dbLabels {
+		entityType := types.EntityType(label.EntityType)
+
+		// rows are sorted by entity, so the labels of an entity are contiguous
+		last := len(entityLabels) - 1
+		if last < 0 || entityLabels[last].EntityType != entityType || entityLabels[last].EntityID != label.EntityID {
+			entityLabels = append(entityLabels, &types.EntityLabels{
+				EntityType: entityType,
+				EntityID:   label.EntityID,
+				Labels:     map[string]string{},
+			})
+			last++
+		}
+
+		entityLabels[last].Labels[label.Key] = label.Value
+	}
+
+	return entityLabels, nil
+}
+
+// WriteLabels replaces the labels of the entity, removing them if labels is empty
+func (d *Driver) WriteLabels(ctx context.Context, entityType types.EntityType, entityID string, labels map[string]string) error {
+	if entityID == "" {
+		return ErrMissingID
+	}
+
+	tx, err := d.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	err = writeLabelsInTx(tx, entityType, entityID, labels)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func writeLabelsInTx(tx *sqlx.Tx, entityType types.EntityType, entityID string, labels map[string]string) error {
+	_, err := tx.Exec(deleteEntityLabelsScript, entityType, entityID)
+	if err != nil {
+		return err
+	}
+
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		_, err = tx.Exec(insertLabelScript, entityType, entityID, key, labels[key])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/pokt-foundation/pocket-http-db/types"
+	postgresdriver "github.com/pokt-foundation/portal-api-go/postgres-driver"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDriver_ReadLabels(t *testing.T) {
+	c := require.New(t)
+
+	db, mock, err := sqlmock.New()
+	c.NoError(err)
+
+	defer db.Close()
+
+	driver := NewDriverFromSQLDBInstance(db, postgresdriver.NewListenerMock())
+
+	rows := sqlmock.NewRows([]string{"entity_type", "entity_id", "label_key", "label_value"}).
+		AddRow("application", "5f62b7d8be3591c4dea8566d", "env", "prod").
+		AddRow("application", "5f62b7d8be3591c4dea8566d", "team", "infra").
+		AddRow("load_balancer", "60ecb2bf67774900350d9c42", "team", "infra")
+
+	mock.ExpectQuery("SELECT entity_type, entity_id, label_key, label_value").WillReturnRows(rows)
+
+	labels, err := driver.ReadLabels()
+	c.NoError(err)
+	c.Equal([]*types.EntityLabels{
+		{
+			EntityType: types.EntityApplication,
+			EntityID:   "5f62b7d8be3591c4dea8566d",
+			Labels:     map[string]string{"env": "prod", "team": "infra"},
+		},
+		{
+			EntityType: types.EntityLoadBalancer,
+			EntityID:   "60ecb2bf67774900350d9c42",
+			Labels:     map[string]string{"team": "infra"},
+		},
+	}, labels)
+
+	mock.ExpectQuery("SELECT entity_type, entity_id, label_key, label_value").WillReturnError(errors.New("dummy error"))
+
+	_, err = driver.ReadLabels()
+	c.EqualError(err, "dummy error")
+
+	c.NoError(mock.ExpectationsWereMet())
+}
+
+func TestDriver_WriteLabels(t *testing.T) {
+	c := require.New(t)
+
+	db, mock, err := sqlmock.New()
+	c.NoError(err)
+
+	defer db.Close()
+
+	driver := NewDriverFromSQLDBInstance(db, postgresdriver.NewListenerMock())
+
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM entity_labels").WithArgs("application", "5f62b7d8be3591c4dea8566d").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT into entity_labels").WithArgs("application", "5f62b7d8be3591c4dea8566d", "env", "prod").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT into entity_labels").WithArgs("application", "5f62b7d8be3591c4dea8566d", "team", "infra").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	err = driver.WriteLabels(context.Background(), types.EntityApplication, "5f62b7d8be3591c4dea8566d", map[string]string{"team": "infra", "env": "prod"})
+	c.NoError(err)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM entity_labels").WillReturnError(errors.New("dummy error"))
+	mock.ExpectRollback()
+
+	err = driver.WriteLabels(context.Background(), types.EntityApplication, "5f62b7d8be3591c4dea8566d", nil)
+	c.EqualError(err, "dummy error")
+
+	err = driver.WriteLabels(context.Background(), types.EntityApplication, "", nil)
+	c.Equal(ErrMissingID, err)
+
+	c.NoError(mock.ExpectationsWereMet())
+}
@@ -0,0 +1,147 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pokt-foundation/pocket-http-db/types"
+)
+
+const (
+	selectLoadBalancerForUpdate = `
+	SELECT lb_id FROM loadbalancers
+	WHERE lb_id = $1
+	FOR UPDATE`
+	// applications added without a position, as upstream does, come after the ordered ones in the order they were added
+	selectLoadBalancerApps = `
+	SELECT app_id, position FROM lb_apps
+	WHERE lb_id = $1
+	ORDER BY position NULLS LAST, id`
+	selectLoadBalancersAppsScript = `
+	SELECT lb_id, app_id FROM lb_apps
+	ORDER BY lb_id, position NULLS LAST, id`
+	deleteLoadBalancerApp               = `DELETE FROM lb_apps WHERE lb_id = $1 AND app_id = $2`
+	insertLoadBalancerAppScript         = `INSERT into lb_apps (lb_id, app_id, position) VALUES ($1, $2, $3)`
+	updateLoadBalancerAppPositionScript = `UPDATE lb_apps SET position = $3 WHERE lb_id = $1 AND app_id = $2`
+)
+
+type dbLoadBalancerApp struct {
+	LbID     string        `db:"lb_id"`
+	AppID    string        `db:"app_id"`
+	Position sql.NullInt64 `db:"position"`
+}
+
+// ReadLoadBalancerApplicationOrders returns the IDs of the applications of every load balancer in their order
+func (d *Driver) ReadLoadBalancerApplicationOrders() (map[string][]string, error) {
+	var dbApps []*dbLoadBalancerApp
+
+	err := d.Select(&dbApps, selectLoadBalancersAppsScript)
+	if err != nil {
+		return nil, err
+	}
+
+	orders := make(map[string][]string)
+
+	for _, app := range dbApps {
+		orders[app.LbID] = append(orders[app.LbID], app.AppID)
+	}
+
+	return orders, nil
+}
+
+// SetLoadBalancerApplications replaces the applications of the load balancer with appIDs, in their order
+// version is the types.LoadBalancerAppsVersion of the applications the change is based on,
+// a *types.LoadBalancerAppsConflictError with the current applications is returned if they changed since
+func (d *Driver) SetLoadBalancerApplications(ctx context.Context, lbID string, appIDs []string, version string) error {
+	if lbID == "" {
+		return ErrMissingID
+	}
+
+	tx, err := d.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	err = setLoadBalancerAppsInTx(tx, lbID, appIDs, version)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// setLoadBalancerAppsInTx locks the load balancer row so concurrent membership changes are serialized,
+// then only deletes, inserts and moves the applications that differ
+func setLoadBalancerAppsInTx(tx *sqlx.Tx, lbID string, appIDs []string, version string) error {
+	var lockedID string
+
+	err := tx.Get(&lockedID, selectLoadBalancerForUpdate, lbID)
+	if err != nil {
+		return err
+	}
+
+	var currentApps []*dbLoadBalancerApp
+
+	err = tx.Select(&currentApps, selectLoadBalancerApps, lbID)
+	if err != nil {
+		return err
+	}
+
+	currentIDs := make([]string, 0, len(currentApps))
+	positions := make(map[string]sql.NullInt64, len(currentApps))
+
+	for _, app := range currentApps {
+		currentIDs = append(currentIDs, app.AppID)
+		positions[app.AppID] = app.Position
+	}
+
+	if currentVersion := types.LoadBalancerAppsVersion(currentIDs); currentVersion != version {
+		return &types.LoadBalancerAppsConflictError{ApplicationIDs: currentIDs, Version: currentVersion}
+	}
+
+	wanted := make(map[string]bool, len(appIDs))
+	for _, appID := range appIDs {
+		wanted[appID] = true
+	}
+
+	for _, appID := range currentIDs {
+		if wanted[appID] {
+			continue
+		}
+
+		_, err = tx.Exec(deleteLoadBalancerApp, lbID, appID)
+		if err != nil {
+			return err
+		}
+	}
+
+	written := make(map[string]bool, len(appIDs))
+	position := 0
+
+	for _, appID := range appIDs {
+		if written[appID] {
+			continue // duplicated IDs are only written once, at their first position
+		}
+
+		written[appID] = true
+
+		currentPosition, ok := positions[appID]
+
+		switch {
+		case !ok:
+			_, err = tx.Exec(insertLoadBalancerAppScript, lbID, appID, position)
+		case !currentPosition.Valid || currentPosition.Int64 != int64(position):
+			_, err = tx.Exec(updateLoadBalancerAppPositionScript, lbID, appID, position)
+		}
+
+		if err != nil {
+			return err
+		}
+
+		position++
+	}
+
+	return nil
+}
@@ -0,0 +1,118 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/pokt-foundation/pocket-http-db/types"
+	postgresdriver "github.com/pokt-foundation/portal-api-go/postgres-driver"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDriver_SetLoadBalancerApplications(t *testing.T) {
+	c := require.New(t)
+
+	db, mock, err := sqlmock.New()
+	c.NoError(err)
+
+	defer db.Close()
+
+	driver := NewDriverFromSQLDBInstance(db, postgresdriver.NewListenerMock())
+
+	currentIDs := []string{"5f62b7d8be3591c4dea8566d", "5f62b7d8be3591c4dea8566a"}
+	version := types.LoadBalancerAppsVersion(currentIDs)
+
+	currentRows := func() *sqlmock.Rows {
+		return sqlmock.NewRows([]string{"app_id", "position"}).AddRow(currentIDs[0], nil).AddRow(currentIDs[1], nil)
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT lb_id FROM loadbalancers").WithArgs("60ecb2bf67774900350d9c42").
+		WillReturnRows(sqlmock.NewRows([]string{"lb_id"}).AddRow("60ecb2bf67774900350d9c42"))
+	mock.ExpectQuery("SELECT app_id, position FROM lb_apps").WithArgs("60ecb2bf67774900350d9c42").WillReturnRows(currentRows())
+	mock.ExpectExec("DELETE FROM lb_apps").WithArgs("60ecb2bf67774900350d9c42", "5f62b7d8be3591c4dea8566a").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE lb_apps SET position").WithArgs("60ecb2bf67774900350d9c42", "5f62b7d8be3591c4dea8566d", 0).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT into lb_apps").WithArgs("60ecb2bf67774900350d9c42", "5f62b7d8be3591c4dea8566f", 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	err = driver.SetLoadBalancerApplications(context.Background(), "60ecb2bf67774900350d9c42",
+		[]string{"5f62b7d8be3591c4dea8566d", "5f62b7d8be3591c4dea8566f", "5f62b7d8be3591c4dea8566f"}, version)
+	c.NoError(err)
+
+	// only the applications out of place are moved
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT lb_id FROM loadbalancers").WithArgs("60ecb2bf67774900350d9c42").
+		WillReturnRows(sqlmock.NewRows([]string{"lb_id"}).AddRow("60ecb2bf67774900350d9c42"))
+	mock.ExpectQuery("SELECT app_id, position FROM lb_apps").WithArgs("60ecb2bf67774900350d9c42").
+		WillReturnRows(sqlmock.NewRows([]string{"app_id", "position"}).AddRow(currentIDs[0], 0).AddRow(currentIDs[1], 1))
+	mock.ExpectExec("UPDATE lb_apps SET position").WithArgs("60ecb2bf67774900350d9c42", "5f62b7d8be3591c4dea8566a", 0).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE lb_apps SET position").WithArgs("60ecb2bf67774900350d9c42", "5f62b7d8be3591c4dea8566d", 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err = driver.SetLoadBalancerApplications(context.Background(), "60ecb2bf67774900350d9c42",
+		[]string{"5f62b7d8be3591c4dea8566a", "5f62b7d8be3591c4dea8566d"}, version)
+	c.NoError(err)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT lb_id FROM loadbalancers").WithArgs("60ecb2bf67774900350d9c42").
+		WillReturnRows(sqlmock.NewRows([]string{"lb_id"}).AddRow("60ecb2bf67774900350d9c42"))
+	mock.ExpectQuery("SELECT app_id, position FROM lb_apps").WithArgs("60ecb2bf67774900350d9c42").WillReturnRows(currentRows())
+	mock.ExpectRollback()
+
+	err = driver.SetLoadBalancerApplications(context.Background(), "60ecb2bf67774900350d9c42", []string{}, "stale")
+	c.ErrorIs(err, types.ErrLoadBalancerAppsConflict)
+
+	var conflict *types.LoadBalancerAppsConflictError
+	c.ErrorAs(err, &conflict)
+	c.Equal(currentIDs, conflict.ApplicationIDs)
+	c.Equal(version, conflict.Version)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT lb_id FROM loadbalancers").WillReturnError(errors.New("dummy error"))
+	mock.ExpectRollback()
+
+	err = driver.SetLoadBalancerApplications(context.Background(), "60ecb2bf67774900350d9c42", []string{}, version)
+	c.EqualError(err, "dummy error")
+
+	err = driver.SetLoadBalancerApplications(context.Background(), "", []string{}, version)
+	c.Equal(ErrMissingID, err)
+
+	c.NoError(mock.ExpectationsWereMet())
+}
+
+func TestDriver_ReadLoadBalancerApplicationOrders(t *testing.T) {
+	c := require.New(t)
+
+	db, mock, err := sqlmock.New()
+	c.NoError(err)
+
+	defer db.Close()
+
+	driver := NewDriverFromSQLDBInstance(db, postgresdriver.NewListenerMock())
+
+	mock.ExpectQuery("SELECT lb_id, app_id FROM lb_apps").WillReturnRows(sqlmock.NewRows([]string{"lb_id", "app_id"}).
+		AddRow("60ecb2bf67774900350d9c42", "5f62b7d8be3591c4dea8566a").
+		AddRow("60ecb2bf67774900350d9c42", "5f62b7d8be3591c4dea8566d").
+		AddRow("60ecb2bf67774900350d9c43", "5f62b7d8be3591c4dea8566f"))
+
+	orders, err := driver.ReadLoadBalancerApplicationOrders()
+	c.NoError(err)
+	c.Equal(map[string][]string{
+		"60ecb2bf67774900350d9c42": {"5f62b7d8be3591c4dea8566a", "5f62b7d8be3591c4dea8566d"},
+		"60ecb2bf67774900350d9c43": {"5f62b7d8be3591c4dea8566f"},
+	}, orders)
+
+	mock.ExpectQuery("SELECT lb_id, app_id FROM lb_apps").WillReturnError(errors.New("dummy error"))
+
+	_, err = driver.ReadLoadBalancerApplicationOrders()
+	c.EqualError(err, "dummy error")
+
+	c.NoError(mock.ExpectationsWereMet())
+}
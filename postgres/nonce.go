@@ -0,0 +1,51 @@
+package postgres
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	deleteExpiredNoncesScript = `DELETE FROM request_nonces WHERE expires_at <= $1`
+	insertNonceScript         = `
+	INSERT into request_nonces (nonce, expires_at)
+	VALUES ($1, $2)
+	ON CONFLICT (nonce) DO NOTHING`
+	deleteNonceScript = `DELETE FROM request_nonces WHERE nonce = $1`
+)
+
+// UseNonce marks nonce as used until expiresAt, returns false if it was already in use
+// expired nonces are deleted first, so an expired nonce can be used again
+func (d *Driver) UseNonce(ctx context.Context, nonce string, expiresAt time.Time) (bool, error) {
+	if nonce == "" {
+		return false, ErrMissingID
+	}
+
+	_, err := d.ExecContext(ctx, deleteExpiredNoncesScript, time.Now())
+	if err != nil {
+		return false, err
+	}
+
+	result, err := d.ExecContext(ctx, insertNonceScript, nonce, expiresAt)
+	if err != nil {
+		return false, err
+	}
+
+	inserted, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return inserted == 1, nil
+}
+
+// ReleaseNonce deletes nonce, so it can be used again
+func (d *Driver) ReleaseNonce(ctx context.Context, nonce string) error {
+	if nonce == "" {
+		return ErrMissingID
+	}
+
+	_, err := d.ExecContext(ctx, deleteNonceScript, nonce)
+
+	return err
+}
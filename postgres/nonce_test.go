@@ -0,0 +1,72 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	postgresdriver "github.com/pokt-foundation/portal-api-go/postgres-driver"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDriver_UseNonce(t *testing.T) {
+	c := require.New(t)
+
+	db, mock, err := sqlmock.New()
+	c.NoError(err)
+
+	defer db.Close()
+
+	driver := NewDriverFromSQLDBInstance(db, postgresdriver.NewListenerMock())
+
+	expiresAt := time.Now().Add(5 * time.Minute)
+
+	mock.ExpectExec("DELETE FROM request_nonces").WithArgs(sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec("INSERT into request_nonces").WithArgs("a1b2c3d4", expiresAt).WillReturnResult(sqlmock.NewResult(1, 1))
+
+	ok, err := driver.UseNonce(context.Background(), "a1b2c3d4", expiresAt)
+	c.NoError(err)
+	c.True(ok)
+
+	mock.ExpectExec("DELETE FROM request_nonces").WithArgs(sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("INSERT into request_nonces").WithArgs("a1b2c3d4", expiresAt).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	ok, err = driver.UseNonce(context.Background(), "a1b2c3d4", expiresAt)
+	c.NoError(err)
+	c.False(ok)
+
+	mock.ExpectExec("DELETE FROM request_nonces").WillReturnError(errors.New("dummy error"))
+
+	_, err = driver.UseNonce(context.Background(), "a1b2c3d4", expiresAt)
+	c.EqualError(err, "dummy error")
+
+	_, err = driver.UseNonce(context.Background(), "", expiresAt)
+	c.Equal(ErrMissingID, err)
+
+	c.NoError(mock.ExpectationsWereMet())
+}
+
+func TestDriver_ReleaseNonce(t *testing.T) {
+	c := require.New(t)
+
+	db, mock, err := sqlmock.New()
+	c.NoError(err)
+
+	defer db.Close()
+
+	driver := NewDriverFromSQLDBInstance(db, postgresdriver.NewListenerMock())
+
+	mock.ExpectExec("DELETE FROM request_nonces WHERE nonce").WithArgs("a1b2c3d4").WillReturnResult(sqlmock.NewResult(0, 1))
+
+	c.NoError(driver.ReleaseNonce(context.Background(), "a1b2c3d4"))
+
+	mock.ExpectExec("DELETE FROM request_nonces WHERE nonce").WithArgs("a1b2c3d4").WillReturnError(errors.New("dummy error"))
+
+	c.EqualError(driver.ReleaseNonce(context.Background(), "a1b2c3d4"), "dummy error")
+
+	c.Equal(ErrMissingID, driver.ReleaseNonce(context.Background(), ""))
+
+	c.NoError(mock.ExpectationsWereMet())
+}
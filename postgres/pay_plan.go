@@ -0,0 +1,49 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/pokt-foundation/portal-api-go/repository"
+)
+
+const (
+	insertPayPlanScript = `
+	INSERT INTO pay_plans (plan_type, daily_limit) VALUES ($1, $2)`
+	updatePayPlanDailyLimitScript = `
+	UPDATE pay_plans SET daily_limit = $1 WHERE plan_type = $2`
+	deletePayPlanScript = `
+	DELETE FROM pay_plans WHERE plan_type = $1`
+)
+
+// WritePayPlan saves the new pay plan, the type of an existing plan can't be saved again
+func (d *Driver) WritePayPlan(ctx context.Context, plan *repository.PayPlan) error {
+	if plan.PlanType == "" {
+		return ErrMissingID
+	}
+
+	_, err := d.ExecContext(ctx, insertPayPlanScript, plan.PlanType, plan.DailyLimit)
+
+	return err
+}
+
+// UpdatePayPlanDailyLimit sets the daily limit of the pay plan of planType
+func (d *Driver) UpdatePayPlanDailyLimit(ctx context.Context, planType repository.PayPlanType, dailyLimit int) error {
+	if planType == "" {
+		return ErrMissingID
+	}
+
+	_, err := d.ExecContext(ctx, updatePayPlanDailyLimitScript, dailyLimit, planType)
+
+	return err
+}
+
+// RemovePayPlan permanently deletes the pay plan of planType, the plans of applications can't be deleted
+func (d *Driver) RemovePayPlan(ctx context.Context, planType repository.PayPlanType) error {
+	if planType == "" {
+		return ErrMissingID
+	}
+
+	_, err := d.ExecContext(ctx, deletePayPlanScript, planType)
+
+	return err
+}
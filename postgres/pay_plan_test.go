@@ -0,0 +1,89 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	postgresdriver "github.com/pokt-foundation/portal-api-go/postgres-driver"
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDriver_UpdatePayPlanDailyLimit(t *testing.T) {
+	c := require.New(t)
+
+	db, mock, err := sqlmock.New()
+	c.NoError(err)
+
+	defer db.Close()
+
+	driver := NewDriverFromSQLDBInstance(db, postgresdriver.NewListenerMock())
+
+	mock.ExpectExec("UPDATE pay_plans").WithArgs(500000, repository.FreetierV0).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = driver.UpdatePayPlanDailyLimit(context.Background(), repository.FreetierV0, 500000)
+	c.NoError(err)
+
+	mock.ExpectExec("UPDATE pay_plans").WillReturnError(errors.New("dummy error"))
+
+	err = driver.UpdatePayPlanDailyLimit(context.Background(), repository.FreetierV0, 500000)
+	c.EqualError(err, "dummy error")
+
+	err = driver.UpdatePayPlanDailyLimit(context.Background(), "", 500000)
+	c.Equal(ErrMissingID, err)
+
+	c.NoError(mock.ExpectationsWereMet())
+}
+
+func TestDriver_WritePayPlan(t *testing.T) {
+	c := require.New(t)
+
+	db, mock, err := sqlmock.New()
+	c.NoError(err)
+
+	defer db.Close()
+
+	driver := NewDriverFromSQLDBInstance(db, postgresdriver.NewListenerMock())
+
+	mock.ExpectExec("INSERT INTO pay_plans").WithArgs(repository.PayPlanType("ENTERPRISE_V0"), 1000000).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = driver.WritePayPlan(context.Background(), &repository.PayPlan{PlanType: "ENTERPRISE_V0", DailyLimit: 1000000})
+	c.NoError(err)
+
+	mock.ExpectExec("INSERT INTO pay_plans").WillReturnError(errors.New("dummy error"))
+
+	err = driver.WritePayPlan(context.Background(), &repository.PayPlan{PlanType: "ENTERPRISE_V0"})
+	c.EqualError(err, "dummy error")
+
+	err = driver.WritePayPlan(context.Background(), &repository.PayPlan{})
+	c.Equal(ErrMissingID, err)
+
+	c.NoError(mock.ExpectationsWereMet())
+}
+
+func TestDriver_RemovePayPlan(t *testing.T) {
+	c := require.New(t)
+
+	db, mock, err := sqlmock.New()
+	c.NoError(err)
+
+	defer db.Close()
+
+	driver := NewDriverFromSQLDBInstance(db, postgresdriver.NewListenerMock())
+
+	mock.ExpectExec("DELETE FROM pay_plans").WithArgs(repository.PayPlanType("ENTERPRISE_V0")).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	c.NoError(driver.RemovePayPlan(context.Background(), "ENTERPRISE_V0"))
+
+	mock.ExpectExec("DELETE FROM pay_plans").WillReturnError(errors.New("dummy error"))
+
+	c.EqualError(driver.RemovePayPlan(context.Background(), "ENTERPRISE_V0"), "dummy error")
+	c.Equal(ErrMissingID, driver.RemovePayPlan(context.Background(), ""))
+
+	c.NoError(mock.ExpectationsWereMet())
+}
@@ -0,0 +1,47 @@
+// Package postgres extends the portal-api-go postgres driver with the writes
+// needed by this service that are not available upstream
+package postgres
+
+import (
+	"database/sql"
+	"errors"
+
+	postgresdriver "github.com/pokt-foundation/portal-api-go/postgres-driver"
+)
+
+var (
+	// ErrMissingID error when ID is missing
+	ErrMissingID = errors.New("missing id")
+)
+
+// Driver struct handler for PostgresDB related functions
+type Driver struct {
+	*postgresdriver.PostgresDriver
+}
+
+// NewDriverFromConnectionString returns Driver instance from connection string
+func NewDriverFromConnectionString(connectionString string, listener postgresdriver.Listener) (*Driver, error) {
+	driver, err := postgresdriver.NewPostgresDriverFromConnectionString(connectionString, listener)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Driver{PostgresDriver: driver}, nil
+}
+
+// NewDriverFromSQLDBInstance returns Driver instance from sql.DB instance
+// mostly used for mocking tests
+func NewDriverFromSQLDBInstance(db *sql.DB, listener postgresdriver.Listener) *Driver {
+	return &Driver{PostgresDriver: postgresdriver.NewPostgresDriverFromSQLDBInstance(db, listener)}
+}
+
+func newSQLNullString(value string) sql.NullString {
+	if value == "" {
+		return sql.NullString{}
+	}
+
+	return sql.NullString{
+		String: value,
+		Valid:  true,
+	}
+}
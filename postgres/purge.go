@@ -0,0 +1,236 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/pokt-foundation/pocket-http-db/types"
+	"github.com/pokt-foundation/portal-api-go/repository"
+)
+
+const (
+	selectPurgeableApplications = `
+	SELECT application_id FROM applications
+	WHERE application_id = ANY($1) AND status = $2
+	FOR UPDATE`
+	selectPurgeableLoadBalancers = `
+	SELECT lb_id FROM loadbalancers
+	WHERE lb_id = ANY($1) AND user_id = ''
+	FOR UPDATE`
+)
+
+// applicationPurges deletes the rows of applications, children before the applications table
+var applicationPurges = []string{
+	`DELETE FROM gateway_aat WHERE application_id = ANY($1)`,
+	`DELETE FROM gateway_settings WHERE application_id = ANY($1)`,
+	`DELETE FROM notification_settings WHERE application_id = ANY($1)`,
+	`DELETE FROM lb_apps WHERE app_id = ANY($1)`,
+	`DELETE FROM applications WHERE application_id = ANY($1)`,
+}
+
+// loadBalancerPurges deletes the rows of load balancers, children before the loadbalancers table
+var loadBalancerPurges = []string{
+	`DELETE FROM stickiness_options WHERE lb_id = ANY($1)`,
+	`DELETE FROM lb_apps WHERE lb_id = ANY($1)`,
+	`DELETE FROM loadbalancers WHERE lb_id = ANY($1)`,
+}
+
+// PurgeApplications permanently deletes the applications in ids that are still awaiting grace period
+// returns the IDs of the deleted applications
+func (d *Driver) PurgeApplications(ids []string) ([]string, error) {
+	return d.purge(ids, applicationPurges, selectPurgeableApplications, string(repository.AwaitingGracePeriod))
+}
+
+// PurgeLoadBalancers permanently deletes the load balancers in ids that are still removed
+// returns the IDs of the deleted load balancers
+func (d *Driver) PurgeLoadBalancers(ids []string) ([]string, error) {
+	return d.purge(ids, loadBalancerPurges, selectPurgeableLoadBalancers)
+}
+
+// purge locks the rows in ids still matching selectQuery and runs the deletes on them in a single transaction,
+// so entities restored since they were picked are kept
+func (d *Driver) purge(ids []string, deletes []string, selectQuery string, selectArgs ...any) ([]string, error) {
+	if len(ids) == 0 {
+		return nil, ErrMissingID
+	}
+
+	tx, err := d.Beginx()
+	if err != nil {
+		return nil, err
+	}
+
+	purged, err := purgeInTx(tx, ids, deletes, selectQuery, selectArgs...)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	return purged, tx.Commit()
+}
+
+func purgeInTx(tx *sqlx.Tx, ids []string, deletes []string, selectQuery string, selectArgs ...any) ([]string, error) {
+	var purgeable []string
+
+	err := tx.Select(&purgeable, selectQuery, append([]any{pq.StringArray(ids)}, selectArgs...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(purgeable) == 0 {
+		return nil, nil
+	}
+
+	for _, query := range deletes {
+		_, err = tx.Exec(query, pq.StringArray(purgeable))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return purgeable, nil
+}
+
+const (
+	selectOrphanApplications = `
+	SELECT application_id FROM applications
+	WHERE application_id = ANY($1)
+	AND NOT EXISTS (SELECT 1 FROM lb_apps WHERE lb_apps.app_id = applications.application_id AND lb_apps.lb_id <> $2)
+	FOR UPDATE`
+	selectDeletableLoadBalancer = `SELECT lb_id FROM loadbalancers WHERE lb_id = ANY($1) FOR UPDATE`
+	deleteEntityLabels          = `DELETE FROM entity_labels WHERE entity_type = $1 AND entity_id = ANY($2)`
+)
+
+// DeleteLoadBalancer permanently deletes the load balancer with given id, whatever its state,
+// along with the applications in orphanAppIDs that no other load balancer holds, in a single transaction
+// the other applications of the load balancer are detached from it, returns the IDs of the deleted applications
+func (d *Driver) DeleteLoadBalancer(ctx context.Context, id string, orphanAppIDs []string) ([]string, error) {
+	if id == "" {
+		return nil, ErrMissingID
+	}
+
+	tx, err := d.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	deleted, err := deleteLoadBalancerInTx(tx, id, orphanAppIDs)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	return deleted, tx.Commit()
+}
+
+func deleteLoadBalancerInTx(tx *sqlx.Tx, id string, orphanAppIDs []string) ([]string, error) {
+	var deletedApps []string
+
+	if len(orphanAppIDs) > 0 {
+		var err error
+
+		deletedApps, err = purgeInTx(tx, orphanAppIDs, applicationPurges, selectOrphanApplications, id)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(deletedApps) > 0 {
+			_, err = tx.Exec(deleteEntityLabels, types.EntityApplication, pq.StringArray(deletedApps))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	_, err := purgeInTx(tx, []string{id}, loadBalancerPurges, selectDeletableLoadBalancer)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.Exec(deleteEntityLabels, types.EntityLoadBalancer, pq.StringArray{id})
+	if err != nil {
+		return nil, err
+	}
+
+	return deletedApps, nil
+}
+
+const (
+	selectUserApplications = `
+	SELECT application_id FROM applications
+	WHERE application_id = ANY($1) OR user_id = $2
+	FOR UPDATE`
+	selectUserLoadBalancers = `
+	SELECT lb_id FROM loadbalancers
+	WHERE lb_id = ANY($1) OR user_id = $2
+	FOR UPDATE`
+	selectSharedContactApplications = `
+	SELECT application_id FROM applications
+	WHERE contact_email = ANY($1)`
+)
+
+// PurgeUser permanently deletes the applications and load balancers in appIDs and lbIDs, along with the ones
+// still owned by the user purge holds the ID of, reporting them in purge along with the remaining applications
+// whose contact is one of emails, then saves entry with the purge as its data, all in a single transaction
+func (d *Driver) PurgeUser(ctx context.Context, purge *types.UserPurge, appIDs, lbIDs, emails []string, entry *types.AuditLogEntry) error {
+	if purge.UserID == "" {
+		return ErrMissingID
+	}
+
+	tx, err := d.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	err = purgeUserInTx(tx, purge, appIDs, lbIDs, emails, entry)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func purgeUserInTx(tx *sqlx.Tx, purge *types.UserPurge, appIDs, lbIDs, emails []string, entry *types.AuditLogEntry) error {
+	var err error
+
+	purge.DeletedApplicationIDs, err = purgeInTx(tx, appIDs, applicationPurges, selectUserApplications, purge.UserID)
+	if err != nil {
+		return err
+	}
+
+	if len(purge.DeletedApplicationIDs) > 0 {
+		_, err = tx.Exec(deleteEntityLabels, types.EntityApplication, pq.StringArray(purge.DeletedApplicationIDs))
+		if err != nil {
+			return err
+		}
+	}
+
+	purge.DeletedLoadBalancerIDs, err = purgeInTx(tx, lbIDs, loadBalancerPurges, selectUserLoadBalancers, purge.UserID)
+	if err != nil {
+		return err
+	}
+
+	if len(purge.DeletedLoadBalancerIDs) > 0 {
+		_, err = tx.Exec(deleteEntityLabels, types.EntityLoadBalancer, pq.StringArray(purge.DeletedLoadBalancerIDs))
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(emails) > 0 {
+		err = tx.Select(&purge.SharedContactApplicationIDs, selectSharedContactApplications, pq.StringArray(emails))
+		if err != nil {
+			return err
+		}
+	}
+
+	// the report only holds IDs, so the audit log keeps no personal data of the purged user
+	entry.Data, err = json.Marshal(purge)
+	if err != nil {
+		return err
+	}
+
+	return writeAuditLogEntryInTx(tx, entry)
+}
@@ -0,0 +1,198 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/pokt-foundation/pocket-http-db/types"
+	postgresdriver "github.com/pokt-foundation/portal-api-go/postgres-driver"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDriver_PurgeApplications(t *testing.T) {
+	c := require.New(t)
+
+	db, mock, err := sqlmock.New()
+	c.NoError(err)
+
+	defer db.Close()
+
+	driver := NewDriverFromSQLDBInstance(db, postgresdriver.NewListenerMock())
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT application_id FROM applications").WithArgs(sqlmock.AnyArg(), "AWAITING_GRACE_PERIOD").
+		WillReturnRows(sqlmock.NewRows([]string{"application_id"}).AddRow("60ddc61b6e2936fhtrns63h2"))
+
+	for _, table := range []string{"gateway_aat", "gateway_settings", "notification_settings", "lb_apps", "applications"} {
+		mock.ExpectExec("DELETE FROM " + table).WithArgs(sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+
+	mock.ExpectCommit()
+
+	purged, err := driver.PurgeApplications([]string{"60ddc61b6e2936fhtrns63h2", "60ddc61b6e2936fhtrns63h3"})
+	c.NoError(err)
+	c.Equal([]string{"60ddc61b6e2936fhtrns63h2"}, purged)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT application_id FROM applications").WithArgs(sqlmock.AnyArg(), "AWAITING_GRACE_PERIOD").
+		WillReturnRows(sqlmock.NewRows([]string{"application_id"}))
+	mock.ExpectCommit()
+
+	purged, err = driver.PurgeApplications([]string{"60ddc61b6e2936fhtrns63h3"})
+	c.NoError(err)
+	c.Empty(purged)
+
+	_, err = driver.PurgeApplications(nil)
+	c.Equal(ErrMissingID, err)
+
+	c.NoError(mock.ExpectationsWereMet())
+}
+
+func TestDriver_PurgeLoadBalancers(t *testing.T) {
+	c := require.New(t)
+
+	db, mock, err := sqlmock.New()
+	c.NoError(err)
+
+	defer db.Close()
+
+	driver := NewDriverFromSQLDBInstance(db, postgresdriver.NewListenerMock())
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT lb_id FROM loadbalancers").WithArgs(sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"lb_id"}).AddRow("60ecb2bf67774900350d9c42"))
+	mock.ExpectExec("DELETE FROM stickiness_options").WithArgs(sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("DELETE FROM lb_apps").WithArgs(sqlmock.AnyArg()).WillReturnError(errors.New("dummy error"))
+	mock.ExpectRollback()
+
+	_, err = driver.PurgeLoadBalancers([]string{"60ecb2bf67774900350d9c42"})
+	c.EqualError(err, "dummy error")
+
+	c.NoError(mock.ExpectationsWereMet())
+}
+
+func TestDriver_DeleteLoadBalancer(t *testing.T) {
+	c := require.New(t)
+
+	db, mock, err := sqlmock.New()
+	c.NoError(err)
+
+	defer db.Close()
+
+	driver := NewDriverFromSQLDBInstance(db, postgresdriver.NewListenerMock())
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT application_id FROM applications").WithArgs(sqlmock.AnyArg(), "60ddc61b6e29c3003378361D").
+		WillReturnRows(sqlmock.NewRows([]string{"application_id"}).AddRow("60ddc61b6e2936fhtrns63h2"))
+
+	for _, table := range []string{"gateway_aat", "gateway_settings", "notification_settings", "lb_apps", "applications"} {
+		mock.ExpectExec("DELETE FROM " + table).WithArgs(sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+
+	mock.ExpectExec("DELETE FROM entity_labels").WithArgs("application", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery("SELECT lb_id FROM loadbalancers").WithArgs(sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"lb_id"}).AddRow("60ddc61b6e29c3003378361D"))
+
+	for _, table := range []string{"stickiness_options", "lb_apps", "loadbalancers"} {
+		mock.ExpectExec("DELETE FROM " + table).WithArgs(sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+
+	mock.ExpectExec("DELETE FROM entity_labels").WithArgs("load_balancer", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	deleted, err := driver.DeleteLoadBalancer(context.Background(), "60ddc61b6e29c3003378361D",
+		[]string{"60ddc61b6e2936fhtrns63h2", "60ddc61b6e2936fhtrns63h3"})
+	c.NoError(err)
+	c.Equal([]string{"60ddc61b6e2936fhtrns63h2"}, deleted)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT lb_id FROM loadbalancers").WithArgs(sqlmock.AnyArg()).
+		WillReturnError(errors.New("dummy error"))
+	mock.ExpectRollback()
+
+	_, err = driver.DeleteLoadBalancer(context.Background(), "60ddc61b6e29c3003378361D", nil)
+	c.EqualError(err, "dummy error")
+
+	_, err = driver.DeleteLoadBalancer(context.Background(), "", nil)
+	c.Equal(ErrMissingID, err)
+
+	c.NoError(mock.ExpectationsWereMet())
+}
+
+func TestDriver_PurgeUser(t *testing.T) {
+	c := require.New(t)
+
+	db, mock, err := sqlmock.New()
+	c.NoError(err)
+
+	defer db.Close()
+
+	driver := NewDriverFromSQLDBInstance(db, postgresdriver.NewListenerMock())
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT application_id FROM applications").WithArgs(sqlmock.AnyArg(), "60ecb2bf67774900350d9c43").
+		WillReturnRows(sqlmock.NewRows([]string{"application_id"}).AddRow("60ddc61b6e2936fhtrns63h2"))
+
+	for _, table := range []string{"gateway_aat", "gateway_settings", "notification_settings", "lb_apps", "applications"} {
+		mock.ExpectExec("DELETE FROM " + table).WithArgs(sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+
+	mock.ExpectExec("DELETE FROM entity_labels").WithArgs("application", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery("SELECT lb_id FROM loadbalancers").WithArgs(sqlmock.AnyArg(), "60ecb2bf67774900350d9c43").
+		WillReturnRows(sqlmock.NewRows([]string{"lb_id"}).AddRow("60ecb2bf67774900350d9c42"))
+
+	for _, table := range []string{"stickiness_options", "lb_apps", "loadbalancers"} {
+		mock.ExpectExec("DELETE FROM " + table).WithArgs(sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+
+	mock.ExpectExec("DELETE FROM entity_labels").WithArgs("load_balancer", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery("SELECT application_id FROM applications WHERE contact_email").WithArgs(sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"application_id"}).AddRow("60ddc61b6e2936fhtrns63h3"))
+	mock.ExpectExec("INSERT into audit_log").
+		WithArgs("user", "60ecb2bf67774900350d9c43", "purge", nil, nil, sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	purge := &types.UserPurge{UserID: "60ecb2bf67774900350d9c43"}
+	entry := &types.AuditLogEntry{EntityType: types.EntityUser, EntityID: "60ecb2bf67774900350d9c43", Action: types.AuditActionPurge}
+
+	err = driver.PurgeUser(context.Background(), purge, []string{"60ddc61b6e2936fhtrns63h2"},
+		[]string{"60ecb2bf67774900350d9c42"}, []string{"dummy@ro.com"}, entry)
+	c.NoError(err)
+	c.Contains(string(entry.Data), "60ddc61b6e2936fhtrns63h2")
+	c.Equal([]string{"60ddc61b6e2936fhtrns63h2"}, purge.DeletedApplicationIDs)
+	c.Equal([]string{"60ecb2bf67774900350d9c42"}, purge.DeletedLoadBalancerIDs)
+	c.Equal([]string{"60ddc61b6e2936fhtrns63h3"}, purge.SharedContactApplicationIDs)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT application_id FROM applications").WithArgs(sqlmock.AnyArg(), "60ecb2bf67774900350d9c43").
+		WillReturnRows(sqlmock.NewRows([]string{"application_id"}))
+	mock.ExpectQuery("SELECT lb_id FROM loadbalancers").WithArgs(sqlmock.AnyArg(), "60ecb2bf67774900350d9c43").
+		WillReturnError(errors.New("dummy error"))
+	mock.ExpectRollback()
+
+	err = driver.PurgeUser(context.Background(), &types.UserPurge{UserID: "60ecb2bf67774900350d9c43"}, nil, nil, nil, entry)
+	c.EqualError(err, "dummy error")
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT application_id FROM applications").WithArgs(sqlmock.AnyArg(), "60ecb2bf67774900350d9c43").
+		WillReturnRows(sqlmock.NewRows([]string{"application_id"}))
+	mock.ExpectQuery("SELECT lb_id FROM loadbalancers").WithArgs(sqlmock.AnyArg(), "60ecb2bf67774900350d9c43").
+		WillReturnRows(sqlmock.NewRows([]string{"lb_id"}))
+	mock.ExpectExec("INSERT into audit_log").WillReturnError(errors.New("dummy error"))
+	mock.ExpectRollback()
+
+	err = driver.PurgeUser(context.Background(), &types.UserPurge{UserID: "60ecb2bf67774900350d9c43"}, nil, nil, nil, entry)
+	c.EqualError(err, "dummy error")
+
+	err = driver.PurgeUser(context.Background(), &types.UserPurge{}, nil, nil, nil, entry)
+	c.Equal(ErrMissingID, err)
+
+	c.NoError(mock.ExpectationsWereMet())
+}
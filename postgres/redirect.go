@@ -0,0 +1,111 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/pokt-foundation/portal-api-go/repository"
+)
+
+const (
+	selectRedirectsScript = `
+	SELECT id::text AS id, blockchain_id, alias, loadbalancer, domain, created_at, updated_at
+	FROM redirects
+	ORDER BY id`
+	insertRedirectScript = `
+	INSERT into redirects (blockchain_id, alias, loadbalancer, domain, created_at, updated_at)
+	VALUES ($1, $2, $3, $4, $5, $6)
+	RETURNING id::text`
+	deleteRedirectScript = `DELETE FROM redirects WHERE id::text = $1`
+)
+
+// dbRedirect mirrors the redirect rows, along with the ID the upstream driver does not read
+type dbRedirect struct {
+	ID             string         `db:"id"`
+	BlockchainID   string         `db:"blockchain_id"`
+	Alias          sql.NullString `db:"alias"`
+	LoadBalancerID sql.NullString `db:"loadbalancer"`
+	Domain         sql.NullString `db:"domain"`
+	CreatedAt      sql.NullTime   `db:"created_at"`
+	UpdatedAt      sql.NullTime   `db:"updated_at"`
+}
+
+func (r *dbRedirect) toRedirect() *repository.Redirect {
+	return &repository.Redirect{
+		ID:             r.ID,
+		BlockchainID:   r.BlockchainID,
+		Alias:          r.Alias.String,
+		LoadBalancerID: r.LoadBalancerID.String,
+		Domain:         r.Domain.String,
+		CreatedAt:      r.CreatedAt.Time,
+		UpdatedAt:      r.UpdatedAt.Time,
+	}
+}
+
+// ReadRedirects returns all the redirects in the database with their IDs
+func (d *Driver) ReadRedirects() ([]*repository.Redirect, error) {
+	var dbRedirects []*dbRedirect
+
+	err := d.Select(&dbRedirects, selectRedirectsScript)
+	if err != nil {
+		return nil, err
+	}
+
+	redirects := make([]*repository.Redirect, 0, len(dbRedirects))
+
+	for _, dbRedirect := range dbRedirects {
+		redirects = append(redirects, dbRedirect.toRedirect())
+	}
+
+	return redirects, nil
+}
+
+// WriteRedirects saves all the redirects in a single transaction, none of them is saved if any insert fails
+// returns the redirects as saved, with the IDs given by the database
+func (d *Driver) WriteRedirects(ctx context.Context, redirects []*repository.Redirect) ([]*repository.Redirect, error) {
+	now := time.Now()
+
+	tx, err := d.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	saved := make([]*repository.Redirect, 0, len(redirects))
+
+	for _, redirect := range redirects {
+		var id string
+
+		err = tx.QueryRowContext(ctx, insertRedirectScript, redirect.BlockchainID, newSQLNullString(redirect.Alias),
+			newSQLNullString(redirect.LoadBalancerID), newSQLNullString(redirect.Domain), now, now).Scan(&id)
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+
+		savedRedirect := *redirect
+		savedRedirect.ID = id
+		savedRedirect.CreatedAt = now
+		savedRedirect.UpdatedAt = now
+
+		saved = append(saved, &savedRedirect)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return saved, nil
+}
+
+// RemoveRedirect permanently deletes the redirect with given id, removing a missing redirect is not an error
+func (d *Driver) RemoveRedirect(ctx context.Context, id string) error {
+	if id == "" {
+		return ErrMissingID
+	}
+
+	_, err := d.ExecContext(ctx, deleteRedirectScript, id)
+
+	return err
+}
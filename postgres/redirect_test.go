@@ -0,0 +1,124 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	postgresdriver "github.com/pokt-foundation/portal-api-go/postgres-driver"
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDriver_ReadRedirects(t *testing.T) {
+	c := require.New(t)
+
+	db, mock, err := sqlmock.New()
+	c.NoError(err)
+
+	defer db.Close()
+
+	driver := NewDriverFromSQLDBInstance(db, postgresdriver.NewListenerMock())
+
+	createdAt := time.Date(2022, time.July, 21, 0, 0, 0, 0, time.UTC)
+
+	rows := sqlmock.NewRows([]string{"id", "blockchain_id", "alias", "loadbalancer", "domain", "created_at", "updated_at"}).
+		AddRow("1", "0040", "harmony-0", "60ecb2bf67774900350d9c42", "harmony-0.gateway.network", createdAt, createdAt).
+		AddRow("2", "0040", nil, nil, "harmony-0-archival.gateway.network", nil, nil)
+
+	mock.ExpectQuery("FROM redirects").WillReturnRows(rows)
+
+	redirects, err := driver.ReadRedirects()
+	c.NoError(err)
+	c.Len(redirects, 2)
+	c.Equal("1", redirects[0].ID)
+	c.Equal("harmony-0", redirects[0].Alias)
+	c.Equal("60ecb2bf67774900350d9c42", redirects[0].LoadBalancerID)
+	c.Equal(createdAt, redirects[0].CreatedAt)
+	c.Equal("2", redirects[1].ID)
+	c.Empty(redirects[1].Alias)
+	c.True(redirects[1].CreatedAt.IsZero())
+
+	mock.ExpectQuery("FROM redirects").WillReturnError(errors.New("dummy error"))
+
+	_, err = driver.ReadRedirects()
+	c.EqualError(err, "dummy error")
+
+	c.NoError(mock.ExpectationsWereMet())
+}
+
+func TestDriver_WriteRedirects(t *testing.T) {
+	c := require.New(t)
+
+	db, mock, err := sqlmock.New()
+	c.NoError(err)
+
+	defer db.Close()
+
+	driver := NewDriverFromSQLDBInstance(db, postgresdriver.NewListenerMock())
+
+	redirects := []*repository.Redirect{
+		{BlockchainID: "0040", Alias: "harmony-0", Domain: "harmony-0.gateway.network", LoadBalancerID: "60ecb2bf67774900350d9c42"},
+		{BlockchainID: "0040", Alias: "harmony-0-archival", Domain: "harmony-0-archival.gateway.network"},
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("INSERT into redirects").
+		WithArgs("0040", "harmony-0", "60ecb2bf67774900350d9c42", "harmony-0.gateway.network", sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("7"))
+	mock.ExpectQuery("INSERT into redirects").
+		WithArgs("0040", "harmony-0-archival", nil, "harmony-0-archival.gateway.network", sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("8"))
+	mock.ExpectCommit()
+
+	saved, err := driver.WriteRedirects(context.Background(), redirects)
+	c.NoError(err)
+	c.Len(saved, 2)
+	c.Equal("7", saved[0].ID)
+	c.Equal("8", saved[1].ID)
+	c.Equal("harmony-0-archival", saved[1].Alias)
+	c.False(saved[1].CreatedAt.IsZero())
+	c.Empty(redirects[0].ID)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("INSERT into redirects").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("9"))
+	mock.ExpectQuery("INSERT into redirects").WillReturnError(errors.New("dummy error"))
+	mock.ExpectRollback()
+
+	_, err = driver.WriteRedirects(context.Background(), redirects)
+	c.EqualError(err, "dummy error")
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("INSERT into redirects").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("10"))
+	mock.ExpectCommit()
+
+	redirect, err := driver.WriteRedirect(context.Background(), redirects[0])
+	c.NoError(err)
+	c.Equal("10", redirect.ID)
+
+	c.NoError(mock.ExpectationsWereMet())
+}
+
+func TestDriver_RemoveRedirect(t *testing.T) {
+	c := require.New(t)
+
+	db, mock, err := sqlmock.New()
+	c.NoError(err)
+
+	defer db.Close()
+
+	driver := NewDriverFromSQLDBInstance(db, postgresdriver.NewListenerMock())
+
+	mock.ExpectExec("DELETE FROM redirects").WithArgs("7").WillReturnResult(sqlmock.NewResult(0, 1))
+
+	c.NoError(driver.RemoveRedirect(context.Background(), "7"))
+
+	mock.ExpectExec("DELETE FROM redirects").WithArgs("8").WillReturnError(errors.New("dummy error"))
+
+	c.EqualError(driver.RemoveRedirect(context.Background(), "8"), "dummy error")
+	c.ErrorIs(driver.RemoveRedirect(context.Background(), ""), ErrMissingID)
+
+	c.NoError(mock.ExpectationsWereMet())
+}
@@ -0,0 +1,28 @@
+package postgres
+
+import (
+	"time"
+)
+
+// selfTestID is the entity ID, actor and action of the audit log entry written by CheckWrite
+const selfTestID = "selftest"
+
+// CheckWrite exercises a write in a transaction that is always rolled back, so nothing is persisted
+func (d *Driver) CheckWrite() error {
+	tx, err := d.Beginx()
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	_, err = tx.NamedExec(insertAuditLogEntryScript, &insertAuditLogEntry{
+		EntityType: selfTestID,
+		EntityID:   selfTestID,
+		Action:     selfTestID,
+		Actor:      newSQLNullString(selfTestID),
+		CreatedAt:  time.Now(),
+	})
+
+	return err
+}
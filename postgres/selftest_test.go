@@ -0,0 +1,37 @@
+package postgres
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	postgresdriver "github.com/pokt-foundation/portal-api-go/postgres-driver"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDriver_CheckWrite(t *testing.T) {
+	c := require.New(t)
+
+	db, mock, err := sqlmock.New()
+	c.NoError(err)
+
+	defer db.Close()
+
+	driver := NewDriverFromSQLDBInstance(db, postgresdriver.NewListenerMock())
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT into audit_log").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectRollback()
+
+	err = driver.CheckWrite()
+	c.NoError(err)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT into audit_log").WillReturnError(errors.New("dummy error"))
+	mock.ExpectRollback()
+
+	err = driver.CheckWrite()
+	c.EqualError(err, "dummy error")
+
+	c.NoError(mock.ExpectationsWereMet())
+}
@@ -0,0 +1,325 @@
+// Package query parses filter expressions over the fields of an entity, such as
+// status=="IN_SERVICE" && plan!="FREETIER_V0", so lists can be filtered without a bespoke parameter for every field
+//
+// An expression compares fields to values with == and !=, combined with && and ||, negated with ! and grouped
+// with parentheses, && binding tighter than ||. Values are double-quoted strings, with \" and \\ escapes,
+// or bare words of letters, digits and _ . - characters.
+package query
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// MaxLength is the longest expression parsed, so nesting stays bounded
+const MaxLength = 1024
+
+var (
+	ErrTooLong      = fmt.Errorf("expression longer than %d characters", MaxLength)
+	ErrUnknownField = errors.New("unknown field")
+	ErrSyntax       = errors.New("syntax error")
+)
+
+// Expr represents a parsed expression
+type Expr interface {
+	// Match returns true if the entity whose field values are returned by value matches the expression
+	Match(value func(field string) string) bool
+}
+
+// all matches every entity, it is the expression of a blank filter
+type all struct{}
+
+func (all) Match(func(string) string) bool { return true }
+
+type comparison struct {
+	field string
+	value string
+	equal bool
+}
+
+func (c comparison) Match(value func(string) string) bool {
+	return (value(c.field) == c.value) == c.equal
+}
+
+type and struct {
+	left, right Expr
+}
+
+func (a and) Match(value func(string) string) bool {
+	return a.left.Match(value) && a.right.Match(value)
+}
+
+type or struct {
+	left, right Expr
+}
+
+func (o or) Match(value func(string) string) bool {
+	return o.left.Match(value) || o.right.Match(value)
+}
+
+type not struct {
+	expr Expr
+}
+
+func (n not) Match(value func(string) string) bool {
+	return !n.expr.Match(value)
+}
+
+// Parse parses expression, which can only compare the fields set in fields
+// a blank expression matches every entity
+func Parse(expression string, fields map[string]bool) (Expr, error) {
+	if len(expression) > MaxLength {
+		return nil, ErrTooLong
+	}
+
+	tokens, err := tokenize(expression)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tokens) == 0 {
+		return all{}, nil
+	}
+
+	p := &parser{tokens: tokens, fields: fields}
+
+	expr, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.pos < len(p.tokens) {
+		return nil, p.unexpected()
+	}
+
+	return expr, nil
+}
+
+type tokenKind int
+
+const (
+	tokenWord tokenKind = iota
+	tokenString
+	tokenOperator
+)
+
+type token struct {
+	kind  tokenKind
+	text  string
+	start int
+}
+
+// operators are the operators tokens, two characters operators first
+var operators = []string{"==", "!=", "&&", "||", "!", "(", ")"}
+
+// tokenize splits expression into words, strings and operators
+func tokenize(expression string) ([]token, error) {
+	tokens := []token{}
+
+	for i := 0; i < len(expression); {
+		char := expression[i]
+
+		switch {
+		case char == ' ' || char == '\t' || char == '\n' || char == '\r':
+			i++
+		case char == '"':
+			value, end, err := readString(expression, i)
+			if err != nil {
+				return nil, err
+			}
+
+			tokens = append(tokens, token{kind: tokenString, text: value, start: i})
+			i = end
+		case isWordChar(char):
+			start := i
+			for i < len(expression) && isWordChar(expression[i]) {
+				i++
+			}
+
+			tokens = append(tokens, token{kind: tokenWord, text: expression[start:i], start: start})
+		default:
+			operator := ""
+			for _, op := range operators {
+				if strings.HasPrefix(expression[i:], op) {
+					operator = op
+					break
+				}
+			}
+
+			if operator == "" {
+				return nil, fmt.Errorf("%w: unexpected %q at %d", ErrSyntax, char, i)
+			}
+
+			tokens = append(tokens, token{kind: tokenOperator, text: operator, start: i})
+			i += len(operator)
+		}
+	}
+
+	return tokens, nil
+}
+
+// readString reads the double-quoted string starting at start, returning its value and the index after it
+func readString(expression string, start int) (string, int, error) {
+	var value strings.Builder
+
+	for i := start + 1; i < len(expression); i++ {
+		switch expression[i] {
+		case '"':
+			return value.String(), i + 1, nil
+		case '\\':
+			if i+1 < len(expression) && (expression[i+1] == '"' || expression[i+1] == '\\') {
+				i++
+				value.WriteByte(expression[i])
+				continue
+			}
+
+			return "", 0, fmt.Errorf("%w: invalid escape at %d", ErrSyntax, i)
+		default:
+			value.WriteByte(expression[i])
+		}
+	}
+
+	return "", 0, fmt.Errorf("%w: unterminated string at %d", ErrSyntax, start)
+}
+
+func isWordChar(char byte) bool {
+	return char >= 'a' && char <= 'z' || char >= 'A' && char <= 'Z' || char >= '0' && char <= '9' ||
+		char == '_' || char == '.' || char == '-'
+}
+
+// parser is a recursive descent parser over the tokens of an expression
+type parser struct {
+	tokens []token
+	pos    int
+	fields map[string]bool
+}
+
+// parseOr parses and expressions separated by ||
+func (p *parser) parseOr() (Expr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.accept("||") {
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+
+		left = or{left: left, right: right}
+	}
+
+	return left, nil
+}
+
+// parseAnd parses unary expressions separated by &&
+func (p *parser) parseAnd() (Expr, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.accept("&&") {
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+
+		left = and{left: left, right: right}
+	}
+
+	return left, nil
+}
+
+// parseUnary parses a negation, a parenthesized expression or a comparison
+func (p *parser) parseUnary() (Expr, error) {
+	if p.accept("!") {
+		expr, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+
+		return not{expr: expr}, nil
+	}
+
+	if p.accept("(") {
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+
+		if !p.accept(")") {
+			return nil, p.unexpected()
+		}
+
+		return expr, nil
+	}
+
+	return p.parseComparison()
+}
+
+// parseComparison parses field==value or field!=value
+func (p *parser) parseComparison() (Expr, error) {
+	field, ok := p.next(tokenWord)
+	if !ok {
+		return nil, p.unexpected()
+	}
+
+	if !p.fields[field.text] {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownField, field.text)
+	}
+
+	equal := true
+
+	switch {
+	case p.accept("=="):
+	case p.accept("!="):
+		equal = false
+	default:
+		return nil, p.unexpected()
+	}
+
+	value, ok := p.next(tokenString)
+	if !ok {
+		value, ok = p.next(tokenWord)
+	}
+
+	if !ok {
+		return nil, p.unexpected()
+	}
+
+	return comparison{field: field.text, value: value.text, equal: equal}, nil
+}
+
+// accept consumes the next token if it is the operator op
+func (p *parser) accept(op string) bool {
+	if p.pos < len(p.tokens) && p.tokens[p.pos].kind == tokenOperator && p.tokens[p.pos].text == op {
+		p.pos++
+		return true
+	}
+
+	return false
+}
+
+// next consumes the next token if it is of given kind
+func (p *parser) next(kind tokenKind) (token, bool) {
+	if p.pos < len(p.tokens) && p.tokens[p.pos].kind == kind {
+		p.pos++
+		return p.tokens[p.pos-1], true
+	}
+
+	return token{}, false
+}
+
+// unexpected returns the syntax error of the current token
+func (p *parser) unexpected() error {
+	if p.pos >= len(p.tokens) {
+		return fmt.Errorf("%w: unexpected end of expression", ErrSyntax)
+	}
+
+	tok := p.tokens[p.pos]
+
+	return fmt.Errorf("%w: unexpected %q at %d", ErrSyntax, tok.text, tok.start)
+}
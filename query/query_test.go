@@ -0,0 +1,72 @@
+package query
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	c := require.New(t)
+
+	fields := map[string]bool{"status": true, "plan": true, "name": true}
+
+	entity := map[string]string{"status": "IN_SERVICE", "plan": "PAY_AS_YOU_GO_V0", "name": `my "app"`}
+	value := func(field string) string { return entity[field] }
+
+	tests := []struct {
+		expression string
+		match      bool
+	}{
+		{"", true},
+		{"  ", true},
+		{`status=="IN_SERVICE"`, true},
+		{`status==IN_SERVICE`, true},
+		{`status!="IN_SERVICE"`, false},
+		{`status=="IN_SERVICE" && plan!="FREETIER_V0"`, true},
+		{`status=="IN_SERVICE" && plan=="FREETIER_V0"`, false},
+		{`status=="AWAITING_GRACE_PERIOD" || plan==PAY_AS_YOU_GO_V0`, true},
+		{`!(status=="IN_SERVICE")`, false},
+		{`!status=="ORPHANED"`, true},
+		// && binds tighter than ||
+		{`plan=="PAY_AS_YOU_GO_V0" || status=="ORPHANED" && plan=="FREETIER_V0"`, true},
+		{`(plan=="PAY_AS_YOU_GO_V0" || status=="ORPHANED") && plan=="FREETIER_V0"`, false},
+		{`name=="my \"app\""`, true},
+	}
+
+	for _, tt := range tests {
+		expr, err := Parse(tt.expression, fields)
+		c.NoError(err, tt.expression)
+		c.Equal(tt.match, expr.Match(value), tt.expression)
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	c := require.New(t)
+
+	fields := map[string]bool{"status": true}
+
+	_, err := Parse(`contactEmail=="a@b.c"`, fields)
+	c.ErrorIs(err, ErrUnknownField)
+
+	_, err = Parse(strings.Repeat("(", MaxLength+1), fields)
+	c.ErrorIs(err, ErrTooLong)
+
+	for _, expression := range []string{
+		`status`,
+		`status=`,
+		`status==`,
+		`status=="IN_SERVICE`,
+		`status=="IN_SERVICE\n"`,
+		`status=="IN_SERVICE" &&`,
+		`status=="IN_SERVICE" status=="ORPHANED"`,
+		`(status=="IN_SERVICE"`,
+		`status=="IN_SERVICE")`,
+		`"IN_SERVICE"==status`,
+		`status~="IN_SERVICE"`,
+	} {
+		_, err := Parse(expression, fields)
+		c.ErrorIs(err, ErrSyntax, expression)
+	}
+}
@@ -0,0 +1,84 @@
+// Package relaymeter pushes application limits to the relay meter as soon as they change
+// so it doesn't have to wait for its next poll of /application/limits
+package relaymeter
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/sirupsen/logrus"
+)
+
+var errResponseNotOK = errors.New("relay meter response not ok")
+
+// Pusher struct handler for relay meter pushes
+type Pusher struct {
+	url    string
+	apiKey string
+	client *http.Client
+	log    *logrus.Logger
+}
+
+// NewPusher returns Pusher instance posting limits to url
+func NewPusher(url, apiKey string, timeout time.Duration, logger *logrus.Logger) *Pusher {
+	return &Pusher{
+		url:    url,
+		apiKey: apiKey,
+		client: &http.Client{Timeout: timeout},
+		log:    logger,
+	}
+}
+
+// Push sends limits to the relay meter in the background
+func (p *Pusher) Push(limits ...repository.AppLimits) {
+	if len(limits) == 0 {
+		return
+	}
+
+	go func() {
+		err := p.push(limits)
+		if err != nil {
+			p.log.WithFields(logrus.Fields{
+				"err": err.Error(),
+			}).Error(fmt.Errorf("relay meter push failed: %w", err))
+		}
+	}()
+}
+
+func (p *Pusher) push(limits []repository.AppLimits) error {
+	body, err := json.Marshal(limits)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, p.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	if p.apiKey != "" {
+		req.Header.Set("Authorization", p.apiKey)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("%w: status %d", errResponseNotOK, resp.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package relaymeter
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPusher_Push(t *testing.T) {
+	c := require.New(t)
+
+	pushed := make(chan []repository.AppLimits, 1)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Equal("relay-meter-key", r.Header.Get("Authorization"))
+
+		var limits []repository.AppLimits
+
+		body, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(body, &limits)
+
+		pushed <- limits
+	}))
+	defer server.Close()
+
+	pusher := NewPusher(server.URL, "relay-meter-key", time.Second, logrus.New())
+
+	pusher.Push(repository.AppLimits{
+		AppID:      "5f62b7d8be3591c4dea8566d",
+		PlanType:   repository.FreetierV0,
+		DailyLimit: 250000,
+	})
+
+	limits := <-pushed
+	c.Len(limits, 1)
+	c.Equal("5f62b7d8be3591c4dea8566d", limits[0].AppID)
+	c.Equal(250000, limits[0].DailyLimit)
+}
+
+func TestPusher_push(t *testing.T) {
+	c := require.New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	pusher := NewPusher(server.URL, "", time.Second, logrus.New())
+
+	err := pusher.push([]repository.AppLimits{{AppID: "5f62b7d8be3591c4dea8566d"}})
+	c.ErrorIs(err, errResponseNotOK)
+}
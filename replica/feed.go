@@ -0,0 +1,220 @@
+// Package replica lets instances in other regions follow a primary instance over HTTP
+// instead of connecting to Postgres, the primary records the changes it applies and followers replay them
+package replica
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pokt-foundation/pocket-http-db/cache"
+	"github.com/pokt-foundation/pocket-http-db/types"
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/sirupsen/logrus"
+)
+
+const defaultFeedSize = 10000
+
+// ErrFeedExpired error when the requested changes are no longer kept, the follower has to resync
+var ErrFeedExpired = errors.New("changes feed expired")
+
+// Change represents a single change applied to the cache of the primary
+type Change struct {
+	Sequence uint64            `json:"sequence"`
+	Table    repository.Table  `json:"table"`
+	Action   repository.Action `json:"action"`
+	Data     json.RawMessage   `json:"data"`
+	Time     time.Time         `json:"time"`
+}
+
+// Changes is the output of the changes feed
+type Changes struct {
+	FeedID   string   `json:"feedID"`
+	Sequence uint64   `json:"sequence"`
+	Changes  []Change `json:"changes"`
+}
+
+// Feed struct handler for the last changes of the primary
+// the feed ID changes on every start so followers notice sequences starting over
+type Feed struct {
+	id       string
+	started  time.Time
+	size     int
+	sequence uint64
+	changes  []Change
+	rwMutex  sync.RWMutex
+	log      *logrus.Logger
+}
+
+// NewFeed returns Feed instance keeping the last size changes, size <= 0 uses the default size
+func NewFeed(size int, logger *logrus.Logger) *Feed {
+	if size <= 0 {
+		size = defaultFeedSize
+	}
+
+	id := make([]byte, 8)
+	_, _ = rand.Read(id)
+
+	return &Feed{
+		id:      hex.EncodeToString(id),
+		started: time.Now(),
+		size:    size,
+		log:     logger,
+	}
+}
+
+// ID returns the ID of the feed
+func (f *Feed) ID() string {
+	return f.id
+}
+
+// Sequence returns the sequence of the last change
+func (f *Feed) Sequence() uint64 {
+	f.rwMutex.RLock()
+	defer f.rwMutex.RUnlock()
+
+	return f.sequence
+}
+
+// Append records n as the next change
+func (f *Feed) Append(n repository.Notification) {
+	rawData, err := json.Marshal(n.Data)
+	if err != nil {
+		f.log.WithFields(logrus.Fields{
+			"err": err.Error(),
+		}).Error(err)
+
+		return
+	}
+
+	f.rwMutex.Lock()
+	defer f.rwMutex.Unlock()
+
+	f.sequence++
+
+	f.changes = append(f.changes, Change{
+		Sequence: f.sequence,
+		Table:    n.Table,
+		Action:   n.Action,
+		Data:     rawData,
+		Time:     time.Now(),
+	})
+
+	if len(f.changes) > f.size {
+		f.changes = append([]Change(nil), f.changes[len(f.changes)-f.size:]...)
+	}
+}
+
+// Since returns the changes after sequence in feedID
+func (f *Feed) Since(feedID string, sequence uint64) (*Changes, error) {
+	f.rwMutex.RLock()
+	defer f.rwMutex.RUnlock()
+
+	if feedID != f.id || sequence > f.sequence {
+		return nil, ErrFeedExpired
+	}
+
+	first := f.sequence - uint64(len(f.changes)) + 1
+
+	if sequence+1 < first {
+		return nil, ErrFeedExpired
+	}
+
+	return &Changes{
+		FeedID:   f.id,
+		Sequence: f.sequence,
+		Changes:  append([]Change{}, f.changes[sequence+1-first:]...),
+	}, nil
+}
+
+// SinceTime returns the changes applied after t, for clients keeping the time of the last change they got
+// rather than the feed and sequence, the changes are expired if t is before the start of the feed
+// or some changes after t are no longer kept
+func (f *Feed) SinceTime(t time.Time) (*Changes, error) {
+	f.rwMutex.RLock()
+	defer f.rwMutex.RUnlock()
+
+	if t.Before(f.started) {
+		return nil, ErrFeedExpired
+	}
+
+	dropped := f.sequence > uint64(len(f.changes))
+
+	if dropped && (len(f.changes) == 0 || t.Before(f.changes[0].Time)) {
+		return nil, ErrFeedExpired
+	}
+
+	i := sort.Search(len(f.changes), func(i int) bool {
+		return f.changes[i].Time.After(t)
+	})
+
+	return &Changes{
+		FeedID:   f.id,
+		Sequence: f.sequence,
+		Changes:  append([]Change{}, f.changes[i:]...),
+	}, nil
+}
+
+// recordingReader is a cache reader recording every notification in a feed before handing it to the cache
+type recordingReader struct {
+	cache.Reader
+	notifications chan *repository.Notification
+}
+
+// Record returns a reader recording in f the notifications of reader
+func (f *Feed) Record(reader cache.Reader) cache.Reader {
+	r := &recordingReader{
+		Reader:        reader,
+		notifications: make(chan *repository.Notification, 32),
+	}
+
+	go func() {
+		for n := range reader.NotificationChannel() {
+			f.Append(*n)
+			r.notifications <- n
+		}
+	}()
+
+	return r
+}
+
+func (r *recordingReader) NotificationChannel() <-chan *repository.Notification {
+	return r.notifications
+}
+
+// ReadLabels returns the labels of the recorded reader, none if it does not hold labels
+// label changes are not notified, followers get them on the snapshot of their next cache refresh
+func (r *recordingReader) ReadLabels() ([]*types.EntityLabels, error) {
+	labelReader, ok := r.Reader.(cache.LabelReader)
+	if !ok {
+		return nil, nil
+	}
+
+	return labelReader.ReadLabels()
+}
+
+// ReadApplicationFilters returns the application filters of the recorded reader, none if it does not hold them
+// filter changes are not notified, followers get them on the snapshot of their next cache refresh
+func (r *recordingReader) ReadApplicationFilters() ([]*types.ApplicationFilter, error) {
+	filterReader, ok := r.Reader.(cache.ApplicationFilterReader)
+	if !ok {
+		return nil, nil
+	}
+
+	return filterReader.ReadApplicationFilters()
+}
+
+// ReadApplication returns the application with given id from the recorded reader, none if it cannot read single applications
+// applications read are not appended to the feed, followers get them on their next cache refresh
+func (r *recordingReader) ReadApplication(id string) (*repository.Application, error) {
+	appReader, ok := r.Reader.(cache.ApplicationReader)
+	if !ok {
+		return nil, nil
+	}
+
+	return appReader.ReadApplication(id)
+}
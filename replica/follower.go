@@ -0,0 +1,282 @@
+package replica
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pokt-foundation/pocket-http-db/cache"
+	"github.com/pokt-foundation/pocket-http-db/snapshot"
+	"github.com/pokt-foundation/pocket-http-db/types"
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	errPrimaryResponseNotOK = errors.New("primary response not ok")
+	errUnknownTable         = errors.New("unknown table")
+)
+
+// Export is the output of the snapshot endpoint of the primary, sequence is the last change included
+type Export struct {
+	FeedID   string             `json:"feedID"`
+	Sequence uint64             `json:"sequence"`
+	Snapshot *snapshot.Snapshot `json:"snapshot"`
+}
+
+// Follower struct handler for a cache reader following a primary instance
+// it implements cache.Reader so the cache is populated and maintained as when reading from Postgres
+type Follower struct {
+	primaryURL    string
+	apiKey        string
+	client        *http.Client
+	notifications chan *repository.Notification
+	mutex         sync.Mutex
+	export        *Export
+	consumed      map[types.EntityType]bool
+	log           *logrus.Logger
+}
+
+// NewFollower returns Follower instance following the primary in primaryURL
+func NewFollower(primaryURL, apiKey string, timeout time.Duration, logger *logrus.Logger) *Follower {
+	return &Follower{
+		primaryURL:    strings.TrimSuffix(primaryURL, "/"),
+		apiKey:        apiKey,
+		client:        &http.Client{Timeout: timeout},
+		notifications: make(chan *repository.Notification, 32),
+		consumed:      make(map[types.EntityType]bool),
+		log:           logger,
+	}
+}
+
+func (f *Follower) get(path string, out any) error {
+	req, err := http.NewRequest(http.MethodGet, f.primaryURL+path, nil)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Authorization", f.apiKey)
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusGone {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return ErrFeedExpired
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return fmt.Errorf("%w: status %d", errPrimaryResponseNotOK, resp.StatusCode)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// load returns the export holding entityType, a new export is fetched once every entity type
+// has been read from the current one so each cache refresh gets fresh data
+func (f *Follower) load(entityType types.EntityType) (*snapshot.Snapshot, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if f.export == nil || f.consumed[entityType] {
+		var export Export
+
+		err := f.get("/changes/snapshot", &export)
+		if err != nil {
+			return nil, fmt.Errorf("snapshot from primary failed: %w", err)
+		}
+
+		if export.Snapshot == nil {
+			export.Snapshot = &snapshot.Snapshot{}
+		}
+
+		f.export = &export
+		f.consumed = make(map[types.EntityType]bool)
+	}
+
+	f.consumed[entityType] = true
+
+	return f.export.Snapshot, nil
+}
+
+func (f *Follower) ReadApplications() ([]*repository.Application, error) {
+	s, err := f.load(types.EntityApplication)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.Applications, nil
+}
+
+func (f *Follower) ReadBlockchains() ([]*repository.Blockchain, error) {
+	s, err := f.load(types.EntityBlockchain)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.Blockchains, nil
+}
+
+func (f *Follower) ReadLoadBalancers() ([]*repository.LoadBalancer, error) {
+	s, err := f.load(types.EntityLoadBalancer)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.LoadBalancers, nil
+}
+
+func (f *Follower) ReadPayPlans() ([]*repository.PayPlan, error) {
+	s, err := f.load(types.EntityPayPlan)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.PayPlans, nil
+}
+
+func (f *Follower) ReadRedirects() ([]*repository.Redirect, error) {
+	s, err := f.load(types.EntityRedirect)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.Redirects, nil
+}
+
+func (f *Follower) ReadLabels() ([]*types.EntityLabels, error) {
+	s, err := f.load(types.EntityLabel)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.Labels, nil
+}
+
+func (f *Follower) ReadApplicationFilters() ([]*types.ApplicationFilter, error) {
+	s, err := f.load(types.EntityApplicationFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.ApplicationFilters, nil
+}
+
+func (f *Follower) NotificationChannel() <-chan *repository.Notification {
+	return f.notifications
+}
+
+// Poll hands to the cache the changes of the primary since the last export or poll
+func (f *Follower) Poll() error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if f.export == nil {
+		return ErrFeedExpired
+	}
+
+	var changes Changes
+
+	query := url.Values{}
+	query.Set("feed", f.export.FeedID)
+	query.Set("since", strconv.FormatUint(f.export.Sequence, 10))
+
+	err := f.get("/changes?"+query.Encode(), &changes)
+	if err != nil {
+		return err
+	}
+
+	for _, change := range changes.Changes {
+		data, err := decodeData(change.Table, change.Data)
+		if err != nil {
+			f.logError(fmt.Errorf("change %d decode failed: %w", change.Sequence, err))
+			continue
+		}
+
+		f.notifications <- &repository.Notification{
+			Table:  change.Table,
+			Action: change.Action,
+			Data:   data,
+		}
+	}
+
+	f.export.Sequence = changes.Sequence
+
+	return nil
+}
+
+// Follow polls the primary every interval until ctx is done, fully reloading c when the feed expired
+func (f *Follower) Follow(ctx context.Context, c *cache.Cache, interval time.Duration) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(interval):
+		}
+
+		err := f.Poll()
+		if errors.Is(err, ErrFeedExpired) {
+			err = c.SetCache()
+		}
+
+		if err != nil {
+			f.logError(fmt.Errorf("follow primary failed: %w", err))
+		}
+	}
+}
+
+func (f *Follower) logError(err error) {
+	fields := logrus.Fields{
+		"err": err.Error(),
+	}
+
+	f.log.WithFields(fields).Error(err)
+}
+
+func decodeData(table repository.Table, rawData json.RawMessage) (repository.SavedOnDB, error) {
+	var data repository.SavedOnDB
+
+	switch table {
+	case repository.TableApplications:
+		data = &repository.Application{}
+	case repository.TableBlockchains:
+		data = &repository.Blockchain{}
+	case repository.TableGatewayAAT:
+		data = &repository.GatewayAAT{}
+	case repository.TableGatewaySettings:
+		data = &repository.GatewaySettings{}
+	case repository.TableLoadBalancers:
+		data = &repository.LoadBalancer{}
+	case repository.TableNotificationSettings:
+		data = &repository.NotificationSettings{}
+	case repository.TableRedirects:
+		data = &repository.Redirect{}
+	case repository.TableStickinessOptions:
+		data = &repository.StickyOptions{}
+	case repository.TableSyncCheckOptions:
+		data = &repository.SyncCheckOptions{}
+	case repository.TableLbApps:
+		data = &repository.LbApp{}
+	default:
+		return nil, fmt.Errorf("%w: %s", errUnknownTable, table)
+	}
+
+	err := json.Unmarshal(rawData, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
@@ -0,0 +1,223 @@
+package replica
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/pokt-foundation/pocket-http-db/cache"
+	"github.com/pokt-foundation/pocket-http-db/snapshot"
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeed_Since(t *testing.T) {
+	c := require.New(t)
+
+	feed := NewFeed(2, logrus.New())
+
+	changes, err := feed.Since(feed.ID(), 0)
+	c.NoError(err)
+	c.Empty(changes.Changes)
+
+	for _, id := range []string{"0021", "0022", "0023"} {
+		feed.Append(repository.Notification{
+			Table:  repository.TableBlockchains,
+			Action: repository.ActionInsert,
+			Data:   &repository.Blockchain{ID: id},
+		})
+	}
+
+	c.Equal(uint64(3), feed.Sequence())
+
+	changes, err = feed.Since(feed.ID(), 1)
+	c.NoError(err)
+	c.Equal(uint64(3), changes.Sequence)
+	c.Len(changes.Changes, 2)
+	c.Equal(uint64(2), changes.Changes[0].Sequence)
+	c.JSONEq(`"0023"`, string(mustField(t, changes.Changes[1].Data, "id")))
+
+	changes, err = feed.Since(feed.ID(), 3)
+	c.NoError(err)
+	c.Empty(changes.Changes)
+
+	_, err = feed.Since(feed.ID(), 0)
+	c.ErrorIs(err, ErrFeedExpired)
+
+	_, err = feed.Since(feed.ID(), 4)
+	c.ErrorIs(err, ErrFeedExpired)
+
+	_, err = feed.Since("restarted", 3)
+	c.ErrorIs(err, ErrFeedExpired)
+}
+
+func TestFeed_SinceTime(t *testing.T) {
+	c := require.New(t)
+
+	feed := NewFeed(2, logrus.New())
+
+	start := time.Now()
+
+	changes, err := feed.SinceTime(time.Now())
+	c.NoError(err)
+	c.Empty(changes.Changes)
+
+	_, err = feed.SinceTime(start.Add(-time.Second))
+	c.ErrorIs(err, ErrFeedExpired)
+
+	for _, id := range []string{"0021", "0022"} {
+		feed.Append(repository.Notification{
+			Table:  repository.TableBlockchains,
+			Action: repository.ActionInsert,
+			Data:   &repository.Blockchain{ID: id},
+		})
+	}
+
+	changes, err = feed.SinceTime(start)
+	c.NoError(err)
+	c.Len(changes.Changes, 2)
+	c.False(changes.Changes[0].Time.IsZero())
+
+	changes, err = feed.SinceTime(changes.Changes[0].Time)
+	c.NoError(err)
+	c.Len(changes.Changes, 1)
+	c.Equal(uint64(2), changes.Changes[0].Sequence)
+
+	feed.Append(repository.Notification{
+		Table:  repository.TableBlockchains,
+		Action: repository.ActionInsert,
+		Data:   &repository.Blockchain{ID: "0023"},
+	})
+
+	_, err = feed.SinceTime(start)
+	c.ErrorIs(err, ErrFeedExpired)
+
+	changes, err = feed.SinceTime(changes.Changes[0].Time)
+	c.NoError(err)
+	c.Len(changes.Changes, 1)
+	c.Equal(uint64(3), changes.Changes[0].Sequence)
+}
+
+func mustField(t *testing.T, raw json.RawMessage, field string) json.RawMessage {
+	var fields map[string]json.RawMessage
+
+	require.NoError(t, json.Unmarshal(raw, &fields))
+
+	return fields[field]
+}
+
+type notifyingReader struct {
+	cache.Reader
+	notifications chan *repository.Notification
+}
+
+func (r *notifyingReader) NotificationChannel() <-chan *repository.Notification {
+	return r.notifications
+}
+
+func TestFeed_Record(t *testing.T) {
+	c := require.New(t)
+
+	inner := &notifyingReader{notifications: make(chan *repository.Notification, 1)}
+
+	feed := NewFeed(0, logrus.New())
+	reader := feed.Record(inner)
+
+	inner.notifications <- &repository.Notification{
+		Table:  repository.TableApplications,
+		Action: repository.ActionUpdate,
+		Data:   &repository.Application{ID: "5f62b7d8be3591c4dea8566d"},
+	}
+
+	n := <-reader.NotificationChannel()
+	c.Equal(repository.TableApplications, n.Table)
+	c.Equal(uint64(1), feed.Sequence())
+}
+
+func newPrimary(c *require.Assertions, feed *Feed, export *snapshot.Snapshot) *httptest.Server {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/changes/snapshot", func(w http.ResponseWriter, r *http.Request) {
+		c.Equal("follower_key", r.Header.Get("Authorization"))
+
+		_ = json.NewEncoder(w).Encode(Export{FeedID: feed.ID(), Sequence: feed.Sequence(), Snapshot: export})
+	})
+
+	mux.HandleFunc("/changes", func(w http.ResponseWriter, r *http.Request) {
+		since, err := strconv.ParseUint(r.URL.Query().Get("since"), 10, 64)
+		c.NoError(err)
+
+		changes, err := feed.Since(r.URL.Query().Get("feed"), since)
+		if err != nil {
+			w.WriteHeader(http.StatusGone)
+			return
+		}
+
+		_ = json.NewEncoder(w).Encode(changes)
+	})
+
+	return httptest.NewServer(mux)
+}
+
+func TestFollower(t *testing.T) {
+	c := require.New(t)
+
+	feed := NewFeed(0, logrus.New())
+
+	primary := newPrimary(c, feed, &snapshot.Snapshot{
+		Applications: []*repository.Application{
+			{ID: "5f62b7d8be3591c4dea8566d", UserID: "60ecb2bf67774900350d9c43"},
+		},
+		Blockchains: []*repository.Blockchain{{ID: "0021"}},
+		LoadBalancers: []*repository.LoadBalancer{
+			{ID: "60ecb2bf67774900350d9c42", ApplicationIDs: []string{"5f62b7d8be3591c4dea8566d"}},
+		},
+		PayPlans: []*repository.PayPlan{{PlanType: repository.FreetierV0, DailyLimit: 250000}},
+	})
+	defer primary.Close()
+
+	follower := NewFollower(primary.URL+"/", "follower_key", time.Second, logrus.New())
+
+	c.ErrorIs(follower.Poll(), ErrFeedExpired)
+
+	cache := cache.NewCache(follower, logrus.New())
+
+	c.NoError(cache.SetCache())
+	c.Len(cache.GetApplications(), 1)
+	c.Len(cache.GetLoadBalancer("60ecb2bf67774900350d9c42").Applications, 1)
+	c.NotNil(cache.GetPayPlan(repository.FreetierV0))
+
+	feed.Append(repository.Notification{
+		Table:  repository.TableBlockchains,
+		Action: repository.ActionInsert,
+		Data:   &repository.Blockchain{ID: "0022", Ticker: "ETH"},
+	})
+
+	c.NoError(follower.Poll())
+
+	c.Eventually(func() bool {
+		return cache.GetBlockchain("0022") != nil
+	}, time.Second, 10*time.Millisecond)
+	c.Equal("ETH", cache.GetBlockchain("0022").Ticker)
+
+	// a second refresh fetches the export again
+	c.NoError(cache.SetCache())
+	c.Len(cache.GetBlockchains(), 1)
+
+	c.NoError(follower.Poll())
+}
+
+func TestDecodeData(t *testing.T) {
+	c := require.New(t)
+
+	data, err := decodeData(repository.TableLbApps, json.RawMessage(`{"lb_id":"60ecb2bf67774900350d9c42","app_id":"5f62b7d8be3591c4dea8566d"}`))
+	c.NoError(err)
+	c.Equal(&repository.LbApp{LbID: "60ecb2bf67774900350d9c42", AppID: "5f62b7d8be3591c4dea8566d"}, data)
+
+	_, err = decodeData("users", json.RawMessage(`{}`))
+	c.ErrorIs(err, errUnknownTable)
+}
@@ -0,0 +1,129 @@
+// Package retention permanently purges removed entities once they are past their retention window
+package retention
+
+import (
+	"expvar"
+	"fmt"
+	"time"
+
+	"github.com/pokt-foundation/pocket-http-db/cache"
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/sirupsen/logrus"
+)
+
+// purgedCounts holds the total of purged entities by type, exposed in /debug/vars
+var purgedCounts = expvar.NewMap("retention_purged")
+
+// Purger represents the implementation of the purge writes
+type Purger interface {
+	PurgeApplications(ids []string) ([]string, error)
+	PurgeLoadBalancers(ids []string) ([]string, error)
+}
+
+// Policy holds how long removed entities are kept by type, a window <= 0 never purges that type
+type Policy struct {
+	Applications  time.Duration
+	LoadBalancers time.Duration
+}
+
+// Report holds the entities purged, or that would be purged on dry run, in a single run
+type Report struct {
+	DryRun        bool      `json:"dryRun"`
+	Time          time.Time `json:"time"`
+	Applications  []string  `json:"applications"`
+	LoadBalancers []string  `json:"loadBalancers"`
+}
+
+// Job struct handler for retention runs
+type Job struct {
+	cache  *cache.Cache
+	purger Purger
+	policy Policy
+	dryRun bool
+	log    *logrus.Logger
+}
+
+// NewJob returns Job instance purging from purger the expired entities found in cache
+// on dryRun entities are only reported
+func NewJob(cache *cache.Cache, purger Purger, policy Policy, dryRun bool, logger *logrus.Logger) *Job {
+	return &Job{
+		cache:  cache,
+		purger: purger,
+		policy: policy,
+		dryRun: dryRun,
+		log:    logger,
+	}
+}
+
+// Run purges the entities expired at now
+func (j *Job) Run(now time.Time) (*Report, error) {
+	report := &Report{
+		DryRun:        j.dryRun,
+		Time:          now,
+		Applications:  j.expiredApplications(now),
+		LoadBalancers: j.expiredLoadBalancers(now),
+	}
+
+	if j.dryRun {
+		return report, nil
+	}
+
+	if len(report.Applications) > 0 {
+		purged, err := j.purger.PurgeApplications(report.Applications)
+		if err != nil {
+			return nil, fmt.Errorf("PurgeApplications failed: %w", err)
+		}
+
+		j.cache.RemoveApplications(purged...)
+		purgedCounts.Add("applications", int64(len(purged)))
+
+		report.Applications = purged
+	}
+
+	if len(report.LoadBalancers) > 0 {
+		purged, err := j.purger.PurgeLoadBalancers(report.LoadBalancers)
+		if err != nil {
+			return nil, fmt.Errorf("PurgeLoadBalancers failed: %w", err)
+		}
+
+		j.cache.RemoveLoadBalancers(purged...)
+		purgedCounts.Add("load_balancers", int64(len(purged)))
+
+		report.LoadBalancers = purged
+	}
+
+	return report, nil
+}
+
+func (j *Job) expiredApplications(now time.Time) []string {
+	if j.policy.Applications <= 0 {
+		return nil
+	}
+
+	var expired []string
+
+	for _, app := range j.cache.GetApplications() {
+		if app.Status == repository.AwaitingGracePeriod && app.UpdatedAt.Add(j.policy.Applications).Before(now) {
+			expired = append(expired, app.ID)
+		}
+	}
+
+	return expired
+}
+
+func (j *Job) expiredLoadBalancers(now time.Time) []string {
+	if j.policy.LoadBalancers <= 0 {
+		return nil
+	}
+
+	var expired []string
+
+	// removed load balancers are the ones without user
+	for _, lb := range j.cache.GetLoadBalancers() {
+		if lb.UserID == "" && lb.UpdatedAt.Add(j.policy.LoadBalancers).Before(now) {
+			expired = append(expired, lb.ID)
+		}
+	}
+
+	return expired
+}
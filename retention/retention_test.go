@@ -0,0 +1,111 @@
+package retention
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/pokt-foundation/pocket-http-db/cache"
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type purgerMock struct {
+	mock.Mock
+}
+
+func (p *purgerMock) PurgeApplications(ids []string) ([]string, error) {
+	args := p.Called(ids)
+
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (p *purgerMock) PurgeLoadBalancers(ids []string) ([]string, error) {
+	args := p.Called(ids)
+
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func newTestCache(now time.Time) (*cache.Cache, error) {
+	readerMock := &cache.ReaderMock{}
+
+	readerMock.On("ReadPayPlans").Return([]*repository.PayPlan{}, nil)
+	readerMock.On("ReadRedirects").Return([]*repository.Redirect{}, nil)
+	readerMock.On("ReadBlockchains").Return([]*repository.Blockchain{}, nil)
+
+	readerMock.On("ReadApplications").Return([]*repository.Application{
+		{
+			ID:        "5f62b7d8be3591c4dea8566d",
+			Status:    repository.AwaitingGracePeriod,
+			UpdatedAt: now.Add(-40 * 24 * time.Hour),
+		},
+		{
+			ID:        "5f62b7d8be3591c4dea8566a",
+			Status:    repository.AwaitingGracePeriod,
+			UpdatedAt: now.Add(-10 * 24 * time.Hour),
+		},
+		{
+			ID:        "5f62b7d8be3591c4dea8566f",
+			Status:    repository.InService,
+			UpdatedAt: now.Add(-40 * 24 * time.Hour),
+		},
+	}, nil)
+
+	readerMock.On("ReadLoadBalancers").Return([]*repository.LoadBalancer{
+		{
+			ID:        "60ecb2bf67774900350d9c42",
+			UpdatedAt: now.Add(-100 * 24 * time.Hour),
+		},
+		{
+			ID:        "60ecb2bf67774900350d9c43",
+			UserID:    "60ecb2bf67774900350d9c43",
+			UpdatedAt: now.Add(-100 * 24 * time.Hour),
+		},
+	}, nil)
+
+	cache := cache.NewCache(readerMock, logrus.New())
+
+	return cache, cache.SetCache()
+}
+
+func TestJob_Run(t *testing.T) {
+	c := require.New(t)
+
+	now := time.Now()
+
+	cache, err := newTestCache(now)
+	c.NoError(err)
+
+	policy := Policy{
+		Applications:  30 * 24 * time.Hour,
+		LoadBalancers: 90 * 24 * time.Hour,
+	}
+
+	purger := &purgerMock{}
+
+	report, err := NewJob(cache, purger, policy, true, logrus.New()).Run(now)
+	c.NoError(err)
+	c.True(report.DryRun)
+	c.Equal([]string{"5f62b7d8be3591c4dea8566d"}, report.Applications)
+	c.Equal([]string{"60ecb2bf67774900350d9c42"}, report.LoadBalancers)
+	purger.AssertNotCalled(t, "PurgeApplications", mock.Anything)
+	c.Len(cache.GetApplications(), 3)
+
+	purger.On("PurgeApplications", []string{"5f62b7d8be3591c4dea8566d"}).Return([]string{"5f62b7d8be3591c4dea8566d"}, nil).Once()
+	purger.On("PurgeLoadBalancers", []string{"60ecb2bf67774900350d9c42"}).Return([]string{}, nil).Once()
+
+	report, err = NewJob(cache, purger, policy, false, logrus.New()).Run(now)
+	c.NoError(err)
+	c.Equal([]string{"5f62b7d8be3591c4dea8566d"}, report.Applications)
+	c.Empty(report.LoadBalancers)
+	c.Nil(cache.GetApplication("5f62b7d8be3591c4dea8566d"))
+	c.Len(cache.GetLoadBalancers(), 2)
+	c.Equal("1", purgedCounts.Get("applications").String())
+
+	purger.On("PurgeLoadBalancers", mock.Anything).Return([]string(nil), errors.New("dummy error")).Once()
+
+	_, err = NewJob(cache, purger, Policy{LoadBalancers: time.Hour}, false, logrus.New()).Run(now)
+	c.EqualError(err, "PurgeLoadBalancers failed: dummy error")
+}
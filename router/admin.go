@@ -0,0 +1,65 @@
+package router
+
+import (
+	"context"
+	"net/http"
+	"net/http/pprof"
+
+	"github.com/gorilla/mux"
+)
+
+// adminListenerKey is the context key marking the requests served by the Admin handler
+type adminListenerKey struct{}
+
+// Admin returns the handler of the admin listener, serving the routes of the admin group and the pprof profiles
+// behind the middlewares of the API, meant for a listener bound to localhost or an internal interface
+// with SeparateAdmin set the admin routes are only served here, so they can't be reached through the public load balancer
+func (rt *Router) Admin() http.Handler {
+	adminRouter := mux.NewRouter()
+
+	adminRouter.HandleFunc("/", rt.HealthCheck).Methods(http.MethodGet)
+
+	for _, rte := range rt.routes {
+		if rte.group == RouteGroupAdmin {
+			adminRouter.HandleFunc(rte.pattern, rte.handler).Methods(rte.method)
+		}
+	}
+
+	adminRouter.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	adminRouter.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	adminRouter.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	adminRouter.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	adminRouter.PathPrefix("/debug/pprof/").HandlerFunc(pprof.Index)
+
+	adminRouter.Use(adminListenerHandler)
+
+	for _, middleware := range rt.middlewares() {
+		adminRouter.Use(middleware)
+	}
+
+	return adminRouter
+}
+
+// adminListenerHandler marks the requests as served by the admin listener
+func adminListenerHandler(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		h.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), adminListenerKey{}, true)))
+	})
+}
+
+// adminRouteHandler responds as if the route did not exist when it is an admin route, SeparateAdmin is set
+// and the request did not come through the admin listener
+func (rt *Router) adminRouteHandler(group RouteGroup, handler http.HandlerFunc) http.HandlerFunc {
+	if group != RouteGroupAdmin {
+		return handler
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		if rt.SeparateAdmin && r.Context().Value(adminListenerKey{}) == nil {
+			respondWithError(w, http.StatusNotFound, errRouteDisabled.Error())
+			return
+		}
+
+		handler(w, r)
+	}
+}
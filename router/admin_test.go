@@ -0,0 +1,52 @@
+package router
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouter_Admin(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	admin := router.Admin()
+
+	get := func(h http.Handler, path string) int {
+		req, err := http.NewRequest(http.MethodGet, path, nil)
+		c.NoError(err)
+
+		rr := httptest.NewRecorder()
+
+		h.ServeHTTP(rr, req)
+
+		return rr.Code
+	}
+
+	// the admin routes require the admin scope, the other routes of the admin listener don't
+	c.Equal(http.StatusForbidden, get(router.Router, "/filter"))
+	c.Equal(http.StatusForbidden, get(admin, "/filter"))
+	c.Equal(http.StatusOK, get(admin, "/debug/pprof/"))
+
+	grantAdminScope(router)
+
+	c.Equal(http.StatusOK, get(router.Router, "/filter"))
+	c.Equal(http.StatusOK, get(admin, "/filter"))
+	c.Equal(http.StatusOK, get(admin, "/debug/pprof/"))
+	c.Equal(http.StatusNotFound, get(admin, "/application"))
+
+	router.SeparateAdmin = true
+
+	c.Equal(http.StatusNotFound, get(router.Router, "/filter"))
+	c.Equal(http.StatusNotFound, get(router.Router, "/debug/pprof/"))
+	c.Equal(http.StatusOK, get(router.Router, "/application"))
+	c.Equal(http.StatusOK, get(admin, "/filter"))
+
+	router.APIKeys = map[string]bool{"key": true}
+
+	c.Equal(http.StatusUnauthorized, get(admin, "/filter"))
+}
@@ -0,0 +1,106 @@
+package router
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/pokt-foundation/pocket-http-db/accesslog"
+	"github.com/pokt-foundation/pocket-http-db/notifier"
+	jsonresponse "github.com/pokt-foundation/utils-go/json-response"
+	"github.com/sirupsen/logrus"
+)
+
+// rateLimitWarningHeader is set on the writes of restricted keys close to their limit, before they get 429s
+const rateLimitWarningHeader = "X-RateLimit-Warning"
+
+var (
+	errAnomalyDetectionDisabled = errors.New("write anomaly detection not enabled")
+	errKeyNotRestricted         = errors.New("key is not restricted")
+	errWriteLimitRestricted     = errors.New("writes of this key are restricted after a write burst")
+)
+
+// WriteAnomalyHandler observes the writes of every API key, flagging unusual bursts
+// and rejecting the writes of restricted keys beyond their limit
+func (rt *Router) WriteAnomalyHandler(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if rt.WriteAnomalies == nil || isReadRequest(r) {
+			h.ServeHTTP(w, r)
+
+			return
+		}
+
+		keyID := accesslog.KeyID(r.Header.Get("Authorization"))
+		route := routeTemplate(r)
+
+		decision := rt.WriteAnomalies.Observe(keyID, route)
+
+		if decision.Anomaly != nil {
+			rt.log.WithFields(logrus.Fields{
+				"keyID":           keyID,
+				"route":           route,
+				"count":           decision.Anomaly.Count,
+				"restrictedUntil": decision.Anomaly.RestrictedUntil,
+			}).Warn("write burst detected")
+
+			if rt.Metrics != nil {
+				rt.Metrics.ObserveWriteAnomaly(keyID, route)
+			}
+
+			if rt.Notifier != nil {
+				rt.Notifier.Notify(notifier.Notification{
+					Event:   notifier.EventWriteAnomaly,
+					Subject: fmt.Sprintf("Write burst from API key %s", keyID),
+					Text: fmt.Sprintf("API key %s made %d writes on %s since %s",
+						keyID, decision.Anomaly.Count, route, decision.Anomaly.WindowStart.UTC().Format(time.RFC3339)),
+				})
+			}
+		}
+
+		if !decision.Allowed {
+			w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(decision.RetryAfter.Seconds()))))
+			respondWithError(w, http.StatusTooManyRequests, errWriteLimitRestricted.Error())
+
+			return
+		}
+
+		if decision.Warning != nil {
+			w.Header().Set(rateLimitWarningHeader, fmt.Sprintf("%d of %d writes used, resets in %ds",
+				decision.Warning.Count, decision.Warning.Limit, int(math.Ceil(decision.Warning.ResetIn.Seconds()))))
+
+			if decision.Warning.First && rt.Metrics != nil {
+				rt.Metrics.ObserveRateLimitWarning(keyID)
+			}
+		}
+
+		h.ServeHTTP(w, r)
+	})
+}
+
+// GetWriteAnomalies responds with the recent write bursts and the restricted keys
+func (rt *Router) GetWriteAnomalies(w http.ResponseWriter, r *http.Request) {
+	if rt.WriteAnomalies == nil {
+		respondWithError(w, http.StatusNotFound, errAnomalyDetectionDisabled.Error())
+		return
+	}
+
+	jsonresponse.RespondWithJSON(w, http.StatusOK, rt.WriteAnomalies.Status())
+}
+
+// LiftWriteRestriction removes the restriction of the key with the ID of the path
+func (rt *Router) LiftWriteRestriction(w http.ResponseWriter, r *http.Request) {
+	if rt.WriteAnomalies == nil {
+		respondWithError(w, http.StatusNotFound, errAnomalyDetectionDisabled.Error())
+		return
+	}
+
+	if !rt.WriteAnomalies.Lift(pathParam(r, "keyID")) {
+		respondWithError(w, http.StatusNotFound, errKeyNotRestricted.Error())
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
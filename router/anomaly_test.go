@@ -0,0 +1,96 @@
+package router
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pokt-foundation/pocket-http-db/accesslog"
+	"github.com/pokt-foundation/pocket-http-db/anomaly"
+	"github.com/pokt-foundation/pocket-http-db/metrics"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouter_WriteAnomalies(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	router.APIKeys["burst_key"] = true
+	router.KeyScopes = map[string]map[string]bool{
+		accesslog.KeyID(""):          {ScopeAdmin: true},
+		accesslog.KeyID("burst_key"): {ScopeAdmin: true},
+	}
+
+	serve := func(method, path string) *httptest.ResponseRecorder {
+		req, err := http.NewRequest(method, path, strings.NewReader("{"))
+		c.NoError(err)
+
+		req.Header.Set("Authorization", "burst_key")
+
+		rr := httptest.NewRecorder()
+
+		router.Router.ServeHTTP(rr, req)
+
+		return rr
+	}
+
+	c.Equal(http.StatusNotFound, serve(http.MethodGet, "/admin/write_anomalies").Code)
+
+	router.Metrics = metrics.NewRegistry(metrics.DefaultBuckets, 0)
+	router.WriteAnomalies = anomaly.NewDetector(2, time.Hour)
+	router.WriteAnomalies.RestrictedLimit = 1
+	router.WriteAnomalies.RestrictFor = time.Hour
+
+	// the writes are rejected by the handler, they still count
+	for i := 0; i < 3; i++ {
+		c.Equal(http.StatusBadRequest, serve(http.MethodPost, "/application").Code)
+	}
+
+	// reads are not observed
+	c.Equal(http.StatusOK, serve(http.MethodGet, "/application").Code)
+
+	// the last write allowed to the restricted key is warned
+	rr := serve(http.MethodPost, "/application")
+	c.Equal(http.StatusBadRequest, rr.Code)
+	c.Contains(rr.Header().Get("X-RateLimit-Warning"), "1 of 1 writes used")
+
+	rr = serve(http.MethodPost, "/application")
+	c.Equal(http.StatusTooManyRequests, rr.Code)
+	c.NotEmpty(rr.Header().Get("Retry-After"))
+
+	rr = serve(http.MethodGet, "/admin/write_anomalies")
+	c.Equal(http.StatusOK, rr.Code)
+
+	var status anomaly.Status
+
+	c.NoError(json.Unmarshal(rr.Body.Bytes(), &status))
+	c.Len(status.Anomalies, 1)
+	c.Equal("/application", status.Anomalies[0].Route)
+	c.Equal(3, status.Anomalies[0].Count)
+	c.Len(status.Restrictions, 1)
+
+	keyID := accesslog.KeyID("burst_key")
+
+	var b strings.Builder
+	c.NoError(router.Metrics.Write(&b))
+	c.Contains(b.String(), `pocket_http_db_write_anomalies_total{key="`+keyID+`",route="/application"} 1`)
+	c.Contains(b.String(), `pocket_http_db_rate_limit_warnings_total{key="`+keyID+`"} 1`)
+
+	// lifting the restriction is a write of the restricted key too, so it is lifted by another key
+	req, err := http.NewRequest(http.MethodDelete, "/admin/write_anomalies/restriction/"+keyID, nil)
+	c.NoError(err)
+
+	rr = httptest.NewRecorder()
+	router.Router.ServeHTTP(rr, req)
+	c.Equal(http.StatusNoContent, rr.Code)
+
+	rr = serve(http.MethodPost, "/application")
+	c.Equal(http.StatusBadRequest, rr.Code)
+	c.Empty(rr.Header().Get("X-RateLimit-Warning"))
+	c.Equal(http.StatusNotFound, serve(http.MethodDelete, "/admin/write_anomalies/restriction/"+keyID).Code)
+}
@@ -0,0 +1,36 @@
+package router
+
+import (
+	"fmt"
+	"net/http"
+
+	jsonresponse "github.com/pokt-foundation/utils-go/json-response"
+)
+
+// applicationDeltaInput struct holding the applications a consumer holds, by ID, with their version or last update time
+type applicationDeltaInput struct {
+	Known map[string]string `json:"known"`
+}
+
+// GetApplicationDelta responds with the known applications that changed and the IDs of the ones removed,
+// so consumers can sync their copies without reading every application again
+func (rt *Router) GetApplicationDelta(w http.ResponseWriter, r *http.Request) {
+	var input applicationDeltaInput
+
+	err := decodeBody(r, &input)
+	if err != nil {
+		rt.logError(fmt.Errorf("GetApplicationDelta decode failed: %w", err))
+		respondWithBodyError(w, err)
+		return
+	}
+
+	defer r.Body.Close()
+
+	delta := rt.applications().Delta(input.Known)
+
+	if !rt.allowsListSize(w, r, len(delta.Changed)) {
+		return
+	}
+
+	jsonresponse.RespondWithJSON(w, http.StatusOK, delta)
+}
@@ -0,0 +1,58 @@
+package router
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pokt-foundation/pocket-http-db/service"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouter_GetApplicationDelta(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	req, err := http.NewRequest(http.MethodGet, "/application/5f62b7d8be3591c4dea8566d", nil)
+	c.NoError(err)
+
+	rr := httptest.NewRecorder()
+	router.Router.ServeHTTP(rr, req)
+	c.Equal(http.StatusOK, rr.Code)
+
+	etag := rr.Header().Get("ETag")
+	c.NotEmpty(etag)
+
+	rawInput, err := json.Marshal(applicationDeltaInput{Known: map[string]string{
+		"5f62b7d8be3591c4dea8566d": etag,
+		"5f62b7d8be3591c4dea8566a": "0123456789abcdef",
+		"5f62b7d8be3591c4dea85664": etag,
+	}})
+	c.NoError(err)
+
+	req, err = http.NewRequest(http.MethodPost, "/application/delta", bytes.NewBuffer(rawInput))
+	c.NoError(err)
+
+	rr = httptest.NewRecorder()
+	router.Router.ServeHTTP(rr, req)
+	c.Equal(http.StatusOK, rr.Code)
+
+	var delta service.ApplicationDelta
+
+	c.NoError(json.Unmarshal(rr.Body.Bytes(), &delta))
+	c.Len(delta.Changed, 1)
+	c.Equal("5f62b7d8be3591c4dea8566a", delta.Changed[0].ID)
+	c.NotEmpty(delta.Changed[0].Version)
+	c.Equal([]string{"5f62b7d8be3591c4dea85664"}, delta.Removed)
+
+	req, err = http.NewRequest(http.MethodPost, "/application/delta", bytes.NewBufferString("{"))
+	c.NoError(err)
+
+	rr = httptest.NewRecorder()
+	router.Router.ServeHTTP(rr, req)
+	c.Equal(http.StatusBadRequest, rr.Code)
+}
@@ -0,0 +1,80 @@
+package router
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/pokt-foundation/pocket-http-db/types"
+	jsonresponse "github.com/pokt-foundation/utils-go/json-response"
+)
+
+func (rt *Router) GetApplicationFilters(w http.ResponseWriter, r *http.Request) {
+	jsonresponse.RespondWithJSON(w, http.StatusOK, rt.applications().GetFilters())
+}
+
+func (rt *Router) GetApplicationFilter(w http.ResponseWriter, r *http.Request) {
+	filter, err := rt.applications().GetFilter(pathParam(r, "name"))
+	if err != nil {
+		rt.respondWithServiceError(w, "GetApplicationFilter", err)
+		return
+	}
+
+	jsonresponse.RespondWithJSON(w, http.StatusOK, filter)
+}
+
+// SetApplicationFilter saves the filter in the body under the name of the path, replacing the filter with that name
+func (rt *Router) SetApplicationFilter(w http.ResponseWriter, r *http.Request) {
+	var filter types.ApplicationFilter
+
+	err := decodeBody(r, &filter)
+	if err != nil {
+		rt.logError(fmt.Errorf("SetApplicationFilter decode failed: %w", err))
+		respondWithBodyError(w, err)
+		return
+	}
+
+	defer r.Body.Close()
+
+	filter.Name = pathParam(r, "name")
+
+	savedFilter, err := rt.applications().SetFilter(r.Context(), &filter)
+	if err != nil {
+		rt.respondWithServiceError(w, "SetApplicationFilter", err)
+		return
+	}
+
+	jsonresponse.RespondWithJSON(w, http.StatusOK, savedFilter)
+}
+
+func (rt *Router) RemoveApplicationFilter(w http.ResponseWriter, r *http.Request) {
+	filter, err := rt.applications().RemoveFilter(r.Context(), pathParam(r, "name"))
+	if err != nil {
+		rt.respondWithServiceError(w, "RemoveApplicationFilter", err)
+		return
+	}
+
+	jsonresponse.RespondWithJSON(w, http.StatusOK, filter)
+}
+
+// GetApplicationsByFilter returns the applications matching the named filter, expanding their pay plans if asked to
+func (rt *Router) GetApplicationsByFilter(w http.ResponseWriter, r *http.Request) {
+	expand, err := expandsPayPlan(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	apps := rt.applications()
+
+	filteredApps, err := apps.GetByFilter(pathParam(r, "name"))
+	if err != nil {
+		rt.respondWithServiceError(w, "GetApplicationsByFilter", err)
+		return
+	}
+
+	if !rt.allowsListSize(w, r, len(filteredApps)) {
+		return
+	}
+
+	respondWithApplications(w, apps, filteredApps, expand)
+}
@@ -0,0 +1,87 @@
+package router
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/pokt-foundation/pocket-http-db/types"
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouter_ApplicationFilters(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	grantAdminScope(router)
+
+	savedFilter := &types.ApplicationFilter{Name: "freetier-apps", PayPlanType: repository.FreetierV0}
+
+	writerMock := &writerMock{}
+	writerMock.On("WriteApplicationFilter", savedFilter).Return(nil).Once()
+	writerMock.On("RemoveApplicationFilter", "freetier-apps").Return(nil).Once()
+
+	router.Writer = writerMock
+
+	tests := []struct {
+		method       string
+		path         string
+		body         string
+		expectedCode int
+		expectedBody string
+	}{
+		{http.MethodPut, "/filter/freetier-apps", `{"payPlanType":"freetier_v0"}`, http.StatusOK, `{"name":"freetier-apps","payPlanType":"FREETIER_V0"}`},
+		{http.MethodGet, "/filter", "", http.StatusOK, `[{"name":"freetier-apps","payPlanType":"FREETIER_V0"}]`},
+		{http.MethodGet, "/filter/freetier-apps", "", http.StatusOK, `{"name":"freetier-apps","payPlanType":"FREETIER_V0"}`},
+		{http.MethodPut, "/filter/in-service", `{"status":"WRONG"}`, http.StatusBadRequest, ""},
+		{http.MethodPut, "/filter/in-service", `{"status"`, http.StatusBadRequest, ""},
+		{http.MethodGet, "/filter/wrong", "", http.StatusNotFound, ""},
+		{http.MethodGet, "/filter/wrong/applications", "", http.StatusNotFound, ""},
+	}
+
+	for _, tt := range tests {
+		req, err := http.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+		c.NoError(err)
+
+		rr := httptest.NewRecorder()
+
+		router.Router.ServeHTTP(rr, req)
+
+		c.Equal(tt.expectedCode, rr.Code, tt.path)
+
+		if tt.expectedBody != "" {
+			c.JSONEq(tt.expectedBody, rr.Body.String())
+		}
+	}
+
+	req, err := http.NewRequest(http.MethodGet, "/filter/freetier-apps/applications", nil)
+	c.NoError(err)
+
+	rr := httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusOK, rr.Code)
+
+	var apps []*repository.Application
+	c.NoError(json.Unmarshal(rr.Body.Bytes(), &apps))
+	c.Len(apps, 1)
+	c.Equal("5f62b7d8be3591c4dea8566d", apps[0].ID)
+
+	req, err = http.NewRequest(http.MethodDelete, "/filter/freetier-apps", nil)
+	c.NoError(err)
+
+	rr = httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusOK, rr.Code)
+	c.Empty(router.applications().GetFilters())
+
+	writerMock.AssertExpectations(t)
+}
@@ -0,0 +1,38 @@
+package router
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/pokt-foundation/portal-api-go/repository"
+	jsonresponse "github.com/pokt-foundation/utils-go/json-response"
+)
+
+// updateApplicationsStatus struct holding the input of a bulk status update
+type updateApplicationsStatus struct {
+	ApplicationIDs []string             `json:"applicationIDs"`
+	Status         repository.AppStatus `json:"status"`
+}
+
+// UpdateApplicationsStatus moves a batch of applications to the same status
+// every valid application is updated in a single transaction, invalid ones are reported in the results
+func (rt *Router) UpdateApplicationsStatus(w http.ResponseWriter, r *http.Request) {
+	var updateInput updateApplicationsStatus
+
+	err := decodeBody(r, &updateInput)
+	if err != nil {
+		rt.logError(fmt.Errorf("UpdateApplicationsStatus decode failed: %w", err))
+		respondWithBodyError(w, err)
+		return
+	}
+
+	defer r.Body.Close()
+
+	results, err := rt.applications().UpdateStatus(r.Context(), updateInput.ApplicationIDs, updateInput.Status)
+	if err != nil {
+		rt.respondWithServiceError(w, "UpdateApplicationsStatus", err)
+		return
+	}
+
+	jsonresponse.RespondWithJSON(w, http.StatusOK, results)
+}
@@ -0,0 +1,109 @@
+package router
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pokt-foundation/pocket-http-db/service"
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouter_UpdateApplicationsStatus(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	router.Cache.UpdateApplication("5f62b7d8be3591c4dea8566d", func(app *repository.Application) { app.Status = repository.InService })
+	router.Cache.UpdateApplication("5f62b7d8be3591c4dea8566a", func(app *repository.Application) { app.Status = repository.Decomissioned })
+
+	writerMock := &writerMock{}
+
+	writerMock.On("UpdateApplicationsStatus", mock.Anything).Return(nil).Once()
+
+	router.Writer = writerMock
+
+	inputToSend, err := json.Marshal(&updateApplicationsStatus{
+		ApplicationIDs: []string{
+			"5f62b7d8be3591c4dea8566d",
+			"5f62b7d8be3591c4dea8566a",
+			"5f62b7d8be3591c4dea8566f",
+			"5f62b7d8be3591c4dea85664",
+			"5f62b7d8be3591c4dea8566d",
+		},
+		Status: repository.Orphaned,
+	})
+	c.NoError(err)
+
+	req, err := http.NewRequest(http.MethodPost, "/application/status", bytes.NewBuffer(inputToSend))
+	c.NoError(err)
+
+	rr := httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusOK, rr.Code)
+
+	var results []service.ApplicationStatusResult
+
+	c.NoError(json.Unmarshal(rr.Body.Bytes(), &results))
+	c.Equal([]service.ApplicationStatusResult{
+		{ID: "5f62b7d8be3591c4dea8566d", PreviousStatus: repository.InService, Updated: true},
+		{ID: "5f62b7d8be3591c4dea8566a", PreviousStatus: repository.Decomissioned, Error: "invalid status transition: DECOMISSIONED to ORPHANED"},
+		{ID: "5f62b7d8be3591c4dea8566f", Updated: true},
+		{ID: "5f62b7d8be3591c4dea85664", Error: service.ErrApplicationNotFound.Error()},
+		{ID: "5f62b7d8be3591c4dea8566d", Error: service.ErrDuplicatedApplicationID.Error()},
+	}, results)
+
+	c.Equal(repository.Orphaned, router.Cache.GetApplication("5f62b7d8be3591c4dea8566d").Status)
+	c.Equal(repository.Orphaned, router.Cache.GetApplication("5f62b7d8be3591c4dea8566f").Status)
+	c.Equal(repository.Decomissioned, router.Cache.GetApplication("5f62b7d8be3591c4dea8566a").Status)
+
+	req, err = http.NewRequest(http.MethodPost, "/application/status", bytes.NewBuffer(inputToSend))
+	c.NoError(err)
+
+	rr = httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusOK, rr.Code)
+
+	c.NoError(json.Unmarshal(rr.Body.Bytes(), &results))
+	c.Equal(service.ApplicationStatusResult{ID: "5f62b7d8be3591c4dea8566d", PreviousStatus: repository.Orphaned}, results[0])
+
+	router.Cache.UpdateApplication("5f62b7d8be3591c4dea8566d", func(app *repository.Application) { app.Status = repository.InService })
+
+	writerMock.On("UpdateApplicationsStatus", mock.Anything).Return(errors.New("dummy error")).Once()
+
+	req, err = http.NewRequest(http.MethodPost, "/application/status", bytes.NewBuffer(inputToSend))
+	c.NoError(err)
+
+	rr = httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusInternalServerError, rr.Code)
+	c.Equal(repository.InService, router.Cache.GetApplication("5f62b7d8be3591c4dea8566d").Status)
+
+	for _, body := range []string{
+		"wrong",
+		`{"applicationIDs":[],"status":"ORPHANED"}`,
+		`{"applicationIDs":["5f62b7d8be3591c4dea8566d"],"status":"WRONG"}`,
+		`{"applicationIDs":["5f62b7d8be3591c4dea8566d"]}`,
+	} {
+		req, err = http.NewRequest(http.MethodPost, "/application/status", bytes.NewBufferString(body))
+		c.NoError(err)
+
+		rr = httptest.NewRecorder()
+
+		router.Router.ServeHTTP(rr, req)
+
+		c.Equal(http.StatusBadRequest, rr.Code)
+	}
+}
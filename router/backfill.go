@@ -0,0 +1,45 @@
+package router
+
+import (
+	"errors"
+	"net/http"
+
+	jsonresponse "github.com/pokt-foundation/utils-go/json-response"
+)
+
+var errBackfillsDisabled = errors.New("backfills not enabled")
+
+// StartBackfill starts recomputing and persisting the field of the path across all the entities,
+// responding with the progress and the status URL, or the progress of the backfill already in progress
+func (rt *Router) StartBackfill(w http.ResponseWriter, r *http.Request) {
+	if rt.Backfills == nil {
+		respondWithError(w, http.StatusNotFound, errBackfillsDisabled.Error())
+		return
+	}
+
+	status, _, err := rt.Backfills.Start(pathParam(r, "field"))
+	if err != nil {
+		rt.respondWithServiceError(w, "StartBackfill", err)
+		return
+	}
+
+	w.Header().Set("Location", r.URL.Path)
+
+	jsonresponse.RespondWithJSON(w, http.StatusAccepted, status)
+}
+
+// GetBackfillStatus returns the progress of the last backfill of the field of the path
+func (rt *Router) GetBackfillStatus(w http.ResponseWriter, r *http.Request) {
+	if rt.Backfills == nil {
+		respondWithError(w, http.StatusNotFound, errBackfillsDisabled.Error())
+		return
+	}
+
+	status, err := rt.Backfills.Status(pathParam(r, "field"))
+	if err != nil {
+		rt.respondWithServiceError(w, "GetBackfillStatus", err)
+		return
+	}
+
+	jsonresponse.RespondWithJSON(w, http.StatusOK, status)
+}
@@ -0,0 +1,67 @@
+package router
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/pokt-foundation/pocket-http-db/service"
+	"github.com/pokt-foundation/pocket-http-db/types"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouter_Backfill(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	grantAdminScope(router)
+
+	serve := func(method, path string) (*httptest.ResponseRecorder, service.BackfillStatus) {
+		req, err := http.NewRequest(method, path, nil)
+		c.NoError(err)
+
+		rr := httptest.NewRecorder()
+
+		router.Router.ServeHTTP(rr, req)
+
+		var status service.BackfillStatus
+
+		_ = json.Unmarshal(rr.Body.Bytes(), &status)
+
+		return rr, status
+	}
+
+	rr, _ := serve(http.MethodPost, "/admin/backfill/updated_at")
+	c.Equal(http.StatusNotFound, rr.Code)
+
+	writerMock := &writerMock{}
+	writerMock.On("BackfillUpdatedAt", types.EntityApplication, mock.Anything).Return(int64(3), nil).Once()
+	writerMock.On("BackfillUpdatedAt", types.EntityLoadBalancer, mock.Anything).Return(int64(2), nil).Once()
+
+	router.Backfills = service.NewBackfiller(router.Cache, writerMock, logrus.New())
+
+	rr, _ = serve(http.MethodPost, "/admin/backfill/created_at")
+	c.Equal(http.StatusNotFound, rr.Code)
+
+	rr, status := serve(http.MethodPost, "/admin/backfill/updated_at")
+	c.Equal(http.StatusAccepted, rr.Code)
+	c.Equal("/admin/backfill/updated_at", rr.Header().Get("Location"))
+	c.Equal(5, status.Total)
+
+	c.Eventually(func() bool {
+		rr, status = serve(http.MethodGet, "/admin/backfill/updated_at")
+		return rr.Code == http.StatusOK && !status.InProgress
+	}, time.Second, 5*time.Millisecond)
+
+	c.Equal(5, status.Processed)
+	c.Equal(int64(5), status.Updated)
+	c.Equal(2, status.Batches)
+
+	writerMock.AssertExpectations(t)
+}
@@ -0,0 +1,61 @@
+package router
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/pokt-foundation/pocket-http-db/snapshot"
+	jsonresponse "github.com/pokt-foundation/utils-go/json-response"
+)
+
+var errBackupVerificationDisabled = errors.New("backup verification not configured")
+
+// backupVerification is the output of backup verifications
+type backupVerification struct {
+	Verified          bool             `json:"verified"`
+	SnapshotCreatedAt time.Time        `json:"snapshotCreatedAt"`
+	Entities          []snapshot.Drift `json:"entities"`
+}
+
+func (rt *Router) VerifyBackup(w http.ResponseWriter, r *http.Request) {
+	if rt.Snapshots == nil {
+		respondWithError(w, http.StatusNotFound, errBackupVerificationDisabled.Error())
+		return
+	}
+
+	stored, err := rt.Snapshots.Load()
+	if err != nil {
+		rt.logError(fmt.Errorf("Load in VerifyBackup failed: %w", err))
+
+		if errors.Is(err, snapshot.ErrNotFound) {
+			respondWithError(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		respondWithError(w, http.StatusBadGateway, err.Error())
+		return
+	}
+
+	drifts, err := snapshot.Compare(snapshot.FromCache(rt.Cache), stored)
+	if err != nil {
+		rt.logError(fmt.Errorf("Compare in VerifyBackup failed: %w", err))
+		respondWithError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	verification := backupVerification{
+		Verified:          true,
+		SnapshotCreatedAt: stored.CreatedAt,
+		Entities:          drifts,
+	}
+
+	for _, drift := range drifts {
+		if drift.RowDrift != 0 || !drift.HashMatch {
+			verification.Verified = false
+		}
+	}
+
+	jsonresponse.RespondWithJSON(w, http.StatusOK, verification)
+}
@@ -0,0 +1,71 @@
+package router
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pokt-foundation/pocket-http-db/snapshot"
+	"github.com/pokt-foundation/pocket-http-db/types"
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouter_VerifyBackup(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	grantAdminScope(router)
+
+	verify := func() *httptest.ResponseRecorder {
+		req, err := http.NewRequest(http.MethodGet, "/admin/backup/verify", nil)
+		c.NoError(err)
+
+		rr := httptest.NewRecorder()
+
+		router.Router.ServeHTTP(rr, req)
+
+		return rr
+	}
+
+	rr := verify()
+	c.Equal(http.StatusNotFound, rr.Code)
+
+	path := filepath.Join(t.TempDir(), "snapshot.json")
+
+	router.Snapshots = snapshot.NewLocalStore(path)
+
+	rr = verify()
+	c.Equal(http.StatusNotFound, rr.Code)
+
+	rawSnapshot, err := json.Marshal(snapshot.FromCache(router.Cache))
+	c.NoError(err)
+	c.NoError(os.WriteFile(path, rawSnapshot, 0o600))
+
+	rr = verify()
+	c.Equal(http.StatusOK, rr.Code)
+
+	var verification backupVerification
+
+	c.NoError(json.Unmarshal(rr.Body.Bytes(), &verification))
+	c.True(verification.Verified)
+	c.Len(verification.Entities, 5)
+
+	router.Cache.UpdateApplication("5f62b7d8be3591c4dea8566d", func(app *repository.Application) { app.Name = "changed" })
+
+	rr = verify()
+	c.Equal(http.StatusOK, rr.Code)
+
+	c.NoError(json.Unmarshal(rr.Body.Bytes(), &verification))
+	c.False(verification.Verified)
+
+	for _, drift := range verification.Entities {
+		c.Equal(drift.EntityType != types.EntityApplication, drift.HashMatch)
+		c.Zero(drift.RowDrift)
+	}
+}
@@ -0,0 +1,72 @@
+package router
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/pokt-foundation/portal-api-go/repository"
+	jsonresponse "github.com/pokt-foundation/utils-go/json-response"
+)
+
+const (
+	applicationBatchPath  = "/application/batch"
+	loadBalancerBatchPath = "/load_balancer/batch"
+)
+
+// applicationsBatch struct holding the applications of a batch read and the IDs not found
+type applicationsBatch struct {
+	Applications []*repository.Application `json:"applications"`
+	Missing      []string                  `json:"missing"`
+}
+
+// loadBalancersBatch struct holding the load balancers of a batch read and the IDs not found
+type loadBalancersBatch struct {
+	LoadBalancers []*repository.LoadBalancer `json:"loadBalancers"`
+	Missing       []string                   `json:"missing"`
+}
+
+// GetApplicationsBatch responds with the cached applications of the JSON array of IDs in the body and the IDs not found,
+// replacing a GET /application/{id} per application
+func (rt *Router) GetApplicationsBatch(w http.ResponseWriter, r *http.Request) {
+	var ids []string
+
+	err := decodeBody(r, &ids)
+	if err != nil {
+		rt.logError(fmt.Errorf("GetApplicationsBatch decode failed: %w", err))
+		respondWithBodyError(w, err)
+		return
+	}
+
+	defer r.Body.Close()
+
+	apps, missing, err := rt.applications().GetMany(ids)
+	if err != nil {
+		rt.respondWithServiceError(w, "GetApplicationsBatch", err)
+		return
+	}
+
+	jsonresponse.RespondWithJSON(w, http.StatusOK, applicationsBatch{Applications: apps, Missing: missing})
+}
+
+// GetLoadBalancersBatch responds with the cached load balancers of the JSON array of IDs in the body and the IDs not found,
+// replacing a GET /load_balancer/{id} per load balancer
+func (rt *Router) GetLoadBalancersBatch(w http.ResponseWriter, r *http.Request) {
+	var ids []string
+
+	err := decodeBody(r, &ids)
+	if err != nil {
+		rt.logError(fmt.Errorf("GetLoadBalancersBatch decode failed: %w", err))
+		respondWithBodyError(w, err)
+		return
+	}
+
+	defer r.Body.Close()
+
+	lbs, missing, err := rt.loadBalancers().GetMany(ids)
+	if err != nil {
+		rt.respondWithServiceError(w, "GetLoadBalancersBatch", err)
+		return
+	}
+
+	jsonresponse.RespondWithJSON(w, http.StatusOK, loadBalancersBatch{LoadBalancers: lbs, Missing: missing})
+}
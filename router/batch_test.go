@@ -0,0 +1,57 @@
+package router
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouter_GetBatches(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	post := func(path, body string) *httptest.ResponseRecorder {
+		req, err := http.NewRequest(http.MethodPost, path, bytes.NewBufferString(body))
+		c.NoError(err)
+
+		rr := httptest.NewRecorder()
+		router.Router.ServeHTTP(rr, req)
+
+		return rr
+	}
+
+	rr := post("/application/batch", `["5f62b7d8be3591c4dea8566f", "5f62b7d8be3591c4dea85664"]`)
+	c.Equal(http.StatusOK, rr.Code)
+
+	var apps applicationsBatch
+
+	c.NoError(json.Unmarshal(rr.Body.Bytes(), &apps))
+	c.Len(apps.Applications, 1)
+	c.Equal("5f62b7d8be3591c4dea8566f", apps.Applications[0].ID)
+	c.Equal([]string{"5f62b7d8be3591c4dea85664"}, apps.Missing)
+
+	rr = post("/load_balancer/batch", `["60ecb2bf67774900350d9c42", "60ecb2bf67774900350d9c43"]`)
+	c.Equal(http.StatusOK, rr.Code)
+
+	var lbs loadBalancersBatch
+
+	c.NoError(json.Unmarshal(rr.Body.Bytes(), &lbs))
+	c.Len(lbs.LoadBalancers, 2)
+	c.Empty(lbs.Missing)
+
+	rr = post("/load_balancer/batch", `{"ids": []}`)
+	c.Equal(http.StatusBadRequest, rr.Code)
+
+	// batch reads are reads, served by followers
+	router.ReadOnly = true
+
+	rr = post("/application/batch", `[]`)
+	c.Equal(http.StatusOK, rr.Code)
+	c.JSONEq(`{"applications": [], "missing": []}`, rr.Body.String())
+}
@@ -0,0 +1,159 @@
+package router
+
+import (
+	"crypto/hmac"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/pokt-foundation/pocket-http-db/service"
+	"github.com/pokt-foundation/pocket-http-db/webhook"
+	jsonresponse "github.com/pokt-foundation/utils-go/json-response"
+)
+
+// billingWebhookPath is authenticated by the request signature instead of an API key
+const billingWebhookPath = "/integrations/billing/webhook"
+
+const (
+	// BillingSignatureHeader is the header holding the signature of the events sent by the billing provider,
+	// see SignBillingEvent
+	BillingSignatureHeader = "X-Billing-Signature"
+	// BillingTimestampHeader holds the unix time in seconds a billing event was sent at
+	BillingTimestampHeader = "X-Billing-Timestamp"
+
+	// DefaultBillingTolerance is how far from the server time a billing event timestamp can be
+	DefaultBillingTolerance = 5 * time.Minute
+
+	// maxBillingEventSize is the largest body of a billing event read
+	maxBillingEventSize = 64 << 10
+)
+
+// billingEventPlanChanged is the only billing event type acted upon, others are acknowledged and ignored
+const billingEventPlanChanged = "plan.changed"
+
+var (
+	errBillingWebhookDisabled = errors.New("billing webhook not configured")
+	errInvalidSignature       = errors.New("invalid signature")
+	errStaleBillingEvent      = errors.New("billing event timestamp outside of the tolerance window")
+	errUnknownPlanCode        = errors.New("unknown plan code")
+)
+
+// billingEvent struct holding the input of billing provider webhooks
+type billingEvent struct {
+	ID            string `json:"id"`
+	Type          string `json:"type"`
+	ApplicationID string `json:"applicationID"`
+	PlanCode      string `json:"planCode"`
+}
+
+// SignBillingEvent returns the signature of a billing event, the hex HMAC-SHA256 with secret of
+// the timestamp and the body joined by a dot
+func SignBillingEvent(secret, timestamp string, body []byte) string {
+	return webhook.Sign([]byte(secret), append([]byte(timestamp+"."), body...))
+}
+
+// validBillingSignature reports whether signature is the signature of the billing event sent at timestamp with body
+func validBillingSignature(secret, timestamp string, body []byte, signature string) bool {
+	expected, err := hex.DecodeString(SignBillingEvent(secret, timestamp, body))
+	if err != nil {
+		return false
+	}
+
+	received, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+
+	return hmac.Equal(expected, received)
+}
+
+// BillingWebhook applies the plan changes sent by the billing provider, whose events are signed with their timestamp
+// events sent further than BillingTolerance from the server time are rejected, so captured events cannot be replayed
+func (rt *Router) BillingWebhook(w http.ResponseWriter, r *http.Request) {
+	if rt.BillingWebhookSecret == "" {
+		respondWithError(w, http.StatusNotFound, errBillingWebhookDisabled.Error())
+		return
+	}
+
+	// the body is limited as the webhook is served without API key
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBillingEventSize))
+	if err != nil {
+		respondWithError(w, http.StatusRequestEntityTooLarge, err.Error())
+		return
+	}
+
+	defer r.Body.Close()
+
+	timestamp := r.Header.Get(BillingTimestampHeader)
+
+	if !validBillingSignature(rt.BillingWebhookSecret, timestamp, body, r.Header.Get(BillingSignatureHeader)) {
+		rt.logError(fmt.Errorf("BillingWebhook failed: %w", errInvalidSignature))
+		respondWithError(w, http.StatusUnauthorized, errInvalidSignature.Error())
+		return
+	}
+
+	unixTimestamp, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, errInvalidTimestamp.Error())
+		return
+	}
+
+	tolerance := rt.BillingTolerance
+	if tolerance <= 0 {
+		tolerance = DefaultBillingTolerance
+	}
+
+	if age := time.Since(time.Unix(unixTimestamp, 0)); age > tolerance || age < -tolerance {
+		rt.logError(fmt.Errorf("BillingWebhook failed: %w", errStaleBillingEvent))
+		respondWithError(w, http.StatusUnauthorized, errStaleBillingEvent.Error())
+		return
+	}
+
+	var event billingEvent
+
+	err = json.Unmarshal(body, &event)
+	if err != nil {
+		rt.logError(fmt.Errorf("BillingWebhook decode failed: %w", err))
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if event.Type != billingEventPlanChanged {
+		jsonresponse.RespondWithJSON(w, http.StatusOK, map[string]string{"status": "ignored"})
+		return
+	}
+
+	apps := rt.applications()
+
+	_, err = apps.Get(event.ApplicationID)
+	if err != nil {
+		rt.respondWithServiceError(w, "GetApplication in BillingWebhook", err)
+		return
+	}
+
+	planType, ok := rt.BillingPlanCodes[event.PlanCode]
+	if !ok {
+		rt.logError(fmt.Errorf("BillingWebhook event %s failed: %w: %s", event.ID, errUnknownPlanCode, event.PlanCode))
+		respondWithError(w, http.StatusUnprocessableEntity, fmt.Sprintf("%s: %s", errUnknownPlanCode, event.PlanCode))
+		return
+	}
+
+	// providers redeliver events, applying the plan the application already has is a no-op
+	app, err := apps.ChangePayPlan(r.Context(), event.ApplicationID, planType)
+	if errors.Is(err, service.ErrPayPlanNotFound) {
+		rt.logError(fmt.Errorf("ChangePayPlan in BillingWebhook failed: %w: %s", err, planType))
+		respondWithError(w, http.StatusUnprocessableEntity, err.Error())
+		return
+	}
+	if err != nil {
+		rt.respondWithServiceError(w, "ChangePayPlan in BillingWebhook", err)
+		return
+	}
+
+	jsonresponse.RespondWithJSON(w, http.StatusOK, app)
+}
@@ -0,0 +1,114 @@
+package router
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pokt-foundation/pocket-http-db/webhook"
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouter_BillingWebhook(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	// the billing provider does not hold an API key
+	router.APIKeys = map[string]bool{"test_api_key": true}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	sendEvent := func(body, signature string) *httptest.ResponseRecorder {
+		req, err := http.NewRequest(http.MethodPost, billingWebhookPath, bytes.NewBufferString(body))
+		c.NoError(err)
+
+		req.Header.Set(BillingSignatureHeader, signature)
+		req.Header.Set(BillingTimestampHeader, timestamp)
+
+		rr := httptest.NewRecorder()
+
+		router.Router.ServeHTTP(rr, req)
+
+		return rr
+	}
+
+	sign := func(body string) string {
+		return SignBillingEvent("billing_secret", timestamp, []byte(body))
+	}
+
+	planChanged := `{"id":"evt_1","type":"plan.changed","applicationID":"5f62b7d8be3591c4dea8566d","planCode":"growth"}`
+
+	rr := sendEvent(planChanged, sign(planChanged))
+	c.Equal(http.StatusNotFound, rr.Code)
+
+	router.BillingWebhookSecret = "billing_secret"
+	router.BillingPlanCodes = map[string]repository.PayPlanType{
+		"growth": repository.PayAsYouGoV0,
+		"legacy": repository.PayPlanType("LEGACY_V0"),
+	}
+
+	writerMock := &writerMock{}
+
+	writerMock.On("UpdateApplication", mock.Anything).Return(nil).Once()
+
+	router.Writer = writerMock
+
+	rr = sendEvent(planChanged, "deadbeef")
+	c.Equal(http.StatusUnauthorized, rr.Code)
+
+	// the timestamp is signed with the body
+	rr = sendEvent(planChanged, webhook.Sign([]byte("billing_secret"), []byte(planChanged)))
+	c.Equal(http.StatusUnauthorized, rr.Code)
+
+	rr = sendEvent(`{"id":"`+strings.Repeat("a", maxBillingEventSize)+`"}`, "deadbeef")
+	c.Equal(http.StatusRequestEntityTooLarge, rr.Code)
+
+	// events sent outside of the tolerance window are rejected, even correctly signed
+	timestamp = strconv.FormatInt(time.Now().Add(-DefaultBillingTolerance-time.Minute).Unix(), 10)
+
+	rr = sendEvent(planChanged, sign(planChanged))
+	c.Equal(http.StatusUnauthorized, rr.Code)
+
+	router.BillingTolerance = time.Hour
+
+	rr = sendEvent(planChanged, sign(planChanged))
+	c.Equal(http.StatusOK, rr.Code)
+
+	router.BillingTolerance = 0
+	timestamp = strconv.FormatInt(time.Now().Unix(), 10)
+
+	c.Equal(repository.PayAsYouGoV0, router.Cache.GetApplication("5f62b7d8be3591c4dea8566d").Limits.PlanType)
+
+	// redelivered events do not write again
+	rr = sendEvent(planChanged, sign(planChanged))
+	c.Equal(http.StatusOK, rr.Code)
+	writerMock.AssertNumberOfCalls(t, "UpdateApplication", 1)
+
+	ignored := `{"id":"evt_2","type":"invoice.paid","applicationID":"5f62b7d8be3591c4dea8566d"}`
+
+	rr = sendEvent(ignored, sign(ignored))
+	c.Equal(http.StatusOK, rr.Code)
+
+	unknownCode := `{"id":"evt_3","type":"plan.changed","applicationID":"5f62b7d8be3591c4dea8566d","planCode":"enterprise"}`
+
+	rr = sendEvent(unknownCode, sign(unknownCode))
+	c.Equal(http.StatusUnprocessableEntity, rr.Code)
+
+	unknownPlan := `{"id":"evt_4","type":"plan.changed","applicationID":"5f62b7d8be3591c4dea8566d","planCode":"legacy"}`
+
+	rr = sendEvent(unknownPlan, sign(unknownPlan))
+	c.Equal(http.StatusUnprocessableEntity, rr.Code)
+
+	unknownApp := `{"id":"evt_5","type":"plan.changed","applicationID":"not-an-app","planCode":"growth"}`
+
+	rr = sendEvent(unknownApp, sign(unknownApp))
+	c.Equal(http.StatusNotFound, rr.Code)
+}
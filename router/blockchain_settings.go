@@ -0,0 +1,45 @@
+package router
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/pokt-foundation/pocket-http-db/service"
+	jsonresponse "github.com/pokt-foundation/utils-go/json-response"
+)
+
+// GetBlockchainSettings returns the operational settings of the blockchain, without its identity fields
+func (rt *Router) GetBlockchainSettings(w http.ResponseWriter, r *http.Request) {
+	settings, err := rt.blockchains().GetSettings(pathParam(r, "id"))
+	if err != nil {
+		rt.respondWithServiceError(w, "GetBlockchainSettings", err)
+		return
+	}
+
+	jsonresponse.RespondWithJSON(w, http.StatusOK, settings)
+}
+
+// UpdateBlockchainSettings changes the operational settings set on input and responds with the resulting ones,
+// the change is audited as done by the actor on input, or by the API key used if none
+func (rt *Router) UpdateBlockchainSettings(w http.ResponseWriter, r *http.Request) {
+	var input service.BlockchainSettingsUpdate
+
+	err := decodeBody(r, &input)
+	if err != nil {
+		rt.logError(fmt.Errorf("UpdateBlockchainSettings decode failed: %w", err))
+		respondWithBodyError(w, err)
+		return
+	}
+
+	defer r.Body.Close()
+
+	input.Actor = actor(r, input.Actor)
+
+	settings, err := rt.blockchains().UpdateSettings(r.Context(), pathParam(r, "id"), input)
+	if err != nil {
+		rt.respondWithServiceError(w, "UpdateBlockchainSettings", err)
+		return
+	}
+
+	jsonresponse.RespondWithJSON(w, http.StatusOK, settings)
+}
@@ -0,0 +1,65 @@
+package router
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pokt-foundation/pocket-http-db/accesslog"
+	"github.com/pokt-foundation/pocket-http-db/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouter_BlockchainSettings(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	writerMock := &writerMock{}
+
+	writerMock.On("UpdateBlockchainSettings", "0021", &types.BlockchainSettings{
+		LogLimitBlocks: 100000,
+		EnforceResult:  "JSON",
+	}).Return(nil).Once()
+	writerMock.On("WriteAuditLogEntry", mock.MatchedBy(func(entry *types.AuditLogEntry) bool {
+		return entry.Action == types.AuditActionUpdateSettings && entry.EntityID == "0021" &&
+			entry.Actor == "api_key:"+accesslog.KeyID("")
+	})).Return(nil).Once()
+
+	router.Writer = writerMock
+
+	serve := func(method, body string) *httptest.ResponseRecorder {
+		req, err := http.NewRequest(method, "/blockchain/0021/settings", bytes.NewBufferString(body))
+		c.NoError(err)
+
+		rr := httptest.NewRecorder()
+
+		router.Router.ServeHTTP(rr, req)
+
+		return rr
+	}
+
+	rr := serve(http.MethodPut, `{"logLimitBlocks":100000,"enforceResult":"JSON"}`)
+	c.Equal(http.StatusOK, rr.Code)
+	c.JSONEq(`{"logLimitBlocks":100000,"requestTimeout":0,"enforceResult":"JSON"}`, rr.Body.String())
+
+	rr = serve(http.MethodGet, "")
+	c.Equal(http.StatusOK, rr.Code)
+	c.JSONEq(`{"logLimitBlocks":100000,"requestTimeout":0,"enforceResult":"JSON"}`, rr.Body.String())
+
+	rr = serve(http.MethodPut, `{"requestTimeout":-1}`)
+	c.Equal(http.StatusUnprocessableEntity, rr.Code)
+	c.JSONEq(`{
+		"error":"invalid blockchain settings: requestTimeout must be between 0 and 120000",
+		"code":"unprocessable_entity",
+		"details":{"requestTimeout":"must be between 0 and 120000"}
+	}`, rr.Body.String())
+
+	rr = serve(http.MethodPut, `{`)
+	c.Equal(http.StatusBadRequest, rr.Code)
+
+	writerMock.AssertExpectations(t)
+}
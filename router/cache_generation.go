@@ -0,0 +1,32 @@
+package router
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	// CacheGenerationHeader holds the generation of the cache a read was served from
+	CacheGenerationHeader = "X-Cache-Generation"
+	// CacheRefreshedAtHeader holds when the cache a read was served from was last fully loaded, as RFC3339
+	CacheRefreshedAtHeader = "X-Cache-Refreshed-At"
+)
+
+// CacheGenerationHandler sets the cache generation headers on reads
+// so divergent responses can be told apart by cache generation or by replica
+func (rt *Router) CacheGenerationHandler(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet || r.Method == http.MethodHead {
+			generation, refreshedAt := rt.Cache.Generation()
+
+			w.Header().Set(CacheGenerationHeader, strconv.FormatUint(generation, 10))
+
+			if !refreshedAt.IsZero() {
+				w.Header().Set(CacheRefreshedAtHeader, refreshedAt.UTC().Format(time.RFC3339))
+			}
+		}
+
+		h.ServeHTTP(w, r)
+	})
+}
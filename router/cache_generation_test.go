@@ -0,0 +1,49 @@
+package router
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouter_CacheGenerationHandler(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	req, err := http.NewRequest(http.MethodGet, "/pay_plan", nil)
+	c.NoError(err)
+
+	rr := httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusOK, rr.Code)
+	c.Equal("1", rr.Header().Get(CacheGenerationHeader))
+
+	_, err = time.Parse(time.RFC3339, rr.Header().Get(CacheRefreshedAtHeader))
+	c.NoError(err)
+
+	err = router.Cache.SetCache()
+	c.NoError(err)
+
+	rr = httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal("2", rr.Header().Get(CacheGenerationHeader))
+
+	req, err = http.NewRequest(http.MethodPost, "/application", bytes.NewBufferString("wrong"))
+	c.NoError(err)
+
+	rr = httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Empty(rr.Header().Get(CacheGenerationHeader))
+}
@@ -0,0 +1,109 @@
+package router
+
+import (
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/pokt-foundation/pocket-http-db/accesslog"
+	"github.com/pokt-foundation/pocket-http-db/cache"
+	jsonresponse "github.com/pokt-foundation/utils-go/json-response"
+)
+
+const (
+	cacheRefreshPath       = "/cache/refresh"
+	cacheRefreshStatusPath = "/cache/refresh/status"
+	cacheRefreshEntityPath = "/cache/refresh/{entity}"
+
+	// DefaultRefreshWait is how long the caller starting a cache refresh waits for it when RefreshWait is not set
+	DefaultRefreshWait = 10 * time.Second
+)
+
+// refreshWait returns how long the caller starting a cache refresh waits for it
+func (rt *Router) refreshWait() time.Duration {
+	if rt.RefreshWait > 0 {
+		return rt.RefreshWait
+	}
+
+	return DefaultRefreshWait
+}
+
+// RefreshCache reloads the cache from the database, coalescing concurrent requests into a single reload
+// the request starting the reload waits for it, requests joining a reload in flight or outlasting the wait
+// are accepted with the status URL and a Retry-After estimated from the last reload duration
+// the reload is recorded as triggered by the access log ID of the API key
+func (rt *Router) RefreshCache(w http.ResponseWriter, r *http.Request) {
+	done, started := rt.Cache.StartRefresh(accesslog.KeyID(r.Header.Get("Authorization")))
+
+	if started {
+		timer := time.NewTimer(rt.refreshWait())
+		defer timer.Stop()
+
+		select {
+		case <-done:
+			status := rt.Cache.RefreshStatus()
+			if status.LastError != "" {
+				jsonresponse.RespondWithJSON(w, http.StatusInternalServerError, status)
+				return
+			}
+
+			jsonresponse.RespondWithJSON(w, http.StatusOK, status)
+
+			return
+		case <-timer.C:
+		case <-r.Context().Done():
+		}
+	}
+
+	status := rt.Cache.RefreshStatus()
+
+	w.Header().Set("Location", cacheRefreshStatusPath)
+	w.Header().Set("Retry-After", strconv.Itoa(retryAfterSeconds(status.StartedAt, status.LastDurationSeconds)))
+
+	jsonresponse.RespondWithJSON(w, http.StatusAccepted, status)
+}
+
+// entityRefresh is the response of a refresh of a single entity of the cache
+type entityRefresh struct {
+	Entity string `json:"entity"`
+	// Refreshed are the entities reloaded, the one asked for and the ones built from it
+	Refreshed []string `json:"refreshed"`
+}
+
+// RefreshCacheEntity reloads a single entity of the cache from the database, along with the entities built from it,
+// for fixing a stale entity without reloading the whole cache
+func (rt *Router) RefreshCacheEntity(w http.ResponseWriter, r *http.Request) {
+	entity := mux.Vars(r)["entity"]
+
+	refreshed, err := rt.Cache.RefreshEntity(entity)
+	if errors.Is(err, cache.ErrUnknownEntity) {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err != nil {
+		respondWithError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	jsonresponse.RespondWithJSON(w, http.StatusOK, entityRefresh{Entity: entity, Refreshed: refreshed})
+}
+
+// GetCacheRefreshStatus returns whether a cache refresh is in flight, who triggered it, when and its progress,
+// or the same of the last refresh, for automation to poll once a refresh is accepted
+func (rt *Router) GetCacheRefreshStatus(w http.ResponseWriter, r *http.Request) {
+	jsonresponse.RespondWithJSON(w, http.StatusOK, rt.Cache.RefreshStatus())
+}
+
+// retryAfterSeconds estimates when a reload started at startedAt is done from the last reload duration, at least 1
+func retryAfterSeconds(startedAt time.Time, lastDurationSeconds float64) int {
+	remaining := lastDurationSeconds - time.Since(startedAt).Seconds()
+	if remaining < 1 {
+		return 1
+	}
+
+	return int(math.Ceil(remaining))
+}
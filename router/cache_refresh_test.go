@@ -0,0 +1,174 @@
+package router
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/pokt-foundation/pocket-http-db/accesslog"
+	"github.com/pokt-foundation/pocket-http-db/cache"
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouter_RefreshCache(t *testing.T) {
+	c := require.New(t)
+
+	release := make(chan struct{})
+
+	readerMock := &cache.ReaderMock{}
+
+	readerMock.On("ReadPayPlans").Return([]*repository.PayPlan{}, nil)
+	readerMock.On("ReadApplications").Return([]*repository.Application{}, nil).Once()
+	readerMock.On("ReadApplications").Return([]*repository.Application{}, nil).Run(func(mock.Arguments) {
+		<-release
+	})
+	readerMock.On("ReadBlockchains").Return([]*repository.Blockchain{}, nil)
+	readerMock.On("ReadLoadBalancers").Return([]*repository.LoadBalancer{}, nil)
+	readerMock.On("ReadRedirects").Return([]*repository.Redirect{}, nil)
+
+	router, err := NewRouter(readerMock, nil, map[string]bool{"": true}, logrus.New())
+	c.NoError(err)
+
+	router.RefreshWait = 10 * time.Millisecond
+
+	serve := func(method, path string) *httptest.ResponseRecorder {
+		req, err := http.NewRequest(method, path, nil)
+		c.NoError(err)
+
+		rr := httptest.NewRecorder()
+
+		router.Router.ServeHTTP(rr, req)
+
+		return rr
+	}
+
+	rr := serve(http.MethodPost, "/cache/refresh")
+	c.Equal(http.StatusForbidden, rr.Code)
+
+	router.KeyScopes = map[string]map[string]bool{
+		accesslog.KeyID(""):          {ScopeAdmin: true},
+		accesslog.KeyID("other_key"): {ScopeAdmin: true},
+	}
+
+	// the refresh outlasts the wait of the request starting it
+	rr = serve(http.MethodPost, "/cache/refresh")
+	c.Equal(http.StatusAccepted, rr.Code)
+	c.Equal("/cache/refresh/status", rr.Header().Get("Location"))
+	c.Equal("1", rr.Header().Get("Retry-After"))
+
+	// requests joining the refresh in flight do not wait for it
+	req, err := http.NewRequest(http.MethodPost, "/cache/refresh", nil)
+	c.NoError(err)
+
+	req.Header.Set("Authorization", "other_key")
+	router.APIKeys["other_key"] = true
+
+	rr = httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusAccepted, rr.Code)
+
+	var status cache.RefreshStatus
+
+	c.NoError(json.Unmarshal(rr.Body.Bytes(), &status))
+	c.True(status.InProgress)
+	c.Equal(accesslog.KeyID(""), status.TriggeredBy)
+	c.Equal(2, status.StepsDone)
+	c.Equal(7, status.TotalSteps)
+	c.Equal("redirects", status.LastStep)
+	c.Zero(status.Generation)
+
+	close(release)
+
+	router.RefreshWait = time.Second
+
+	c.Eventually(func() bool {
+		return !router.Cache.RefreshStatus().InProgress
+	}, time.Second, time.Millisecond)
+
+	rr = serve(http.MethodPost, "/cache/refresh")
+	c.Equal(http.StatusOK, rr.Code)
+	c.NoError(json.Unmarshal(rr.Body.Bytes(), &status))
+	c.False(status.InProgress)
+	c.Equal(uint64(3), status.Generation)
+
+	rr = serve(http.MethodGet, "/cache/refresh/status")
+	c.Equal(http.StatusOK, rr.Code)
+	c.NoError(json.Unmarshal(rr.Body.Bytes(), &status))
+	c.False(status.InProgress)
+	c.Equal(7, status.StepsDone)
+	c.Equal(uint64(3), status.Generation)
+
+	readerMock.AssertNumberOfCalls(t, "ReadPayPlans", 3)
+}
+
+func TestRouter_RefreshCacheEntity(t *testing.T) {
+	c := require.New(t)
+
+	readerMock := &cache.ReaderMock{}
+
+	readerMock.On("ReadPayPlans").Return([]*repository.PayPlan{}, nil)
+	readerMock.On("ReadApplications").Return([]*repository.Application{}, nil)
+	readerMock.On("ReadBlockchains").Return([]*repository.Blockchain{}, nil).Once()
+	readerMock.On("ReadLoadBalancers").Return([]*repository.LoadBalancer{}, nil).Once()
+	readerMock.On("ReadRedirects").Return([]*repository.Redirect{}, nil)
+
+	router, err := NewRouter(readerMock, nil, map[string]bool{"": true}, logrus.New())
+	c.NoError(err)
+
+	serve := func(path string) *httptest.ResponseRecorder {
+		req, err := http.NewRequest(http.MethodPost, path, nil)
+		c.NoError(err)
+
+		rr := httptest.NewRecorder()
+
+		router.Router.ServeHTTP(rr, req)
+
+		return rr
+	}
+
+	readerMock.On("ReadLoadBalancers").Return([]*repository.LoadBalancer{
+		{ID: "60ecb2bf67774900350d9c42", UserID: "60ecb2bf67774900350d9c43"},
+	}, nil)
+
+	rr := serve("/cache/refresh/load_balancers")
+	c.Equal(http.StatusForbidden, rr.Code)
+
+	grantAdminScope(router)
+
+	rr = serve("/cache/refresh/load_balancers")
+	c.Equal(http.StatusOK, rr.Code)
+	c.JSONEq(`{"entity":"load_balancers","refreshed":["load_balancers"]}`, rr.Body.String())
+	c.NotNil(router.Cache.GetLoadBalancer("60ecb2bf67774900350d9c42"))
+
+	rr = serve("/cache/refresh/applications")
+	c.Equal(http.StatusOK, rr.Code)
+	c.JSONEq(`{"entity":"applications","refreshed":["applications","load_balancers"]}`, rr.Body.String())
+
+	rr = serve("/cache/refresh/status")
+	c.Equal(http.StatusBadRequest, rr.Code)
+
+	readerMock.On("ReadBlockchains").Return([]*repository.Blockchain(nil), errors.New("dummy error"))
+
+	rr = serve("/cache/refresh/blockchains")
+	c.Equal(http.StatusInternalServerError, rr.Code)
+
+	// refreshing an entity does not start a new generation
+	generation, _ := router.Cache.Generation()
+	c.Equal(uint64(1), generation)
+}
+
+func TestRetryAfterSeconds(t *testing.T) {
+	c := require.New(t)
+
+	c.Equal(1, retryAfterSeconds(time.Now(), 0))
+	c.Equal(5, retryAfterSeconds(time.Now().Add(-time.Second), 5.5))
+	c.Equal(1, retryAfterSeconds(time.Now().Add(-time.Minute), 5))
+}
@@ -0,0 +1,175 @@
+package router
+
+import (
+	"bytes"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pokt-foundation/pocket-http-db/accesslog"
+	"github.com/pokt-foundation/pocket-http-db/cache"
+	"github.com/pokt-foundation/pocket-http-db/types"
+)
+
+// coalescedCall is a read in flight, whose response is written to every identical request waiting on done
+type coalescedCall struct {
+	done      chan struct{}
+	waiters   int
+	completed bool
+	status    int
+	header    http.Header
+	body      []byte
+}
+
+// coalescer tracks the reads in flight by request, forgotten on every change of the cache
+// so the requests arriving after a change are never answered with a response computed before it
+type coalescer struct {
+	mutex sync.Mutex
+	calls map[string]*coalescedCall
+}
+
+func newCoalescer() *coalescer {
+	return &coalescer{calls: map[string]*coalescedCall{}}
+}
+
+// join returns the call in flight for key and false, or a new call and true if the caller must serve it
+func (c *coalescer) join(key string) (*coalescedCall, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if call, ok := c.calls[key]; ok {
+		call.waiters++
+		return call, false
+	}
+
+	call := &coalescedCall{done: make(chan struct{})}
+	c.calls[key] = call
+
+	return call, true
+}
+
+// finish releases the requests waiting on call
+func (c *coalescer) finish(key string, call *coalescedCall) {
+	c.mutex.Lock()
+	if c.calls[key] == call {
+		delete(c.calls, key)
+	}
+	c.mutex.Unlock()
+
+	close(call.done)
+}
+
+// forget lets the next requests start new calls, the ones already waiting keep theirs
+func (c *coalescer) forget(types.EntityType, cache.Operation, string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.calls = map[string]*coalescedCall{}
+}
+
+// coalescingRecorder keeps the response of a coalesced call to write it to every request waiting on it
+type coalescingRecorder struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (c *coalescingRecorder) Header() http.Header {
+	return c.header
+}
+
+func (c *coalescingRecorder) WriteHeader(status int) {
+	if c.status == 0 {
+		c.status = status
+	}
+}
+
+func (c *coalescingRecorder) Write(b []byte) (int, error) {
+	c.WriteHeader(http.StatusOK)
+
+	return c.body.Write(b)
+}
+
+// write writes the response of call to w
+func (call *coalescedCall) write(w http.ResponseWriter) {
+	for name, values := range call.header {
+		w.Header()[name] = append([]string(nil), values...)
+	}
+
+	w.WriteHeader(call.status)
+
+	_, err := w.Write(call.body)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// coalescingKey identifies the identical reads, by method, URL and scopes of the API key,
+// the only parts of a request the handlers of cacheable groups read
+func (rt *Router) coalescingKey(r *http.Request) string {
+	scopes := []string{}
+	for scope, granted := range rt.KeyScopes[accesslog.KeyID(r.Header.Get("Authorization"))] {
+		if granted {
+			scopes = append(scopes, scope)
+		}
+	}
+
+	sort.Strings(scopes)
+
+	return r.Method + " " + r.URL.RequestURI() + " " + strings.Join(scopes, ",")
+}
+
+// coalescingHandler serves the identical reads of cacheable groups in flight at once with a single response,
+// encoded once and written to all of them, if CoalesceReads is set
+// it cuts the encoding of the same list for every gateway instance when their caches expire together
+func (rt *Router) coalescingHandler(group RouteGroup, method string, handler http.HandlerFunc) http.HandlerFunc {
+	if !cacheableGroups[group] || !isRead(method) {
+		return handler
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !rt.CoalesceReads {
+			handler(w, r)
+			return
+		}
+
+		key := rt.coalescingKey(r)
+
+		call, leader := rt.coalescer.join(key)
+		if !leader {
+			select {
+			case <-call.done:
+			case <-r.Context().Done():
+				return
+			}
+
+			// the call panicked, the request is served on its own
+			if !call.completed {
+				handler(w, r)
+				return
+			}
+
+			call.write(w)
+
+			return
+		}
+
+		defer rt.coalescer.finish(key, call)
+
+		recorder := &coalescingRecorder{header: http.Header{}}
+
+		handler(recorder, r)
+
+		if recorder.status == 0 {
+			recorder.status = http.StatusOK
+		}
+
+		call.status = recorder.status
+		call.header = recorder.header
+		call.body = recorder.body.Bytes()
+		call.completed = true
+
+		call.write(w)
+	}
+}
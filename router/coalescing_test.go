@@ -0,0 +1,167 @@
+package router
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pokt-foundation/pocket-http-db/accesslog"
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/stretchr/testify/require"
+)
+
+// waiters returns the number of requests waiting on the call in flight for key
+func (c *coalescer) waiters(key string) int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	call, ok := c.calls[key]
+	if !ok {
+		return -1
+	}
+
+	return call.waiters
+}
+
+func TestRouter_CoalescingHandler(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	router.CoalesceReads = true
+
+	var calls int32
+
+	entered := make(chan struct{}, 10)
+	release := make(chan struct{})
+
+	handler := router.coalescingHandler(RouteGroupBlockchain, http.MethodGet, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		entered <- struct{}{}
+		<-release
+
+		w.Header().Set("X-Test", "coalesced")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`[{"id":"0021"}]`))
+	})
+
+	get := func(target string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, target, nil)
+		rr := httptest.NewRecorder()
+
+		handler(rr, req)
+
+		return rr
+	}
+
+	recorders := make([]*httptest.ResponseRecorder, 5)
+
+	var wg sync.WaitGroup
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		recorders[0] = get("/blockchain")
+	}()
+
+	<-entered
+
+	for i := 1; i < len(recorders); i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			recorders[i] = get("/blockchain")
+		}(i)
+	}
+
+	key := router.coalescingKey(httptest.NewRequest(http.MethodGet, "/blockchain", nil))
+
+	c.Eventually(func() bool {
+		return router.coalescer.waiters(key) == len(recorders)-1
+	}, time.Second, time.Millisecond)
+
+	close(release)
+	wg.Wait()
+
+	c.Equal(int32(1), atomic.LoadInt32(&calls))
+
+	for _, rr := range recorders {
+		c.Equal(http.StatusOK, rr.Code)
+		c.Equal("coalesced", rr.Header().Get("X-Test"))
+		c.Equal(`[{"id":"0021"}]`, rr.Body.String())
+	}
+
+	// the call is over, the next request is served again
+	get("/blockchain")
+	c.Equal(int32(2), atomic.LoadInt32(&calls))
+
+	// other queries and scopes are not identical reads
+	router.KeyScopes = map[string]map[string]bool{accesslog.KeyID("bulk"): {ScopeBulk: true}}
+
+	bulkReq := httptest.NewRequest(http.MethodGet, "/blockchain", nil)
+	bulkReq.Header.Set("Authorization", "bulk")
+
+	c.NotEqual(key, router.coalescingKey(bulkReq))
+	c.NotEqual(key, router.coalescingKey(httptest.NewRequest(http.MethodGet, "/blockchain?limit=1", nil)))
+
+	router.CoalesceReads = false
+
+	get("/blockchain")
+	c.Equal(int32(3), atomic.LoadInt32(&calls))
+}
+
+func TestRouter_CoalescingForgetsOnChange(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	key := router.coalescingKey(httptest.NewRequest(http.MethodGet, "/blockchain", nil))
+
+	call, leader := router.coalescer.join(key)
+	c.True(leader)
+
+	router.Cache.AddPayPlan(&repository.PayPlan{PlanType: "ENTERPRISE_V0"})
+
+	// the requests after the change don't wait on the call started before it
+	next, leader := router.coalescer.join(key)
+	c.True(leader)
+	c.NotSame(call, next)
+
+	router.coalescer.finish(key, call)
+	c.Equal(0, router.coalescer.waiters(key))
+
+	router.coalescer.finish(key, next)
+	c.Equal(-1, router.coalescer.waiters(key))
+}
+
+func TestRouter_CoalesceReads(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	router.CoalesceReads = true
+
+	req, err := http.NewRequest(http.MethodGet, "/blockchain", nil)
+	c.NoError(err)
+
+	rr := httptest.NewRecorder()
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusOK, rr.Code)
+	c.Equal("application/json", rr.Header().Get("Content-Type"))
+	c.Contains(rr.Body.String(), `"0021"`)
+
+	req, err = http.NewRequest(http.MethodGet, "/blockchain/wrong", nil)
+	c.NoError(err)
+
+	rr = httptest.NewRecorder()
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusNotFound, rr.Code)
+}
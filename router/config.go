@@ -0,0 +1,19 @@
+package router
+
+import (
+	"errors"
+	"net/http"
+
+	jsonresponse "github.com/pokt-foundation/utils-go/json-response"
+)
+
+var errConfigDisabled = errors.New("config introspection not configured")
+
+func (rt *Router) GetConfig(w http.ResponseWriter, r *http.Request) {
+	if rt.Config == nil {
+		respondWithError(w, http.StatusNotFound, errConfigDisabled.Error())
+		return
+	}
+
+	jsonresponse.RespondWithJSON(w, http.StatusOK, rt.Config.Settings())
+}
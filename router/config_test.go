@@ -0,0 +1,54 @@
+package router
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pokt-foundation/pocket-http-db/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouter_GetConfig(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	grantAdminScope(router)
+
+	req, err := http.NewRequest(http.MethodGet, "/admin/config", nil)
+	c.NoError(err)
+
+	rr := httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusNotFound, rr.Code)
+
+	t.Setenv("CONFIG_TEST_SECRET", "s3cr3t")
+
+	router.Config, err = config.Load(&struct {
+		Secret string `env:"CONFIG_TEST_SECRET,secret"`
+		Port   int64  `env:"CONFIG_TEST_PORT" default:"8080"`
+	}{})
+	c.NoError(err)
+
+	rr = httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusOK, rr.Code)
+	c.NotContains(rr.Body.String(), "s3cr3t")
+
+	var settings []config.Setting
+
+	err = json.Unmarshal(rr.Body.Bytes(), &settings)
+	c.NoError(err)
+
+	c.Equal([]config.Setting{
+		{Name: "CONFIG_TEST_PORT", Value: "8080", Default: "8080"},
+		{Name: "CONFIG_TEST_SECRET", Value: config.Redacted, Set: true, Secret: true},
+	}, settings)
+}
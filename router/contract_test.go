@@ -0,0 +1,126 @@
+package router
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/pokt-foundation/portal-api-go/repository"
+	jsonresponse "github.com/pokt-foundation/utils-go/json-response"
+	"github.com/stretchr/testify/require"
+)
+
+// updateGolden rewrites the contract golden files with the current serialization, run after reviewing a change
+var updateGolden = flag.Bool("update-golden", false, "rewrite the contract golden files")
+
+// contractTypes are the portal-api-go repository structs served and accepted by the router
+var contractTypes = map[string]any{
+	"application":                 repository.Application{},
+	"app_limits":                  repository.AppLimits{},
+	"pay_plan":                    repository.PayPlan{},
+	"update_application":          repository.UpdateApplication{},
+	"update_first_date_surpassed": repository.UpdateFirstDateSurpassed{},
+	"gateway_aat":                 repository.GatewayAAT{},
+	"gateway_settings":            repository.GatewaySettings{},
+	"whitelist_contract":          repository.WhitelistContract{},
+	"whitelist_method":            repository.WhitelistMethod{},
+	"notification_settings":       repository.NotificationSettings{},
+	"blockchain":                  repository.Blockchain{},
+	"redirect":                    repository.Redirect{},
+	"sync_check_options":          repository.SyncCheckOptions{},
+	"load_balancer":               repository.LoadBalancer{},
+	"lb_app":                      repository.LbApp{},
+	"update_load_balancer":        repository.UpdateLoadBalancer{},
+	"sticky_options":              repository.StickyOptions{},
+	"user":                        repository.User{},
+}
+
+// contractTime is the value of every time field, so the golden files are stable
+var contractTime = time.Date(2022, time.July, 21, 10, 30, 0, 0, time.UTC)
+
+// fillValue sets every field reachable from v to a non-zero value derived from its path,
+// so a renamed, removed or added field changes the serialization
+func fillValue(v reflect.Value, path string) {
+	if v.Type() == reflect.TypeOf(time.Time{}) {
+		v.Set(reflect.ValueOf(contractTime))
+		return
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(path)
+	case reflect.Bool:
+		v.SetBool(true)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(int64(len(path)))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v.SetUint(uint64(len(path)))
+	case reflect.Float32, reflect.Float64:
+		v.SetFloat(float64(len(path)) / 2)
+	case reflect.Ptr:
+		v.Set(reflect.New(v.Type().Elem()))
+		fillValue(v.Elem(), path)
+	case reflect.Slice:
+		v.Set(reflect.MakeSlice(v.Type(), 1, 1))
+		fillValue(v.Index(0), path+"[0]")
+	case reflect.Map:
+		key := reflect.New(v.Type().Key()).Elem()
+		fillValue(key, path+".key")
+
+		value := reflect.New(v.Type().Elem()).Elem()
+		fillValue(value, path+".value")
+
+		v.Set(reflect.MakeMap(v.Type()))
+		v.SetMapIndex(key, value)
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() {
+				fillValue(v.Field(i), path+"."+v.Type().Field(i).Name)
+			}
+		}
+	}
+}
+
+// TestRouter_RepositoryContract round-trips every repository struct through the JSON responses of the router,
+// asserting the serialization matches the golden files so portal-api-go bumps cannot change the API unnoticed
+func TestRouter_RepositoryContract(t *testing.T) {
+	for name, zero := range contractTypes {
+		t.Run(name, func(t *testing.T) {
+			c := require.New(t)
+
+			value := reflect.New(reflect.TypeOf(zero))
+			fillValue(value.Elem(), reflect.TypeOf(zero).Name())
+
+			rr := httptest.NewRecorder()
+			jsonresponse.RespondWithJSON(rr, http.StatusOK, value.Interface())
+
+			var indented bytes.Buffer
+			c.NoError(json.Indent(&indented, rr.Body.Bytes(), "", "  "))
+			indented.WriteString("\n")
+
+			goldenPath := filepath.Join("testdata", "contract", name+".json")
+
+			if *updateGolden {
+				c.NoError(os.MkdirAll(filepath.Dir(goldenPath), 0o755))
+				c.NoError(os.WriteFile(goldenPath, indented.Bytes(), 0o644))
+			}
+
+			golden, err := os.ReadFile(goldenPath)
+			c.NoError(err, "missing golden file, run go test ./router -run RepositoryContract -update-golden")
+			c.Equal(string(golden), indented.String(),
+				fmt.Sprintf("%s serialization changed, review it and run with -update-golden if intended", name))
+
+			decoded := reflect.New(reflect.TypeOf(zero))
+			c.NoError(json.Unmarshal(rr.Body.Bytes(), decoded.Interface()))
+			c.Equal(value.Interface(), decoded.Interface(), "%s does not round-trip", name)
+		})
+	}
+}
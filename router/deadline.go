@@ -0,0 +1,205 @@
+package router
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	jsonresponse "github.com/pokt-foundation/utils-go/json-response"
+)
+
+const (
+	// DeadlineHeader holds the request deadline as an RFC3339 timestamp or a grpc-timeout style duration
+	DeadlineHeader = "X-Request-Deadline"
+	// GRPCTimeoutHeader holds the request budget in the grpc-timeout format, e.g. 250m
+	GRPCTimeoutHeader = "Grpc-Timeout"
+)
+
+var (
+	errInvalidDeadline  = errors.New("invalid request deadline")
+	errDeadlineExceeded = errors.New("request deadline exceeded")
+)
+
+// grpcTimeoutUnits are the units of the grpc-timeout format
+var grpcTimeoutUnits = map[byte]time.Duration{
+	'H': time.Hour,
+	'M': time.Minute,
+	'S': time.Second,
+	'm': time.Millisecond,
+	'u': time.Microsecond,
+	'n': time.Nanosecond,
+}
+
+// deadlineDiagnostics is the output of requests that exceeded their deadline
+type deadlineDiagnostics struct {
+	Error     string    `json:"error"`
+	Route     string    `json:"route,omitempty"`
+	Deadline  time.Time `json:"deadline"`
+	ElapsedMS float64   `json:"elapsedMS"`
+	// Status is the status the handler had set before the deadline, 0 if none
+	Status int `json:"status,omitempty"`
+	// BufferedBytes is how much of the response the handler had written before the deadline
+	BufferedBytes int `json:"bufferedBytes"`
+}
+
+// parseGRPCTimeout parses a grpc-timeout value, a positive integer of up to 8 digits followed by a unit
+func parseGRPCTimeout(value string) (time.Duration, error) {
+	if len(value) < 2 || len(value) > 9 {
+		return 0, errInvalidDeadline
+	}
+
+	unit, ok := grpcTimeoutUnits[value[len(value)-1]]
+	if !ok {
+		return 0, errInvalidDeadline
+	}
+
+	amount, err := strconv.ParseUint(value[:len(value)-1], 10, 64)
+	if err != nil {
+		return 0, errInvalidDeadline
+	}
+
+	return time.Duration(amount) * unit, nil
+}
+
+// requestDeadline returns the deadline set on the request headers, ok is false when there is none
+func requestDeadline(r *http.Request, now time.Time) (deadline time.Time, ok bool, err error) {
+	if value := r.Header.Get(DeadlineHeader); value != "" {
+		deadline, err = time.Parse(time.RFC3339Nano, value)
+		if err == nil {
+			return deadline, true, nil
+		}
+
+		timeout, err := parseGRPCTimeout(value)
+		if err != nil {
+			return time.Time{}, false, err
+		}
+
+		return now.Add(timeout), true, nil
+	}
+
+	if value := r.Header.Get(GRPCTimeoutHeader); value != "" {
+		timeout, err := parseGRPCTimeout(value)
+		if err != nil {
+			return time.Time{}, false, err
+		}
+
+		return now.Add(timeout), true, nil
+	}
+
+	return time.Time{}, false, nil
+}
+
+// deadlineWriter buffers the response of a handler until it finishes in time
+type deadlineWriter struct {
+	w        http.ResponseWriter
+	header   http.Header
+	body     bytes.Buffer
+	status   int
+	mutex    sync.Mutex
+	timedOut bool
+}
+
+func (d *deadlineWriter) Header() http.Header {
+	return d.header
+}
+
+func (d *deadlineWriter) WriteHeader(status int) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if d.timedOut || d.status != 0 {
+		return
+	}
+
+	d.status = status
+}
+
+func (d *deadlineWriter) Write(p []byte) (int, error) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if d.timedOut {
+		return 0, http.ErrHandlerTimeout
+	}
+
+	return d.body.Write(p)
+}
+
+// DeadlineHandler bounds the handling of requests carrying a deadline, responding 504 when it is exceeded
+// handlers keep running in the background after the deadline, writes already sent to the database are not undone
+func (rt *Router) DeadlineHandler(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+
+		deadline, ok, err := requestDeadline(r, start)
+		if err != nil {
+			respondWithError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		if !ok {
+			h.ServeHTTP(w, r)
+
+			return
+		}
+
+		ctx, cancel := context.WithDeadline(r.Context(), deadline)
+		defer cancel()
+
+		dw := &deadlineWriter{w: w, header: make(http.Header)}
+		done := make(chan struct{})
+		panicked := make(chan any, 1)
+
+		go func() {
+			defer func() {
+				if p := recover(); p != nil {
+					panicked <- p
+				}
+			}()
+
+			h.ServeHTTP(dw, r.WithContext(ctx))
+			close(done)
+		}()
+
+		select {
+		case p := <-panicked:
+			panic(p)
+		case <-done:
+			dw.mutex.Lock()
+			defer dw.mutex.Unlock()
+
+			for key, values := range dw.header {
+				w.Header()[key] = values
+			}
+
+			if dw.status == 0 {
+				dw.status = http.StatusOK
+			}
+
+			w.WriteHeader(dw.status)
+
+			_, err := w.Write(dw.body.Bytes())
+			if err != nil {
+				rt.logError(err)
+			}
+		case <-ctx.Done():
+			dw.mutex.Lock()
+			defer dw.mutex.Unlock()
+
+			dw.timedOut = true
+
+			jsonresponse.RespondWithJSON(w, http.StatusGatewayTimeout, deadlineDiagnostics{
+				Error:         errDeadlineExceeded.Error(),
+				Route:         routeTemplate(r),
+				Deadline:      deadline,
+				ElapsedMS:     float64(time.Since(start).Microseconds()) / 1000,
+				Status:        dw.status,
+				BufferedBytes: dw.body.Len(),
+			})
+		}
+	})
+}
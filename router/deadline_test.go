@@ -0,0 +1,83 @@
+package router
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseGRPCTimeout(t *testing.T) {
+	c := require.New(t)
+
+	timeout, err := parseGRPCTimeout("250m")
+	c.NoError(err)
+	c.Equal(250*time.Millisecond, timeout)
+
+	timeout, err = parseGRPCTimeout("2S")
+	c.NoError(err)
+	c.Equal(2*time.Second, timeout)
+
+	for _, invalid := range []string{"", "m", "10x", "-1S", "123456789S"} {
+		_, err = parseGRPCTimeout(invalid)
+		c.ErrorIs(err, errInvalidDeadline, invalid)
+	}
+}
+
+func TestRouter_Deadline(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	release := make(chan struct{})
+	defer close(release)
+
+	router.Router.HandleFunc("/slow", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte("partial"))
+
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}).Methods(http.MethodGet)
+
+	serve := func(path, header, value string) *httptest.ResponseRecorder {
+		req, err := http.NewRequest(http.MethodGet, path, nil)
+		c.NoError(err)
+
+		req.Header.Set(header, value)
+
+		rr := httptest.NewRecorder()
+
+		router.Router.ServeHTTP(rr, req)
+
+		return rr
+	}
+
+	rr := serve("/slow", GRPCTimeoutHeader, "20m")
+	c.Equal(http.StatusGatewayTimeout, rr.Code)
+
+	var diagnostics deadlineDiagnostics
+
+	c.NoError(json.Unmarshal(rr.Body.Bytes(), &diagnostics))
+	c.Equal("/slow", diagnostics.Route)
+	c.Equal(http.StatusAccepted, diagnostics.Status)
+	c.Equal(len("partial"), diagnostics.BufferedBytes)
+	c.GreaterOrEqual(diagnostics.ElapsedMS, float64(20))
+
+	rr = serve("/slow", DeadlineHeader, time.Now().Add(-time.Second).Format(time.RFC3339Nano))
+	c.Equal(http.StatusGatewayTimeout, rr.Code)
+
+	rr = serve("/blockchain/0021", DeadlineHeader, time.Now().Add(time.Minute).Format(time.RFC3339Nano))
+	c.Equal(http.StatusOK, rr.Code)
+	c.Equal("application/json", rr.Header().Get("Content-Type"))
+	c.Contains(rr.Body.String(), `"0021"`)
+
+	rr = serve("/blockchain/0021", DeadlineHeader, "tomorrow")
+	c.Equal(http.StatusBadRequest, rr.Code)
+}
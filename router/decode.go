@@ -0,0 +1,198 @@
+package router
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/pokt-foundation/pocket-http-db/apierrors"
+	jsonresponse "github.com/pokt-foundation/utils-go/json-response"
+)
+
+var errInvalidBody = errors.New("invalid request body")
+
+// unmarshalerType is the type of the values decoding themselves, their fields are not checked
+var unmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+
+// bodyError is returned by decodeBody when the body has fields the input does not have or of the wrong type
+type bodyError struct {
+	// Details maps the fields failing to decode, as dotted paths, to the reason they failed
+	Details map[string]string
+}
+
+func (e *bodyError) Error() string {
+	fields := make([]string, 0, len(e.Details))
+	for field := range e.Details {
+		fields = append(fields, field)
+	}
+
+	sort.Strings(fields)
+
+	return fmt.Sprintf("%s: %s", errInvalidBody, strings.Join(fields, ", "))
+}
+
+// decodeBody decodes the JSON body of r into v, rejecting the fields v does not have so typos are not silently ignored
+// returns a *bodyError listing every unknown field and the field of the wrong type, if any
+func decodeBody(r *http.Request, v any) error {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return err
+	}
+
+	details := map[string]string{}
+	unknownFields(details, "", body, reflect.TypeOf(v))
+
+	if len(details) > 0 {
+		return &bodyError{Details: details}
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.DisallowUnknownFields()
+
+	err = decoder.Decode(v)
+
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) && typeErr.Field != "" {
+		return &bodyError{Details: map[string]string{typeErr.Field: "must be " + jsonKind(typeErr.Type)}}
+	}
+
+	return err
+}
+
+// unknownFields adds to details the fields of the JSON value raw at path that values of t do not have
+// raw values not matching t are left to the decoder to report
+func unknownFields(details map[string]string, path string, raw json.RawMessage, t reflect.Type) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	if t.Implements(unmarshalerType) || reflect.PointerTo(t).Implements(unmarshalerType) {
+		return
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		var object map[string]json.RawMessage
+		if json.Unmarshal(raw, &object) != nil {
+			return
+		}
+
+		fields := jsonFields(t)
+
+		for name, value := range object {
+			field, ok := fields[strings.ToLower(name)]
+			if !ok {
+				details[joinPath(path, name)] = "unknown field"
+				continue
+			}
+
+			unknownFields(details, joinPath(path, name), value, field)
+		}
+	case reflect.Slice, reflect.Array:
+		var values []json.RawMessage
+		if json.Unmarshal(raw, &values) != nil {
+			return
+		}
+
+		for i, value := range values {
+			unknownFields(details, fmt.Sprintf("%s[%d]", path, i), value, t.Elem())
+		}
+	case reflect.Map:
+		var values map[string]json.RawMessage
+		if json.Unmarshal(raw, &values) != nil {
+			return
+		}
+
+		for key, value := range values {
+			unknownFields(details, joinPath(path, key), value, t.Elem())
+		}
+	}
+}
+
+// jsonFields returns the types of the fields of the struct type t by lowercased JSON name,
+// as the decoder matches the names regardless of their case
+func jsonFields(t reflect.Type) map[string]reflect.Type {
+	fields := map[string]reflect.Type{}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+
+		if field.Anonymous && name == "" {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Pointer {
+				embedded = embedded.Elem()
+			}
+
+			if embedded.Kind() == reflect.Struct {
+				for embeddedName, embeddedType := range jsonFields(embedded) {
+					fields[embeddedName] = embeddedType
+				}
+
+				continue
+			}
+		}
+
+		if !field.IsExported() {
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+
+		fields[strings.ToLower(name)] = field.Type
+	}
+
+	return fields
+}
+
+// jsonKind returns the kind of the JSON values decoded into values of t
+func jsonKind(t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.Bool:
+		return "a boolean"
+	case reflect.String:
+		return "a string"
+	case reflect.Slice, reflect.Array:
+		return "an array"
+	case reflect.Map, reflect.Struct:
+		return "an object"
+	default:
+		return "a number"
+	}
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+
+	return path + "." + name
+}
+
+// respondWithBodyError responds with err returned by decodeBody, listing the fields failing to decode if any
+func respondWithBodyError(w http.ResponseWriter, err error) {
+	var bodyErr *bodyError
+	if errors.As(err, &bodyErr) {
+		jsonresponse.RespondWithJSON(w, http.StatusBadRequest, map[string]interface{}{
+			"error":   bodyErr.Error(),
+			"code":    apierrors.CodeBadRequest,
+			"details": bodyErr.Details,
+		})
+
+		return
+	}
+
+	respondWithError(w, http.StatusBadRequest, err.Error())
+}
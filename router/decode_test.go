@@ -0,0 +1,93 @@
+package router
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pokt-foundation/pocket-http-db/apierrors"
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeBody(t *testing.T) {
+	c := require.New(t)
+
+	decode := func(body string, v any) error {
+		req, err := http.NewRequest(http.MethodPost, "/application", bytes.NewBufferString(body))
+		c.NoError(err)
+
+		return decodeBody(req, v)
+	}
+
+	var app repository.Application
+
+	c.NoError(decode(`{"userID":"60ddc61b6e29c3003378361D","PAYPLANTYPE":"FREETIER_V0","firstDateSurpassed":null}`, &app))
+	c.Equal(repository.FreetierV0, app.PayPlanType)
+
+	err := decode(`{"pay_plan_type":"FREETIER_V0","gatewaySettings":{"whitelistOrigins":[],"secretKeyRequired":true,"origin":"x"},`+
+		`"limits":{"dailyLimit":10},"dumy":true}`, &app)
+
+	var bodyErr *bodyError
+	c.ErrorAs(err, &bodyErr)
+	c.Equal(map[string]string{
+		"pay_plan_type":          "unknown field",
+		"gatewaySettings.origin": "unknown field",
+		"dumy":                   "unknown field",
+	}, bodyErr.Details)
+	c.EqualError(err, "invalid request body: dumy, gatewaySettings.origin, pay_plan_type")
+
+	err = decode(`{"dummy":"yes"}`, &app)
+	c.ErrorAs(err, &bodyErr)
+	c.Equal(map[string]string{"dummy": "must be a boolean"}, bodyErr.Details)
+
+	var input updateApplicationsStatus
+
+	err = decode(`{"applicationIDs":"5f62b7d8be3591c4dea8566d","status":"READY"}`, &input)
+	c.ErrorAs(err, &bodyErr)
+	c.Equal(map[string]string{"applicationIDs": "must be an array"}, bodyErr.Details)
+
+	err = decode(`{"applicationIDs":[{"id":"5f62b7d8be3591c4dea8566d"}],"state":"READY"}`, &input)
+	c.ErrorAs(err, &bodyErr)
+	c.Equal(map[string]string{"state": "unknown field"}, bodyErr.Details)
+
+	var labels map[string]string
+
+	c.NoError(decode(`{"team":"portal"}`, &labels))
+	c.Equal(map[string]string{"team": "portal"}, labels)
+
+	err = decode(`wrong`, &labels)
+	c.Error(err)
+	c.False(errors.As(err, &bodyErr))
+}
+
+func TestRouter_CreateApplicationUnknownFields(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	writerMock := &writerMock{}
+	router.Writer = writerMock
+
+	req, err := http.NewRequest(http.MethodPost, "/application",
+		bytes.NewBufferString(`{"userID":"60ddc61b6e29c3003378361D","pay_plan_type":"FREETIER_V0"}`))
+	c.NoError(err)
+
+	rr := httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusBadRequest, rr.Code)
+
+	var apiErr apierrors.Error
+	c.NoError(json.Unmarshal(rr.Body.Bytes(), &apiErr))
+	c.Equal(apierrors.CodeBadRequest, apiErr.Code)
+	c.Equal(map[string]string{"pay_plan_type": "unknown field"}, apiErr.Details)
+
+	writerMock.AssertNotCalled(t, "WriteApplication", mock.Anything)
+}
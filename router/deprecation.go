@@ -0,0 +1,67 @@
+package router
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RouteDeprecation holds the deprecation metadata of a route, sent to its consumers as headers
+type RouteDeprecation struct {
+	Method string `json:"method"`
+	// Pattern is the route template, such as /application/{id}
+	Pattern string `json:"pattern"`
+	// Since is when the route was deprecated, the route is flagged as deprecated without date if zero
+	Since time.Time `json:"since"`
+	// Sunset is when the route stops being served, not announced if zero
+	Sunset time.Time `json:"sunset"`
+	// Successor is the link to the replacement of the route
+	Successor string `json:"successor"`
+}
+
+// SetHeaders sets the Deprecation, Sunset and Link headers of d on w
+// handlers serving legacy payload shapes can call it for routes that are otherwise current
+func (d RouteDeprecation) SetHeaders(w http.ResponseWriter) {
+	if d.Since.IsZero() {
+		w.Header().Set("Deprecation", "true")
+	} else {
+		w.Header().Set("Deprecation", "@"+strconv.FormatInt(d.Since.Unix(), 10))
+	}
+
+	if !d.Sunset.IsZero() {
+		w.Header().Set("Sunset", d.Sunset.UTC().Format(http.TimeFormat))
+	}
+
+	if d.Successor != "" {
+		w.Header().Add("Link", fmt.Sprintf("<%s>; rel=\"successor-version\"", d.Successor))
+	}
+}
+
+// routeDeprecation returns the deprecation of the route matching r, false if it is not deprecated
+func (rt *Router) routeDeprecation(r *http.Request) (RouteDeprecation, bool) {
+	if len(rt.DeprecatedRoutes) == 0 {
+		return RouteDeprecation{}, false
+	}
+
+	pattern := routeTemplate(r)
+
+	for _, deprecation := range rt.DeprecatedRoutes {
+		if deprecation.Method == r.Method && deprecation.Pattern == pattern {
+			return deprecation, true
+		}
+	}
+
+	return RouteDeprecation{}, false
+}
+
+// DeprecationHandler sets the deprecation headers on responses of deprecated routes
+func (rt *Router) DeprecationHandler(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if deprecation, ok := rt.routeDeprecation(r); ok {
+			deprecation.SetHeaders(w)
+		}
+
+		h.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,63 @@
+package router
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouter_DeprecationHandler(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	router.DeprecatedRoutes = []RouteDeprecation{
+		{
+			Method:    http.MethodGet,
+			Pattern:   "/application/{id}",
+			Since:     time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Sunset:    time.Date(2023, time.July, 1, 0, 0, 0, 0, time.UTC),
+			Successor: "/v2/application/{id}",
+		},
+		{
+			Method:  http.MethodGet,
+			Pattern: "/pay_plan",
+		},
+	}
+
+	req, err := http.NewRequest(http.MethodGet, "/application/5f62b7d8be3591c4dea8566d", nil)
+	c.NoError(err)
+
+	rr := httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusOK, rr.Code)
+	c.Equal("@1672531200", rr.Header().Get("Deprecation"))
+	c.Equal("Sat, 01 Jul 2023 00:00:00 GMT", rr.Header().Get("Sunset"))
+	c.Equal(`</v2/application/{id}>; rel="successor-version"`, rr.Header().Get("Link"))
+
+	req, err = http.NewRequest(http.MethodGet, "/pay_plan", nil)
+	c.NoError(err)
+
+	rr = httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal("true", rr.Header().Get("Deprecation"))
+	c.Empty(rr.Header().Get("Sunset"))
+	c.Empty(rr.Header().Get("Link"))
+
+	req, err = http.NewRequest(http.MethodGet, "/load_balancer", nil)
+	c.NoError(err)
+
+	rr = httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Empty(rr.Header().Get("Deprecation"))
+}
@@ -0,0 +1,136 @@
+package router
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pokt-foundation/pocket-http-db/accesslog"
+	"github.com/pokt-foundation/pocket-http-db/tracing"
+)
+
+const (
+	// ResponseEnvelopeHeader is the request header selecting the response envelope version,
+	// EnvelopeV1 wraps the responses in an envelope and EnvelopeNone sends them raw
+	ResponseEnvelopeHeader = "X-Response-Envelope"
+	EnvelopeV1             = "v1"
+	EnvelopeNone           = "none"
+)
+
+// envelope wraps the body of a successful JSON response with the metadata of the request
+type envelope struct {
+	Data json.RawMessage `json:"data"`
+	Meta envelopeMeta    `json:"meta"`
+}
+
+// envelopeMeta holds the provenance and pagination metadata of an enveloped response
+type envelopeMeta struct {
+	Generation uint64 `json:"generation"`
+	// Count is the number of entities of list responses, Total the number of entities across all their pages
+	Count      *int    `json:"count,omitempty"`
+	Total      *int    `json:"total,omitempty"`
+	NextCursor string  `json:"next_cursor,omitempty"`
+	RequestID  string  `json:"request_id"`
+	DurationMS float64 `json:"duration_ms"`
+}
+
+// responseEnvelope returns true if the response must be enveloped, as set by the request header
+// falling back to the envelope feature and the enveloped keys, false if the header is invalid
+func (rt *Router) responseEnvelope(r *http.Request) (enveloped bool, ok bool) {
+	switch r.Header.Get(ResponseEnvelopeHeader) {
+	case "":
+		return hasFeature(r, FeatureEnvelope) || rt.EnvelopedKeys[accesslog.KeyID(r.Header.Get("Authorization"))], true
+	case EnvelopeV1:
+		return true, true
+	case EnvelopeNone:
+		return false, true
+	default:
+		return false, false
+	}
+}
+
+// EnvelopeHandler wraps successful JSON responses in {data, meta} for the requests opting in, by header or by API key
+// error responses are left as they are so their {error, code} body stays the same for every client
+func (rt *Router) EnvelopeHandler(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Vary", ResponseEnvelopeHeader)
+
+		enveloped, ok := rt.responseEnvelope(r)
+		if !ok {
+			respondWithError(w, http.StatusBadRequest, "invalid "+ResponseEnvelopeHeader)
+			return
+		}
+
+		if !enveloped {
+			h.ServeHTTP(w, r)
+
+			return
+		}
+
+		start := time.Now()
+
+		requestID := tracing.NewTraceID()
+		if span, ok := tracing.FromContext(r.Context()); ok {
+			requestID = span.TraceID
+		}
+
+		buffered := &bufferedResponse{ResponseWriter: w, status: http.StatusOK}
+
+		h.ServeHTTP(buffered, r)
+
+		body := buffered.body.Bytes()
+
+		if buffered.status >= 200 && buffered.status < 300 {
+			meta := envelopeMeta{
+				NextCursor: w.Header().Get(NextCursorHeader),
+				RequestID:  requestID,
+				DurationMS: float64(time.Since(start).Microseconds()) / 1000,
+			}
+			meta.Generation, _ = rt.Cache.Generation()
+
+			if total, err := strconv.Atoi(w.Header().Get(TotalCountHeader)); err == nil {
+				meta.Total = &total
+			}
+
+			rewritten, err := wrapEnvelope(w.Header().Get("Content-Type"), body, meta)
+			if err != nil {
+				rt.logError(err)
+			} else {
+				body = rewritten
+				w.Header().Del("Content-Length")
+			}
+		}
+
+		w.WriteHeader(buffered.status)
+
+		_, err := w.Write(body)
+		if err != nil {
+			rt.logError(err)
+		}
+	})
+}
+
+// wrapEnvelope returns the JSON body wrapped in an envelope with meta, counting its entities if it is a list
+// bodies of other content types are returned as they are
+func wrapEnvelope(contentType string, body []byte, meta envelopeMeta) ([]byte, error) {
+	if !strings.HasPrefix(contentType, "application/json") {
+		return body, nil
+	}
+
+	if trimmed := bytes.TrimSpace(body); len(trimmed) > 0 && trimmed[0] == '[' {
+		var entities []json.RawMessage
+
+		err := json.Unmarshal(trimmed, &entities)
+		if err != nil {
+			return nil, err
+		}
+
+		count := len(entities)
+		meta.Count = &count
+	}
+
+	return json.Marshal(envelope{Data: body, Meta: meta})
+}
@@ -0,0 +1,87 @@
+package router
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pokt-foundation/pocket-http-db/accesslog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouter_Envelope(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	router.APIKeys["enveloped_key"] = true
+	router.EnvelopedKeys = map[string]bool{accesslog.KeyID("enveloped_key"): true}
+
+	get := func(path, apiKey, envelopeVersion string) *httptest.ResponseRecorder {
+		req, err := http.NewRequest(http.MethodGet, path, nil)
+		c.NoError(err)
+
+		req.Header.Set("Authorization", apiKey)
+		if envelopeVersion != "" {
+			req.Header.Set(ResponseEnvelopeHeader, envelopeVersion)
+		}
+
+		rr := httptest.NewRecorder()
+
+		router.Router.ServeHTTP(rr, req)
+
+		return rr
+	}
+
+	type body struct {
+		Data json.RawMessage `json:"data"`
+		Meta map[string]any  `json:"meta"`
+	}
+
+	decode := func(rr *httptest.ResponseRecorder) body {
+		var b body
+
+		c.NoError(json.Unmarshal(rr.Body.Bytes(), &b))
+
+		return b
+	}
+
+	// raw unless asked for
+	rr := get("/application/5f62b7d8be3591c4dea8566d", "", "")
+	c.Equal(http.StatusOK, rr.Code)
+	c.Nil(decode(rr).Meta)
+
+	rr = get("/application/5f62b7d8be3591c4dea8566d", "", EnvelopeV1)
+	c.Equal(http.StatusOK, rr.Code)
+
+	b := decode(rr)
+	c.Contains(string(b.Data), `"id":"5f62b7d8be3591c4dea8566d"`)
+	c.Contains(b.Meta, "generation")
+	c.Contains(b.Meta, "request_id")
+	c.Contains(b.Meta, "duration_ms")
+	c.NotContains(b.Meta, "count")
+	c.NotContains(b.Meta, "total")
+
+	rr = get("/application?limit=1", "enveloped_key", "")
+	c.Equal(http.StatusOK, rr.Code)
+
+	b = decode(rr)
+	c.Equal(float64(1), b.Meta["count"])
+	c.Equal(rr.Header().Get(NextCursorHeader), b.Meta["next_cursor"])
+	c.NotEmpty(b.Meta["next_cursor"])
+	c.Equal(float64(3), b.Meta["total"])
+
+	// the header takes precedence over the key
+	rr = get("/application/5f62b7d8be3591c4dea8566d", "enveloped_key", EnvelopeNone)
+	c.Nil(decode(rr).Meta)
+
+	// errors keep their body
+	rr = get("/application/wrong", "enveloped_key", "")
+	c.Equal(http.StatusNotFound, rr.Code)
+	c.Nil(decode(rr).Meta)
+
+	rr = get("/application/5f62b7d8be3591c4dea8566d", "", "v2")
+	c.Equal(http.StatusBadRequest, rr.Code)
+}
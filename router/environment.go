@@ -0,0 +1,33 @@
+package router
+
+import (
+	"net/http"
+)
+
+// EnvironmentHeader holds the environment of the deployment that served the request, such as
+// "production; region=us-east-2", so clients notice when they are pointed at the wrong deployment
+const EnvironmentHeader = "X-Environment"
+
+// environmentBanner returns the value of the environment header, empty if no environment is configured
+func (rt *Router) environmentBanner() string {
+	if rt.Environment == "" {
+		return ""
+	}
+
+	if rt.Region == "" {
+		return rt.Environment
+	}
+
+	return rt.Environment + "; region=" + rt.Region
+}
+
+// EnvironmentHandler sets the environment header on every response, if the deployment has an environment
+func (rt *Router) EnvironmentHandler(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if banner := rt.environmentBanner(); banner != "" {
+			w.Header().Set(EnvironmentHeader, banner)
+		}
+
+		h.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,42 @@
+package router
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouter_EnvironmentHandler(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	serve := func(apiKey string) *httptest.ResponseRecorder {
+		req, err := http.NewRequest(http.MethodGet, "/blockchain", nil)
+		c.NoError(err)
+
+		req.Header.Set("Authorization", apiKey)
+
+		rr := httptest.NewRecorder()
+
+		router.Router.ServeHTTP(rr, req)
+
+		return rr
+	}
+
+	c.Empty(serve("").Header().Get(EnvironmentHeader))
+
+	router.Environment = "staging"
+	c.Equal("staging", serve("").Header().Get(EnvironmentHeader))
+
+	router.Region = "us-east-2"
+	c.Equal("staging; region=us-east-2", serve("").Header().Get(EnvironmentHeader))
+
+	// set on rejected requests too
+	rr := serve("wrong")
+	c.Equal(http.StatusUnauthorized, rr.Code)
+	c.Equal("staging; region=us-east-2", rr.Header().Get(EnvironmentHeader))
+}
@@ -0,0 +1,56 @@
+package router
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/pokt-foundation/pocket-http-db/service"
+	"github.com/pokt-foundation/portal-api-go/repository"
+	jsonresponse "github.com/pokt-foundation/utils-go/json-response"
+)
+
+// expandPayPlan is the expand value embedding the pay plan in application responses
+const expandPayPlan = "pay_plan"
+
+var errInvalidExpand = errors.New("invalid expand")
+
+// expandsPayPlan returns true if the expand query of r asks for the pay plan, fails on unknown values
+func expandsPayPlan(r *http.Request) (bool, error) {
+	rawExpand := r.URL.Query().Get("expand")
+	if rawExpand == "" {
+		return false, nil
+	}
+
+	expand := false
+
+	for _, value := range strings.Split(rawExpand, ",") {
+		if strings.TrimSpace(value) != expandPayPlan {
+			return false, errInvalidExpand
+		}
+
+		expand = true
+	}
+
+	return expand, nil
+}
+
+// respondWithApplication responds with app, along with its pay plan if expand is set
+func respondWithApplication(w http.ResponseWriter, apps *service.ApplicationService, app *repository.Application, expand bool) {
+	if expand {
+		jsonresponse.RespondWithJSON(w, http.StatusOK, apps.WithPayPlan(app))
+		return
+	}
+
+	jsonresponse.RespondWithJSON(w, http.StatusOK, app)
+}
+
+// respondWithApplications responds with appList, along with their pay plans if expand is set
+func respondWithApplications(w http.ResponseWriter, apps *service.ApplicationService, appList []*repository.Application, expand bool) {
+	if expand {
+		jsonresponse.RespondWithJSON(w, http.StatusOK, apps.WithPayPlans(appList))
+		return
+	}
+
+	jsonresponse.RespondWithJSON(w, http.StatusOK, appList)
+}
@@ -0,0 +1,102 @@
+package router
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pokt-foundation/pocket-http-db/service"
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouter_GetApplicationExpandPayPlan(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	req, err := http.NewRequest(http.MethodGet, "/application/5f62b7d8be3591c4dea8566d?expand=pay_plan", nil)
+	c.NoError(err)
+
+	rr := httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusOK, rr.Code)
+
+	var app service.ApplicationWithPayPlan
+
+	err = json.Unmarshal(rr.Body.Bytes(), &app)
+	c.NoError(err)
+
+	c.Equal("5f62b7d8be3591c4dea8566d", app.ID)
+	c.Equal(&repository.PayPlan{PlanType: repository.FreetierV0, DailyLimit: 250000}, app.PayPlan)
+
+	req, err = http.NewRequest(http.MethodGet, "/application/5f62b7d8be3591c4dea8566d", nil)
+	c.NoError(err)
+
+	rr = httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusOK, rr.Code)
+	c.NotContains(rr.Body.String(), "payPlan")
+
+	req, err = http.NewRequest(http.MethodGet, "/application/5f62b7d8be3591c4dea8566d?expand=user", nil)
+	c.NoError(err)
+
+	rr = httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func TestRouter_GetApplicationsExpandPayPlan(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	req, err := http.NewRequest(http.MethodGet, "/user/60ecb2bf67774900350d9c43/application?expand=pay_plan", nil)
+	c.NoError(err)
+
+	rr := httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusOK, rr.Code)
+
+	var apps []service.ApplicationWithPayPlan
+
+	err = json.Unmarshal(rr.Body.Bytes(), &apps)
+	c.NoError(err)
+
+	c.NotEmpty(apps)
+
+	for _, app := range apps {
+		if app.Limits.PlanType == "" {
+			c.Nil(app.PayPlan)
+			continue
+		}
+
+		c.Equal(app.Limits.PlanType, app.PayPlan.PlanType)
+	}
+
+	req, err = http.NewRequest(http.MethodGet, "/application?expand=pay_plan", nil)
+	c.NoError(err)
+
+	rr = httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusOK, rr.Code)
+
+	err = json.Unmarshal(rr.Body.Bytes(), &apps)
+	c.NoError(err)
+
+	c.Len(apps, 3)
+	c.Equal(repository.FreetierV0, apps[0].PayPlan.PlanType)
+}
@@ -0,0 +1,69 @@
+package router
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouter_FilterExpressions(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	serve := func(path, filter string) *httptest.ResponseRecorder {
+		req, err := http.NewRequest(http.MethodGet, path+"?filter="+url.QueryEscape(filter), nil)
+		c.NoError(err)
+
+		rr := httptest.NewRecorder()
+
+		router.Router.ServeHTTP(rr, req)
+
+		return rr
+	}
+
+	rr := serve("/application", `plan=="FREETIER_V0" || userID=="60ecb2bf67774900350d9c44"`)
+	c.Equal(http.StatusOK, rr.Code)
+
+	var apps []*repository.Application
+	c.NoError(json.Unmarshal(rr.Body.Bytes(), &apps))
+	c.Len(apps, 2)
+	c.Equal("5f62b7d8be3591c4dea8566d", apps[0].ID)
+	c.Equal("5f62b7d8be3591c4dea8566f", apps[1].ID)
+
+	rr = serve("/user/60ecb2bf67774900350d9c43/application", `plan!="FREETIER_V0"`)
+	c.Equal(http.StatusOK, rr.Code)
+
+	apps = nil
+	c.NoError(json.Unmarshal(rr.Body.Bytes(), &apps))
+	c.Len(apps, 1)
+	c.Equal("5f62b7d8be3591c4dea8566a", apps[0].ID)
+
+	rr = serve("/load_balancer", `userID=="60ecb2bf67774900350d9c43"`)
+	c.Equal(http.StatusOK, rr.Code)
+
+	var lbs []*repository.LoadBalancer
+	c.NoError(json.Unmarshal(rr.Body.Bytes(), &lbs))
+	c.Len(lbs, 1)
+	c.Equal("60ecb2bf67774900350d9c42", lbs[0].ID)
+
+	rr = serve("/blockchain", `id=="0022"`)
+	c.Equal(http.StatusOK, rr.Code)
+
+	var blockchains []*repository.Blockchain
+	c.NoError(json.Unmarshal(rr.Body.Bytes(), &blockchains))
+	c.Len(blockchains, 1)
+	c.Equal("0022", blockchains[0].ID)
+
+	rr = serve("/application", `contactEmail=="a@b.c"`)
+	c.Equal(http.StatusBadRequest, rr.Code)
+	c.Contains(rr.Body.String(), "invalid filter expression")
+
+	c.Equal(http.StatusBadRequest, serve("/load_balancer", `name==`).Code)
+}
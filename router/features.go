@@ -0,0 +1,158 @@
+package router
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	jsonresponse "github.com/pokt-foundation/utils-go/json-response"
+)
+
+// FeaturesHeader is the request header opting into features, such as features=envelope,camelcase
+// the response header of the same name lists the features applied
+const FeaturesHeader = "X-PHD-Features"
+
+const (
+	// FeatureEnvelope wraps the responses in {data, meta}, as EnvelopeV1 does
+	FeatureEnvelope = "envelope"
+	// FeatureCamelCase renames the fields of the responses to camelCase, as the camel response profile does
+	FeatureCamelCase = "camelcase"
+)
+
+// featuresPath is the path the supported features are discovered on
+const featuresPath = "/features"
+
+// featureDescriptions are the features clients can opt into, by name
+var featureDescriptions = map[string]string{
+	FeatureEnvelope:  "Wraps successful JSON responses in {data, meta}",
+	FeatureCamelCase: "Renames the fields of JSON responses to camelCase",
+}
+
+// featureCapability describes a supported feature along with the number of requests that opted into it
+type featureCapability struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Requests    uint64 `json:"requests"`
+}
+
+// featureCapabilities holds the supported features and the number of requests served since the instance started,
+// so legacy response shapes can be retired once no client relies on them
+type featureCapabilities struct {
+	Header   string              `json:"header"`
+	Features []featureCapability `json:"features"`
+	Requests uint64              `json:"requests"`
+}
+
+// featureUsage counts the requests opting into every feature
+type featureUsage struct {
+	counts   map[string]uint64
+	requests uint64
+	mutex    sync.Mutex
+}
+
+func newFeatureUsage() *featureUsage {
+	return &featureUsage{counts: map[string]uint64{}}
+}
+
+// observe records a request opting into features
+func (u *featureUsage) observe(features map[string]bool) {
+	u.mutex.Lock()
+	defer u.mutex.Unlock()
+
+	u.requests++
+
+	for name := range features {
+		u.counts[name]++
+	}
+}
+
+// capabilities returns the supported features, sorted by name, with their usage
+func (u *featureUsage) capabilities() featureCapabilities {
+	u.mutex.Lock()
+	defer u.mutex.Unlock()
+
+	capabilities := featureCapabilities{
+		Header:   FeaturesHeader,
+		Features: make([]featureCapability, 0, len(featureDescriptions)),
+		Requests: u.requests,
+	}
+
+	for name, description := range featureDescriptions {
+		capabilities.Features = append(capabilities.Features, featureCapability{
+			Name:        name,
+			Description: description,
+			Requests:    u.counts[name],
+		})
+	}
+
+	sort.Slice(capabilities.Features, func(i, j int) bool {
+		return capabilities.Features[i].Name < capabilities.Features[j].Name
+	})
+
+	return capabilities
+}
+
+// parseFeatures returns the supported features of the value of FeaturesHeader, with or without the features= prefix
+// unknown features are ignored so clients can ask for features newer than the instance
+func parseFeatures(value string) map[string]bool {
+	value = strings.TrimSpace(value)
+	value = strings.TrimSpace(strings.TrimPrefix(value, "features="))
+
+	features := map[string]bool{}
+
+	for _, name := range strings.Split(value, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+
+		if _, ok := featureDescriptions[name]; ok {
+			features[name] = true
+		}
+	}
+
+	return features
+}
+
+// featuresContextKey is the context key of the features requested
+type featuresContextKey struct{}
+
+// hasFeature returns true if r opted into the feature with given name
+func hasFeature(r *http.Request, name string) bool {
+	features, _ := r.Context().Value(featuresContextKey{}).(map[string]bool)
+
+	return features[name]
+}
+
+// FeaturesHandler keeps the features requested by FeaturesHeader for the handlers and middlewares after it,
+// echoing the ones applied
+func (rt *Router) FeaturesHandler(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Vary", FeaturesHeader)
+
+		features := parseFeatures(r.Header.Get(FeaturesHeader))
+
+		rt.featureUsage.observe(features)
+
+		if len(features) == 0 {
+			h.ServeHTTP(w, r)
+
+			return
+		}
+
+		names := make([]string, 0, len(features))
+		for name := range features {
+			names = append(names, name)
+		}
+
+		sort.Strings(names)
+
+		w.Header().Set(FeaturesHeader, strings.Join(names, ","))
+
+		h.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), featuresContextKey{}, features)))
+	})
+}
+
+// GetFeatures responds with the features clients can opt into and how many requests did
+func (rt *Router) GetFeatures(w http.ResponseWriter, r *http.Request) {
+	jsonresponse.RespondWithJSON(w, http.StatusOK, rt.featureUsage.capabilities())
+}
@@ -0,0 +1,85 @@
+package router
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFeatures(t *testing.T) {
+	c := require.New(t)
+
+	c.Equal(map[string]bool{"envelope": true, "camelcase": true}, parseFeatures("features=pagination, Envelope,camelcase"))
+	c.Equal(map[string]bool{"envelope": true}, parseFeatures("envelope"))
+	c.Empty(parseFeatures(""))
+	c.Empty(parseFeatures("features=unknown"))
+}
+
+func TestRouter_Features(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	serve := func(path, features string) *httptest.ResponseRecorder {
+		req, err := http.NewRequest(http.MethodGet, path, nil)
+		c.NoError(err)
+
+		if features != "" {
+			req.Header.Set(FeaturesHeader, features)
+		}
+
+		rr := httptest.NewRecorder()
+
+		router.Router.ServeHTTP(rr, req)
+
+		return rr
+	}
+
+	rr := serve("/load_balancer/60ecb2bf67774900350d9c42", "")
+	c.Equal(http.StatusOK, rr.Code)
+	c.Empty(rr.Header().Get(FeaturesHeader))
+	c.Contains(rr.Body.String(), `"Applications"`)
+
+	rr = serve("/load_balancer/60ecb2bf67774900350d9c42", "features=camelcase,envelope,unknown")
+	c.Equal(http.StatusOK, rr.Code)
+	c.Equal("camelcase,envelope", rr.Header().Get(FeaturesHeader))
+
+	var enveloped struct {
+		Data map[string]any `json:"data"`
+		Meta map[string]any `json:"meta"`
+	}
+
+	c.NoError(json.Unmarshal(rr.Body.Bytes(), &enveloped))
+	c.Contains(enveloped.Data, "applications")
+	c.NotContains(enveloped.Data, "Applications")
+	c.NotNil(enveloped.Meta)
+
+	// the dedicated headers take precedence over the features
+	req, err := http.NewRequest(http.MethodGet, "/application/5f62b7d8be3591c4dea8566d", nil)
+	c.NoError(err)
+
+	req.Header.Set(FeaturesHeader, "envelope")
+	req.Header.Set(ResponseEnvelopeHeader, EnvelopeNone)
+
+	rr = httptest.NewRecorder()
+	router.Router.ServeHTTP(rr, req)
+	c.Contains(rr.Body.String(), `"userID"`)
+	c.NotContains(rr.Body.String(), `"meta"`)
+
+	rr = serve("/features", "")
+	c.Equal(http.StatusOK, rr.Code)
+
+	var capabilities featureCapabilities
+
+	c.NoError(json.Unmarshal(rr.Body.Bytes(), &capabilities))
+	c.Equal(FeaturesHeader, capabilities.Header)
+	c.Equal(uint64(4), capabilities.Requests)
+	c.Equal([]featureCapability{
+		{Name: FeatureCamelCase, Description: featureDescriptions[FeatureCamelCase], Requests: 1},
+		{Name: FeatureEnvelope, Description: featureDescriptions[FeatureEnvelope], Requests: 2},
+	}, capabilities.Features)
+}
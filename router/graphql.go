@@ -0,0 +1,249 @@
+package router
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/pokt-foundation/pocket-http-db/accesslog"
+	"github.com/pokt-foundation/pocket-http-db/graphql"
+	"github.com/pokt-foundation/pocket-http-db/service"
+	"github.com/pokt-foundation/portal-api-go/repository"
+	jsonresponse "github.com/pokt-foundation/utils-go/json-response"
+)
+
+// graphQLPath is the path GraphQL queries are served on, both as GET ?query= and as POST {query, variables}
+const graphQLPath = "/graphql"
+
+// graphQLField returns a field of the objects of typ, or leaf values if typ is nil, resolved by resolve
+// it is resolved as missing when the reads of group are disabled, as the routes of the group are
+func (rt *Router) graphQLField(group RouteGroup, typ *graphql.Object, resolve func(source any, args graphql.Args) (any, error)) *graphql.Field {
+	return &graphql.Field{
+		Type: typ,
+		Resolve: func(source any, args graphql.Args) (any, error) {
+			if rt.DisabledReads[group] {
+				return nil, errRouteDisabled
+			}
+
+			return resolve(source, args)
+		},
+	}
+}
+
+// graphQLList returns the first entities of list if first is set, refusing lists beyond MaxListSize otherwise
+// unless r is bulk scoped, as allowsListSize does
+func graphQLList[T any](rt *Router, r *http.Request, list []T, args graphql.Args) ([]T, error) {
+	first, err := args.Int("first")
+	if err != nil {
+		return nil, err
+	}
+
+	if first < 0 {
+		return nil, fmt.Errorf("%w: first must be positive", graphql.ErrInvalidArgument)
+	}
+
+	if first > 0 && first < len(list) {
+		return list[:first], nil
+	}
+
+	if first == 0 && rt.MaxListSize > 0 && len(list) > rt.MaxListSize && !rt.hasScope(r, ScopeBulk) {
+		return nil, fmt.Errorf("list of %d entities exceeds the limit of %d, narrow it down with first", len(list), rt.MaxListSize)
+	}
+
+	return list, nil
+}
+
+// graphQLSchema returns the schema of the entities held in cache, resolved for r
+// the fields stripped by the redaction of r are always null so they cannot be selected under another name
+func (rt *Router) graphQLSchema(r *http.Request) *graphql.Schema {
+	apps := rt.applications()
+	lbs := rt.loadBalancers()
+	blockchains := rt.blockchains()
+	plans := service.NewPayPlanService(rt.Cache)
+
+	payPlan := graphql.NewObject("PayPlan", repository.PayPlan{}, nil)
+	blockchain := graphql.NewObject("Blockchain", repository.Blockchain{}, nil)
+
+	application := graphql.NewObject("Application", repository.Application{}, map[string]*graphql.Field{
+		"payPlan": rt.graphQLField(RouteGroupPayPlan, payPlan, func(source any, args graphql.Args) (any, error) {
+			return apps.PayPlan(source.(*repository.Application)), nil
+		}),
+		"blockchains": rt.graphQLField(RouteGroupBlockchain, blockchain, func(source any, args graphql.Args) (any, error) {
+			whitelisted := []*repository.Blockchain{}
+
+			for _, id := range source.(*repository.Application).GatewaySettings.WhitelistBlockchains {
+				if chain, err := blockchains.Get(id); err == nil {
+					whitelisted = append(whitelisted, chain)
+				}
+			}
+
+			return whitelisted, nil
+		}),
+		"labels": rt.graphQLField(RouteGroupApplication, nil, func(source any, args graphql.Args) (any, error) {
+			return apps.GetLabels(source.(*repository.Application).ID)
+		}),
+	})
+
+	if rt.RedactedKeys[accesslog.KeyID(r.Header.Get("Authorization"))] {
+		application.Fields["contactEmail"] = &graphql.Field{
+			Resolve: func(source any, args graphql.Args) (any, error) { return nil, nil },
+		}
+	}
+
+	loadBalancer := graphql.NewObject("LoadBalancer", repository.LoadBalancer{}, map[string]*graphql.Field{
+		"applications": rt.graphQLField(RouteGroupApplication, application, func(source any, args graphql.Args) (any, error) {
+			return source.(*repository.LoadBalancer).Applications, nil
+		}),
+		"labels": rt.graphQLField(RouteGroupLoadBalancer, nil, func(source any, args graphql.Args) (any, error) {
+			return lbs.GetLabels(source.(*repository.LoadBalancer).ID)
+		}),
+	})
+
+	user := &graphql.Object{Name: "User", Fields: map[string]*graphql.Field{
+		"id": {Resolve: func(source any, args graphql.Args) (any, error) { return source, nil }},
+		"applications": rt.graphQLField(RouteGroupApplication, application, func(source any, args graphql.Args) (any, error) {
+			return rt.Cache.GetApplicationsByUserID(source.(string)), nil
+		}),
+		"loadBalancers": rt.graphQLField(RouteGroupLoadBalancer, loadBalancer, func(source any, args graphql.Args) (any, error) {
+			return rt.Cache.GetLoadBalancersByUserID(source.(string)), nil
+		}),
+	}}
+
+	query := &graphql.Object{Name: "Query", Fields: map[string]*graphql.Field{
+		"application": rt.graphQLField(RouteGroupApplication, application, func(source any, args graphql.Args) (any, error) {
+			id, err := args.String("id")
+			if err != nil {
+				return nil, err
+			}
+
+			return apps.Get(id)
+		}),
+		"applicationByAddress": rt.graphQLField(RouteGroupApplication, application, func(source any, args graphql.Args) (any, error) {
+			address, err := args.String("address")
+			if err != nil {
+				return nil, err
+			}
+
+			return apps.GetByAddress(address)
+		}),
+		"applications": rt.graphQLField(RouteGroupApplication, application, func(source any, args graphql.Args) (any, error) {
+			filter, err := args.String("filter")
+			if err != nil {
+				return nil, err
+			}
+
+			expr, err := service.ParseApplicationExpression(filter)
+			if err != nil {
+				return nil, err
+			}
+
+			return graphQLList(rt, r, expr.Filter(apps.GetAll()), args)
+		}),
+		"loadBalancer": rt.graphQLField(RouteGroupLoadBalancer, loadBalancer, func(source any, args graphql.Args) (any, error) {
+			id, err := args.String("id")
+			if err != nil {
+				return nil, err
+			}
+
+			return lbs.Get(id)
+		}),
+		"loadBalancers": rt.graphQLField(RouteGroupLoadBalancer, loadBalancer, func(source any, args graphql.Args) (any, error) {
+			filter, err := args.String("filter")
+			if err != nil {
+				return nil, err
+			}
+
+			expr, err := service.ParseLoadBalancerExpression(filter)
+			if err != nil {
+				return nil, err
+			}
+
+			return graphQLList(rt, r, expr.Filter(lbs.GetAll()), args)
+		}),
+		"blockchain": rt.graphQLField(RouteGroupBlockchain, blockchain, func(source any, args graphql.Args) (any, error) {
+			id, err := args.String("id")
+			if err != nil {
+				return nil, err
+			}
+
+			return blockchains.Get(id)
+		}),
+		"blockchains": rt.graphQLField(RouteGroupBlockchain, blockchain, func(source any, args graphql.Args) (any, error) {
+			filter, err := args.String("filter")
+			if err != nil {
+				return nil, err
+			}
+
+			expr, err := service.ParseBlockchainExpression(filter)
+			if err != nil {
+				return nil, err
+			}
+
+			return graphQLList(rt, r, expr.Filter(blockchains.GetAll()), args)
+		}),
+		"payPlan": rt.graphQLField(RouteGroupPayPlan, payPlan, func(source any, args graphql.Args) (any, error) {
+			planType, err := args.String("type")
+			if err != nil {
+				return nil, err
+			}
+
+			return plans.Get(repository.PayPlanType(planType))
+		}),
+		"payPlans": rt.graphQLField(RouteGroupPayPlan, payPlan, func(source any, args graphql.Args) (any, error) {
+			return plans.GetAll(), nil
+		}),
+		"user": rt.graphQLField(RouteGroupApplication, user, func(source any, args graphql.Args) (any, error) {
+			id, err := args.String("id")
+			if err != nil {
+				return nil, err
+			}
+
+			if len(rt.Cache.GetApplicationsByUserID(id)) == 0 && len(rt.Cache.GetLoadBalancersByUserID(id)) == 0 {
+				return nil, service.ErrUserNotFound
+			}
+
+			return id, nil
+		}),
+	}}
+
+	return &graphql.Schema{Query: query}
+}
+
+// GraphQL executes the GraphQL query of the request against the entities held in cache, so a user view
+// can be assembled in a single request, the fields failing to resolve are reported in errors
+// responds bad request if the query cannot be executed at all
+func (rt *Router) GraphQL(w http.ResponseWriter, r *http.Request) {
+	var request graphql.Request
+
+	if r.Method == http.MethodGet {
+		query := r.URL.Query()
+
+		request.Query = query.Get("query")
+		request.OperationName = query.Get("operationName")
+
+		if rawVariables := query.Get("variables"); rawVariables != "" {
+			err := json.Unmarshal([]byte(rawVariables), &request.Variables)
+			if err != nil {
+				respondWithError(w, http.StatusBadRequest, "invalid variables")
+				return
+			}
+		}
+	} else {
+		err := decodeBody(r, &request)
+		if err != nil {
+			respondWithBodyError(w, err)
+			return
+		}
+
+		defer r.Body.Close()
+	}
+
+	response := rt.graphQLSchema(r).Execute(request)
+
+	status := http.StatusOK
+	if response.Data == nil {
+		status = http.StatusBadRequest
+	}
+
+	jsonresponse.RespondWithJSON(w, status, response)
+}
@@ -0,0 +1,121 @@
+package router
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouter_GraphQL(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	router.MaxListSize = 2
+
+	userQuery := `query User($id: ID!) { user(id: $id) { id applications { id payPlan { planType } } ` +
+		`loadBalancers { id applications { id } } } }`
+
+	tests := []struct {
+		name         string
+		method       string
+		path         string
+		body         string
+		expectedCode int
+		expectedBody string
+	}{
+		{
+			name:         "user view",
+			method:       http.MethodPost,
+			path:         "/graphql",
+			body:         `{"query":"` + strings.ReplaceAll(userQuery, `"`, `\"`) + `","variables":{"id":"60ecb2bf67774900350d9c43"}}`,
+			expectedCode: http.StatusOK,
+			expectedBody: `{"data":{"user":{"id":"60ecb2bf67774900350d9c43","applications":[` +
+				`{"id":"5f62b7d8be3591c4dea8566d","payPlan":{"planType":"FREETIER_V0"}},` +
+				`{"id":"5f62b7d8be3591c4dea8566a","payPlan":null}],` +
+				`"loadBalancers":[{"id":"60ecb2bf67774900350d9c42","applications":[` +
+				`{"id":"5f62b7d8be3591c4dea8566d"},{"id":"5f62b7d8be3591c4dea8566a"}]}]}}}`,
+		},
+		{
+			name:         "get with aliases",
+			method:       http.MethodGet,
+			path:         "/graphql?query=" + url.QueryEscape(`{ a: application(id: "5f62b7d8be3591c4dea8566f") { userID } chain: blockchain(id: "0021") { id } }`),
+			expectedCode: http.StatusOK,
+			expectedBody: `{"data":{"a":{"userID":"60ecb2bf67774900350d9c44"},"chain":{"id":"0021"}}}`,
+		},
+		{
+			name:         "list limits",
+			method:       http.MethodGet,
+			path:         "/graphql?query=" + url.QueryEscape(`{ all: applications { id } first: applications(first: 1) { id } }`),
+			expectedCode: http.StatusOK,
+			expectedBody: `{"data":{"all":null,"first":[{"id":"5f62b7d8be3591c4dea8566d"}]},"errors":[` +
+				`{"message":"list of 3 entities exceeds the limit of 2, narrow it down with first","path":["all"]}]}`,
+		},
+		{
+			name:         "field errors",
+			method:       http.MethodPost,
+			path:         "/graphql",
+			body:         `{"query":"{ user(id: \"wrong\") { id } application(id: \"5f62b7d8be3591c4dea8566d\") { id name secret } }"}`,
+			expectedCode: http.StatusOK,
+			expectedBody: `{"data":{"user":null,"application":{"id":"5f62b7d8be3591c4dea8566d","name":"","secret":null}},"errors":[` +
+				`{"message":"user not found","path":["user"]},` +
+				`{"message":"unknown field: secret on Application","path":["application","secret"]}]}`,
+		},
+		{
+			name:         "syntax error",
+			method:       http.MethodPost,
+			path:         "/graphql",
+			body:         `{"query":"{ application(id: \"5f62b7d8be3591c4dea8566d\") { id }"}`,
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "mutation",
+			method:       http.MethodPost,
+			path:         "/graphql",
+			body:         `{"query":"mutation { application(id: \"5f62b7d8be3591c4dea8566d\") { id } }"}`,
+			expectedCode: http.StatusBadRequest,
+			expectedBody: `{"errors":[{"message":"only queries are supported: mutation"}]}`,
+		},
+		{
+			name:         "unknown body field",
+			method:       http.MethodPost,
+			path:         "/graphql",
+			body:         `{"query":"{ payPlans { planType } }","variable":{}}`,
+			expectedCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		req, err := http.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+		c.NoError(err)
+
+		rr := httptest.NewRecorder()
+
+		router.Router.ServeHTTP(rr, req)
+
+		c.Equal(tt.expectedCode, rr.Code, tt.name)
+
+		if tt.expectedBody != "" {
+			c.JSONEq(tt.expectedBody, rr.Body.String(), tt.name)
+		}
+	}
+
+	router.DisabledReads = map[RouteGroup]bool{RouteGroupPayPlan: true}
+
+	req, err := http.NewRequest(http.MethodPost, "/graphql",
+		strings.NewReader(`{"query":"{ application(id: \"5f62b7d8be3591c4dea8566d\") { id payPlan { planType } } }"}`))
+	c.NoError(err)
+
+	rr := httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusOK, rr.Code)
+	c.JSONEq(`{"data":{"application":{"id":"5f62b7d8be3591c4dea8566d","payPlan":null}},"errors":[`+
+		`{"message":"`+errRouteDisabled.Error()+`","path":["application","payPlan"]}]}`, rr.Body.String())
+}
@@ -0,0 +1,57 @@
+package router
+
+import (
+	"github.com/pokt-foundation/pocket-http-db/accesslog"
+	"github.com/pokt-foundation/pocket-http-db/grpcapi"
+	"github.com/pokt-foundation/pocket-http-db/service"
+)
+
+// GRPCServer returns the gRPC service over the same services, keys, read-only mode, disabled route groups
+// and request deadlines as the routes, its errors get the code of the status the routes respond them with
+// the keys restricted to a user by KeyUsers are not accepted, the service does not scope its reads
+// writes are rejected while replay protection or write anomaly detection is enabled, calls cannot carry a nonce
+// and are not observed
+func (rt *Router) GRPCServer() *grpcapi.Server {
+	apiKeys := make(map[string]bool, len(rt.APIKeys))
+
+	for key, allowed := range rt.APIKeys {
+		if _, restricted := rt.KeyUsers[accesslog.KeyID(key)]; !restricted {
+			apiKeys[key] = allowed
+		}
+	}
+
+	return &grpcapi.Server{
+		Applications:  rt.applications,
+		LoadBalancers: rt.loadBalancers,
+		Blockchains:   rt.blockchains,
+		PayPlans: func() *service.PayPlanService {
+			return service.NewPayPlanService(rt.Cache)
+		},
+		ErrorStatus:  serviceErrorStatus,
+		APIKeys:      apiKeys,
+		RedactedKeys: rt.RedactedKeys,
+		ReadOnly:     rt.ReadOnly,
+		Disabled: func(group string, write bool) bool {
+			if write {
+				return rt.DisabledWrites[RouteGroup(group)]
+			}
+
+			return rt.DisabledReads[RouteGroup(group)]
+		},
+		Deadline:       requestDeadline,
+		WritesRejected: rt.grpcWritesRejected(),
+		Log:            rt.log,
+	}
+}
+
+// grpcWritesRejected returns why gRPC writes are rejected, empty if they are served
+func (rt *Router) grpcWritesRejected() string {
+	switch {
+	case rt.Nonces != nil:
+		return "writes are disabled on gRPC while replay protection is enabled"
+	case rt.WriteAnomalies != nil:
+		return "writes are disabled on gRPC while write anomaly detection is enabled"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,54 @@
+package router
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/pokt-foundation/pocket-http-db/health"
+	"github.com/pokt-foundation/pocket-http-db/lifecycle"
+	jsonresponse "github.com/pokt-foundation/utils-go/json-response"
+)
+
+var errHealthNotEnabled = errors.New("integration health not enabled")
+
+// healthReport is the detailed health of the instance, healthy unless an enabled integration is failing
+// or a background subsystem failed
+type healthReport struct {
+	Healthy      bool               `json:"healthy"`
+	Integrations []health.Status    `json:"integrations"`
+	Subsystems   []lifecycle.Status `json:"subsystems"`
+}
+
+// GetHealth reports the connectivity status of the enabled integrations, with their last error and last success
+// it responds OK even if an integration is failing, they are optional so the instance keeps serving
+func (rt *Router) GetHealth(w http.ResponseWriter, r *http.Request) {
+	report := healthReport{Healthy: true, Integrations: []health.Status{}, Subsystems: []lifecycle.Status{}}
+
+	if rt.Health != nil {
+		report.Healthy = rt.Health.Healthy()
+		report.Integrations = rt.Health.Statuses()
+	}
+
+	if rt.Subsystems != nil {
+		report.Healthy = report.Healthy && rt.Subsystems.Healthy()
+		report.Subsystems = rt.Subsystems.Statuses()
+	}
+
+	jsonresponse.RespondWithJSON(w, http.StatusOK, report)
+}
+
+// EnableIntegration re-enables an integration disabled after exhausting its error budget, responding with its status
+func (rt *Router) EnableIntegration(w http.ResponseWriter, r *http.Request) {
+	if rt.Health == nil {
+		respondWithError(w, http.StatusNotFound, errHealthNotEnabled.Error())
+		return
+	}
+
+	status, err := rt.Health.Enable(pathParam(r, "integration"))
+	if errors.Is(err, health.ErrUnknownIntegration) {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	jsonresponse.RespondWithJSON(w, http.StatusOK, status)
+}
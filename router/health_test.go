@@ -0,0 +1,106 @@
+package router
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/pokt-foundation/pocket-http-db/health"
+	"github.com/pokt-foundation/pocket-http-db/lifecycle"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouter_GetHealth(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	grantAdminScope(router)
+
+	getHealth := func() healthReport {
+		req, err := http.NewRequest(http.MethodGet, "/admin/health", nil)
+		c.NoError(err)
+
+		rr := httptest.NewRecorder()
+
+		router.Router.ServeHTTP(rr, req)
+
+		c.Equal(http.StatusOK, rr.Code)
+
+		var report healthReport
+		c.NoError(json.Unmarshal(rr.Body.Bytes(), &report))
+
+		return report
+	}
+
+	report := getHealth()
+	c.True(report.Healthy)
+	c.Empty(report.Integrations)
+
+	router.Health = health.NewRegistry()
+	router.Health.Probe("webhooks").Record(nil)
+	router.Health.Probe("kafka").Record(errors.New("connection refused"))
+
+	report = getHealth()
+	c.False(report.Healthy)
+	c.Len(report.Integrations, 2)
+	c.Equal("kafka", report.Integrations[0].Name)
+	c.Equal(health.StateFailing, report.Integrations[0].State)
+	c.Equal("connection refused", report.Integrations[0].LastError)
+	c.Equal(health.StateOK, report.Integrations[1].State)
+	c.NotNil(report.Integrations[1].LastSuccess)
+
+	enable := func(integration string) *httptest.ResponseRecorder {
+		req, err := http.NewRequest(http.MethodPost, "/admin/health/"+integration+"/enable", nil)
+		c.NoError(err)
+
+		rr := httptest.NewRecorder()
+
+		router.Router.ServeHTTP(rr, req)
+
+		return rr
+	}
+
+	router.Health.SetErrorBudget("kafka", 1)
+	router.Health.Probe("kafka").Record(errors.New("connection refused"))
+
+	report = getHealth()
+	c.Equal(health.StateDisabled, report.Integrations[0].State)
+	c.NotNil(report.Integrations[0].DisabledAt)
+
+	rr := enable("kafka")
+	c.Equal(http.StatusOK, rr.Code)
+
+	var status health.Status
+	c.NoError(json.Unmarshal(rr.Body.Bytes(), &status))
+	c.Equal(health.StateFailing, status.State)
+	c.True(router.Health.Probe("kafka").Enabled())
+
+	c.Equal(http.StatusNotFound, enable("smtp").Code)
+
+	router.Health = nil
+	router.Subsystems = lifecycle.NewManager(logrus.New())
+	router.Subsystems.Add(lifecycle.Subsystem{
+		Name: "retention",
+		Run: func(ctx context.Context) error {
+			return errors.New("database gone")
+		},
+	})
+	router.Subsystems.Start(context.Background())
+
+	c.Eventually(func() bool {
+		return !router.Subsystems.Healthy()
+	}, time.Second, 10*time.Millisecond)
+
+	report = getHealth()
+	c.False(report.Healthy)
+	c.Len(report.Subsystems, 1)
+	c.Equal(lifecycle.StateFailed, report.Subsystems[0].State)
+	c.Equal("database gone", report.Subsystems[0].LastError)
+}
@@ -0,0 +1,62 @@
+package router
+
+import (
+	"net/http"
+	"strconv"
+)
+
+// cacheableGroups are the route groups whose reads change rarely enough to be cached by proxies
+var cacheableGroups = map[RouteGroup]bool{
+	RouteGroupBlockchain: true,
+	RouteGroupPayPlan:    true,
+}
+
+// cacheHeadersWriter sets the HTTP caching headers right before successful responses are written
+type cacheHeadersWriter struct {
+	http.ResponseWriter
+	rt          *Router
+	wroteHeader bool
+}
+
+func (c *cacheHeadersWriter) WriteHeader(status int) {
+	if !c.wroteHeader && status == http.StatusOK {
+		c.rt.setCacheHeaders(c.Header())
+	}
+
+	c.wroteHeader = true
+	c.ResponseWriter.WriteHeader(status)
+}
+
+func (c *cacheHeadersWriter) Write(b []byte) (int, error) {
+	if !c.wroteHeader {
+		c.WriteHeader(http.StatusOK)
+	}
+
+	return c.ResponseWriter.Write(b)
+}
+
+// setCacheHeaders sets Cache-Control with the configured max age and Last-Modified as the last cache refresh
+func (rt *Router) setCacheHeaders(header http.Header) {
+	header.Set("Cache-Control", "public, max-age="+strconv.Itoa(int(rt.HTTPCacheMaxAge.Seconds())))
+
+	if _, refreshedAt := rt.Cache.Generation(); !refreshedAt.IsZero() {
+		header.Set("Last-Modified", refreshedAt.UTC().Format(http.TimeFormat))
+	}
+}
+
+// httpCacheHandler sets the HTTP caching headers on successful reads of cacheable groups,
+// if a max age is configured
+func (rt *Router) httpCacheHandler(group RouteGroup, method string, handler http.HandlerFunc) http.HandlerFunc {
+	if !cacheableGroups[group] || !isRead(method) {
+		return handler
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		if rt.HTTPCacheMaxAge <= 0 {
+			handler(w, r)
+			return
+		}
+
+		handler(&cacheHeadersWriter{ResponseWriter: w, rt: rt}, r)
+	}
+}
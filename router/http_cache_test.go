@@ -0,0 +1,67 @@
+package router
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouter_HTTPCacheHeaders(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	req, err := http.NewRequest(http.MethodGet, "/blockchain/0021", nil)
+	c.NoError(err)
+
+	rr := httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusOK, rr.Code)
+	c.Empty(rr.Header().Get("Cache-Control"))
+
+	router.HTTPCacheMaxAge = 5 * time.Minute
+
+	rr = httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusOK, rr.Code)
+	c.Equal("public, max-age=300", rr.Header().Get("Cache-Control"))
+
+	_, refreshedAt := router.Cache.Generation()
+	c.Equal(refreshedAt.UTC().Format(http.TimeFormat), rr.Header().Get("Last-Modified"))
+
+	req, err = http.NewRequest(http.MethodGet, "/pay_plan", nil)
+	c.NoError(err)
+
+	rr = httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal("public, max-age=300", rr.Header().Get("Cache-Control"))
+
+	req, err = http.NewRequest(http.MethodGet, "/blockchain/9999", nil)
+	c.NoError(err)
+
+	rr = httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusNotFound, rr.Code)
+	c.Empty(rr.Header().Get("Cache-Control"))
+
+	req, err = http.NewRequest(http.MethodGet, "/load_balancer", nil)
+	c.NoError(err)
+
+	rr = httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Empty(rr.Header().Get("Cache-Control"))
+}
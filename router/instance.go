@@ -0,0 +1,41 @@
+package router
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	jsonresponse "github.com/pokt-foundation/utils-go/json-response"
+)
+
+// InstanceIDHeader holds the ID of the instance that served the request
+const InstanceIDHeader = "X-Instance-ID"
+
+var errInstanceRegistryDisabled = errors.New("instance registry not configured")
+
+// InstanceIDHandler sets the instance ID header on every response, if the instance has an ID
+func (rt *Router) InstanceIDHandler(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if rt.InstanceID != "" {
+			w.Header().Set(InstanceIDHeader, rt.InstanceID)
+		}
+
+		h.ServeHTTP(w, r)
+	})
+}
+
+func (rt *Router) GetInstances(w http.ResponseWriter, r *http.Request) {
+	if rt.Instances == nil {
+		respondWithError(w, http.StatusNotFound, errInstanceRegistryDisabled.Error())
+		return
+	}
+
+	instances, err := rt.Instances.Instances()
+	if err != nil {
+		rt.logError(fmt.Errorf("Instances in GetInstances failed: %w", err))
+		respondWithError(w, http.StatusBadGateway, err.Error())
+		return
+	}
+
+	jsonresponse.RespondWithJSON(w, http.StatusOK, instances)
+}
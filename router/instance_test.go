@@ -0,0 +1,90 @@
+package router
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/pokt-foundation/pocket-http-db/instance"
+	"github.com/pokt-foundation/pocket-http-db/types"
+	"github.com/stretchr/testify/require"
+)
+
+// instanceStoreMock keeps the last written instance
+type instanceStoreMock struct {
+	instance *types.Instance
+}
+
+func (s *instanceStoreMock) WriteInstance(instance *types.Instance) error {
+	s.instance = instance
+
+	return nil
+}
+
+func (s *instanceStoreMock) ReadInstances(since time.Time) ([]*types.Instance, error) {
+	return []*types.Instance{s.instance}, nil
+}
+
+func TestRouter_InstanceIDHandler(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	req, err := http.NewRequest(http.MethodGet, "/pay_plan", nil)
+	c.NoError(err)
+
+	rr := httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Empty(rr.Header().Get(InstanceIDHeader))
+
+	router.InstanceID = "pocket-http-db-1"
+
+	rr = httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal("pocket-http-db-1", rr.Header().Get(InstanceIDHeader))
+}
+
+func TestRouter_GetInstances(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	grantAdminScope(router)
+
+	req, err := http.NewRequest(http.MethodGet, "/admin/instances", nil)
+	c.NoError(err)
+
+	rr := httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusNotFound, rr.Code)
+
+	router.Instances = instance.NewRegistry("pocket-http-db-1", router.Cache, &instanceStoreMock{}, time.Minute)
+
+	err = router.Instances.Heartbeat()
+	c.NoError(err)
+
+	rr = httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusOK, rr.Code)
+
+	var instances []*types.Instance
+
+	err = json.Unmarshal(rr.Body.Bytes(), &instances)
+	c.NoError(err)
+
+	c.Len(instances, 1)
+	c.Equal("pocket-http-db-1", instances[0].ID)
+	c.Equal(uint64(1), instances[0].CacheGeneration)
+}
@@ -0,0 +1,122 @@
+package router
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/pokt-foundation/pocket-http-db/accesslog"
+	"github.com/pokt-foundation/pocket-http-db/service"
+)
+
+var errKeyRestricted = errors.New("API key restricted to the entities of its user")
+
+// keyUserCheck returns an error if the request to a route can't be served to the key restricted to userID
+type keyUserCheck func(rt *Router, r *http.Request, userID string) error
+
+// keyUserRoutes are the route templates the keys of KeyUsers can read, with the check of their ownership
+// every other route is refused to them
+var keyUserRoutes = map[string]keyUserCheck{
+	"/":                                allowKeyUser,
+	openAPIPath:                        allowKeyUser,
+	featuresPath:                       allowKeyUser,
+	"/blockchain":                      allowKeyUser,
+	"/blockchain/{id}":                 allowKeyUser,
+	"/pay_plan":                        allowKeyUser,
+	"/pay_plan/{type}":                 allowKeyUser,
+	"/application":                     checkKeyUserQuery,
+	"/load_balancer":                   checkKeyUserQuery,
+	"/application/{id}":                checkKeyUserApplication,
+	"/application/{id}/labels":         checkKeyUserApplication,
+	"/application/address/{address}":   checkKeyUserAddress,
+	"/load_balancer/{id}":              checkKeyUserLoadBalancer,
+	"/load_balancer/{id}/applications": checkKeyUserLoadBalancer,
+	"/load_balancer/{id}/labels":       checkKeyUserLoadBalancer,
+	"/load_balancer/{id}/limits":       checkKeyUserLoadBalancer,
+	"/user/{id}/application":           checkKeyUserPath,
+	"/user/{id}/load_balancer":         checkKeyUserPath,
+}
+
+func allowKeyUser(rt *Router, r *http.Request, userID string) error {
+	return nil
+}
+
+// checkKeyUserQuery restricts the lists to the entities of the user, filtering them by userID
+func checkKeyUserQuery(rt *Router, r *http.Request, userID string) error {
+	query := r.URL.Query()
+
+	if queriedUserID := query.Get("userID"); queriedUserID != "" && queriedUserID != userID {
+		return errKeyRestricted
+	}
+
+	query.Set("userID", userID)
+	r.URL.RawQuery = query.Encode()
+
+	return nil
+}
+
+// checkKeyUserApplication answers the applications of other users as not found, so their IDs can't be probed
+func checkKeyUserApplication(rt *Router, r *http.Request, userID string) error {
+	app, err := rt.applications().Get(pathParam(r, "id"))
+	if err != nil || app.UserID != userID {
+		return service.ErrApplicationNotFound
+	}
+
+	return nil
+}
+
+func checkKeyUserAddress(rt *Router, r *http.Request, userID string) error {
+	app, err := rt.applications().GetByAddress(pathParam(r, "address"))
+	if err != nil || app.UserID != userID {
+		return service.ErrApplicationNotFound
+	}
+
+	return nil
+}
+
+func checkKeyUserLoadBalancer(rt *Router, r *http.Request, userID string) error {
+	lb, err := rt.loadBalancers().Get(pathParam(r, "id"))
+	if err != nil || lb.UserID != userID {
+		return service.ErrLoadBalancerNotFound
+	}
+
+	return nil
+}
+
+func checkKeyUserPath(rt *Router, r *http.Request, userID string) error {
+	if pathParam(r, "id") != userID {
+		return errKeyRestricted
+	}
+
+	return nil
+}
+
+// KeyUserHandler restricts the keys of KeyUsers to reading the entities of their user, enforced before any handler runs
+// the lists are filtered to them, the entities of other users are not found and the other routes are forbidden
+func (rt *Router) KeyUserHandler(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, ok := rt.KeyUsers[accesslog.KeyID(r.Header.Get("Authorization"))]
+		if !ok {
+			h.ServeHTTP(w, r)
+
+			return
+		}
+
+		check, ok := keyUserRoutes[routeTemplate(r)]
+		if !ok || !isRead(r.Method) {
+			respondWithError(w, http.StatusForbidden, errKeyRestricted.Error())
+			return
+		}
+
+		err := check(rt, r, userID)
+		if errors.Is(err, errKeyRestricted) {
+			respondWithError(w, http.StatusForbidden, err.Error())
+			return
+		}
+		if err != nil {
+			respondWithError(w, serviceErrorStatus(err), err.Error())
+			return
+		}
+
+		h.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,73 @@
+package router
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pokt-foundation/pocket-http-db/accesslog"
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouter_KeyUserHandler(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	router.APIKeys["customer"] = true
+	router.KeyUsers = map[string]string{accesslog.KeyID("customer"): "60ecb2bf67774900350d9c44"}
+
+	serve := func(method, path, key string) *httptest.ResponseRecorder {
+		req, err := http.NewRequest(method, path, nil)
+		c.NoError(err)
+
+		req.Header.Set("Authorization", key)
+
+		rr := httptest.NewRecorder()
+		router.Router.ServeHTTP(rr, req)
+
+		return rr
+	}
+
+	rr := serve(http.MethodGet, "/application", "customer")
+	c.Equal(http.StatusOK, rr.Code)
+
+	var apps []*repository.Application
+
+	c.NoError(json.Unmarshal(rr.Body.Bytes(), &apps))
+	c.Len(apps, 1)
+	c.Equal("5f62b7d8be3591c4dea8566f", apps[0].ID)
+
+	rr = serve(http.MethodGet, "/application", "")
+	c.Equal(http.StatusOK, rr.Code)
+
+	c.NoError(json.Unmarshal(rr.Body.Bytes(), &apps))
+	c.Len(apps, 3)
+
+	rr = serve(http.MethodGet, "/load_balancer", "customer")
+	c.Equal(http.StatusOK, rr.Code)
+	c.JSONEq(`[]`, rr.Body.String())
+
+	for path, status := range map[string]int{
+		"/application/5f62b7d8be3591c4dea8566f":                         http.StatusOK,
+		"/application/address/e2a7a3d0b9c2ec11d9cd6d6ab8b1b2c6b8f4a7ee": http.StatusOK,
+		"/user/60ecb2bf67774900350d9c44/application":                    http.StatusOK,
+		"/blockchain":                                  http.StatusOK,
+		"/application/5f62b7d8be3591c4dea8566d":        http.StatusNotFound,
+		"/load_balancer/60ecb2bf67774900350d9c42":      http.StatusNotFound,
+		"/application?userID=60ecb2bf67774900350d9c43": http.StatusForbidden,
+		"/user/60ecb2bf67774900350d9c43/application":   http.StatusForbidden,
+		"/changes": http.StatusForbidden,
+		"/filter":  http.StatusForbidden,
+	} {
+		c.Equal(status, serve(http.MethodGet, path, "customer").Code, path)
+	}
+
+	c.Equal(http.StatusForbidden, serve(http.MethodDelete, "/application/5f62b7d8be3591c4dea8566f", "customer").Code)
+
+	c.False(router.GRPCServer().APIKeys["customer"])
+	c.True(router.GRPCServer().APIKeys[""])
+}
@@ -0,0 +1,83 @@
+package router
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/pokt-foundation/pocket-http-db/service"
+	jsonresponse "github.com/pokt-foundation/utils-go/json-response"
+)
+
+// labelSelectors returns the selectors of the label query parameters of r, every one of them must match
+func labelSelectors(r *http.Request) ([]service.LabelSelector, error) {
+	return service.ParseLabelSelectors(r.URL.Query()["label"])
+}
+
+// decodeLabels decodes the labels in the body of r
+func (rt *Router) decodeLabels(r *http.Request, operation string) (map[string]string, error) {
+	var labels map[string]string
+
+	err := decodeBody(r, &labels)
+	if err != nil {
+		rt.logError(fmt.Errorf("%s decode failed: %w", operation, err))
+		return nil, err
+	}
+
+	defer r.Body.Close()
+
+	return labels, nil
+}
+
+func (rt *Router) GetApplicationLabels(w http.ResponseWriter, r *http.Request) {
+	labels, err := rt.applications().GetLabels(pathParam(r, "id"))
+	if err != nil {
+		rt.respondWithServiceError(w, "GetApplicationLabels", err)
+		return
+	}
+
+	jsonresponse.RespondWithJSON(w, http.StatusOK, labels)
+}
+
+func (rt *Router) SetApplicationLabels(w http.ResponseWriter, r *http.Request) {
+	labels, err := rt.decodeLabels(r, "SetApplicationLabels")
+	if err != nil {
+		respondWithBodyError(w, err)
+		return
+	}
+
+	apps := rt.applications()
+
+	err = apps.SetLabels(r.Context(), pathParam(r, "id"), labels)
+	if err != nil {
+		rt.respondWithServiceError(w, "SetApplicationLabels", err)
+		return
+	}
+
+	rt.GetApplicationLabels(w, r)
+}
+
+func (rt *Router) GetLoadBalancerLabels(w http.ResponseWriter, r *http.Request) {
+	labels, err := rt.loadBalancers().GetLabels(pathParam(r, "id"))
+	if err != nil {
+		rt.respondWithServiceError(w, "GetLoadBalancerLabels", err)
+		return
+	}
+
+	jsonresponse.RespondWithJSON(w, http.StatusOK, labels)
+}
+
+func (rt *Router) SetLoadBalancerLabels(w http.ResponseWriter, r *http.Request) {
+	labels, err := rt.decodeLabels(r, "SetLoadBalancerLabels")
+	if err != nil {
+		respondWithBodyError(w, err)
+		return
+	}
+
+	err = rt.loadBalancers().SetLabels(r.Context(), pathParam(r, "id"), labels)
+	if err != nil {
+		rt.respondWithServiceError(w, "SetLoadBalancerLabels", err)
+		return
+	}
+
+	rt.GetLoadBalancerLabels(w, r)
+}
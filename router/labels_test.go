@@ -0,0 +1,91 @@
+package router
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/pokt-foundation/pocket-http-db/types"
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouter_Labels(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	writerMock := &writerMock{}
+	writerMock.On("WriteLabels", types.EntityApplication, "5f62b7d8be3591c4dea8566d", mock.Anything).Return(nil).Once()
+	writerMock.On("WriteLabels", types.EntityLoadBalancer, "60ecb2bf67774900350d9c43", mock.Anything).Return(nil).Once()
+
+	router.Writer = writerMock
+
+	tests := []struct {
+		method       string
+		path         string
+		body         string
+		expectedCode int
+		expectedBody string
+	}{
+		{http.MethodPut, "/application/5f62b7d8be3591c4dea8566d/labels", `{"team":"infra"}`, http.StatusOK, `{"team":"infra"}`},
+		{http.MethodGet, "/application/5f62b7d8be3591c4dea8566a/labels", "", http.StatusOK, `{}`},
+		{http.MethodPut, "/application/5f62b7d8be3591c4dea8566a/labels", `{"team/x":"infra"}`, http.StatusBadRequest, ""},
+		{http.MethodPut, "/load_balancer/60ecb2bf67774900350d9c43/labels", `{"team":"infra"}`, http.StatusOK, `{"team":"infra"}`},
+		{http.MethodGet, "/load_balancer/wrong/labels", "", http.StatusNotFound, ""},
+	}
+
+	for _, tt := range tests {
+		req, err := http.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+		c.NoError(err)
+
+		rr := httptest.NewRecorder()
+
+		router.Router.ServeHTTP(rr, req)
+
+		c.Equal(tt.expectedCode, rr.Code, tt.path)
+
+		if tt.expectedBody != "" {
+			c.JSONEq(tt.expectedBody, rr.Body.String())
+		}
+	}
+
+	req, err := http.NewRequest(http.MethodGet, "/application?label=team:infra", nil)
+	c.NoError(err)
+
+	rr := httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusOK, rr.Code)
+
+	var apps []*repository.Application
+	c.NoError(json.Unmarshal(rr.Body.Bytes(), &apps))
+	c.Len(apps, 1)
+	c.Equal("5f62b7d8be3591c4dea8566d", apps[0].ID)
+
+	req, err = http.NewRequest(http.MethodGet, "/load_balancer?label=team:infra&label=env", nil)
+	c.NoError(err)
+
+	rr = httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusOK, rr.Code)
+	c.JSONEq(`[]`, rr.Body.String())
+
+	req, err = http.NewRequest(http.MethodGet, "/user/60ecb2bf67774900350d9c43/application?label=:infra", nil)
+	c.NoError(err)
+
+	rr = httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusBadRequest, rr.Code)
+
+	writerMock.AssertExpectations(t)
+}
@@ -0,0 +1,40 @@
+package router
+
+import (
+	"net/http"
+	"strings"
+
+	jsonresponse "github.com/pokt-foundation/utils-go/json-response"
+)
+
+// notModified sets version as the ETag of the response and responds not modified if r already holds it
+// returns true if the response was written
+func notModified(w http.ResponseWriter, r *http.Request, version string) bool {
+	etag := `"` + version + `"`
+
+	w.Header().Set("ETag", etag)
+
+	for _, held := range strings.Split(r.Header.Get("If-None-Match"), ",") {
+		if strings.TrimPrefix(strings.TrimSpace(held), "W/") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetApplicationsLimitsDelta responds with the application limits changed since the version of the since parameter,
+// as returned by a previous delta, so pollers only transfer what changed
+// the full limits are sent if the version is not set or not held anymore
+func (rt *Router) GetApplicationsLimitsDelta(w http.ResponseWriter, r *http.Request) {
+	since := strings.Trim(r.URL.Query().Get("since"), `"`)
+
+	delta := rt.LimitsVersions.Delta(rt.applications().GetLimits(), since)
+
+	if notModified(w, r, delta.Version) {
+		return
+	}
+
+	jsonresponse.RespondWithJSON(w, http.StatusOK, delta)
+}
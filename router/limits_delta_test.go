@@ -0,0 +1,66 @@
+package router
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pokt-foundation/pocket-http-db/service"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouter_GetApplicationsLimitsDelta(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	router.LimitsVersions = service.NewLimitsVersions(0)
+
+	serve := func(path, ifNoneMatch string) *httptest.ResponseRecorder {
+		req, err := http.NewRequest(http.MethodGet, path, nil)
+		c.NoError(err)
+
+		if ifNoneMatch != "" {
+			req.Header.Set("If-None-Match", ifNoneMatch)
+		}
+
+		rr := httptest.NewRecorder()
+
+		router.Router.ServeHTTP(rr, req)
+
+		return rr
+	}
+
+	rr := serve("/application/limits/delta", "")
+	c.Equal(http.StatusOK, rr.Code)
+
+	var delta service.LimitsDelta
+
+	c.NoError(json.Unmarshal(rr.Body.Bytes(), &delta))
+	c.True(delta.Full)
+	c.Len(delta.Changed, 3)
+	c.Equal(`"`+delta.Version+`"`, rr.Header().Get("ETag"))
+
+	rr = serve("/application/limits/delta?since="+delta.Version, "")
+	c.Equal(http.StatusOK, rr.Code)
+
+	var unchanged service.LimitsDelta
+
+	c.NoError(json.Unmarshal(rr.Body.Bytes(), &unchanged))
+	c.False(unchanged.Full)
+	c.Empty(unchanged.Changed)
+	c.Empty(unchanged.Removed)
+
+	rr = serve("/application/limits/delta?since="+delta.Version, rr.Header().Get("ETag"))
+	c.Equal(http.StatusNotModified, rr.Code)
+	c.Empty(rr.Body.Bytes())
+
+	// the full limits carry the same version
+	rr = serve("/application/limits", "")
+	c.Equal(http.StatusOK, rr.Code)
+	c.Equal(`"`+delta.Version+`"`, rr.Header().Get("ETag"))
+
+	c.Equal(http.StatusNotModified, serve("/application/limits", `W/"other", "`+delta.Version+`"`).Code)
+}
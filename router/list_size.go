@@ -0,0 +1,50 @@
+package router
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/pokt-foundation/pocket-http-db/accesslog"
+)
+
+// ScopeBulk is the scope of the API keys allowed to get full lists beyond MaxListSize
+const ScopeBulk = "bulk"
+
+// hasScope returns true if the API key of r has scope
+func (rt *Router) hasScope(r *http.Request, scope string) bool {
+	return rt.KeyScopes[accesslog.KeyID(r.Header.Get("Authorization"))][scope]
+}
+
+// allowsListSize returns true if a full list of count entities can be served to r, responding with the error otherwise
+// lists beyond MaxListSize are only served with all=true, which only bulk scoped keys can pass
+func (rt *Router) allowsListSize(w http.ResponseWriter, r *http.Request, count int) bool {
+	if rt.MaxListSize <= 0 {
+		return true
+	}
+
+	all := false
+
+	if rawAll := r.URL.Query().Get("all"); rawAll != "" {
+		var err error
+
+		all, err = strconv.ParseBool(rawAll)
+		if err != nil {
+			respondWithError(w, http.StatusBadRequest, "invalid all")
+			return false
+		}
+	}
+
+	if all && !rt.hasScope(r, ScopeBulk) {
+		respondWithError(w, http.StatusBadRequest, fmt.Sprintf("all=true requires an API key with the %s scope", ScopeBulk))
+		return false
+	}
+
+	if !all && count > rt.MaxListSize {
+		respondWithError(w, http.StatusRequestEntityTooLarge,
+			fmt.Sprintf("list of %d entities exceeds the limit of %d, narrow it down or pass all=true", count, rt.MaxListSize))
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,61 @@
+package router
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pokt-foundation/pocket-http-db/accesslog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouter_ListSize(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	router.APIKeys["bulk_key"] = true
+	router.KeyScopes = map[string]map[string]bool{accesslog.KeyID("bulk_key"): {ScopeBulk: true}}
+	router.MaxListSize = 2
+
+	tests := []struct {
+		name         string
+		apiKey       string
+		path         string
+		expectedCode int
+	}{
+		{"list beyond the limit", "", "/application", http.StatusRequestEntityTooLarge},
+		{"list within the limit", "", "/load_balancer", http.StatusOK},
+		{"narrowed list", "", "/application?status=IN_SERVICE", http.StatusOK},
+		{"all without bulk scope", "", "/application?all=true", http.StatusBadRequest},
+		{"all without bulk scope on short list", "", "/load_balancer?all=true", http.StatusBadRequest},
+		{"invalid all", "bulk_key", "/application?all=maybe", http.StatusBadRequest},
+		{"all with bulk scope", "bulk_key", "/application?all=true", http.StatusOK},
+		{"bulk scope without all", "bulk_key", "/application", http.StatusRequestEntityTooLarge},
+	}
+
+	for _, tt := range tests {
+		req, err := http.NewRequest(http.MethodGet, tt.path, nil)
+		c.NoError(err)
+
+		req.Header.Set("Authorization", tt.apiKey)
+
+		rr := httptest.NewRecorder()
+
+		router.Router.ServeHTTP(rr, req)
+
+		c.Equal(tt.expectedCode, rr.Code, tt.name)
+	}
+
+	router.MaxListSize = 0
+
+	req, err := http.NewRequest(http.MethodGet, "/application?all=true", nil)
+	c.NoError(err)
+
+	rr := httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusOK, rr.Code)
+}
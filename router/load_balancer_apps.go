@@ -0,0 +1,89 @@
+package router
+
+import (
+	"fmt"
+	"net/http"
+
+	jsonresponse "github.com/pokt-foundation/utils-go/json-response"
+)
+
+// loadBalancerApps is the body of the load balancer applications routes
+// version must be the one last read, so a change made concurrently by someone else is not overwritten
+type loadBalancerApps struct {
+	ApplicationIDs []string `json:"applicationIDs"`
+	Version        string   `json:"version"`
+}
+
+func (rt *Router) GetLoadBalancerApplications(w http.ResponseWriter, r *http.Request) {
+	appIDs, version, err := rt.loadBalancers().GetApplications(pathParam(r, "id"))
+	if err != nil {
+		rt.respondWithServiceError(w, "GetLoadBalancerApplications", err)
+		return
+	}
+
+	jsonresponse.RespondWithJSON(w, http.StatusOK, loadBalancerApps{ApplicationIDs: appIDs, Version: version})
+}
+
+func (rt *Router) SetLoadBalancerApplications(w http.ResponseWriter, r *http.Request) {
+	id := pathParam(r, "id")
+
+	var input loadBalancerApps
+
+	err := decodeBody(r, &input)
+	if err != nil {
+		rt.logError(fmt.Errorf("SetLoadBalancerApplications decode failed: %w", err))
+		respondWithBodyError(w, err)
+		return
+	}
+
+	defer r.Body.Close()
+
+	lbs := rt.loadBalancers()
+
+	err = lbs.SetApplications(r.Context(), id, input.ApplicationIDs, input.Version)
+	if err != nil {
+		rt.respondWithServiceError(w, "SetLoadBalancerApplications", err)
+		return
+	}
+
+	appIDs, version, err := lbs.GetApplications(id)
+	if err != nil {
+		rt.respondWithServiceError(w, "GetLoadBalancerApplications in SetLoadBalancerApplications", err)
+		return
+	}
+
+	jsonresponse.RespondWithJSON(w, http.StatusOK, loadBalancerApps{ApplicationIDs: appIDs, Version: version})
+}
+
+// ReorderLoadBalancerApplications sets the order the gateway fails over the applications of the load balancer in,
+// the body must hold every application of the load balancer, adding or removing them is done by SetLoadBalancerApplications
+func (rt *Router) ReorderLoadBalancerApplications(w http.ResponseWriter, r *http.Request) {
+	id := pathParam(r, "id")
+
+	var input loadBalancerApps
+
+	err := decodeBody(r, &input)
+	if err != nil {
+		rt.logError(fmt.Errorf("ReorderLoadBalancerApplications decode failed: %w", err))
+		respondWithBodyError(w, err)
+		return
+	}
+
+	defer r.Body.Close()
+
+	lbs := rt.loadBalancers()
+
+	err = lbs.ReorderApplications(r.Context(), id, input.ApplicationIDs, input.Version)
+	if err != nil {
+		rt.respondWithServiceError(w, "ReorderLoadBalancerApplications", err)
+		return
+	}
+
+	appIDs, version, err := lbs.GetApplications(id)
+	if err != nil {
+		rt.respondWithServiceError(w, "GetLoadBalancerApplications in ReorderLoadBalancerApplications", err)
+		return
+	}
+
+	jsonresponse.RespondWithJSON(w, http.StatusOK, loadBalancerApps{ApplicationIDs: appIDs, Version: version})
+}
@@ -0,0 +1,161 @@
+package router
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pokt-foundation/pocket-http-db/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouter_LoadBalancerApplications(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	writerMock := &writerMock{}
+	router.Writer = writerMock
+
+	req, err := http.NewRequest(http.MethodGet, "/load_balancer/60ecb2bf67774900350d9c42/applications", nil)
+	c.NoError(err)
+
+	rr := httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusOK, rr.Code)
+
+	var current loadBalancerApps
+	c.NoError(json.Unmarshal(rr.Body.Bytes(), &current))
+	c.Equal([]string{"5f62b7d8be3591c4dea8566d", "5f62b7d8be3591c4dea8566a"}, current.ApplicationIDs)
+	c.Equal(types.LoadBalancerAppsVersion(current.ApplicationIDs), current.Version)
+
+	newIDs := []string{"5f62b7d8be3591c4dea8566d"}
+
+	writerMock.On("SetLoadBalancerApplications", "60ecb2bf67774900350d9c42", newIDs, current.Version).Return(nil).Once()
+
+	body, err := json.Marshal(loadBalancerApps{ApplicationIDs: newIDs, Version: current.Version})
+	c.NoError(err)
+
+	req, err = http.NewRequest(http.MethodPut, "/load_balancer/60ecb2bf67774900350d9c42/applications", bytes.NewBuffer(body))
+	c.NoError(err)
+
+	rr = httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusOK, rr.Code)
+
+	var updated loadBalancerApps
+	c.NoError(json.Unmarshal(rr.Body.Bytes(), &updated))
+	c.Equal(newIDs, updated.ApplicationIDs)
+	c.Equal(types.LoadBalancerAppsVersion(newIDs), updated.Version)
+
+	conflict := &types.LoadBalancerAppsConflictError{ApplicationIDs: newIDs, Version: updated.Version}
+
+	writerMock.On("SetLoadBalancerApplications", "60ecb2bf67774900350d9c42", newIDs, current.Version).Return(conflict).Once()
+
+	req, err = http.NewRequest(http.MethodPut, "/load_balancer/60ecb2bf67774900350d9c42/applications", bytes.NewBuffer(body))
+	c.NoError(err)
+
+	rr = httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusConflict, rr.Code)
+
+	var conflictBody struct {
+		Error          string   `json:"error"`
+		ApplicationIDs []string `json:"applicationIDs"`
+		Version        string   `json:"version"`
+	}
+	c.NoError(json.Unmarshal(rr.Body.Bytes(), &conflictBody))
+	c.Equal(types.ErrLoadBalancerAppsConflict.Error(), conflictBody.Error)
+	c.Equal(newIDs, conflictBody.ApplicationIDs)
+	c.Equal(updated.Version, conflictBody.Version)
+
+	req, err = http.NewRequest(http.MethodPut, "/load_balancer/60ecb2bf67774900350d9c42/applications",
+		bytes.NewBufferString(`{"applicationIDs":[]}`))
+	c.NoError(err)
+
+	rr = httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusBadRequest, rr.Code)
+
+	req, err = http.NewRequest(http.MethodGet, "/load_balancer/wrong/applications", nil)
+	c.NoError(err)
+
+	rr = httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusNotFound, rr.Code)
+
+	writerMock.AssertExpectations(t)
+}
+
+func TestRouter_ReorderLoadBalancerApplications(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	writerMock := &writerMock{}
+	router.Writer = writerMock
+
+	reordered := []string{"5f62b7d8be3591c4dea8566a", "5f62b7d8be3591c4dea8566d"}
+	version := types.LoadBalancerAppsVersion(reordered)
+
+	writerMock.On("SetLoadBalancerApplications", "60ecb2bf67774900350d9c42", reordered, version).Return(nil).Once()
+
+	tests := []struct {
+		body         loadBalancerApps
+		expectedCode int
+	}{
+		{loadBalancerApps{ApplicationIDs: reordered, Version: version}, http.StatusOK},
+		{loadBalancerApps{ApplicationIDs: reordered[:1], Version: version}, http.StatusBadRequest},
+		{loadBalancerApps{ApplicationIDs: reordered, Version: "stale"}, http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		body, err := json.Marshal(tt.body)
+		c.NoError(err)
+
+		req, err := http.NewRequest(http.MethodPut, "/load_balancer/60ecb2bf67774900350d9c42/applications/order", bytes.NewBuffer(body))
+		c.NoError(err)
+
+		rr := httptest.NewRecorder()
+
+		router.Router.ServeHTTP(rr, req)
+
+		c.Equal(tt.expectedCode, rr.Code, tt.body)
+	}
+
+	// the order is kept by the load balancer itself
+	req, err := http.NewRequest(http.MethodGet, "/load_balancer/60ecb2bf67774900350d9c42", nil)
+	c.NoError(err)
+
+	rr := httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusOK, rr.Code)
+
+	var lb struct {
+		Applications []struct {
+			ID string `json:"id"`
+		} `json:"applications"`
+	}
+	c.NoError(json.Unmarshal(rr.Body.Bytes(), &lb))
+	c.Len(lb.Applications, 2)
+	c.Equal(reordered[0], lb.Applications[0].ID)
+	c.Equal(reordered[1], lb.Applications[1].ID)
+
+	writerMock.AssertExpectations(t)
+}
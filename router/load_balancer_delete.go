@@ -0,0 +1,26 @@
+package router
+
+import (
+	"net/http"
+
+	"github.com/pokt-foundation/pocket-http-db/service"
+	jsonresponse "github.com/pokt-foundation/utils-go/json-response"
+)
+
+// DeleteLoadBalancer permanently deletes the load balancer and responds with the affected entities
+// the orphans query parameter sets what happens to the applications no other load balancer holds,
+// detach by default or delete
+func (rt *Router) DeleteLoadBalancer(w http.ResponseWriter, r *http.Request) {
+	orphans := service.OrphansDetach
+	if rawOrphans := r.URL.Query().Get("orphans"); rawOrphans != "" {
+		orphans = service.OrphanPolicy(rawOrphans)
+	}
+
+	deletion, err := rt.loadBalancers().Delete(r.Context(), pathParam(r, "id"), orphans)
+	if err != nil {
+		rt.respondWithServiceError(w, "DeleteLoadBalancer", err)
+		return
+	}
+
+	jsonresponse.RespondWithJSON(w, http.StatusOK, deletion)
+}
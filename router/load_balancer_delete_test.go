@@ -0,0 +1,73 @@
+package router
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouter_DeleteLoadBalancer(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	writerMock := &writerMock{}
+	writerMock.On("DeleteLoadBalancer", "60ecb2bf67774900350d9c43", []string(nil)).Return([]string{}, nil).Once()
+	writerMock.On("DeleteLoadBalancer", "60ecb2bf67774900350d9c42",
+		[]string{"5f62b7d8be3591c4dea8566d", "5f62b7d8be3591c4dea8566a"}).
+		Return([]string{"5f62b7d8be3591c4dea8566d", "5f62b7d8be3591c4dea8566a"}, nil).Once()
+
+	router.Writer = writerMock
+
+	// only admin scoped keys delete load balancers
+	req, err := http.NewRequest(http.MethodDelete, "/admin/load_balancer/60ecb2bf67774900350d9c43?orphans=delete", nil)
+	c.NoError(err)
+
+	rr := httptest.NewRecorder()
+	router.Router.ServeHTTP(rr, req)
+	c.Equal(http.StatusForbidden, rr.Code)
+
+	grantAdminScope(router)
+
+	tests := []struct {
+		path         string
+		expectedCode int
+		expectedBody string
+	}{
+		{"/admin/load_balancer/60ecb2bf67774900350d9c43?orphans=purge", http.StatusBadRequest, ""},
+		// its applications are held by the other load balancer, so none is orphaned
+		{
+			"/admin/load_balancer/60ecb2bf67774900350d9c43?orphans=delete", http.StatusOK,
+			`{"loadBalancerID":"60ecb2bf67774900350d9c43","detachedApplicationIDs":["5f62b7d8be3591c4dea8566d","5f62b7d8be3591c4dea8566a"],"deletedApplicationIDs":[]}`,
+		},
+		{
+			"/admin/load_balancer/60ecb2bf67774900350d9c42?orphans=delete", http.StatusOK,
+			`{"loadBalancerID":"60ecb2bf67774900350d9c42","detachedApplicationIDs":[],"deletedApplicationIDs":["5f62b7d8be3591c4dea8566d","5f62b7d8be3591c4dea8566a"]}`,
+		},
+		{"/admin/load_balancer/60ecb2bf67774900350d9c42", http.StatusNotFound, ""},
+	}
+
+	for _, tt := range tests {
+		req, err := http.NewRequest(http.MethodDelete, tt.path, nil)
+		c.NoError(err)
+
+		rr := httptest.NewRecorder()
+
+		router.Router.ServeHTTP(rr, req)
+
+		c.Equal(tt.expectedCode, rr.Code, tt.path)
+
+		if tt.expectedBody != "" {
+			c.JSONEq(tt.expectedBody, rr.Body.String())
+		}
+	}
+
+	c.Empty(router.Cache.GetLoadBalancers())
+	c.Nil(router.Cache.GetApplication("5f62b7d8be3591c4dea8566d"))
+	c.NotNil(router.Cache.GetApplication("5f62b7d8be3591c4dea8566f"))
+
+	writerMock.AssertExpectations(t)
+}
@@ -0,0 +1,18 @@
+package router
+
+import (
+	"net/http"
+
+	jsonresponse "github.com/pokt-foundation/utils-go/json-response"
+)
+
+// GetLoadBalancerLimits returns the limits of the applications of the load balancer, aggregated
+func (rt *Router) GetLoadBalancerLimits(w http.ResponseWriter, r *http.Request) {
+	limits, err := rt.loadBalancers().GetLimits(pathParam(r, "id"))
+	if err != nil {
+		rt.respondWithServiceError(w, "GetLoadBalancerLimits", err)
+		return
+	}
+
+	jsonresponse.RespondWithJSON(w, http.StatusOK, limits)
+}
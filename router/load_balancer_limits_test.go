@@ -0,0 +1,46 @@
+package router
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pokt-foundation/pocket-http-db/service"
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouter_GetLoadBalancerLimits(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	req, err := http.NewRequest(http.MethodGet, "/load_balancer/60ecb2bf67774900350d9c42/limits", nil)
+	c.NoError(err)
+
+	rr := httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusOK, rr.Code)
+
+	var limits service.LoadBalancerLimits
+	c.NoError(json.Unmarshal(rr.Body.Bytes(), &limits))
+	c.Equal("60ecb2bf67774900350d9c42", limits.LoadBalancerID)
+	c.Equal(250000, limits.DailyLimit)
+	c.True(limits.Unlimited)
+	c.True(limits.MixedPlans)
+	c.Equal([]repository.PayPlanType{"", repository.FreetierV0}, limits.PlanTypes)
+	c.Len(limits.Applications, 2)
+
+	req, err = http.NewRequest(http.MethodGet, "/load_balancer/wrong/limits", nil)
+	c.NoError(err)
+
+	rr = httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusNotFound, rr.Code)
+}
@@ -0,0 +1,66 @@
+package router
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/pokt-foundation/pocket-http-db/health"
+	"github.com/pokt-foundation/pocket-http-db/metrics"
+)
+
+// MetricsHandler records every request on the metrics registry, if one is configured
+// requests are labeled by route template, never by raw path, to keep the number of series bounded
+func (rt *Router) MetricsHandler(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if rt.Metrics == nil {
+			h.ServeHTTP(w, r)
+
+			return
+		}
+
+		start := time.Now()
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+
+		h.ServeHTTP(recorder, r)
+
+		rt.Metrics.ObserveRequest(r.Method, routeTemplate(r), recorder.status, time.Since(start))
+	})
+}
+
+// EntityCounts returns the number of entities held in the cache, to be exported as gauges
+func (rt *Router) EntityCounts() map[string]int {
+	return map[string]int{
+		metrics.EntityApplication:  len(rt.Cache.GetApplications()),
+		metrics.EntityLoadBalancer: len(rt.Cache.GetLoadBalancers()),
+		metrics.EntityBlockchain:   len(rt.Cache.GetBlockchains()),
+	}
+}
+
+// CacheStatus returns the refresh status of the cache, to be exported as gauges
+func (rt *Router) CacheStatus() metrics.CacheStatus {
+	_, refreshedAt := rt.Cache.Generation()
+
+	return metrics.CacheStatus{
+		RefreshedAt:   refreshedAt,
+		RefreshErrors: rt.Cache.RefreshStatus().Errors,
+	}
+}
+
+// IntegrationStatuses returns the status of the integrations with a probe, to be exported as gauges
+func (rt *Router) IntegrationStatuses() []metrics.IntegrationStatus {
+	if rt.Health == nil {
+		return nil
+	}
+
+	statuses := []metrics.IntegrationStatus{}
+
+	for _, status := range rt.Health.Statuses() {
+		statuses = append(statuses, metrics.IntegrationStatus{
+			Name:                status.Name,
+			Disabled:            status.State == health.StateDisabled,
+			ConsecutiveFailures: status.ConsecutiveFailures,
+		})
+	}
+
+	return statuses
+}
@@ -0,0 +1,119 @@
+package router
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/pokt-foundation/pocket-http-db/metrics"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouter_MetricsHandler(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	router.Metrics = metrics.NewRegistry(metrics.DefaultBuckets, 0)
+
+	for i := 0; i < 50; i++ {
+		req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/application/%024d", i), nil)
+		c.NoError(err)
+
+		router.Router.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	for _, path := range []string{"/application/5f62b7d8be3591c4dea8566d", "/blockchain/0021", "/user/60ecb2bf67774900350d9c43/application"} {
+		req, err := http.NewRequest(http.MethodGet, path, nil)
+		c.NoError(err)
+
+		router.Router.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	rr := httptest.NewRecorder()
+	router.Metrics.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	var series []string
+
+	for _, line := range strings.Split(rr.Body.String(), "\n") {
+		if strings.HasPrefix(line, "pocket_http_db_requests_total{") {
+			series = append(series, line)
+		}
+	}
+
+	// one series per route template and status, whatever the number of IDs requested
+	c.Equal([]string{
+		`pocket_http_db_requests_total{method="GET",route="/application/{id}",status="200"} 1`,
+		`pocket_http_db_requests_total{method="GET",route="/application/{id}",status="404"} 50`,
+		`pocket_http_db_requests_total{method="GET",route="/blockchain/{id}",status="200"} 1`,
+		`pocket_http_db_requests_total{method="GET",route="/user/{id}/application",status="200"} 1`,
+	}, series)
+	c.NotContains(rr.Body.String(), "5f62b7d8be3591c4dea8566d")
+	c.NotContains(rr.Body.String(), `route="/blockchain/0021"`)
+}
+
+func TestRouter_MetricsHandlerServeMux(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	router.Metrics = metrics.NewRegistry(metrics.DefaultBuckets, 0)
+
+	serveMux := http.NewServeMux()
+	router.Mount(NewServeMux(serveMux))
+
+	for _, id := range []string{"0021", "0022"} {
+		req, err := http.NewRequest(http.MethodGet, "/blockchain/"+id, nil)
+		c.NoError(err)
+
+		serveMux.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	rr := httptest.NewRecorder()
+	router.Metrics.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	c.Contains(rr.Body.String(), `pocket_http_db_requests_total{method="GET",route="/blockchain/{id}",status="200"} 2`)
+	c.NotContains(rr.Body.String(), `route="/blockchain/0022"`)
+}
+
+func TestRouter_EntityChangeMetrics(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	router.Metrics = metrics.NewRegistry(metrics.DefaultBuckets, 0)
+	router.Metrics.SetEntityCounter(router.EntityCounts)
+	router.Metrics.SetCacheStatus(router.CacheStatus)
+
+	writerMock := &writerMock{}
+	writerMock.On("RemoveLoadBalancer", mock.Anything).Return(nil).Once()
+
+	router.Writer = writerMock
+
+	req, err := http.NewRequest(http.MethodPut, "/load_balancer/60ecb2bf67774900350d9c42", strings.NewReader(`{"remove":true}`))
+	c.NoError(err)
+
+	rr := httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusOK, rr.Code)
+
+	rr = httptest.NewRecorder()
+	router.Metrics.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	c.Contains(rr.Body.String(), `pocket_http_db_entity_changes_total{entity="load_balancer",operation="removed"} 1`)
+	c.Contains(rr.Body.String(), `pocket_http_db_entities{entity="application"} 3`)
+	c.Contains(rr.Body.String(), `pocket_http_db_entities{entity="blockchain"} 2`)
+	c.Contains(rr.Body.String(), `pocket_http_db_entities{entity="load_balancer"} 2`)
+	c.Contains(rr.Body.String(), "pocket_http_db_cache_last_refresh_timestamp_seconds ")
+	c.Contains(rr.Body.String(), "pocket_http_db_cache_refresh_errors_total 0")
+
+	writerMock.AssertExpectations(t)
+}
@@ -0,0 +1,228 @@
+package router
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/gorilla/mux"
+)
+
+// Mux represents an HTTP router the handlers can be mounted on, so embedders are not tied to gorilla/mux
+// patterns use {name} path parameters, as gorilla/mux, chi and the Go 1.22 ServeMux do
+// chi is adapted with Handle calling chi.Router.Method and PathParam calling chi.URLParam
+type Mux interface {
+	// Handle registers handler for method requests matching pattern
+	Handle(method, pattern string, handler http.Handler)
+	// PathParam returns the value of the name path parameter of a request routed by the mux
+	PathParam(r *http.Request, name string) string
+}
+
+// route holds a registered route, kept so it can be mounted on other muxes
+type route struct {
+	group   RouteGroup
+	method  string
+	pattern string
+	handler http.HandlerFunc
+}
+
+// routeContextKey is the context key of the routeContext of requests routed by a mounted Mux
+type routeContextKey struct{}
+
+// routeContext holds the route matched by a mounted Mux
+type routeContext struct {
+	mux     Mux
+	pattern string
+}
+
+// register registers handler for method and path on the gorilla router, keeping it to be mounted on other muxes
+func (rt *Router) register(method, path string, handler http.HandlerFunc) {
+	rt.addRoute(route{method: method, pattern: path, handler: handler})
+}
+
+func (rt *Router) addRoute(rte route) {
+	rt.Router.HandleFunc(rte.pattern, rte.handler).Methods(rte.method)
+	rt.routes = append(rt.routes, rte)
+}
+
+// Mount registers all the routes on m, each one wrapped in the middlewares of the gorilla router
+func (rt *Router) Mount(m Mux) {
+	for _, rte := range rt.routes {
+		handler := rt.withMiddlewares(rte.handler)
+		routeCtx := routeContext{mux: m, pattern: rte.pattern}
+
+		m.Handle(rte.method, rte.pattern, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			handler.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), routeContextKey{}, routeCtx)))
+		}))
+	}
+}
+
+// withMiddlewares wraps h in the middlewares, the first one being the outermost
+func (rt *Router) withMiddlewares(h http.Handler) http.Handler {
+	middlewares := rt.middlewares()
+
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		h = middlewares[i](h)
+	}
+
+	return h
+}
+
+// pathParam returns the value of the name path parameter of r, whichever mux routed it
+func pathParam(r *http.Request, name string) string {
+	if routeCtx, ok := r.Context().Value(routeContextKey{}).(routeContext); ok {
+		return routeCtx.mux.PathParam(r, name)
+	}
+
+	return mux.Vars(r)[name]
+}
+
+// routeTemplate returns the pattern of the route matching r, whichever mux routed it
+func routeTemplate(r *http.Request) string {
+	if routeCtx, ok := r.Context().Value(routeContextKey{}).(routeContext); ok {
+		return routeCtx.pattern
+	}
+
+	if route := mux.CurrentRoute(r); route != nil {
+		template, _ := route.GetPathTemplate()
+
+		return template
+	}
+
+	return ""
+}
+
+// GorillaMux adapts a gorilla/mux router
+type GorillaMux struct {
+	Router *mux.Router
+}
+
+// Handle registers handler for method requests matching pattern
+func (g *GorillaMux) Handle(method, pattern string, handler http.Handler) {
+	g.Router.Handle(pattern, handler).Methods(method)
+}
+
+// PathParam returns the value of the name path parameter of r
+func (g *GorillaMux) PathParam(r *http.Request, name string) string {
+	return mux.Vars(r)[name]
+}
+
+// serveMuxParamsKey is the context key of the path parameters matched by ServeMux
+type serveMuxParamsKey struct{}
+
+// serveMuxRoute holds a route registered on ServeMux, with its pattern split in segments
+type serveMuxRoute struct {
+	method   string
+	segments []string
+	handler  http.Handler
+}
+
+// match returns the path parameters of path if it matches the route segments
+func (s serveMuxRoute) match(path []string) (map[string]string, bool) {
+	if len(path) != len(s.segments) {
+		return nil, false
+	}
+
+	params := map[string]string{}
+
+	for i, segment := range s.segments {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			if path[i] == "" {
+				return nil, false
+			}
+
+			params[segment[1:len(segment)-1]] = path[i]
+
+			continue
+		}
+
+		if segment != path[i] {
+			return nil, false
+		}
+	}
+
+	return params, true
+}
+
+// ServeMux adapts a net/http ServeMux, which has no path parameters before Go 1.22
+// each pattern is registered on the ServeMux up to its first parameter and the adapter matches the rest,
+// so a "/" route makes every path unmatched by the ServeMux reach the adapter
+type ServeMux struct {
+	mux        *http.ServeMux
+	routes     []serveMuxRoute
+	registered map[string]bool
+	mutex      sync.RWMutex
+}
+
+// NewServeMux returns ServeMux instance registering routes on mux
+func NewServeMux(mux *http.ServeMux) *ServeMux {
+	return &ServeMux{
+		mux:        mux,
+		registered: map[string]bool{},
+	}
+}
+
+// Handle registers handler for method requests matching pattern
+func (s *ServeMux) Handle(method, pattern string, handler http.Handler) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.routes = append(s.routes, serveMuxRoute{
+		method:   method,
+		segments: strings.Split(pattern, "/"),
+		handler:  handler,
+	})
+
+	key := pattern
+	if i := strings.Index(pattern, "{"); i >= 0 {
+		key = pattern[:i]
+	}
+
+	if s.registered[key] {
+		return
+	}
+
+	s.registered[key] = true
+	s.mux.HandleFunc(key, s.dispatch)
+}
+
+// dispatch serves r with the first route matching its method and path
+func (s *ServeMux) dispatch(w http.ResponseWriter, r *http.Request) {
+	s.mutex.RLock()
+	routes := s.routes
+	s.mutex.RUnlock()
+
+	path := strings.Split(r.URL.Path, "/")
+	methodMismatch := false
+
+	for _, rte := range routes {
+		params, ok := rte.match(path)
+		if !ok {
+			continue
+		}
+
+		if rte.method != r.Method {
+			methodMismatch = true
+			continue
+		}
+
+		rte.handler.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), serveMuxParamsKey{}, params)))
+
+		return
+	}
+
+	if methodMismatch {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	http.NotFound(w, r)
+}
+
+// PathParam returns the value of the name path parameter of r
+func (s *ServeMux) PathParam(r *http.Request, name string) string {
+	params, _ := r.Context().Value(serveMuxParamsKey{}).(map[string]string)
+
+	return params[name]
+}
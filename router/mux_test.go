@@ -0,0 +1,131 @@
+package router
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouter_MountServeMux(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	writerMock := &writerMock{}
+	router.Writer = writerMock
+
+	serveMux := http.NewServeMux()
+	serveMux.HandleFunc("/metrics", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	})
+
+	router.Mount(NewServeMux(serveMux))
+
+	serve := func(method, path string, body []byte) *httptest.ResponseRecorder {
+		req, err := http.NewRequest(method, path, bytes.NewBuffer(body))
+		c.NoError(err)
+
+		rr := httptest.NewRecorder()
+		serveMux.ServeHTTP(rr, req)
+
+		return rr
+	}
+
+	rr := serve(http.MethodGet, "/application/5f62b7d8be3591c4dea8566d", nil)
+	c.Equal(http.StatusOK, rr.Code)
+
+	var app repository.Application
+
+	c.NoError(json.Unmarshal(rr.Body.Bytes(), &app))
+	c.Equal("5f62b7d8be3591c4dea8566d", app.ID)
+
+	rr = serve(http.MethodGet, "/application/limits", nil)
+	c.Equal(http.StatusOK, rr.Code)
+
+	rr = serve(http.MethodGet, "/pay_plan/freetier_v0", nil)
+	c.Equal(http.StatusOK, rr.Code)
+
+	writerMock.On("UpdateApplication", mock.Anything).Return(nil).Once()
+
+	rr = serve(http.MethodPut, "/application/5f62b7d8be3591c4dea8566a", []byte(`{"name":"mounted"}`))
+	c.Equal(http.StatusOK, rr.Code)
+	c.Equal("mounted", router.Cache.GetApplication("5f62b7d8be3591c4dea8566a").Name)
+
+	rr = serve(http.MethodGet, "/", nil)
+	c.Equal(http.StatusOK, rr.Code)
+
+	rr = serve(http.MethodGet, "/metrics", nil)
+	c.Equal(http.StatusTeapot, rr.Code)
+
+	rr = serve(http.MethodPatch, "/application/5f62b7d8be3591c4dea8566d", nil)
+	c.Equal(http.StatusMethodNotAllowed, rr.Code)
+
+	rr = serve(http.MethodGet, "/application/5f62b7d8be3591c4dea8566d/wrong", nil)
+	c.Equal(http.StatusNotFound, rr.Code)
+
+	router.APIKeys = map[string]bool{}
+
+	rr = serve(http.MethodGet, "/application/5f62b7d8be3591c4dea8566d", nil)
+	c.Equal(http.StatusUnauthorized, rr.Code)
+
+	writerMock.AssertExpectations(t)
+}
+
+func TestRouter_MountGorillaMux(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	gorillaMux := mux.NewRouter()
+	router.Mount(&GorillaMux{Router: gorillaMux})
+
+	req, err := http.NewRequest(http.MethodGet, "/load_balancer/60ecb2bf67774900350d9c42", nil)
+	c.NoError(err)
+
+	rr := httptest.NewRecorder()
+	gorillaMux.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusOK, rr.Code)
+
+	var lb repository.LoadBalancer
+
+	c.NoError(json.Unmarshal(rr.Body.Bytes(), &lb))
+	c.Equal("60ecb2bf67774900350d9c42", lb.ID)
+}
+
+func TestRouteTemplate(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	var templates []string
+
+	router.Router.Use(func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			templates = append(templates, routeTemplate(r))
+			h.ServeHTTP(w, r)
+		})
+	})
+
+	req, err := http.NewRequest(http.MethodGet, "/blockchain/0021", nil)
+	c.NoError(err)
+
+	router.Router.ServeHTTP(httptest.NewRecorder(), req)
+
+	c.Equal([]string{"/blockchain/{id}"}, templates)
+	c.Empty(routeTemplate(req))
+
+	req = req.WithContext(context.WithValue(req.Context(), routeContextKey{}, routeContext{pattern: "/blockchain/{id}"}))
+	c.Equal("/blockchain/{id}", routeTemplate(req))
+}
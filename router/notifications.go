@@ -0,0 +1,35 @@
+package router
+
+import (
+	"fmt"
+	"net/http"
+
+	jsonresponse "github.com/pokt-foundation/utils-go/json-response"
+)
+
+// evaluateNotificationsInput struct holding the relay usage of an application to evaluate
+type evaluateNotificationsInput struct {
+	Usage int64 `json:"usage"`
+}
+
+// EvaluateApplicationNotifications returns the notification thresholds crossed by the usage in the body
+func (rt *Router) EvaluateApplicationNotifications(w http.ResponseWriter, r *http.Request) {
+	var input evaluateNotificationsInput
+
+	err := decodeBody(r, &input)
+	if err != nil {
+		rt.logError(fmt.Errorf("EvaluateApplicationNotifications decode failed: %w", err))
+		respondWithBodyError(w, err)
+		return
+	}
+
+	defer r.Body.Close()
+
+	evaluation, err := rt.applications().EvaluateNotifications(pathParam(r, "id"), input.Usage)
+	if err != nil {
+		rt.respondWithServiceError(w, "EvaluateApplicationNotifications", err)
+		return
+	}
+
+	jsonresponse.RespondWithJSON(w, http.StatusOK, evaluation)
+}
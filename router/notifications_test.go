@@ -0,0 +1,68 @@
+package router
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/pokt-foundation/pocket-http-db/service"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouter_EvaluateApplicationNotifications(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	serve := func(id, body string) *httptest.ResponseRecorder {
+		req, err := http.NewRequest(http.MethodPost, "/application/"+id+"/notifications/evaluate", strings.NewReader(body))
+		c.NoError(err)
+
+		rr := httptest.NewRecorder()
+
+		router.Router.ServeHTTP(rr, req)
+
+		return rr
+	}
+
+	rr := serve("5f62b7d8be3591c4dea8566d", `{"usage":125000}`)
+	c.Equal(http.StatusOK, rr.Code)
+
+	var evaluation service.NotificationEvaluation
+	c.NoError(json.Unmarshal(rr.Body.Bytes(), &evaluation))
+	c.Equal(250000, evaluation.DailyLimit)
+	c.Equal([]service.NotificationThreshold{service.ThresholdQuarter, service.ThresholdHalf}, evaluation.Crossed)
+	c.Empty(evaluation.Notify)
+
+	c.Equal(http.StatusBadRequest, serve("5f62b7d8be3591c4dea8566d", `{"usage":-1}`).Code)
+	c.Equal(http.StatusBadRequest, serve("5f62b7d8be3591c4dea8566d", `{`).Code)
+	c.Equal(http.StatusNotFound, serve("wrong", `{"usage":1}`).Code)
+}
+
+func TestRouter_CreateApplication_InvalidContactEmail(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	req, err := http.NewRequest(http.MethodPost, "/application",
+		strings.NewReader(`{"userID":"60ddc61b6e29c3003378361D","contactEmail":"owner@"}`))
+	c.NoError(err)
+
+	rr := httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusUnprocessableEntity, rr.Code)
+
+	var body struct {
+		Code    string            `json:"code"`
+		Details map[string]string `json:"details"`
+	}
+	c.NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+	c.Equal("unprocessable_entity", body.Code)
+	c.Equal(map[string]string{"contactEmail": "is not a valid email address"}, body.Details)
+}
@@ -0,0 +1,78 @@
+package router
+
+import (
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// openAPIPath is the path the OpenAPI document is served on
+const openAPIPath = "/openapi.json"
+
+// openAPIDocument describes every registered route, kept in sync by CheckOpenAPI
+//
+//go:embed openapi.json
+var openAPIDocument []byte
+
+// ErrOpenAPIDrift is returned when the registered routes and the OpenAPI document describe different endpoints
+var ErrOpenAPIDrift = errors.New("routes and OpenAPI document differ")
+
+// GetOpenAPI serves the OpenAPI document of the registered routes
+func (rt *Router) GetOpenAPI(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	_, err := w.Write(openAPIDocument)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// CheckOpenAPI compares the registered routes against the operations of the OpenAPI document
+// returns ErrOpenAPIDrift listing the routes left undocumented and the operations of no route
+func (rt *Router) CheckOpenAPI() error {
+	var document struct {
+		Paths map[string]map[string]json.RawMessage `json:"paths"`
+	}
+
+	err := json.Unmarshal(openAPIDocument, &document)
+	if err != nil {
+		return fmt.Errorf("%w: invalid document: %s", ErrOpenAPIDrift, err)
+	}
+
+	documented := map[string]bool{}
+	for path, operations := range document.Paths {
+		for method := range operations {
+			documented[strings.ToUpper(method)+" "+path] = true
+		}
+	}
+
+	var undocumented []string
+
+	for _, rte := range rt.routes {
+		operation := rte.method + " " + rte.pattern
+		if !documented[operation] {
+			undocumented = append(undocumented, operation)
+		}
+
+		delete(documented, operation)
+	}
+
+	unregistered := make([]string, 0, len(documented))
+	for operation := range documented {
+		unregistered = append(unregistered, operation)
+	}
+
+	if len(undocumented) == 0 && len(unregistered) == 0 {
+		return nil
+	}
+
+	sort.Strings(undocumented)
+	sort.Strings(unregistered)
+
+	return fmt.Errorf("%w: undocumented routes [%s], operations without route [%s]", ErrOpenAPIDrift,
+		strings.Join(undocumented, ", "), strings.Join(unregistered, ", "))
+}
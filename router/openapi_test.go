@@ -0,0 +1,49 @@
+package router
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouter_CheckOpenAPI(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	// every route added to NewRouter must be described in openapi.json
+	c.NoError(router.CheckOpenAPI())
+
+	router.register(http.MethodGet, "/undocumented", router.HealthCheck)
+	router.routes = router.routes[1:]
+
+	err = router.CheckOpenAPI()
+	c.ErrorIs(err, ErrOpenAPIDrift)
+	c.Contains(err.Error(), "undocumented routes [GET /undocumented]")
+	c.Contains(err.Error(), "operations without route [GET /]")
+}
+
+func TestRouter_GetOpenAPI(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	req, err := http.NewRequest(http.MethodGet, openAPIPath, nil)
+	c.NoError(err)
+
+	rr := httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusOK, rr.Code)
+	c.Equal("application/json", rr.Header().Get("Content-Type"))
+
+	var document map[string]interface{}
+	c.NoError(json.Unmarshal(rr.Body.Bytes(), &document))
+	c.Equal("3.0.3", document["openapi"])
+}
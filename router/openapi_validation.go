@@ -0,0 +1,365 @@
+package router
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// openAPISchema is the subset of the JSON schemas of the OpenAPI document requests are validated against
+type openAPISchema struct {
+	Ref                  string                    `json:"$ref"`
+	Type                 string                    `json:"type"`
+	Enum                 []any                     `json:"enum"`
+	Required             []string                  `json:"required"`
+	Properties           map[string]*openAPISchema `json:"properties"`
+	AdditionalProperties *openAPISchema            `json:"additionalProperties"`
+	Items                *openAPISchema            `json:"items"`
+	MinItems             *int                      `json:"minItems"`
+	MaxItems             *int                      `json:"maxItems"`
+	MinLength            *int                      `json:"minLength"`
+}
+
+type openAPIParameter struct {
+	Name     string         `json:"name"`
+	In       string         `json:"in"`
+	Required bool           `json:"required"`
+	Schema   *openAPISchema `json:"schema"`
+}
+
+type openAPIOperation struct {
+	Parameters  []openAPIParameter `json:"parameters"`
+	RequestBody *struct {
+		Required bool `json:"required"`
+		Content  map[string]struct {
+			Schema *openAPISchema `json:"schema"`
+		} `json:"content"`
+	} `json:"requestBody"`
+}
+
+// openAPIContract holds the operations and schemas of the OpenAPI document, parsed once
+type openAPIContract struct {
+	operations map[string]*openAPIOperation
+	schemas    map[string]*openAPISchema
+}
+
+var (
+	contract     *openAPIContract
+	contractErr  error
+	contractOnce sync.Once
+)
+
+// loadOpenAPIContract parses the operations of the OpenAPI document by "METHOD path template"
+func loadOpenAPIContract() (*openAPIContract, error) {
+	contractOnce.Do(func() {
+		var document struct {
+			Paths      map[string]map[string]*openAPIOperation `json:"paths"`
+			Components struct {
+				Schemas map[string]*openAPISchema `json:"schemas"`
+			} `json:"components"`
+		}
+
+		contractErr = json.Unmarshal(openAPIDocument, &document)
+		if contractErr != nil {
+			return
+		}
+
+		contract = &openAPIContract{
+			operations: map[string]*openAPIOperation{},
+			schemas:    document.Components.Schemas,
+		}
+
+		for path, operations := range document.Paths {
+			for method, operation := range operations {
+				contract.operations[strings.ToUpper(method)+" "+path] = operation
+			}
+		}
+	})
+
+	return contract, contractErr
+}
+
+// RequestValidationHandler rejects the requests whose parameters or JSON body do not follow the schemas of their
+// operation in the OpenAPI document, so the enforced payloads can't drift from the published contract
+// it is enabled by ValidateRequests, the parameters and fields the document does not describe are left to the handlers
+func (rt *Router) RequestValidationHandler(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !rt.ValidateRequests {
+			h.ServeHTTP(w, r)
+
+			return
+		}
+
+		contract, err := loadOpenAPIContract()
+		if err != nil {
+			rt.logError(fmt.Errorf("loading OpenAPI contract failed: %w", err))
+			h.ServeHTTP(w, r)
+
+			return
+		}
+
+		operation, ok := contract.operations[r.Method+" "+routeTemplate(r)]
+		if !ok {
+			h.ServeHTTP(w, r)
+
+			return
+		}
+
+		details := map[string]string{}
+
+		for _, param := range operation.Parameters {
+			contract.validateParameter(details, r, param)
+		}
+
+		if operation.RequestBody != nil {
+			err = contract.validateBody(details, r, operation)
+			if err != nil {
+				respondWithError(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		if len(details) > 0 {
+			respondWithBodyError(w, &bodyError{Details: details})
+			return
+		}
+
+		h.ServeHTTP(w, r)
+	})
+}
+
+func (c *openAPIContract) validateParameter(details map[string]string, r *http.Request, param openAPIParameter) {
+	var (
+		value   string
+		present bool
+	)
+
+	switch param.In {
+	case "path":
+		value = pathParam(r, param.Name)
+		present = value != ""
+	case "query":
+		present = r.URL.Query().Has(param.Name)
+		value = r.URL.Query().Get(param.Name)
+	case "header":
+		value = r.Header.Get(param.Name)
+		present = value != ""
+	default:
+		return
+	}
+
+	path := param.In + "." + param.Name
+
+	if !present {
+		if param.Required {
+			details[path] = "required"
+		}
+
+		return
+	}
+
+	schema := c.resolve(param.Schema)
+	if schema == nil {
+		return
+	}
+
+	var parsed any = value
+
+	switch schema.Type {
+	case "integer":
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			details[path] = "must be an integer"
+			return
+		}
+
+		parsed = json.Number(strconv.FormatInt(n, 10))
+	case "number":
+		_, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			details[path] = "must be a number"
+			return
+		}
+
+		parsed = json.Number(value)
+	case "boolean":
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			details[path] = "must be a boolean"
+			return
+		}
+
+		parsed = b
+	}
+
+	c.validate(details, path, parsed, schema)
+}
+
+// validateBody validates the JSON body of r against the schema of operation, leaving the body to be read again
+// returns an error if the body is not JSON
+func (c *openAPIContract) validateBody(details map[string]string, r *http.Request, operation *openAPIOperation) error {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return err
+	}
+
+	r.Body.Close()
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	if len(bytes.TrimSpace(body)) == 0 {
+		if operation.RequestBody.Required {
+			details["body"] = "required"
+		}
+
+		return nil
+	}
+
+	media, ok := operation.RequestBody.Content["application/json"]
+	if !ok || media.Schema == nil {
+		return nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+
+	var value any
+
+	err = decoder.Decode(&value)
+	if err != nil {
+		return fmt.Errorf("%w: %s", errInvalidBody, err)
+	}
+
+	c.validate(details, "", value, c.resolve(media.Schema))
+
+	return nil
+}
+
+// resolve returns the schema referenced by schema, or schema itself if it is not a reference
+func (c *openAPIContract) resolve(schema *openAPISchema) *openAPISchema {
+	if schema == nil || schema.Ref == "" {
+		return schema
+	}
+
+	return c.schemas[strings.TrimPrefix(schema.Ref, "#/components/schemas/")]
+}
+
+// validate adds to details the reasons value at path does not follow schema, by field path as decodeBody reports them
+// the reasons of the whole body are reported as body
+func (c *openAPIContract) validate(details map[string]string, path string, value any, schema *openAPISchema) {
+	schema = c.resolve(schema)
+	if schema == nil {
+		return
+	}
+
+	key := path
+	if key == "" {
+		key = "body"
+	}
+
+	if !matchesType(value, schema.Type) {
+		details[key] = "must be " + schemaKind(schema.Type)
+		return
+	}
+
+	if len(schema.Enum) > 0 && !inEnum(value, schema.Enum) {
+		details[key] = fmt.Sprintf("must be one of %v", schema.Enum)
+		return
+	}
+
+	switch typed := value.(type) {
+	case string:
+		if schema.MinLength != nil && len(typed) < *schema.MinLength {
+			details[key] = fmt.Sprintf("must have at least %d characters", *schema.MinLength)
+			if *schema.MinLength == 1 {
+				details[key] = "must not be empty"
+			}
+		}
+	case []any:
+		if schema.MinItems != nil && len(typed) < *schema.MinItems {
+			details[key] = fmt.Sprintf("must have at least %d items", *schema.MinItems)
+			return
+		}
+
+		if schema.MaxItems != nil && len(typed) > *schema.MaxItems {
+			details[key] = fmt.Sprintf("must have at most %d items", *schema.MaxItems)
+			return
+		}
+
+		for i, item := range typed {
+			c.validate(details, fmt.Sprintf("%s[%d]", path, i), item, schema.Items)
+		}
+	case map[string]any:
+		for _, name := range schema.Required {
+			if _, ok := typed[name]; !ok {
+				details[joinPath(path, name)] = "required"
+			}
+		}
+
+		for name, field := range typed {
+			fieldSchema, ok := schema.Properties[name]
+			if !ok {
+				fieldSchema = schema.AdditionalProperties
+			}
+
+			c.validate(details, joinPath(path, name), field, fieldSchema)
+		}
+	}
+}
+
+// matchesType returns true if the JSON value decoded with numbers is of the schema type, any type if blank
+func matchesType(value any, schemaType string) bool {
+	switch schemaType {
+	case "":
+		return true
+	case "object":
+		_, ok := value.(map[string]any)
+		return ok
+	case "array":
+		_, ok := value.([]any)
+		return ok
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "number":
+		_, ok := value.(json.Number)
+		return ok
+	case "integer":
+		n, ok := value.(json.Number)
+		if !ok {
+			return false
+		}
+
+		_, err := n.Int64()
+
+		return err == nil
+	default:
+		return true
+	}
+}
+
+func schemaKind(schemaType string) string {
+	switch schemaType {
+	case "object", "array", "integer":
+		return "an " + schemaType
+	default:
+		return "a " + schemaType
+	}
+}
+
+func inEnum(value any, enum []any) bool {
+	for _, allowed := range enum {
+		if fmt.Sprint(allowed) == fmt.Sprint(value) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,74 @@
+package router
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouter_RequestValidationHandler(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	serve := func(method, path, body string) *httptest.ResponseRecorder {
+		req, err := http.NewRequest(method, path, bytes.NewBufferString(body))
+		c.NoError(err)
+
+		rr := httptest.NewRecorder()
+		router.Router.ServeHTTP(rr, req)
+
+		return rr
+	}
+
+	// disabled by default, the handler reports the body error by itself
+	rr := serve(http.MethodPut, "/load_balancer/60ecb2bf67774900350d9c42/applications", `{"applicationIDs": [1]}`)
+	c.Equal(http.StatusBadRequest, rr.Code)
+
+	router.ValidateRequests = true
+
+	rr = serve(http.MethodPut, "/load_balancer/60ecb2bf67774900350d9c42/applications", `{"applicationIDs": [1, ""]}`)
+	c.Equal(http.StatusBadRequest, rr.Code)
+
+	var response struct {
+		Details map[string]string `json:"details"`
+	}
+
+	c.NoError(json.Unmarshal(rr.Body.Bytes(), &response))
+	c.Equal(map[string]string{
+		"applicationIDs[0]": "must be a string",
+		"applicationIDs[1]": "must not be empty",
+		"version":           "required",
+	}, response.Details)
+
+	rr = serve(http.MethodPost, "/application/batch", `{"ids": []}`)
+	c.Equal(http.StatusBadRequest, rr.Code)
+	c.Contains(rr.Body.String(), `"body":"must be an array"`)
+
+	rr = serve(http.MethodPost, "/application/batch", "["+strings.Repeat(`"5f62b7d8be3591c4dea8566d",`, 1000)+`"5f62b7d8be3591c4dea8566d"]`)
+	c.Equal(http.StatusBadRequest, rr.Code)
+	c.Contains(rr.Body.String(), "must have at most 1000 items")
+
+	rr = serve(http.MethodPost, "/application/batch", `["5f62b7d8be3591c4dea8566d"`)
+	c.Equal(http.StatusBadRequest, rr.Code)
+
+	rr = serve(http.MethodPost, "/application/batch", `["5f62b7d8be3591c4dea8566d"]`)
+	c.Equal(http.StatusOK, rr.Code)
+
+	rr = serve(http.MethodGet, "/application?limit=ten", "")
+	c.Equal(http.StatusBadRequest, rr.Code)
+	c.Contains(rr.Body.String(), `"query.limit":"must be an integer"`)
+
+	rr = serve(http.MethodGet, "/graphql", "")
+	c.Equal(http.StatusBadRequest, rr.Code)
+	c.Contains(rr.Body.String(), `"query.query":"required"`)
+
+	rr = serve(http.MethodGet, "/application?limit=2", "")
+	c.Equal(http.StatusOK, rr.Code)
+}
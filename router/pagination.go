@@ -0,0 +1,125 @@
+package router
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/pokt-foundation/pocket-http-db/service"
+)
+
+const (
+	// NextCursorHeader holds the cursor of the next page of a paginated list, it is not set on the last page
+	NextCursorHeader = "X-Next-Cursor"
+	// TotalCountHeader holds the number of entities of a paginated list, across all its pages
+	TotalCountHeader = "X-Total-Count"
+)
+
+// defaultPerPage is the size of numbered pages when perPage is not set
+const defaultPerPage = 100
+
+// positiveParam returns the value of the query parameter name of r as a positive number, 0 if it is not set
+func positiveParam(r *http.Request, name string) (int, error) {
+	rawValue := r.URL.Query().Get(name)
+	if rawValue == "" {
+		return 0, nil
+	}
+
+	value, err := strconv.Atoi(rawValue)
+	if err != nil || value <= 0 {
+		return 0, fmt.Errorf("invalid %s", name)
+	}
+
+	return value, nil
+}
+
+// paginate sorts entities by the sort parameter of r and keeps the limit entities after its after cursor,
+// setting the cursor of the next page, entities keep their cache order if none of the parameters is set
+// page and perPage select numbered pages of the sorted entities instead, they cannot be combined with after nor limit
+// paginated lists set the total number of entities in TotalCountHeader
+// cursors are bound to the cache generation, next pages of a refreshed cache are served from the snapshots held
+// by rt.PageSnapshots or answered with gone so the client restarts
+// returns false after responding with the error if a parameter is invalid
+func paginate[T any](rt *Router, w http.ResponseWriter, r *http.Request, parse func(string) (*service.Ordering[T], error), entities []T) ([]T, bool) {
+	query := r.URL.Query()
+
+	rawSort, rawCursor, rawLimit := query.Get("sort"), query.Get("after"), query.Get("limit")
+	rawPage, rawPerPage := query.Get("page"), query.Get("perPage")
+
+	if rawSort == "" && rawCursor == "" && rawLimit == "" && rawPage == "" && rawPerPage == "" {
+		return entities, true
+	}
+
+	numbered := rawPage != "" || rawPerPage != ""
+	if numbered && (rawCursor != "" || rawLimit != "") {
+		respondWithError(w, http.StatusBadRequest, "page and perPage cannot be combined with after nor limit")
+		return nil, false
+	}
+
+	limit, err := positiveParam(r, "limit")
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return nil, false
+	}
+
+	ordering, err := parse(rawSort)
+	if err != nil {
+		respondWithError(w, serviceErrorStatus(err), err.Error())
+		return nil, false
+	}
+
+	w.Header().Set(TotalCountHeader, strconv.Itoa(len(entities)))
+
+	if numbered {
+		return numberedPage(w, r, ordering, entities)
+	}
+
+	scope := r.URL.Query()
+	scope.Del("after")
+	scope.Del("limit")
+
+	generation, refreshedAt := rt.Cache.Generation()
+
+	ordering.Scope = r.URL.Path + "?" + scope.Encode()
+	ordering.Generation = fmt.Sprintf("%d.%d", generation, refreshedAt.UnixNano())
+	ordering.Snapshots = rt.PageSnapshots
+
+	page, nextCursor, err := ordering.Page(entities, rawCursor, limit)
+	if err != nil {
+		respondWithError(w, serviceErrorStatus(err), err.Error())
+		return nil, false
+	}
+
+	if nextCursor != "" {
+		w.Header().Set(NextCursorHeader, nextCursor)
+	}
+
+	return page, true
+}
+
+// numberedPage returns the page of the sorted entities numbered by the page parameter of r, the first one if not set,
+// holding perPage entities, defaultPerPage if not set
+// returns false after responding with the error if a parameter is invalid
+func numberedPage[T any](w http.ResponseWriter, r *http.Request, ordering *service.Ordering[T], entities []T) ([]T, bool) {
+	page, err := positiveParam(r, "page")
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return nil, false
+	}
+
+	perPage, err := positiveParam(r, "perPage")
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return nil, false
+	}
+
+	if page == 0 {
+		page = 1
+	}
+
+	if perPage == 0 {
+		perPage = defaultPerPage
+	}
+
+	return ordering.Offset(entities, (page-1)*perPage, perPage), true
+}
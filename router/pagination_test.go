@@ -0,0 +1,165 @@
+package router
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/pokt-foundation/pocket-http-db/service"
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouter_Pagination(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	serve := func(path string) *httptest.ResponseRecorder {
+		req, err := http.NewRequest(http.MethodGet, path, nil)
+		c.NoError(err)
+
+		rr := httptest.NewRecorder()
+
+		router.Router.ServeHTTP(rr, req)
+
+		return rr
+	}
+
+	rr := serve("/application?sort=-plan&limit=2")
+	c.Equal(http.StatusOK, rr.Code)
+
+	var apps []*repository.Application
+	c.NoError(json.Unmarshal(rr.Body.Bytes(), &apps))
+	c.Len(apps, 2)
+	c.Equal("5f62b7d8be3591c4dea8566d", apps[0].ID)
+	c.Equal("5f62b7d8be3591c4dea8566a", apps[1].ID)
+
+	cursor := rr.Header().Get(NextCursorHeader)
+	c.NotEmpty(cursor)
+
+	rr = serve("/application?sort=-plan&limit=2&after=" + cursor)
+	c.Equal(http.StatusOK, rr.Code)
+	c.Empty(rr.Header().Get(NextCursorHeader))
+
+	apps = nil
+	c.NoError(json.Unmarshal(rr.Body.Bytes(), &apps))
+	c.Len(apps, 1)
+	c.Equal("5f62b7d8be3591c4dea8566f", apps[0].ID)
+
+	rr = serve("/blockchain?sort=-id")
+	c.Equal(http.StatusOK, rr.Code)
+
+	var blockchains []*repository.Blockchain
+	c.NoError(json.Unmarshal(rr.Body.Bytes(), &blockchains))
+	c.Equal("0022", blockchains[0].ID)
+	c.Equal("0021", blockchains[1].ID)
+
+	rr = serve("/user/60ecb2bf67774900350d9c43/load_balancer?limit=1")
+	c.Equal(http.StatusOK, rr.Code)
+	c.Empty(rr.Header().Get(NextCursorHeader))
+
+	c.Equal(http.StatusBadRequest, serve("/application?sort=contactEmail").Code)
+	c.Equal(http.StatusBadRequest, serve("/application?limit=0").Code)
+	c.Equal(http.StatusBadRequest, serve("/load_balancer?sort=name&after="+cursor).Code)
+}
+
+func TestRouter_NumberedPages(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	serve := func(path string) *httptest.ResponseRecorder {
+		req, err := http.NewRequest(http.MethodGet, path, nil)
+		c.NoError(err)
+
+		rr := httptest.NewRecorder()
+
+		router.Router.ServeHTTP(rr, req)
+
+		return rr
+	}
+
+	rr := serve("/application?page=2&perPage=2")
+	c.Equal(http.StatusOK, rr.Code)
+	c.Equal("3", rr.Header().Get(TotalCountHeader))
+	c.Empty(rr.Header().Get(NextCursorHeader))
+
+	var apps []*repository.Application
+	c.NoError(json.Unmarshal(rr.Body.Bytes(), &apps))
+	c.Len(apps, 1)
+	c.Equal("5f62b7d8be3591c4dea8566f", apps[0].ID)
+
+	rr = serve("/application?page=3&perPage=2")
+	c.Equal(http.StatusOK, rr.Code)
+	c.Equal("[]", rr.Body.String())
+
+	// cursor pages carry the total too, full lists do not
+	rr = serve("/load_balancer?limit=1")
+	c.Equal(http.StatusOK, rr.Code)
+	c.NotEmpty(rr.Header().Get(TotalCountHeader))
+
+	c.Empty(serve("/load_balancer").Header().Get(TotalCountHeader))
+
+	rr = serve("/application/limits?sort=-id&perPage=1")
+	c.Equal(http.StatusOK, rr.Code)
+	c.Equal("3", rr.Header().Get(TotalCountHeader))
+
+	var limits []repository.AppLimits
+	c.NoError(json.Unmarshal(rr.Body.Bytes(), &limits))
+	c.Len(limits, 1)
+	c.Equal("5f62b7d8be3591c4dea8566f", limits[0].AppID)
+
+	c.Equal(http.StatusBadRequest, serve("/application?page=0").Code)
+	c.Equal(http.StatusBadRequest, serve("/application?perPage=x").Code)
+	c.Equal(http.StatusBadRequest, serve("/application?page=1&limit=2").Code)
+	c.Equal(http.StatusBadRequest, serve("/application/limits?sort=dailyLimit").Code)
+}
+
+func TestRouter_PaginationSnapshots(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	serve := func(path string) *httptest.ResponseRecorder {
+		req, err := http.NewRequest(http.MethodGet, path, nil)
+		c.NoError(err)
+
+		rr := httptest.NewRecorder()
+
+		router.Router.ServeHTTP(rr, req)
+
+		return rr
+	}
+
+	cursor := serve("/application?limit=1").Header().Get(NextCursorHeader)
+	c.NotEmpty(cursor)
+
+	// a cursor of another list is rejected
+	c.Equal(http.StatusBadRequest, serve("/application?status=IN_SERVICE&limit=1&after="+cursor).Code)
+
+	c.NoError(router.Cache.SetCache())
+
+	rr := serve("/application?limit=1&after=" + cursor)
+	c.Equal(http.StatusGone, rr.Code)
+	c.Contains(rr.Body.String(), `"code":"gone"`)
+
+	router.PageSnapshots = service.NewPageSnapshots(time.Minute, 0)
+
+	cursor = serve("/application?limit=1").Header().Get(NextCursorHeader)
+
+	c.NoError(router.Cache.SetCache())
+
+	rr = serve("/application?limit=1&after=" + cursor)
+	c.Equal(http.StatusOK, rr.Code)
+
+	var apps []*repository.Application
+	c.NoError(json.Unmarshal(rr.Body.Bytes(), &apps))
+	c.Len(apps, 1)
+	c.Equal("5f62b7d8be3591c4dea8566d", apps[0].ID)
+}
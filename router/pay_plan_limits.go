@@ -0,0 +1,43 @@
+package router
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/pokt-foundation/portal-api-go/repository"
+	jsonresponse "github.com/pokt-foundation/utils-go/json-response"
+)
+
+// updatePayPlan struct holding the input of a pay plan update
+type updatePayPlan struct {
+	DailyLimit *int `json:"dailyLimit"`
+	// Propagate applies the limit right away to the cached applications of the plan
+	Propagate bool `json:"propagate"`
+}
+
+// UpdatePayPlan sets the daily limit of a pay plan, applied to its applications right away if propagate is set
+func (rt *Router) UpdatePayPlan(w http.ResponseWriter, r *http.Request) {
+	var input updatePayPlan
+
+	err := decodeBody(r, &input)
+	if err != nil {
+		rt.logError(fmt.Errorf("UpdatePayPlan decode failed: %w", err))
+		respondWithBodyError(w, err)
+		return
+	}
+
+	defer r.Body.Close()
+
+	if input.DailyLimit == nil {
+		respondWithError(w, http.StatusBadRequest, "dailyLimit is required")
+		return
+	}
+
+	update, err := rt.applications().UpdatePayPlanLimit(r.Context(), repository.PayPlanType(pathParam(r, "type")), *input.DailyLimit, input.Propagate)
+	if err != nil {
+		rt.respondWithServiceError(w, "UpdatePayPlan", err)
+		return
+	}
+
+	jsonresponse.RespondWithJSON(w, http.StatusOK, update)
+}
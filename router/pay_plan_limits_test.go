@@ -0,0 +1,66 @@
+package router
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/pokt-foundation/pocket-http-db/accesslog"
+	"github.com/pokt-foundation/pocket-http-db/service"
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouter_UpdatePayPlan(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	writerMock := &writerMock{}
+	router.Writer = writerMock
+
+	router.KeyScopes = map[string]map[string]bool{accesslog.KeyID(""): {ScopeAdmin: true}}
+
+	updatePayPlan := func(planType, body string) *httptest.ResponseRecorder {
+		req, err := http.NewRequest(http.MethodPut, "/pay_plan/"+planType, strings.NewReader(body))
+		c.NoError(err)
+
+		rr := httptest.NewRecorder()
+
+		router.Router.ServeHTTP(rr, req)
+
+		return rr
+	}
+
+	writerMock.On("UpdatePayPlanDailyLimit", repository.FreetierV0, 500000).Return(nil).Once()
+
+	rr := updatePayPlan("FREETIER_V0", `{"dailyLimit":500000,"propagate":true}`)
+	c.Equal(http.StatusOK, rr.Code)
+
+	var update service.PayPlanLimitUpdate
+	c.NoError(json.Unmarshal(rr.Body.Bytes(), &update))
+	c.Equal(500000, update.PayPlan.DailyLimit)
+	c.True(update.Propagated)
+	c.Equal([]string{"5f62b7d8be3591c4dea8566d"}, update.ApplicationIDs)
+	c.Equal(500000, router.Cache.GetApplication("5f62b7d8be3591c4dea8566d").Limits.DailyLimit)
+
+	rr = updatePayPlan("FREETIER_V0", `{"propagate":true}`)
+	c.Equal(http.StatusBadRequest, rr.Code)
+
+	rr = updatePayPlan("FREETIER_V0", `{"dailyLimit":-1}`)
+	c.Equal(http.StatusBadRequest, rr.Code)
+
+	rr = updatePayPlan("WRONG_V0", `{"dailyLimit":1}`)
+	c.Equal(http.StatusNotFound, rr.Code)
+
+	// pay plans are only managed by admin scoped keys
+	router.KeyScopes = nil
+
+	rr = updatePayPlan("FREETIER_V0", `{"dailyLimit":1}`)
+	c.Equal(http.StatusForbidden, rr.Code)
+
+	writerMock.AssertExpectations(t)
+}
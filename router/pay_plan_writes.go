@@ -0,0 +1,59 @@
+package router
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/pokt-foundation/portal-api-go/repository"
+	jsonresponse "github.com/pokt-foundation/utils-go/json-response"
+)
+
+// ScopeAdmin is the scope of the API keys allowed to manage the pay plans and to use the admin routes
+const ScopeAdmin = "admin"
+
+var errAdminScopeRequired = fmt.Errorf("requires an API key with the %s scope", ScopeAdmin)
+
+// adminScoped serves handler to the API keys with the ScopeAdmin scope only, the others are forbidden
+func (rt *Router) adminScoped(handler http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !rt.hasScope(r, ScopeAdmin) {
+			respondWithError(w, http.StatusForbidden, errAdminScopeRequired.Error())
+			return
+		}
+
+		handler(w, r)
+	}
+}
+
+// CreatePayPlan creates a pay plan, which the cache serves right away
+func (rt *Router) CreatePayPlan(w http.ResponseWriter, r *http.Request) {
+	var plan repository.PayPlan
+
+	err := decodeBody(r, &plan)
+	if err != nil {
+		rt.logError(fmt.Errorf("CreatePayPlan decode failed: %w", err))
+		respondWithBodyError(w, err)
+		return
+	}
+
+	defer r.Body.Close()
+
+	saved, err := rt.applications().CreatePayPlan(r.Context(), &plan)
+	if err != nil {
+		rt.respondWithServiceError(w, "CreatePayPlan", err)
+		return
+	}
+
+	jsonresponse.RespondWithJSON(w, http.StatusOK, saved)
+}
+
+// RemovePayPlan permanently removes a pay plan no application has and responds it
+func (rt *Router) RemovePayPlan(w http.ResponseWriter, r *http.Request) {
+	plan, err := rt.applications().RemovePayPlan(r.Context(), repository.PayPlanType(pathParam(r, "type")))
+	if err != nil {
+		rt.respondWithServiceError(w, "RemovePayPlan", err)
+		return
+	}
+
+	jsonresponse.RespondWithJSON(w, http.StatusOK, plan)
+}
@@ -0,0 +1,85 @@
+package router
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/lib/pq"
+	"github.com/pokt-foundation/pocket-http-db/accesslog"
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouter_CreateRemovePayPlan(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	writerMock := &writerMock{}
+	router.Writer = writerMock
+
+	send := func(method, path, body string) *httptest.ResponseRecorder {
+		req, err := http.NewRequest(method, path, strings.NewReader(body))
+		c.NoError(err)
+
+		rr := httptest.NewRecorder()
+
+		router.Router.ServeHTTP(rr, req)
+
+		return rr
+	}
+
+	rr := send(http.MethodPost, "/pay_plan", `{"planType":"enterprise-v0","dailyLimit":1000000}`)
+	c.Equal(http.StatusForbidden, rr.Code)
+
+	rr = send(http.MethodDelete, "/pay_plan/PAY_AS_YOU_GO_V0", "")
+	c.Equal(http.StatusForbidden, rr.Code)
+
+	router.KeyScopes = map[string]map[string]bool{accesslog.KeyID(""): {ScopeAdmin: true}}
+
+	expectedPlan := &repository.PayPlan{PlanType: "ENTERPRISE_V0", DailyLimit: 1000000}
+
+	writerMock.On("WritePayPlan", expectedPlan).Return(nil).Once()
+
+	rr = send(http.MethodPost, "/pay_plan", `{"planType":"enterprise-v0","dailyLimit":1000000}`)
+	c.Equal(http.StatusOK, rr.Code)
+
+	var plan repository.PayPlan
+	c.NoError(json.Unmarshal(rr.Body.Bytes(), &plan))
+	c.Equal(expectedPlan, &plan)
+	c.Equal(expectedPlan, router.Cache.GetPayPlan("ENTERPRISE_V0"))
+
+	rr = send(http.MethodPost, "/pay_plan", `{"planType":"ENTERPRISE_V0","dailyLimit":1}`)
+	c.Equal(http.StatusConflict, rr.Code)
+
+	// a plan written by another instance since the cache refresh is rejected by the unique plan type
+	writerMock.On("WritePayPlan", &repository.PayPlan{PlanType: "PRO_V0", DailyLimit: 1}).
+		Return(&pq.Error{Code: uniqueViolationCode}).Once()
+
+	rr = send(http.MethodPost, "/pay_plan", `{"planType":"PRO_V0","dailyLimit":1}`)
+	c.Equal(http.StatusConflict, rr.Code)
+
+	rr = send(http.MethodPost, "/pay_plan", `{"planType":"","dailyLimit":1}`)
+	c.Equal(http.StatusBadRequest, rr.Code)
+
+	rr = send(http.MethodPost, "/pay_plan", `{"planType":"BASIC_V0","dailyLimit":-1}`)
+	c.Equal(http.StatusBadRequest, rr.Code)
+
+	rr = send(http.MethodDelete, "/pay_plan/FREETIER_V0", "")
+	c.Equal(http.StatusConflict, rr.Code)
+
+	writerMock.On("RemovePayPlan", repository.PayPlanType("ENTERPRISE_V0")).Return(nil).Once()
+
+	rr = send(http.MethodDelete, "/pay_plan/ENTERPRISE_V0", "")
+	c.Equal(http.StatusOK, rr.Code)
+	c.Nil(router.Cache.GetPayPlan("ENTERPRISE_V0"))
+
+	rr = send(http.MethodDelete, "/pay_plan/ENTERPRISE_V0", "")
+	c.Equal(http.StatusNotFound, rr.Code)
+
+	writerMock.AssertExpectations(t)
+}
@@ -0,0 +1,97 @@
+package router
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/pokt-foundation/pocket-http-db/service"
+	"github.com/pokt-foundation/portal-api-go/repository"
+	jsonresponse "github.com/pokt-foundation/utils-go/json-response"
+)
+
+// publicBlockchain is the view of a blockchain served by the public mirror, without its altruist URL,
+// which holds credentials, nor the checks the gateway runs against its nodes
+type publicBlockchain struct {
+	ID                string   `json:"id"`
+	Blockchain        string   `json:"blockchain"`
+	ChainID           string   `json:"chainID"`
+	Description       string   `json:"description"`
+	Network           string   `json:"network"`
+	Ticker            string   `json:"ticker"`
+	BlockchainAliases []string `json:"blockchainAliases"`
+}
+
+func newPublicBlockchain(blockchain *repository.Blockchain) publicBlockchain {
+	aliases := blockchain.BlockchainAliases
+	if aliases == nil {
+		aliases = []string{}
+	}
+
+	return publicBlockchain{
+		ID:                blockchain.ID,
+		Blockchain:        blockchain.Blockchain,
+		ChainID:           blockchain.ChainID,
+		Description:       blockchain.Description,
+		Network:           blockchain.Network,
+		Ticker:            blockchain.Ticker,
+		BlockchainAliases: aliases,
+	}
+}
+
+// PublicMirror returns the handler of the public mirror, serving the active blockchains and the pay plan catalog
+// without authentication, meant for its own listener so the docs site and the status page can read them directly
+// while customer data stays behind the API keys of the main listener
+// its reads follow the disabled route groups and HTTP caching of the API
+func (rt *Router) PublicMirror() http.Handler {
+	publicRouter := mux.NewRouter()
+
+	publicRoute := func(group RouteGroup, path string, handler http.HandlerFunc) {
+		publicRouter.HandleFunc(path, rt.routeGroupHandler(group, http.MethodGet, rt.httpCacheHandler(group, http.MethodGet, handler))).
+			Methods(http.MethodGet)
+	}
+
+	publicRouter.HandleFunc("/", rt.HealthCheck).Methods(http.MethodGet)
+	publicRoute(RouteGroupBlockchain, "/blockchain", rt.GetPublicBlockchains)
+	publicRoute(RouteGroupBlockchain, "/blockchain/{id}", rt.GetPublicBlockchain)
+	publicRoute(RouteGroupPayPlan, "/pay_plan", rt.GetPayPlans)
+
+	publicRouter.Use(rt.AccessLogHandler, publicMirrorHandler)
+
+	return publicRouter
+}
+
+// publicMirrorHandler lets the pages of any origin read the public mirror
+func publicMirrorHandler(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+
+		h.ServeHTTP(w, r)
+	})
+}
+
+// GetPublicBlockchains responds the active blockchains
+func (rt *Router) GetPublicBlockchains(w http.ResponseWriter, r *http.Request) {
+	blockchains := []publicBlockchain{}
+
+	for _, blockchain := range rt.blockchains().GetAll() {
+		if blockchain.Active {
+			blockchains = append(blockchains, newPublicBlockchain(blockchain))
+		}
+	}
+
+	jsonresponse.RespondWithJSON(w, http.StatusOK, blockchains)
+}
+
+// GetPublicBlockchain responds the blockchain with given id, inactive blockchains are not found
+func (rt *Router) GetPublicBlockchain(w http.ResponseWriter, r *http.Request) {
+	blockchain, err := rt.blockchains().Get(pathParam(r, "id"))
+	if err == nil && !blockchain.Active {
+		err = service.ErrBlockchainNotFound
+	}
+	if err != nil {
+		rt.respondWithServiceError(w, "GetPublicBlockchain", err)
+		return
+	}
+
+	jsonresponse.RespondWithJSON(w, http.StatusOK, newPublicBlockchain(blockchain))
+}
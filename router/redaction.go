@@ -0,0 +1,71 @@
+package router
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+
+	"github.com/pokt-foundation/pocket-http-db/accesslog"
+	"github.com/pokt-foundation/pocket-http-db/casing"
+)
+
+// redactedFields are the fields stripped from the responses of redacted keys, in camelCase so they match
+// whatever casing profile the response was written in
+var redactedFields = map[string]bool{
+	"contactEmail": true,
+	"secretKey":    true,
+	"privateKey":   true,
+}
+
+// RedactionHandler strips notification emails, secret keys and AAT private keys from the JSON responses
+// of the API keys in RedactedKeys, for consumers such as analytics that must not see them
+func (rt *Router) RedactionHandler(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !rt.RedactedKeys[accesslog.KeyID(r.Header.Get("Authorization"))] {
+			h.ServeHTTP(w, r)
+
+			return
+		}
+
+		rt.serveRewritten(w, r, h, redact)
+	})
+}
+
+// redact removes the redacted fields from every object of the JSON document data
+func redact(data []byte) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var document any
+
+	err := decoder.Decode(&document)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(redactValue(document))
+}
+
+func redactValue(value any) any {
+	switch typed := value.(type) {
+	case map[string]any:
+		for key, field := range typed {
+			if redactedFields[casing.ToCamel(key)] {
+				delete(typed, key)
+				continue
+			}
+
+			typed[key] = redactValue(field)
+		}
+
+		return typed
+	case []any:
+		for i, item := range typed {
+			typed[i] = redactValue(item)
+		}
+
+		return typed
+	default:
+		return value
+	}
+}
@@ -0,0 +1,74 @@
+package router
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pokt-foundation/pocket-http-db/accesslog"
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouter_Redaction(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	router.APIKeys["analytics_key"] = true
+	router.RedactedKeys = map[string]bool{accesslog.KeyID("analytics_key"): true}
+
+	router.Cache.UpdateApplication("5f62b7d8be3591c4dea8566d", func(app *repository.Application) {
+		app.ContactEmail = "owner@example.com"
+		app.GatewaySettings.SecretKey = "secret"
+		app.GatewayAAT.PrivateKey = "private"
+	})
+
+	getApplications := func(apiKey, profile string) []map[string]any {
+		req, err := http.NewRequest(http.MethodGet, "/user/60ecb2bf67774900350d9c43/application", nil)
+		c.NoError(err)
+
+		req.Header.Set("Authorization", apiKey)
+		if profile != "" {
+			req.Header.Set(ResponseProfileHeader, profile)
+		}
+
+		rr := httptest.NewRecorder()
+
+		router.Router.ServeHTTP(rr, req)
+
+		c.Equal(http.StatusOK, rr.Code)
+
+		var body []map[string]any
+
+		c.NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+		c.NotEmpty(body)
+
+		return body
+	}
+
+	body := getApplications("", "")
+	c.Equal("owner@example.com", body[0]["contactEmail"])
+	c.Contains(body[0]["gatewaySettings"], "secretKey")
+	c.Contains(body[0]["gatewayAAT"], "privateKey")
+
+	for _, profile := range []string{"", "snake"} {
+		for _, app := range getApplications("analytics_key", profile) {
+			c.NotContains(app, "contactEmail")
+			c.NotContains(app, "contact_email")
+
+			for _, nested := range app {
+				if fields, ok := nested.(map[string]any); ok {
+					c.NotContains(fields, "secretKey")
+					c.NotContains(fields, "secret_key")
+					c.NotContains(fields, "privateKey")
+					c.NotContains(fields, "private_key")
+				}
+			}
+
+			c.Contains(app, "id")
+		}
+	}
+}
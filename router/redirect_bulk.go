@@ -0,0 +1,49 @@
+package router
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/pokt-foundation/pocket-http-db/apierrors"
+	"github.com/pokt-foundation/pocket-http-db/service"
+	"github.com/pokt-foundation/portal-api-go/repository"
+	jsonresponse "github.com/pokt-foundation/utils-go/json-response"
+)
+
+// createRedirects struct holding the input of a bulk redirect creation
+type createRedirects struct {
+	Redirects []*repository.Redirect `json:"redirects"`
+}
+
+// CreateRedirects creates a batch of redirects, such as the ones of a blockchain being onboarded, in a single transaction
+// none is created if any is invalid, the results then tell which ones and why
+func (rt *Router) CreateRedirects(w http.ResponseWriter, r *http.Request) {
+	var input createRedirects
+
+	err := decodeBody(r, &input)
+	if err != nil {
+		rt.logError(fmt.Errorf("CreateRedirects decode failed: %w", err))
+		respondWithBodyError(w, err)
+		return
+	}
+
+	defer r.Body.Close()
+
+	results, err := rt.redirects().CreateMany(r.Context(), input.Redirects)
+	if errors.Is(err, service.ErrInvalidRedirects) {
+		jsonresponse.RespondWithJSON(w, http.StatusBadRequest, map[string]interface{}{
+			"error":   err.Error(),
+			"code":    apierrors.CodeBadRequest,
+			"results": results,
+		})
+
+		return
+	}
+	if err != nil {
+		rt.respondWithServiceError(w, "WriteRedirects in CreateRedirects", err)
+		return
+	}
+
+	jsonresponse.RespondWithJSON(w, http.StatusOK, results)
+}
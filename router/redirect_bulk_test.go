@@ -0,0 +1,71 @@
+package router
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pokt-foundation/pocket-http-db/service"
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouter_CreateRedirects(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	writerMock := &writerMock{}
+	router.Writer = writerMock
+
+	createRedirects := func(body string) *httptest.ResponseRecorder {
+		req, err := http.NewRequest(http.MethodPost, "/redirect/bulk", bytes.NewBufferString(body))
+		c.NoError(err)
+
+		rr := httptest.NewRecorder()
+
+		router.Router.ServeHTTP(rr, req)
+
+		return rr
+	}
+
+	writerMock.On("WriteRedirects", mock.Anything).Return([]*repository.Redirect{
+		{ID: "1", BlockchainID: "0021", Domain: "pokt-archival.gateway.network"},
+		{ID: "2", BlockchainID: "0022", Domain: "eth-archival.gateway.network"},
+	}, nil).Once()
+
+	rr := createRedirects(`{"redirects":[{"blockchainID":"0021","domain":"pokt-archival.gateway.network"},` +
+		`{"blockchainID":"0022","domain":"eth-archival.gateway.network"}]}`)
+	c.Equal(http.StatusOK, rr.Code)
+
+	var results []service.RedirectResult
+	c.NoError(json.Unmarshal(rr.Body.Bytes(), &results))
+	c.Len(results, 2)
+	c.Equal("2", results[1].Redirect.ID)
+
+	// nothing is written when any redirect is invalid
+	rr = createRedirects(`{"redirects":[{"blockchainID":"0021","domain":"pokt-trace.gateway.network"},` +
+		`{"blockchainID":"0099","domain":"new.gateway.network"}]}`)
+	c.Equal(http.StatusBadRequest, rr.Code)
+
+	var invalid struct {
+		Code    string                   `json:"code"`
+		Results []service.RedirectResult `json:"results"`
+	}
+	c.NoError(json.Unmarshal(rr.Body.Bytes(), &invalid))
+	c.Equal("bad_request", invalid.Code)
+	c.Empty(invalid.Results[0].Error)
+	c.Equal("blockchain not found: 0099", invalid.Results[1].Error)
+
+	rr = createRedirects(`{"redirects":[]}`)
+	c.Equal(http.StatusBadRequest, rr.Code)
+
+	rr = createRedirects("wrong")
+	c.Equal(http.StatusBadRequest, rr.Code)
+
+	writerMock.AssertExpectations(t)
+}
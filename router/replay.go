@@ -0,0 +1,165 @@
+package router
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pokt-foundation/pocket-http-db/accesslog"
+	"github.com/pokt-foundation/pocket-http-db/webhook"
+)
+
+const (
+	// NonceHeader holds a unique value of a write request, the request is rejected if it was already used
+	NonceHeader = "X-Request-Nonce"
+	// TimestampHeader holds the unix time in seconds a nonce request was made at
+	TimestampHeader = "X-Request-Timestamp"
+	// RequestSignatureHeader holds the hex HMAC-SHA256 of the signed request, see SignRequest
+	RequestSignatureHeader = "X-Request-Signature"
+
+	// DefaultNonceWindow is how far from the server time a nonce request timestamp can be
+	DefaultNonceWindow = 5 * time.Minute
+
+	minNonceLength = 16
+	maxNonceLength = 128
+)
+
+var (
+	errNonceRequired    = errors.New("request nonce required")
+	errInvalidNonce     = fmt.Errorf("request nonce must have between %d and %d characters", minNonceLength, maxNonceLength)
+	errInvalidTimestamp = errors.New("invalid request timestamp")
+	errStaleTimestamp   = errors.New("request timestamp outside of the replay window")
+	errNonceUsed        = errors.New("request nonce already used")
+)
+
+// SignRequest returns the signature of a nonce request, the hex HMAC-SHA256 with secret of
+// the method, the request URI with its query, the timestamp, the nonce and the body, each one on its own line
+func SignRequest(secret, method, requestURI, timestamp, nonce string, body []byte) string {
+	signed := strings.Join([]string{method, requestURI, timestamp, nonce, string(body)}, "\n")
+
+	return webhook.Sign([]byte(secret), []byte(signed))
+}
+
+// validRequestSignature reports whether signature is the signature of the nonce request r with body
+func validRequestSignature(secret string, r *http.Request, body []byte) bool {
+	expected, err := hex.DecodeString(SignRequest(secret, r.Method, r.URL.RequestURI(),
+		r.Header.Get(TimestampHeader), r.Header.Get(NonceHeader), body))
+	if err != nil {
+		return false
+	}
+
+	received, err := hex.DecodeString(r.Header.Get(RequestSignatureHeader))
+	if err != nil {
+		return false
+	}
+
+	return hmac.Equal(expected, received)
+}
+
+// ReplayProtectionHandler rejects write requests whose nonce was already used by their API key, if a nonce store is configured
+// nonce requests carry their timestamp so nonces are only kept for the replay window,
+// and are signed when a signing secret is configured so a proxy cannot change them
+// the nonce of a request failing with a server error before any write is released, so it can be retried
+func (rt *Router) ReplayProtectionHandler(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// the billing webhook is protected by its own signature
+		if rt.Nonces == nil || isReadRequest(r) || r.URL.Path == billingWebhookPath {
+			h.ServeHTTP(w, r)
+
+			return
+		}
+
+		nonce := r.Header.Get(NonceHeader)
+		if nonce == "" {
+			if rt.RequireNonce {
+				respondWithError(w, http.StatusBadRequest, errNonceRequired.Error())
+				return
+			}
+
+			h.ServeHTTP(w, r)
+
+			return
+		}
+
+		if len(nonce) < minNonceLength || len(nonce) > maxNonceLength {
+			respondWithError(w, http.StatusBadRequest, errInvalidNonce.Error())
+			return
+		}
+
+		unixTimestamp, err := strconv.ParseInt(r.Header.Get(TimestampHeader), 10, 64)
+		if err != nil {
+			respondWithError(w, http.StatusBadRequest, errInvalidTimestamp.Error())
+			return
+		}
+
+		window := rt.NonceWindow
+		if window <= 0 {
+			window = DefaultNonceWindow
+		}
+
+		timestamp := time.Unix(unixTimestamp, 0)
+
+		if age := time.Since(timestamp); age > window || age < -window {
+			respondWithError(w, http.StatusUnauthorized, errStaleTimestamp.Error())
+			return
+		}
+
+		if rt.RequestSigningSecret != "" {
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				respondWithError(w, http.StatusBadRequest, err.Error())
+				return
+			}
+
+			r.Body.Close()
+			r.Body = io.NopCloser(bytes.NewReader(body))
+
+			if !validRequestSignature(rt.RequestSigningSecret, r, body) {
+				rt.logError(fmt.Errorf("ReplayProtectionHandler failed: %w", errInvalidSignature))
+				respondWithError(w, http.StatusUnauthorized, errInvalidSignature.Error())
+				return
+			}
+		}
+
+		// the nonce is kept until no request with its timestamp can be accepted anymore
+		key := nonceKey(r, nonce)
+
+		ok, err := rt.Nonces.UseNonce(r.Context(), key, timestamp.Add(window))
+		if err != nil {
+			rt.logError(fmt.Errorf("UseNonce in ReplayProtectionHandler failed: %w", err))
+			respondWithError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		if !ok {
+			respondWithError(w, http.StatusConflict, errNonceUsed.Error())
+			return
+		}
+
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		ctx, attempted := withWriteAttempts(r.Context())
+
+		h.ServeHTTP(recorder, r.WithContext(ctx))
+
+		// the requests that failed on the server before calling the writer can be sent again with the same nonce,
+		// the others may have written so their nonce is kept
+		if recorder.status >= http.StatusInternalServerError && !writeAttempted(attempted) {
+			err = rt.Nonces.ReleaseNonce(r.Context(), key)
+			if err != nil {
+				rt.logError(fmt.Errorf("ReleaseNonce in ReplayProtectionHandler failed: %w", err))
+			}
+		}
+	})
+}
+
+// nonceKey returns the key nonce is kept by, the nonces of each API key being apart from the others
+func nonceKey(r *http.Request, nonce string) string {
+	return accesslog.KeyID(r.Header.Get("Authorization")) + ":" + nonce
+}
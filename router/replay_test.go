@@ -0,0 +1,182 @@
+package router
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/pokt-foundation/pocket-http-db/nonce"
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouter_ReplayProtectionHandler(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	router.Nonces = nonce.NewMemoryStore()
+	router.RequestSigningSecret = "s3cr3t"
+
+	writer := &writerMock{}
+	writer.On("WriteRedirect", mock.Anything).Return(&repository.Redirect{Alias: "pokt-mainnet"}, nil)
+
+	router.Writer = writer
+
+	body := []byte(`{"alias":"pokt-mainnet"}`)
+
+	newRequest := func(nonce string, timestamp time.Time, signature string) *http.Request {
+		req, err := http.NewRequest(http.MethodPost, "/redirect", bytes.NewReader(body))
+		c.NoError(err)
+
+		rawTimestamp := strconv.FormatInt(timestamp.Unix(), 10)
+
+		if signature == "" {
+			signature = SignRequest("s3cr3t", http.MethodPost, "/redirect", rawTimestamp, nonce, body)
+		}
+
+		req.Header.Set(NonceHeader, nonce)
+		req.Header.Set(TimestampHeader, rawTimestamp)
+		req.Header.Set(RequestSignatureHeader, signature)
+
+		return req
+	}
+
+	tests := []struct {
+		name         string
+		req          *http.Request
+		expectedCode int
+	}{
+		{
+			name:         "first use of the nonce",
+			req:          newRequest("0123456789abcdef", time.Now(), ""),
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "replayed nonce",
+			req:          newRequest("0123456789abcdef", time.Now(), ""),
+			expectedCode: http.StatusConflict,
+		},
+		{
+			name:         "invalid signature",
+			req:          newRequest("fedcba9876543210", time.Now(), "abcd"),
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			name:         "stale timestamp",
+			req:          newRequest("fedcba9876543210", time.Now().Add(-time.Hour), ""),
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			name:         "short nonce",
+			req:          newRequest("0123", time.Now(), ""),
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "signature checked nonce is still unused",
+			req:          newRequest("fedcba9876543210", time.Now(), ""),
+			expectedCode: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		rr := httptest.NewRecorder()
+
+		router.Router.ServeHTTP(rr, tt.req)
+
+		c.Equal(tt.expectedCode, rr.Code, tt.name)
+	}
+
+	serve := func(req *http.Request) int {
+		rr := httptest.NewRecorder()
+
+		router.Router.ServeHTTP(rr, req)
+
+		return rr.Code
+	}
+
+	// the query is signed with the path
+	changedQueryReq := newRequest("2222333344445555", time.Now(), "")
+	changedQueryReq.URL.RawQuery = "force=true"
+
+	c.Equal(http.StatusUnauthorized, serve(changedQueryReq))
+
+	// the nonce of a request failing on the server after calling the writer is kept, it may have written
+	failingWriterMock := &writerMock{}
+	failingWriterMock.On("WriteRedirect").Return((*repository.Redirect)(nil), errors.New("dummy error")).Once()
+	failingWriterMock.On("WriteRedirect").Return(&repository.Redirect{Alias: "pokt-mainnet"}, nil)
+
+	router.Writer = failingWriterMock
+
+	c.Equal(http.StatusInternalServerError, serve(newRequest("1111222233334444", time.Now(), "")))
+	c.Equal(http.StatusConflict, serve(newRequest("1111222233334444", time.Now(), "")))
+
+	// the nonce of a request failing on the server before any write is released, the request can be retried with it
+	failures := 1
+
+	handler := router.ReplayProtectionHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if failures > 0 {
+			failures--
+			w.WriteHeader(http.StatusServiceUnavailable)
+
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	serveHandler := func(req *http.Request) int {
+		rr := httptest.NewRecorder()
+
+		handler.ServeHTTP(rr, req)
+
+		return rr.Code
+	}
+
+	c.Equal(http.StatusServiceUnavailable, serveHandler(newRequest("3333444455556666", time.Now(), "")))
+	c.Equal(http.StatusOK, serveHandler(newRequest("3333444455556666", time.Now(), "")))
+	c.Equal(http.StatusConflict, serveHandler(newRequest("3333444455556666", time.Now(), "")))
+
+	// the nonces of each API key are apart
+	router.APIKeys["other_key"] = true
+
+	otherKeyReq := newRequest("0123456789abcdef", time.Now(), "")
+	otherKeyReq.Header.Set("Authorization", "other_key")
+
+	c.Equal(http.StatusOK, serve(otherKeyReq))
+
+	req, err := http.NewRequest(http.MethodPost, "/redirect", bytes.NewReader(body))
+	c.NoError(err)
+
+	rr := httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusOK, rr.Code)
+
+	router.RequireNonce = true
+
+	req, err = http.NewRequest(http.MethodPost, "/redirect", bytes.NewReader(body))
+	c.NoError(err)
+
+	rr = httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusBadRequest, rr.Code)
+
+	req, err = http.NewRequest(http.MethodGet, "/blockchain", nil)
+	c.NoError(err)
+
+	rr = httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusOK, rr.Code)
+}
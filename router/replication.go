@@ -0,0 +1,79 @@
+package router
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/pokt-foundation/pocket-http-db/replica"
+	"github.com/pokt-foundation/pocket-http-db/snapshot"
+	jsonresponse "github.com/pokt-foundation/utils-go/json-response"
+)
+
+var (
+	errChangesFeedDisabled = errors.New("changes feed not enabled")
+	errReadOnly            = errors.New("writes are not allowed on a follower instance")
+	errInvalidSince        = errors.New("invalid since, expected a sequence or an RFC3339 time")
+)
+
+// ReadOnlyHandler rejects every write when the instance is a follower
+func (rt *Router) ReadOnlyHandler(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if rt.ReadOnly && !isReadRequest(r) {
+			respondWithError(w, http.StatusMethodNotAllowed, errReadOnly.Error())
+			return
+		}
+
+		h.ServeHTTP(w, r)
+	})
+}
+
+func (rt *Router) GetChangesSnapshot(w http.ResponseWriter, r *http.Request) {
+	if rt.Changes == nil {
+		respondWithError(w, http.StatusNotFound, errChangesFeedDisabled.Error())
+		return
+	}
+
+	// the sequence is taken before the export so no change is missed, changes may be replayed instead
+	export := replica.Export{
+		FeedID:   rt.Changes.ID(),
+		Sequence: rt.Changes.Sequence(),
+	}
+
+	export.Snapshot = snapshot.FromCache(rt.Cache)
+
+	jsonresponse.RespondWithJSON(w, http.StatusOK, export)
+}
+
+// GetChanges responds the changes after the since sequence of the feed, or after the since time in any feed
+// so downstream caches can sync incrementally, an expired since has to be followed by a full fetch
+func (rt *Router) GetChanges(w http.ResponseWriter, r *http.Request) {
+	if rt.Changes == nil {
+		respondWithError(w, http.StatusNotFound, errChangesFeedDisabled.Error())
+		return
+	}
+
+	rawSince := r.URL.Query().Get("since")
+
+	var changes *replica.Changes
+
+	sequence, err := strconv.ParseUint(rawSince, 10, 64)
+	if err == nil {
+		changes, err = rt.Changes.Since(r.URL.Query().Get("feed"), sequence)
+	} else {
+		since, parseErr := time.Parse(time.RFC3339Nano, rawSince)
+		if parseErr != nil {
+			respondWithError(w, http.StatusBadRequest, errInvalidSince.Error())
+			return
+		}
+
+		changes, err = rt.Changes.SinceTime(since)
+	}
+	if err != nil {
+		respondWithError(w, http.StatusGone, err.Error())
+		return
+	}
+
+	jsonresponse.RespondWithJSON(w, http.StatusOK, changes)
+}
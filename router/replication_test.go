@@ -0,0 +1,111 @@
+package router
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/pokt-foundation/pocket-http-db/replica"
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouter_GetChanges(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	get := func(path string) *httptest.ResponseRecorder {
+		req, err := http.NewRequest(http.MethodGet, path, nil)
+		c.NoError(err)
+
+		rr := httptest.NewRecorder()
+
+		router.Router.ServeHTTP(rr, req)
+
+		return rr
+	}
+
+	rr := get("/changes/snapshot")
+	c.Equal(http.StatusNotFound, rr.Code)
+
+	router.Changes = replica.NewFeed(0, logrus.New())
+
+	router.Changes.Append(repository.Notification{
+		Table:  repository.TableBlockchains,
+		Action: repository.ActionUpdate,
+		Data:   &repository.Blockchain{ID: "0021"},
+	})
+
+	rr = get("/changes/snapshot")
+	c.Equal(http.StatusOK, rr.Code)
+
+	var export replica.Export
+
+	c.NoError(json.Unmarshal(rr.Body.Bytes(), &export))
+	c.Equal(router.Changes.ID(), export.FeedID)
+	c.Equal(uint64(1), export.Sequence)
+	c.Len(export.Snapshot.Applications, 3)
+
+	rr = get("/changes?feed=" + export.FeedID + "&since=0")
+	c.Equal(http.StatusOK, rr.Code)
+
+	var changes replica.Changes
+
+	c.NoError(json.Unmarshal(rr.Body.Bytes(), &changes))
+	c.Len(changes.Changes, 1)
+
+	rr = get("/changes?feed=other&since=0")
+	c.Equal(http.StatusGone, rr.Code)
+
+	rr = get("/changes?feed=" + export.FeedID + "&since=latest")
+	c.Equal(http.StatusBadRequest, rr.Code)
+
+	rr = get("/changes?since=" + url.QueryEscape(changes.Changes[0].Time.Add(-time.Nanosecond).Format(time.RFC3339Nano)))
+	c.Equal(http.StatusOK, rr.Code)
+
+	c.NoError(json.Unmarshal(rr.Body.Bytes(), &changes))
+	c.Len(changes.Changes, 1)
+
+	rr = get("/changes?since=" + url.QueryEscape(changes.Changes[0].Time.Format(time.RFC3339Nano)))
+	c.Equal(http.StatusOK, rr.Code)
+
+	c.NoError(json.Unmarshal(rr.Body.Bytes(), &changes))
+	c.Empty(changes.Changes)
+
+	rr = get("/changes?since=2020-01-01T00:00:00Z")
+	c.Equal(http.StatusGone, rr.Code)
+}
+
+func TestRouter_ReadOnly(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	router.ReadOnly = true
+
+	req, err := http.NewRequest(http.MethodPost, "/blockchain/0021/activate", bytes.NewBufferString("false"))
+	c.NoError(err)
+
+	rr := httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusMethodNotAllowed, rr.Code)
+
+	req, err = http.NewRequest(http.MethodGet, "/blockchain/0021", nil)
+	c.NoError(err)
+
+	rr = httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusOK, rr.Code)
+}
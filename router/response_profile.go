@@ -0,0 +1,96 @@
+package router
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+
+	"github.com/pokt-foundation/pocket-http-db/accesslog"
+	"github.com/pokt-foundation/pocket-http-db/casing"
+)
+
+// ResponseProfileHeader is the request header selecting the casing profile of the response
+const ResponseProfileHeader = "X-Response-Profile"
+
+// bufferedResponse holds the response of a handler so it can be rewritten before being sent
+type bufferedResponse struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (b *bufferedResponse) WriteHeader(status int) {
+	b.status = status
+}
+
+func (b *bufferedResponse) Write(p []byte) (int, error) {
+	return b.body.Write(p)
+}
+
+// responseProfile returns the profile of the request header, falling back to the camelcase feature
+// and the profile of the API key
+func (rt *Router) responseProfile(r *http.Request) (casing.Profile, bool) {
+	if name := r.Header.Get(ResponseProfileHeader); name != "" {
+		return casing.ParseProfile(name)
+	}
+
+	if hasFeature(r, FeatureCamelCase) {
+		return casing.Camel, true
+	}
+
+	if profile, ok := rt.ResponseProfiles[accesslog.KeyID(r.Header.Get("Authorization"))]; ok {
+		return profile, true
+	}
+
+	return casing.Default, true
+}
+
+// ResponseProfileHandler renames the fields of JSON responses to the casing of the request profile
+func (rt *Router) ResponseProfileHandler(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Vary", ResponseProfileHeader)
+
+		profile, ok := rt.responseProfile(r)
+		if !ok {
+			respondWithError(w, http.StatusBadRequest, "invalid "+ResponseProfileHeader)
+			return
+		}
+
+		if profile == casing.Default {
+			h.ServeHTTP(w, r)
+
+			return
+		}
+
+		rt.serveRewritten(w, r, h, func(body []byte) ([]byte, error) {
+			return casing.Convert(body, profile)
+		})
+	})
+}
+
+// serveRewritten serves r with h, passing its JSON response body through rewrite before sending it
+// the body is sent as written by h if rewrite fails
+func (rt *Router) serveRewritten(w http.ResponseWriter, r *http.Request, h http.Handler, rewrite func([]byte) ([]byte, error)) {
+	buffered := &bufferedResponse{ResponseWriter: w, status: http.StatusOK}
+
+	h.ServeHTTP(buffered, r)
+
+	body := buffered.body.Bytes()
+
+	if strings.HasPrefix(w.Header().Get("Content-Type"), "application/json") {
+		rewritten, err := rewrite(body)
+		if err != nil {
+			rt.logError(err)
+		} else {
+			body = rewritten
+			w.Header().Del("Content-Length")
+		}
+	}
+
+	w.WriteHeader(buffered.status)
+
+	_, err := w.Write(body)
+	if err != nil {
+		rt.logError(err)
+	}
+}
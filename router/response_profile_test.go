@@ -0,0 +1,76 @@
+package router
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pokt-foundation/pocket-http-db/accesslog"
+	"github.com/pokt-foundation/pocket-http-db/casing"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouter_ResponseProfile(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	router.APIKeys["snake_key"] = true
+	router.ResponseProfiles = map[string]casing.Profile{accesslog.KeyID("snake_key"): casing.Snake}
+
+	getApplication := func(apiKey, profile string) *httptest.ResponseRecorder {
+		req, err := http.NewRequest(http.MethodGet, "/application/5f62b7d8be3591c4dea8566d", nil)
+		c.NoError(err)
+
+		req.Header.Set("Authorization", apiKey)
+		if profile != "" {
+			req.Header.Set(ResponseProfileHeader, profile)
+		}
+
+		rr := httptest.NewRecorder()
+
+		router.Router.ServeHTTP(rr, req)
+
+		return rr
+	}
+
+	fields := func(rr *httptest.ResponseRecorder) map[string]any {
+		var body map[string]any
+
+		c.NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+
+		return body
+	}
+
+	rr := getApplication("", "")
+	c.Equal(http.StatusOK, rr.Code)
+	c.Contains(fields(rr), "userID")
+
+	rr = getApplication("", "snake")
+	c.Equal(http.StatusOK, rr.Code)
+	c.Contains(fields(rr), "user_id")
+	c.NotContains(fields(rr), "userID")
+
+	rr = getApplication("snake_key", "")
+	c.Contains(fields(rr), "user_id")
+
+	// the header takes precedence over the profile of the key
+	rr = getApplication("snake_key", "default")
+	c.Contains(fields(rr), "userID")
+
+	rr = getApplication("", "kebab")
+	c.Equal(http.StatusBadRequest, rr.Code)
+
+	req, err := http.NewRequest(http.MethodGet, "/application/not-an-app", nil)
+	c.NoError(err)
+
+	req.Header.Set(ResponseProfileHeader, "snake")
+
+	rr = httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusNotFound, rr.Code)
+}
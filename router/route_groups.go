@@ -0,0 +1,96 @@
+package router
+
+import (
+	"errors"
+	"net/http"
+)
+
+// RouteGroup represents the set of routes of an entity type, which can be disabled as a whole
+type RouteGroup string
+
+const (
+	RouteGroupApplication  RouteGroup = "application"
+	RouteGroupBlockchain   RouteGroup = "blockchain"
+	RouteGroupLoadBalancer RouteGroup = "load_balancer"
+	RouteGroupPayPlan      RouteGroup = "pay_plan"
+	RouteGroupRedirect     RouteGroup = "redirect"
+	RouteGroupChanges      RouteGroup = "changes"
+	RouteGroupAdmin        RouteGroup = "admin"
+	RouteGroupIntegrations RouteGroup = "integrations"
+	// RouteGroupGraphQL is the GraphQL endpoint, its fields also follow the route group of their entity
+	RouteGroupGraphQL RouteGroup = "graphql"
+)
+
+// RouteGroups are all the route groups
+var RouteGroups = []RouteGroup{
+	RouteGroupApplication, RouteGroupBlockchain, RouteGroupLoadBalancer, RouteGroupPayPlan,
+	RouteGroupRedirect, RouteGroupChanges, RouteGroupAdmin, RouteGroupIntegrations, RouteGroupGraphQL,
+}
+
+var (
+	errRouteDisabled      = errors.New("route not found")
+	errRouteWriteDisabled = errors.New("writes are disabled for this route")
+)
+
+// isRead returns true if method does not modify data
+func isRead(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead
+}
+
+// readPostPaths are the paths whose POST requests only read data, such as GraphQL queries and batch reads
+var readPostPaths = map[string]bool{
+	graphQLPath:           true,
+	applicationBatchPath:  true,
+	loadBalancerBatchPath: true,
+}
+
+// isReadRequest returns true if r does not modify data, the requests to readPostPaths are reads even when sent as POST
+func isReadRequest(r *http.Request) bool {
+	return isRead(r.Method) || readPostPaths[r.URL.Path]
+}
+
+// handle registers handler for method and path as part of group
+func (rt *Router) handle(group RouteGroup, method, path string, handler http.HandlerFunc) {
+	rt.addRoute(route{
+		group:   group,
+		method:  method,
+		pattern: path,
+		handler: rt.adminRouteHandler(group, rt.routeGroupHandler(group, method, rt.httpCacheHandler(group, method, rt.coalescingHandler(group, method, handler)))),
+	})
+}
+
+// handleReadPost registers handler for POST requests to path, one of readPostPaths, as reads of group
+func (rt *Router) handleReadPost(group RouteGroup, path string, handler http.HandlerFunc) {
+	rt.addRoute(route{
+		group:   group,
+		method:  http.MethodPost,
+		pattern: path,
+		handler: rt.routeGroupHandler(group, http.MethodGet, handler),
+	})
+}
+
+// routeGroupHandler responds as if the route did not exist when reads of its group are disabled,
+// and with method not allowed when writes are
+// the routes of RouteGroupAdmin are served to the API keys with the ScopeAdmin scope only
+func (rt *Router) routeGroupHandler(group RouteGroup, method string, handler http.HandlerFunc) http.HandlerFunc {
+	read := isRead(method)
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		if read && rt.DisabledReads[group] {
+			respondWithError(w, http.StatusNotFound, errRouteDisabled.Error())
+			return
+		}
+
+		if !read && rt.DisabledWrites[group] {
+			respondWithError(w, http.StatusMethodNotAllowed, errRouteWriteDisabled.Error())
+			return
+		}
+
+		if group == RouteGroupAdmin && !rt.hasScope(r, ScopeAdmin) {
+			respondWithError(w, http.StatusForbidden, errAdminScopeRequired.Error())
+			return
+		}
+
+		handler(w, r)
+	}
+}
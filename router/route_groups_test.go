@@ -0,0 +1,53 @@
+package router
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouter_RouteGroups(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	writerMock := &writerMock{}
+
+	writerMock.On("ActivateBlockchain", mock.Anything).Return(nil)
+
+	router.Writer = writerMock
+
+	router.DisabledReads = map[RouteGroup]bool{RouteGroupApplication: true}
+	router.DisabledWrites = map[RouteGroup]bool{RouteGroupApplication: true, RouteGroupBlockchain: true}
+
+	tests := []struct {
+		method, path, body string
+		expectedCode       int
+	}{
+		{http.MethodGet, "/application/5f62b7d8be3591c4dea8566d", "", http.StatusNotFound},
+		{http.MethodGet, "/user/60ecb2bf67774900350d9c43/application", "", http.StatusNotFound},
+		{http.MethodPut, "/application/5f62b7d8be3591c4dea8566d", `{"name":"papolo"}`, http.StatusMethodNotAllowed},
+		{http.MethodGet, "/blockchain/0021", "", http.StatusOK},
+		{http.MethodPost, "/blockchain/0021/activate", "false", http.StatusMethodNotAllowed},
+		{http.MethodGet, "/pay_plan", "", http.StatusOK},
+		{http.MethodGet, "/", "", http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		req, err := http.NewRequest(tt.method, tt.path, bytes.NewBufferString(tt.body))
+		c.NoError(err)
+
+		rr := httptest.NewRecorder()
+
+		router.Router.ServeHTTP(rr, req)
+
+		c.Equal(tt.expectedCode, rr.Code, "%s %s", tt.method, tt.path)
+	}
+
+	writerMock.AssertNotCalled(t, "ActivateBlockchain", mock.Anything)
+}
@@ -1,47 +1,158 @@
 package router
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gorilla/mux"
+	"github.com/lib/pq"
+	"github.com/pokt-foundation/pocket-http-db/accesslog"
+	"github.com/pokt-foundation/pocket-http-db/anomaly"
+	"github.com/pokt-foundation/pocket-http-db/apierrors"
 	"github.com/pokt-foundation/pocket-http-db/cache"
+	"github.com/pokt-foundation/pocket-http-db/casing"
+	"github.com/pokt-foundation/pocket-http-db/config"
+	"github.com/pokt-foundation/pocket-http-db/health"
+	"github.com/pokt-foundation/pocket-http-db/instance"
+	"github.com/pokt-foundation/pocket-http-db/lifecycle"
+	"github.com/pokt-foundation/pocket-http-db/metrics"
+	"github.com/pokt-foundation/pocket-http-db/nonce"
+	"github.com/pokt-foundation/pocket-http-db/notifier"
+	"github.com/pokt-foundation/pocket-http-db/relaymeter"
+	"github.com/pokt-foundation/pocket-http-db/replica"
+	"github.com/pokt-foundation/pocket-http-db/secrets"
+	"github.com/pokt-foundation/pocket-http-db/service"
+	"github.com/pokt-foundation/pocket-http-db/snapshot"
+	"github.com/pokt-foundation/pocket-http-db/tracing"
+	"github.com/pokt-foundation/pocket-http-db/types"
+	"github.com/pokt-foundation/pocket-http-db/webhook"
 	"github.com/pokt-foundation/portal-api-go/repository"
 	jsonresponse "github.com/pokt-foundation/utils-go/json-response"
 	"github.com/sirupsen/logrus"
 )
 
-var (
-	errNoPayFound          = errors.New("pay plan not found")
-	errBalancerNotFound    = errors.New("load balancer not found")
-	errBlockchainNotFound  = errors.New("blockchain not found")
-	errApplicationNotFound = errors.New("applications not found")
-)
+// uniqueViolationCode is the postgres error code for unique constraint violations
+const uniqueViolationCode = "23505"
 
 // Writer represents the implementation of writer interface
-type Writer interface {
-	WriteLoadBalancer(loadBalancer *repository.LoadBalancer) (*repository.LoadBalancer, error)
-	UpdateLoadBalancer(id string, options *repository.UpdateLoadBalancer) error
-	RemoveLoadBalancer(id string) error
-	WriteApplication(app *repository.Application) (*repository.Application, error)
-	UpdateApplication(id string, options *repository.UpdateApplication) error
-	UpdateFirstDateSurpassed(firstDateSurpassed *repository.UpdateFirstDateSurpassed) error
-	RemoveApplication(id string) error
-	WriteBlockchain(blockchain *repository.Blockchain) (*repository.Blockchain, error)
-	WriteRedirect(redirect *repository.Redirect) (*repository.Redirect, error)
-	ActivateBlockchain(id string, active bool) error
-}
+type Writer = service.Writer
 
 // Router struct handler for router requests
 type Router struct {
-	Cache   *cache.Cache
-	Router  *mux.Router
-	Writer  Writer
-	APIKeys map[string]bool
-	log     *logrus.Logger
+	Cache     *cache.Cache
+	Router    *mux.Router
+	Writer    Writer
+	APIKeys   map[string]bool
+	AccessLog *accesslog.Logger
+	// Nonces keeps the nonces of write requests, replay protection is disabled when nil
+	Nonces nonce.Store
+	// RequireNonce rejects write requests without nonce, RequestSigningSecret rejects unsigned nonce requests
+	RequireNonce         bool
+	RequestSigningSecret string
+	// NonceWindow is how far from the server time a nonce request timestamp can be, DefaultNonceWindow if zero
+	NonceWindow time.Duration
+	// Usage keeps the last requests to report the usage of each API key, the usage report is disabled when nil
+	Usage *accesslog.Ring
+	// Metrics records the requests served, by route template
+	Metrics  *metrics.Registry
+	Webhooks *webhook.Dispatcher
+	// Notifier tells operators about critical mutations such as deactivations and removals
+	Notifier *notifier.Dispatcher
+	// Changes records the changes applied to the cache so follower instances can replay them
+	Changes *replica.Feed
+	// ReadOnly rejects writes, set on follower instances
+	ReadOnly bool
+	// ResponseProfiles maps API key IDs to the casing profile of their responses, when not set by header
+	ResponseProfiles map[string]casing.Profile
+	// Secrets opens the secret fields sealed at rest for the keys with the ScopeSecrets scope, nil if not sealed
+	Secrets *secrets.Envelope
+	// RedactedKeys are the API key IDs whose responses never hold emails, secret keys nor AAT private keys
+	RedactedKeys map[string]bool
+	// RefreshWait is how long the request starting a cache refresh waits for it, DefaultRefreshWait if zero
+	RefreshWait time.Duration
+	// KeyScopes maps API key IDs to their scopes, such as ScopeBulk
+	KeyScopes map[string]map[string]bool
+	// MaxListSize is the number of entities full lists are limited to, unless asked for all by a bulk scoped key
+	// 0 disables the limit
+	MaxListSize int
+	// DisabledReads are the route groups whose reads respond not found
+	DisabledReads map[RouteGroup]bool
+	// DisabledWrites are the route groups whose writes respond method not allowed
+	DisabledWrites map[RouteGroup]bool
+	// Snapshots holds the last stored snapshot, checked against the cache on backup verification
+	Snapshots snapshot.Store
+	// RelayMeter receives the limits of applications right after a limit-affecting change
+	RelayMeter *relaymeter.Pusher
+	// UniqueLoadBalancerNames rejects load balancers whose name is already used by another load balancer of the same user
+	// it is only checked against the cache, there is no database constraint as the existing names may be duplicated,
+	// so concurrent writes of the same name can both succeed
+	UniqueLoadBalancerNames bool
+	// UniqueStickyOrigins rejects load balancers whose sticky origins are used by other load balancers in the scope
+	UniqueStickyOrigins service.StickyOriginScope
+	// UniqueApplicationNames rejects created applications whose name is already used by another application of the same user
+	UniqueApplicationNames bool
+	// BillingWebhookSecret verifies billing provider webhooks, the billing webhook is disabled when empty
+	BillingWebhookSecret string
+	// BillingTolerance is how far from the server time a billing event timestamp can be, DefaultBillingTolerance if zero
+	BillingTolerance time.Duration
+	// BillingPlanCodes maps the plan codes of the billing provider to pay plan types
+	BillingPlanCodes map[string]repository.PayPlanType
+	// GracePeriod is how long removed applications are kept before being permanently removed
+	GracePeriod time.Duration
+	// PlanDeprecations holds the deprecation date of pay plans, warned about in creation and update responses
+	PlanDeprecations map[repository.PayPlanType]time.Time
+	// DeprecatedRoutes are the routes whose responses carry deprecation headers
+	DeprecatedRoutes []RouteDeprecation
+	// HTTPCacheMaxAge is the max age of the HTTP caching headers of blockchain and pay plan reads, 0 disables them
+	HTTPCacheMaxAge time.Duration
+	// CoalesceReads serves the identical blockchain and pay plan reads in flight at once with a single response
+	CoalesceReads bool
+	// CacheSerializedReads serves the full lists of blockchains, pay plans and applications from their cached responses
+	// until the cache changes
+	CacheSerializedReads bool
+	// Config holds the environment variables loaded by the instance, served with secrets redacted
+	Config *config.Registry
+	// InstanceID identifies the instance on every response, so answers can be traced back behind a load balancer
+	InstanceID string
+	// Environment and Region identify the deployment on every response, such as staging and us-east-2
+	Environment string
+	Region      string
+	// Instances lists the known instances and their cache generations
+	Instances *instance.Registry
+	// Backfills recomputes and persists derived fields, nil on follower instances
+	Backfills *service.Backfiller
+	// WriteAnomalies flags write bursts by API key, nil disables the detection
+	WriteAnomalies *anomaly.Detector
+	// PageSnapshots holds the sorted lists of paginated traversals, nil fails their next pages once the cache refreshes
+	PageSnapshots *service.PageSnapshots
+	// LimitsVersions holds the last versions of the application limits, nil answers every limits delta in full
+	LimitsVersions *service.LimitsVersions
+	// EnvelopedKeys are the API key IDs whose responses are wrapped in {data, meta} unless asked otherwise by header
+	EnvelopedKeys map[string]bool
+	// ReadThroughApplications reads the applications missing from cache from the database before responding 404
+	ReadThroughApplications bool
+	// Health keeps the connectivity status of the enabled integrations, reported by the detailed health endpoint
+	Health *health.Registry
+	// Subsystems runs the background subsystems of the instance, reported by the detailed health endpoint
+	Subsystems *lifecycle.Manager
+	// SeparateAdmin serves the admin routes only on the Admin handler, they respond not found on the API
+	SeparateAdmin bool
+	// KeyUsers maps API key IDs to the user they are restricted to, such keys only read the applications
+	// and load balancers of their user, along with the blockchains and pay plans
+	KeyUsers map[string]string
+	// ValidateRequests rejects the requests not following the parameters and body schemas of the OpenAPI document
+	ValidateRequests bool
+	// Tracing samples the requests traced by route class, nil disables the trace context propagation
+	Tracing             *tracing.Sampler
+	featureUsage        *featureUsage
+	coalescer           *coalescer
+	serializedResponses *serializationCache
+	routes              []route
+	log                 *logrus.Logger
 }
 
 func (rt *Router) logError(err error) {
@@ -67,38 +178,191 @@ func NewRouter(reader cache.Reader, writer Writer, apiKeys map[string]bool, logg
 		Router:  mux.NewRouter(),
 		APIKeys: apiKeys,
 		log:     logger,
+
+		featureUsage:        newFeatureUsage(),
+		coalescer:           newCoalescer(),
+		serializedResponses: newSerializationCache(),
 	}
 
-	rt.Router.HandleFunc("/", rt.HealthCheck).Methods(http.MethodGet)
-	rt.Router.HandleFunc("/blockchain", rt.GetBlockchains).Methods(http.MethodGet)
-	rt.Router.HandleFunc("/blockchain", rt.CreateBlockchain).Methods(http.MethodPost)
-	rt.Router.HandleFunc("/blockchain/{id}", rt.GetBlockchain).Methods(http.MethodGet)
-	rt.Router.HandleFunc("/blockchain/{id}/activate", rt.ActivateBlockchain).Methods(http.MethodPost)
-	rt.Router.HandleFunc("/application", rt.GetApplications).Methods(http.MethodGet)
-	rt.Router.HandleFunc("/application", rt.CreateApplication).Methods(http.MethodPost)
-	rt.Router.HandleFunc("/application/limits", rt.GetApplicationsLimits).Methods(http.MethodGet)
-	rt.Router.HandleFunc("/application/{id}", rt.GetApplication).Methods(http.MethodGet)
-	rt.Router.HandleFunc("/application/{id}", rt.UpdateApplication).Methods(http.MethodPut)
-	rt.Router.HandleFunc("/application/first_date_surpassed", rt.UpdateFirstDateSurpassed).Methods(http.MethodPost)
-	rt.Router.HandleFunc("/load_balancer", rt.GetLoadBalancers).Methods(http.MethodGet)
-	rt.Router.HandleFunc("/load_balancer", rt.CreateLoadBalancer).Methods(http.MethodPost)
-	rt.Router.HandleFunc("/load_balancer/{id}", rt.GetLoadBalancer).Methods(http.MethodGet)
-	rt.Router.HandleFunc("/load_balancer/{id}", rt.UpdateLoadBalancer).Methods(http.MethodPut)
-	rt.Router.HandleFunc("/user/{id}/application", rt.GetApplicationByUserID).Methods(http.MethodGet)
-	rt.Router.HandleFunc("/user/{id}/load_balancer", rt.GetLoadBalancerByUserID).Methods(http.MethodGet)
-	rt.Router.HandleFunc("/pay_plan", rt.GetPayPlans).Methods(http.MethodGet)
-	rt.Router.HandleFunc("/pay_plan/{type}", rt.GetPayPlan).Methods(http.MethodGet)
-	rt.Router.HandleFunc("/redirect", rt.CreateRedirect).Methods(http.MethodPost)
-
-	rt.Router.Use(rt.AuthorizationHandler)
+	cache.OnChange(rt.coalescer.forget)
+	cache.OnChange(rt.serializedResponses.invalidate)
+
+	rt.register(http.MethodGet, "/", rt.HealthCheck)
+	rt.register(http.MethodGet, openAPIPath, rt.GetOpenAPI)
+	rt.register(http.MethodGet, featuresPath, rt.GetFeatures)
+	rt.handle(RouteGroupBlockchain, http.MethodGet, "/blockchain", rt.GetBlockchains)
+	rt.handle(RouteGroupBlockchain, http.MethodPost, "/blockchain", rt.CreateBlockchain)
+	rt.handle(RouteGroupBlockchain, http.MethodGet, "/blockchain/{id}", rt.GetBlockchain)
+	rt.handle(RouteGroupBlockchain, http.MethodDelete, "/blockchain/{id}", rt.RemoveBlockchain)
+	rt.handle(RouteGroupBlockchain, http.MethodPost, "/blockchain/{id}/activate", rt.ActivateBlockchain)
+	rt.handle(RouteGroupBlockchain, http.MethodGet, "/blockchain/{id}/settings", rt.GetBlockchainSettings)
+	rt.handle(RouteGroupBlockchain, http.MethodPut, "/blockchain/{id}/settings", rt.UpdateBlockchainSettings)
+	rt.handle(RouteGroupApplication, http.MethodGet, "/application", rt.GetApplications)
+	rt.handle(RouteGroupApplication, http.MethodPost, "/application", rt.CreateApplication)
+	rt.handle(RouteGroupApplication, http.MethodGet, "/application/limits", rt.GetApplicationsLimits)
+	rt.handle(RouteGroupApplication, http.MethodGet, "/application/limits/delta", rt.GetApplicationsLimitsDelta)
+	rt.handle(RouteGroupApplication, http.MethodPost, "/application/status", rt.UpdateApplicationsStatus)
+	rt.handle(RouteGroupApplication, http.MethodPost, "/application/delta", rt.GetApplicationDelta)
+	rt.handleReadPost(RouteGroupApplication, applicationBatchPath, rt.GetApplicationsBatch)
+	rt.handle(RouteGroupApplication, http.MethodGet, "/application/address/{address}", rt.GetApplicationByAddress)
+	rt.handle(RouteGroupApplication, http.MethodGet, "/application/{id}", rt.GetApplication)
+	rt.handle(RouteGroupApplication, http.MethodPut, "/application/{id}", rt.UpdateApplication)
+	rt.handle(RouteGroupApplication, http.MethodDelete, "/application/{id}", rt.RemoveApplication)
+	rt.handle(RouteGroupApplication, http.MethodGet, "/application/{id}/labels", rt.GetApplicationLabels)
+	rt.handle(RouteGroupApplication, http.MethodPut, "/application/{id}/labels", rt.SetApplicationLabels)
+	rt.handle(RouteGroupApplication, http.MethodPost, "/application/{id}/suspend", rt.SuspendApplication)
+	rt.handle(RouteGroupApplication, http.MethodPost, "/application/{id}/unsuspend", rt.UnsuspendApplication)
+	rt.handle(RouteGroupApplication, http.MethodPost, "/application/{id}/notifications/evaluate", rt.EvaluateApplicationNotifications)
+	rt.handle(RouteGroupApplication, http.MethodPost, "/application/first_date_surpassed", rt.UpdateFirstDateSurpassed)
+	rt.handle(RouteGroupLoadBalancer, http.MethodGet, "/load_balancer", rt.GetLoadBalancers)
+	rt.handle(RouteGroupLoadBalancer, http.MethodPost, "/load_balancer", rt.CreateLoadBalancer)
+	rt.handleReadPost(RouteGroupLoadBalancer, loadBalancerBatchPath, rt.GetLoadBalancersBatch)
+	rt.handle(RouteGroupLoadBalancer, http.MethodGet, "/load_balancer/{id}", rt.GetLoadBalancer)
+	rt.handle(RouteGroupLoadBalancer, http.MethodPut, "/load_balancer/{id}", rt.UpdateLoadBalancer)
+	rt.handle(RouteGroupLoadBalancer, http.MethodDelete, "/load_balancer/{id}", rt.RemoveLoadBalancer)
+	rt.handle(RouteGroupLoadBalancer, http.MethodGet, "/load_balancer/{id}/applications", rt.GetLoadBalancerApplications)
+	rt.handle(RouteGroupLoadBalancer, http.MethodPut, "/load_balancer/{id}/applications", rt.SetLoadBalancerApplications)
+	rt.handle(RouteGroupLoadBalancer, http.MethodPut, "/load_balancer/{id}/applications/order", rt.ReorderLoadBalancerApplications)
+	rt.handle(RouteGroupLoadBalancer, http.MethodGet, "/load_balancer/{id}/labels", rt.GetLoadBalancerLabels)
+	rt.handle(RouteGroupLoadBalancer, http.MethodGet, "/load_balancer/{id}/limits", rt.GetLoadBalancerLimits)
+	rt.handle(RouteGroupLoadBalancer, http.MethodPut, "/load_balancer/{id}/labels", rt.SetLoadBalancerLabels)
+	rt.handle(RouteGroupApplication, http.MethodGet, "/user/{id}/application", rt.GetApplicationByUserID)
+	rt.handle(RouteGroupLoadBalancer, http.MethodGet, "/user/{id}/load_balancer", rt.GetLoadBalancerByUserID)
+	rt.handle(RouteGroupPayPlan, http.MethodGet, "/pay_plan", rt.GetPayPlans)
+	rt.handle(RouteGroupPayPlan, http.MethodPost, "/pay_plan", rt.adminScoped(rt.CreatePayPlan))
+	rt.handle(RouteGroupPayPlan, http.MethodGet, "/pay_plan/{type}", rt.GetPayPlan)
+	rt.handle(RouteGroupPayPlan, http.MethodPut, "/pay_plan/{type}", rt.adminScoped(rt.UpdatePayPlan))
+	rt.handle(RouteGroupPayPlan, http.MethodDelete, "/pay_plan/{type}", rt.adminScoped(rt.RemovePayPlan))
+	rt.handle(RouteGroupRedirect, http.MethodGet, "/redirect", rt.GetRedirects)
+	rt.handle(RouteGroupRedirect, http.MethodPost, "/redirect", rt.CreateRedirect)
+	rt.handle(RouteGroupRedirect, http.MethodPost, "/redirect/bulk", rt.CreateRedirects)
+	rt.handle(RouteGroupRedirect, http.MethodDelete, "/redirect/{id}", rt.RemoveRedirect)
+	rt.handle(RouteGroupRedirect, http.MethodGet, "/blockchain/{id}/redirect", rt.GetBlockchainRedirects)
+	rt.handle(RouteGroupChanges, http.MethodGet, "/changes", rt.GetChanges)
+	rt.handle(RouteGroupChanges, http.MethodGet, "/changes/snapshot", rt.GetChangesSnapshot)
+	rt.handle(RouteGroupAdmin, http.MethodGet, "/admin/backup/verify", rt.VerifyBackup)
+	rt.handle(RouteGroupAdmin, http.MethodGet, "/admin/instances", rt.GetInstances)
+	rt.handle(RouteGroupAdmin, http.MethodGet, "/admin/config", rt.GetConfig)
+	rt.handle(RouteGroupAdmin, http.MethodGet, "/admin/health", rt.GetHealth)
+	rt.handle(RouteGroupAdmin, http.MethodPost, "/admin/health/{integration}/enable", rt.EnableIntegration)
+	rt.handle(RouteGroupAdmin, http.MethodGet, "/admin/usage", rt.GetUsage)
+	rt.handle(RouteGroupAdmin, http.MethodPost, cacheRefreshPath, rt.RefreshCache)
+	rt.handle(RouteGroupAdmin, http.MethodDelete, "/admin/load_balancer/{id}", rt.DeleteLoadBalancer)
+	rt.handle(RouteGroupAdmin, http.MethodPost, "/admin/user/{id}/purge", rt.PurgeUser)
+	rt.handle(RouteGroupAdmin, http.MethodGet, "/admin/write_anomalies", rt.GetWriteAnomalies)
+	rt.handle(RouteGroupAdmin, http.MethodPost, "/admin/backfill/{field}", rt.StartBackfill)
+	rt.handle(RouteGroupAdmin, http.MethodGet, "/admin/backfill/{field}", rt.GetBackfillStatus)
+	rt.handle(RouteGroupAdmin, http.MethodDelete, "/admin/write_anomalies/restriction/{keyID}", rt.LiftWriteRestriction)
+	rt.handle(RouteGroupAdmin, http.MethodGet, cacheRefreshStatusPath, rt.GetCacheRefreshStatus)
+	rt.handle(RouteGroupAdmin, http.MethodPost, cacheRefreshEntityPath, rt.RefreshCacheEntity)
+	rt.handle(RouteGroupAdmin, http.MethodGet, "/filter", rt.GetApplicationFilters)
+	rt.handle(RouteGroupAdmin, http.MethodGet, "/filter/{name}", rt.GetApplicationFilter)
+	rt.handle(RouteGroupAdmin, http.MethodPut, "/filter/{name}", rt.SetApplicationFilter)
+	rt.handle(RouteGroupAdmin, http.MethodDelete, "/filter/{name}", rt.RemoveApplicationFilter)
+	rt.handle(RouteGroupApplication, http.MethodGet, "/filter/{name}/applications", rt.GetApplicationsByFilter)
+	rt.handle(RouteGroupIntegrations, http.MethodPost, billingWebhookPath, rt.BillingWebhook)
+	// POSTed queries are reads, so both methods are handled as reads of the group
+	rt.register(http.MethodGet, graphQLPath, rt.routeGroupHandler(RouteGroupGraphQL, http.MethodGet, rt.GraphQL))
+	rt.register(http.MethodPost, graphQLPath, rt.routeGroupHandler(RouteGroupGraphQL, http.MethodGet, rt.GraphQL))
+
+	for _, middleware := range rt.middlewares() {
+		rt.Router.Use(middleware)
+	}
 
 	return rt, nil
 }
 
+// middlewares returns the middlewares wrapping every route, the first one being the outermost
+func (rt *Router) middlewares() []func(http.Handler) http.Handler {
+	return []func(http.Handler) http.Handler{
+		rt.MetricsHandler,
+		rt.InstanceIDHandler,
+		rt.EnvironmentHandler,
+		rt.TracingHandler,
+		rt.AccessLogHandler,
+		rt.AuthorizationHandler,
+		rt.KeyUserHandler,
+		rt.RequestValidationHandler,
+		rt.DeprecationHandler,
+		rt.CacheGenerationHandler,
+		rt.DeadlineHandler,
+		rt.ReadOnlyHandler,
+		rt.ReplayProtectionHandler,
+		rt.WriteAnomalyHandler,
+		rt.FeaturesHandler,
+		rt.EnvelopeHandler,
+		rt.SerializationCacheHandler,
+		rt.ResponseProfileHandler,
+		rt.RedactionHandler,
+		rt.SecretsHandler,
+	}
+}
+
+// statusRecorder keeps the status code and body size written by a handler
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+	bytes  int
+}
+
+func (s *statusRecorder) WriteHeader(status int) {
+	s.status = status
+	s.ResponseWriter.WriteHeader(status)
+}
+
+func (s *statusRecorder) Write(b []byte) (int, error) {
+	n, err := s.ResponseWriter.Write(b)
+	s.bytes += n
+
+	return n, err
+}
+
+// AccessLogHandler records every request on the access log and on the usage ring, if they are configured
+func (rt *Router) AccessLogHandler(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if rt.AccessLog == nil && rt.Usage == nil {
+			h.ServeHTTP(w, r)
+
+			return
+		}
+
+		start := time.Now()
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+
+		h.ServeHTTP(recorder, r)
+
+		record := accesslog.Record{
+			Time:       start.UTC(),
+			Method:     r.Method,
+			Path:       r.URL.Path,
+			Route:      routeTemplate(r),
+			Query:      r.URL.RawQuery,
+			Status:     recorder.status,
+			Bytes:      recorder.bytes,
+			DurationMS: float64(time.Since(start).Microseconds()) / 1000,
+			KeyID:      accesslog.KeyID(r.Header.Get("Authorization")),
+			RemoteAddr: r.RemoteAddr,
+			UserAgent:  r.UserAgent(),
+		}
+
+		if span, ok := tracing.FromContext(r.Context()); ok {
+			record.TraceID = span.TraceID
+			record.Traced = span.Sampled
+		}
+
+		if rt.AccessLog != nil {
+			rt.AccessLog.Log(record)
+		}
+		if rt.Usage != nil {
+			rt.Usage.Add(record)
+		}
+	})
+}
+
 func (rt *Router) AuthorizationHandler(h http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		// This is the path of the health check endpoint
-		if r.URL.Path == "/" {
+		// These are the paths of the health check endpoint and of the signature-verified billing webhook
+		if r.URL.Path == "/" || r.URL.Path == billingWebhookPath {
 			h.ServeHTTP(w, r)
 
 			return
@@ -126,226 +390,569 @@ func (rt *Router) HealthCheck(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-func (rt *Router) GetApplications(w http.ResponseWriter, r *http.Request) {
-	jsonresponse.RespondWithJSON(w, http.StatusOK, rt.Cache.GetApplications())
+// applications returns the application service over the router dependencies
+func (rt *Router) applications() *service.ApplicationService {
+	apps := service.NewApplicationService(rt.Cache, rt.writer(), rt.log)
+
+	apps.GracePeriod = rt.GracePeriod
+	apps.RelayMeter = rt.RelayMeter
+	apps.Notifier = rt.Notifier
+	apps.Webhooks = rt.Webhooks
+	apps.PlanDeprecations = rt.PlanDeprecations
+	apps.UniqueNames = rt.UniqueApplicationNames
+	apps.Metrics = rt.Metrics
+	apps.ReadThrough = rt.ReadThroughApplications
+
+	return apps
 }
 
-func (rt *Router) GetApplicationsLimits(w http.ResponseWriter, r *http.Request) {
-	apps := rt.Cache.GetApplications()
+// loadBalancers returns the load balancer service over the router dependencies
+func (rt *Router) loadBalancers() *service.LoadBalancerService {
+	lbs := service.NewLoadBalancerService(rt.Cache, rt.writer())
 
-	var appsLimits []repository.AppLimits
+	lbs.UniqueNames = rt.UniqueLoadBalancerNames
+	lbs.UniqueStickyOrigins = rt.UniqueStickyOrigins
+	lbs.Metrics = rt.Metrics
+	lbs.Webhooks = rt.Webhooks
 
-	for _, app := range apps {
-		limits := app.Limits
+	return lbs
+}
 
-		limits.AppID = app.ID
-		limits.AppName = app.Name
-		limits.AppUserID = app.UserID
-		limits.PublicKey = app.GatewayAAT.ApplicationPublicKey
-		limits.NotificationSettings = &app.NotificationSettings
+// blockchains returns the blockchain service over the router dependencies
+func (rt *Router) blockchains() *service.BlockchainService {
+	blockchains := service.NewBlockchainService(rt.Cache, rt.writer(), rt.log)
 
-		if !app.FirstDateSurpassed.IsZero() {
-			limits.FirstDateSurpassed = &app.FirstDateSurpassed
-		}
+	blockchains.Notifier = rt.Notifier
+	blockchains.Metrics = rt.Metrics
+	blockchains.Webhooks = rt.Webhooks
+
+	return blockchains
+}
+
+// users returns the user service over the router dependencies
+func (rt *Router) users() *service.UserService {
+	users := service.NewUserService(rt.Cache, rt.writer(), rt.log)
+
+	users.Metrics = rt.Metrics
+	users.Webhooks = rt.Webhooks
+
+	return users
+}
+
+// redirects returns the redirect service over the router dependencies
+func (rt *Router) redirects() *service.RedirectService {
+	redirects := service.NewRedirectService(rt.Cache, rt.writer())
 
-		appsLimits = append(appsLimits, limits)
+	redirects.Webhooks = rt.Webhooks
+
+	return redirects
+}
+
+// serviceErrorStatus returns the HTTP status code matching an error returned by the services
+func serviceErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, service.ErrApplicationNotFound),
+		errors.Is(err, service.ErrLoadBalancerNotFound),
+		errors.Is(err, service.ErrBlockchainNotFound),
+		errors.Is(err, service.ErrPayPlanNotFound),
+		errors.Is(err, service.ErrApplicationFilterNotFound),
+		errors.Is(err, service.ErrUserNotFound),
+		errors.Is(err, service.ErrRedirectNotFound),
+		errors.Is(err, service.ErrBackfillFieldNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, service.ErrCursorExpired):
+		return http.StatusGone
+	case errors.Is(err, service.ErrInvalidNotificationSettings),
+		errors.Is(err, service.ErrInvalidBlockchainSettings):
+		return http.StatusUnprocessableEntity
+	case errors.Is(err, service.ErrInvalidAppStatus),
+		errors.Is(err, service.ErrExpiresBeforeStatus),
+		errors.Is(err, service.ErrNoApplicationIDs),
+		errors.Is(err, service.ErrMissingReason),
+		errors.Is(err, service.ErrMissingVersion),
+		errors.Is(err, service.ErrInvalidPlanType),
+		errors.Is(err, service.ErrInvalidLabels),
+		errors.Is(err, service.ErrInvalidLabelSelector),
+		errors.Is(err, service.ErrInvalidFilterName),
+		errors.Is(err, service.ErrInvalidOrphanPolicy),
+		errors.Is(err, service.ErrInvalidFilterExpression),
+		errors.Is(err, service.ErrInvalidSort),
+		errors.Is(err, service.ErrInvalidUsage),
+		errors.Is(err, service.ErrInvalidDailyLimit),
+		errors.Is(err, service.ErrNoRedirects),
+		errors.Is(err, service.ErrTooManyRedirects),
+		errors.Is(err, service.ErrTooManyIDs),
+		errors.Is(err, service.ErrInvalidRedirects),
+		errors.Is(err, service.ErrInvalidApplicationOrder),
+		errors.Is(err, service.ErrSealedSecret),
+		errors.Is(err, service.ErrInvalidCursor):
+		return http.StatusBadRequest
+	case errors.Is(err, service.ErrApplicationSuspended),
+		errors.Is(err, service.ErrApplicationActive),
+		errors.Is(err, service.ErrInvalidStatusTransition),
+		errors.Is(err, service.ErrLoadBalancerNameUsed),
+		errors.Is(err, service.ErrApplicationNameUsed),
+		errors.Is(err, types.ErrLoadBalancerAppsConflict),
+		errors.Is(err, service.ErrBlockchainReferenced),
+		errors.Is(err, service.ErrStickyOriginUsed),
+		errors.Is(err, service.ErrPayPlanExists),
+		errors.Is(err, service.ErrPayPlanInUse),
+		isUniqueViolation(err):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
 	}
+}
 
-	jsonresponse.RespondWithJSON(w, http.StatusOK, appsLimits)
+// respondWithError responds with the message and the apierrors code of status
+func respondWithError(w http.ResponseWriter, status int, message string) {
+	jsonresponse.RespondWithJSON(w, status, apierrors.New(status, message))
 }
 
-func (rt *Router) GetApplication(w http.ResponseWriter, r *http.Request) {
-	vars := mux.Vars(r)
+// respondWithServiceError logs err returned by the service called in operation and responds with its status code
+func (rt *Router) respondWithServiceError(w http.ResponseWriter, operation string, err error) {
+	rt.logError(fmt.Errorf("%s failed: %w", operation, err))
 
-	app := rt.Cache.GetApplication(vars["id"])
+	var nameConflict *service.NameConflictError
+	if errors.As(err, &nameConflict) {
+		jsonresponse.RespondWithJSON(w, http.StatusConflict, map[string]string{
+			"error":         nameConflict.Error(),
+			"code":          string(apierrors.CodeNameConflict),
+			"conflictingID": nameConflict.ConflictingID,
+		})
 
-	if app == nil {
-		jsonresponse.RespondWithError(w, http.StatusNotFound, errApplicationNotFound.Error())
 		return
 	}
 
-	jsonresponse.RespondWithJSON(w, http.StatusOK, app)
-}
+	var appNameConflict *service.ApplicationNameConflictError
+	if errors.As(err, &appNameConflict) {
+		jsonresponse.RespondWithJSON(w, http.StatusConflict, map[string]interface{}{
+			"error":         appNameConflict.Error(),
+			"code":          apierrors.CodeApplicationNameConflict,
+			"conflictingID": appNameConflict.ConflictingID,
+			"suggestions":   appNameConflict.Suggestions,
+		})
 
-func (rt *Router) CreateApplication(w http.ResponseWriter, r *http.Request) {
-	var app repository.Application
+		return
+	}
 
-	decoder := json.NewDecoder(r.Body)
+	var stickyOriginConflict *service.StickyOriginConflictError
+	if errors.As(err, &stickyOriginConflict) {
+		jsonresponse.RespondWithJSON(w, http.StatusConflict, map[string]string{
+			"error":         stickyOriginConflict.Error(),
+			"code":          string(apierrors.CodeStickyOriginConflict),
+			"origin":        stickyOriginConflict.Origin,
+			"conflictingID": stickyOriginConflict.ConflictingID,
+		})
 
-	err := decoder.Decode(&app)
-	if err != nil {
-		jsonresponse.RespondWithError(w, http.StatusBadRequest, err.Error())
 		return
 	}
 
-	defer r.Body.Close()
-	fullApp, err := rt.Writer.WriteApplication(&app)
-	if err != nil {
-		rt.logError(fmt.Errorf("WriteApplication in CreateApplication failed: %w", errApplicationNotFound))
-		jsonresponse.RespondWithError(w, http.StatusInternalServerError, err.Error())
+	var blockchainReferenced *service.BlockchainReferencedError
+	if errors.As(err, &blockchainReferenced) {
+		jsonresponse.RespondWithJSON(w, http.StatusConflict, map[string]interface{}{
+			"error":          blockchainReferenced.Error(),
+			"code":           apierrors.CodeBlockchainReferenced,
+			"redirects":      blockchainReferenced.Redirects,
+			"applicationIDs": blockchainReferenced.ApplicationIDs,
+		})
+
 		return
 	}
 
-	if fullApp.PayPlanType != "" {
-		newPlan := rt.Cache.GetPayPlan(fullApp.PayPlanType)
-		fullApp.Limits = repository.AppLimits{
-			PlanType:   newPlan.PlanType,
-			DailyLimit: newPlan.DailyLimit,
-		}
+	var notificationSettings *service.NotificationSettingsError
+	if errors.As(err, &notificationSettings) {
+		jsonresponse.RespondWithJSON(w, http.StatusUnprocessableEntity, map[string]interface{}{
+			"error":   notificationSettings.Error(),
+			"code":    apierrors.CodeUnprocessableEntity,
+			"details": notificationSettings.Details,
+		})
 
-		fullApp.PayPlanType = "" // set to empty to avoid two sources of truth
+		return
 	}
 
-	jsonresponse.RespondWithJSON(w, http.StatusOK, fullApp)
-}
+	var blockchainSettings *service.BlockchainSettingsError
+	if errors.As(err, &blockchainSettings) {
+		jsonresponse.RespondWithJSON(w, http.StatusUnprocessableEntity, map[string]interface{}{
+			"error":   blockchainSettings.Error(),
+			"code":    apierrors.CodeUnprocessableEntity,
+			"details": blockchainSettings.Details,
+		})
 
-func (rt *Router) UpdateApplication(w http.ResponseWriter, r *http.Request) {
-	vars := mux.Vars(r)
+		return
+	}
+
+	var appsConflict *types.LoadBalancerAppsConflictError
+	if errors.As(err, &appsConflict) {
+		jsonresponse.RespondWithJSON(w, http.StatusConflict, map[string]interface{}{
+			"error":          appsConflict.Error(),
+			"code":           apierrors.CodeApplicationsVersionConflict,
+			"applicationIDs": appsConflict.ApplicationIDs,
+			"version":        appsConflict.Version,
+		})
 
-	app := rt.Cache.GetApplication(vars["id"])
-	if app == nil {
-		rt.logError(fmt.Errorf("GetApplication in UpdateApplication failed: %w", errApplicationNotFound))
-		jsonresponse.RespondWithError(w, http.StatusNotFound, errApplicationNotFound.Error())
 		return
 	}
 
-	var updateInput repository.UpdateApplication
+	respondWithError(w, serviceErrorStatus(err), err.Error())
+}
+
+// isUniqueViolation returns true if err was caused by a DB unique constraint, such as the ones of pay plan types
+// and redirect domains, hit when another instance wrote the same entity since the cache was refreshed
+func isUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+
+	return errors.As(err, &pqErr) && pqErr.Code == uniqueViolationCode
+}
+
+func (rt *Router) GetApplications(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
 
-	decoder := json.NewDecoder(r.Body)
+	status := repository.AppStatus(strings.ToUpper(query.Get("status")))
+	rawExpiresBefore := query.Get("expires_before")
 
-	err := decoder.Decode(&updateInput)
+	expand, err := expandsPayPlan(r)
 	if err != nil {
-		jsonresponse.RespondWithError(w, http.StatusBadRequest, err.Error())
+		respondWithError(w, http.StatusBadRequest, err.Error())
 		return
 	}
 
-	defer r.Body.Close()
+	selectors, err := labelSelectors(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
 
-	if updateInput.Remove {
-		err = rt.Writer.RemoveApplication(vars["id"])
+	expr, err := service.ParseApplicationExpression(query.Get("filter"))
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	var planType repository.PayPlanType
+
+	if rawPlanType := query.Get("payPlan"); rawPlanType != "" {
+		planType, err = service.NormalizePlanType(rawPlanType)
 		if err != nil {
-			rt.logError(fmt.Errorf("RemoveApplication in UpdateApplication failed: %w", err))
-			jsonresponse.RespondWithError(w, http.StatusInternalServerError, err.Error())
+			respondWithError(w, http.StatusBadRequest, err.Error())
 			return
 		}
+	}
 
-		app.Status = repository.AwaitingGracePeriod
-	} else {
-		err = rt.Writer.UpdateApplication(vars["id"], &updateInput)
-		if err != nil {
-			rt.logError(fmt.Errorf("UpdateApplication failed: %w", err))
-			jsonresponse.RespondWithError(w, http.StatusInternalServerError, err.Error())
+	userID := query.Get("userID")
+
+	apps := rt.applications()
+
+	if status == "" && rawExpiresBefore == "" {
+		allApps := apps.FilterByPlanAndUser(apps.GetAll(), planType, userID)
+
+		allApps, ok := paginate(rt, w, r, service.ParseApplicationOrdering, expr.Filter(apps.FilterByLabels(allApps, selectors)))
+		if !ok || !rt.allowsListSize(w, r, len(allApps)) {
 			return
 		}
 
-		if updateInput.Name != "" {
-			app.Name = updateInput.Name
+		respondWithApplications(w, apps, allApps, expand)
+		return
+	}
+
+	if !types.ValidAppStatus(status) {
+		respondWithError(w, http.StatusBadRequest, service.ErrInvalidAppStatus.Error())
+		return
+	}
+
+	if rawExpiresBefore != "" && status == "" {
+		status = repository.AwaitingGracePeriod
+	}
+
+	if status != repository.AwaitingGracePeriod {
+		if rawExpiresBefore != "" {
+			respondWithError(w, http.StatusBadRequest, service.ErrExpiresBeforeStatus.Error())
+			return
 		}
-		if updateInput.Status != "" {
-			app.Status = updateInput.Status
+
+		appsWithStatus, err := apps.GetByStatus(status)
+		if err != nil {
+			respondWithError(w, serviceErrorStatus(err), err.Error())
+			return
 		}
-		if updateInput.PayPlanType != "" {
-			newPlan := rt.Cache.GetPayPlan(updateInput.PayPlanType)
-			app.Limits = repository.AppLimits{
-				PlanType:   newPlan.PlanType,
-				DailyLimit: newPlan.DailyLimit,
-			}
+
+		appsWithStatus = apps.FilterByPlanAndUser(appsWithStatus, planType, userID)
+
+		appsWithStatus, ok := paginate(rt, w, r, service.ParseApplicationOrdering, expr.Filter(apps.FilterByLabels(appsWithStatus, selectors)))
+		if !ok || !rt.allowsListSize(w, r, len(appsWithStatus)) {
+			return
 		}
-		if !updateInput.FirstDateSurpassed.IsZero() {
-			app.FirstDateSurpassed = updateInput.FirstDateSurpassed
+
+		respondWithApplications(w, apps, appsWithStatus, expand)
+		return
+	}
+
+	var expiresBefore time.Time
+
+	if rawExpiresBefore != "" {
+		expiresBefore, err = time.Parse(time.RFC3339, rawExpiresBefore)
+		if err != nil {
+			respondWithError(w, http.StatusBadRequest, fmt.Sprintf("invalid expires_before: %s", err))
+			return
 		}
-		if updateInput.GatewaySettings != nil {
-			app.GatewaySettings = *updateInput.GatewaySettings
+	}
+
+	gracePeriods := map[string]service.ApplicationWithGracePeriod{}
+	matchingApps := []*repository.Application{}
+
+	for _, app := range apps.GetAwaitingGracePeriod(expiresBefore) {
+		if apps.MatchLabels(app.ID, selectors) && expr.Match(app.Application) &&
+			service.MatchesPlanAndUser(app.Application, planType, userID) {
+			gracePeriods[app.ID] = app
+			matchingApps = append(matchingApps, app.Application)
 		}
-		if updateInput.NotificationSettings != nil {
-			app.NotificationSettings = *updateInput.NotificationSettings
+	}
+
+	matchingApps, ok := paginate(rt, w, r, service.ParseApplicationOrdering, matchingApps)
+	if !ok {
+		return
+	}
+
+	appsAwaitingGracePeriod := make([]service.ApplicationWithGracePeriod, 0, len(matchingApps))
+
+	for _, matchingApp := range matchingApps {
+		app := gracePeriods[matchingApp.ID]
+
+		if expand {
+			app.PayPlan = apps.PayPlan(app.Application)
 		}
+
+		appsAwaitingGracePeriod = append(appsAwaitingGracePeriod, app)
 	}
 
-	jsonresponse.RespondWithJSON(w, http.StatusOK, app)
+	if !rt.allowsListSize(w, r, len(appsAwaitingGracePeriod)) {
+		return
+	}
+
+	jsonresponse.RespondWithJSON(w, http.StatusOK, appsAwaitingGracePeriod)
 }
 
-func (rt *Router) UpdateFirstDateSurpassed(w http.ResponseWriter, r *http.Request) {
-	var updateInput repository.UpdateFirstDateSurpassed
+func (rt *Router) GetApplicationsLimits(w http.ResponseWriter, r *http.Request) {
+	limits, ok := paginate(rt, w, r, service.ParseAppLimitsOrdering, rt.applications().GetLimits())
+	if !ok {
+		return
+	}
 
-	decoder := json.NewDecoder(r.Body)
+	if notModified(w, r, service.LimitsVersion(limits)) {
+		return
+	}
+
+	jsonresponse.RespondWithJSON(w, http.StatusOK, limits)
+}
+
+func (rt *Router) GetApplication(w http.ResponseWriter, r *http.Request) {
+	id := pathParam(r, "id")
 
-	err := decoder.Decode(&updateInput)
+	expand, err := expandsPayPlan(r)
 	if err != nil {
-		rt.logError(fmt.Errorf("UpdateFirstDateSurpassed decode failed: %w", err))
-		jsonresponse.RespondWithError(w, http.StatusBadRequest, err.Error())
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	apps := rt.applications()
+
+	app, err := apps.Get(id)
+	if err != nil {
+		respondWithError(w, serviceErrorStatus(err), err.Error())
+		return
+	}
+
+	w.Header().Set("ETag", `"`+types.ApplicationVersion(app)+`"`)
+
+	respondWithApplication(w, apps, app, expand)
+}
+
+func (rt *Router) GetApplicationByAddress(w http.ResponseWriter, r *http.Request) {
+	address := pathParam(r, "address")
+
+	expand, err := expandsPayPlan(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	apps := rt.applications()
+
+	app, err := apps.GetByAddress(address)
+	if err != nil {
+		rt.respondWithServiceError(w, "GetApplicationByAddress", err)
+		return
+	}
+
+	respondWithApplication(w, apps, app, expand)
+}
+
+func (rt *Router) CreateApplication(w http.ResponseWriter, r *http.Request) {
+	var app repository.Application
+
+	err := decodeBody(r, &app)
+	if err != nil {
+		respondWithBodyError(w, err)
 		return
 	}
 
 	defer r.Body.Close()
 
-	if len(updateInput.ApplicationIDs) == 0 {
-		jsonresponse.RespondWithError(w, http.StatusBadRequest, "no application IDs on input")
+	apps := rt.applications()
+
+	warnings := apps.CreateWarnings(&app)
+
+	fullApp, err := apps.Create(r.Context(), &app)
+	if err != nil {
+		rt.respondWithServiceError(w, "WriteApplication in CreateApplication", err)
 		return
 	}
 
-	var appsToUpdate []*repository.Application
+	respondWithWarnings(w, http.StatusOK, fullApp, warnings)
+}
 
-	for _, appID := range updateInput.ApplicationIDs {
-		app := rt.Cache.GetApplication(appID)
-		if app == nil {
-			jsonresponse.RespondWithError(w, http.StatusNotFound, fmt.Sprintf("%s not found", appID))
-			return
-		}
+func (rt *Router) UpdateApplication(w http.ResponseWriter, r *http.Request) {
+	id := pathParam(r, "id")
+
+	apps := rt.applications()
 
-		appsToUpdate = append(appsToUpdate, app)
+	_, err := apps.Get(id)
+	if err != nil {
+		rt.respondWithServiceError(w, "GetApplication in UpdateApplication", err)
+		return
 	}
 
-	err = rt.Writer.UpdateFirstDateSurpassed(&updateInput)
+	var updateInput repository.UpdateApplication
+
+	err = decodeBody(r, &updateInput)
 	if err != nil {
-		rt.logError(fmt.Errorf("UpdateFirstDateSurpassed failed: %W", err))
-		jsonresponse.RespondWithError(w, http.StatusInternalServerError, err.Error())
+		respondWithBodyError(w, err)
 		return
 	}
 
-	for _, app := range appsToUpdate {
-		app.FirstDateSurpassed = updateInput.FirstDateSurpassed
+	defer r.Body.Close()
+
+	app, err := apps.Update(r.Context(), id, &updateInput)
+	if err != nil {
+		rt.respondWithServiceError(w, "UpdateApplication", err)
+		return
+	}
+
+	if updateInput.Remove {
+		jsonresponse.RespondWithJSON(w, http.StatusOK, app)
+		return
 	}
 
-	jsonresponse.RespondWithJSON(w, http.StatusOK, appsToUpdate)
+	respondWithWarnings(w, http.StatusOK, app, apps.UpdateWarnings(&updateInput))
 }
 
-func (rt *Router) GetApplicationByUserID(w http.ResponseWriter, r *http.Request) {
-	vars := mux.Vars(r)
+// RemoveApplication removes the application, which awaits its grace period before being purged,
+// as an update with the remove flag does
+func (rt *Router) RemoveApplication(w http.ResponseWriter, r *http.Request) {
+	app, err := rt.applications().Remove(r.Context(), pathParam(r, "id"))
+	if err != nil {
+		rt.respondWithServiceError(w, "RemoveApplication", err)
+		return
+	}
+
+	jsonresponse.RespondWithJSON(w, http.StatusOK, app)
+}
 
-	apps := rt.Cache.GetApplicationsByUserID(vars["id"])
+func (rt *Router) UpdateFirstDateSurpassed(w http.ResponseWriter, r *http.Request) {
+	var updateInput repository.UpdateFirstDateSurpassed
 
-	if len(apps) == 0 {
-		rt.logError(fmt.Errorf("GetLoadBalancerByUserID failed: %w", errApplicationNotFound))
-		jsonresponse.RespondWithError(w, http.StatusNotFound, errApplicationNotFound.Error())
+	err := decodeBody(r, &updateInput)
+	if err != nil {
+		rt.logError(fmt.Errorf("UpdateFirstDateSurpassed decode failed: %w", err))
+		respondWithBodyError(w, err)
+		return
+	}
+
+	defer r.Body.Close()
+
+	apps, err := rt.applications().UpdateFirstDateSurpassed(r.Context(), &updateInput)
+	if err != nil {
+		rt.respondWithServiceError(w, "UpdateFirstDateSurpassed", err)
 		return
 	}
 
 	jsonresponse.RespondWithJSON(w, http.StatusOK, apps)
 }
 
+func (rt *Router) GetApplicationByUserID(w http.ResponseWriter, r *http.Request) {
+	id := pathParam(r, "id")
+
+	expand, err := expandsPayPlan(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	selectors, err := labelSelectors(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	expr, err := service.ParseApplicationExpression(r.URL.Query().Get("filter"))
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	apps := rt.applications()
+
+	userApps, err := apps.GetByUserID(id)
+	if err != nil {
+		rt.respondWithServiceError(w, "GetApplicationByUserID", err)
+		return
+	}
+
+	userApps, ok := paginate(rt, w, r, service.ParseApplicationOrdering, expr.Filter(apps.FilterByLabels(userApps, selectors)))
+	if !ok {
+		return
+	}
+
+	respondWithApplications(w, apps, userApps, expand)
+}
+
 func (rt *Router) GetLoadBalancerByUserID(w http.ResponseWriter, r *http.Request) {
-	vars := mux.Vars(r)
+	id := pathParam(r, "id")
 
-	lbs := rt.Cache.GetLoadBalancersByUserID(vars["id"])
+	selectors, err := labelSelectors(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
 
-	if len(lbs) == 0 {
-		rt.logError(fmt.Errorf("GetLoadBalancerByUserID failed: %w", errBalancerNotFound))
-		jsonresponse.RespondWithError(w, http.StatusNotFound, errBalancerNotFound.Error())
+	expr, err := service.ParseLoadBalancerExpression(r.URL.Query().Get("filter"))
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
 		return
 	}
 
-	jsonresponse.RespondWithJSON(w, http.StatusOK, lbs)
+	lbs := rt.loadBalancers()
+
+	userLBs, err := lbs.GetByUserID(id)
+	if err != nil {
+		rt.respondWithServiceError(w, "GetLoadBalancerByUserID", err)
+		return
+	}
+
+	userLBs, ok := paginate(rt, w, r, service.ParseLoadBalancerOrdering, expr.Filter(lbs.FilterByLabels(userLBs, selectors)))
+	if !ok {
+		return
+	}
+
+	jsonresponse.RespondWithJSON(w, http.StatusOK, userLBs)
 }
 
 func (rt *Router) GetBlockchain(w http.ResponseWriter, r *http.Request) {
-	vars := mux.Vars(r)
+	id := pathParam(r, "id")
 
-	blockchain := rt.Cache.GetBlockchain(vars["id"])
-
-	if blockchain == nil {
-		rt.logError(fmt.Errorf("GetBlockchain failed: %w", errBlockchainNotFound))
-		jsonresponse.RespondWithError(w, http.StatusNotFound, errBlockchainNotFound.Error())
+	blockchain, err := rt.blockchains().Get(id)
+	if err != nil {
+		rt.respondWithServiceError(w, "GetBlockchain", err)
 		return
 	}
 
@@ -353,50 +960,66 @@ func (rt *Router) GetBlockchain(w http.ResponseWriter, r *http.Request) {
 }
 
 func (rt *Router) ActivateBlockchain(w http.ResponseWriter, r *http.Request) {
-	vars := mux.Vars(r)
-	blockchainID := vars["id"]
+	id := pathParam(r, "id")
 
 	var active bool
 
-	decoder := json.NewDecoder(r.Body)
-
-	err := decoder.Decode(&active)
+	err := decodeBody(r, &active)
 	if err != nil {
 		rt.logError(fmt.Errorf("ActivateBlockchain decode failed: %w", err))
-		jsonresponse.RespondWithError(w, http.StatusBadRequest, err.Error())
+		respondWithBodyError(w, err)
 		return
 	}
 
 	defer r.Body.Close()
 
-	err = rt.Writer.ActivateBlockchain(blockchainID, active)
+	err = rt.blockchains().Activate(r.Context(), id, active)
 	if err != nil {
-		rt.logError(fmt.Errorf("ActivateBlockchain failed: %w", err))
-		jsonresponse.RespondWithError(w, http.StatusInternalServerError, err.Error())
+		rt.respondWithServiceError(w, "ActivateBlockchain", err)
 		return
 	}
 
 	jsonresponse.RespondWithJSON(w, http.StatusOK, active)
 }
 
+// RemoveBlockchain permanently removes the blockchain, refused while it is referenced unless force=true is passed
+func (rt *Router) RemoveBlockchain(w http.ResponseWriter, r *http.Request) {
+	force := false
+
+	if rawForce := r.URL.Query().Get("force"); rawForce != "" {
+		var err error
+
+		force, err = strconv.ParseBool(rawForce)
+		if err != nil {
+			respondWithError(w, http.StatusBadRequest, "invalid force")
+			return
+		}
+	}
+
+	blockchain, err := rt.blockchains().Remove(r.Context(), pathParam(r, "id"), force)
+	if err != nil {
+		rt.respondWithServiceError(w, "RemoveBlockchain", err)
+		return
+	}
+
+	jsonresponse.RespondWithJSON(w, http.StatusOK, blockchain)
+}
+
 func (rt *Router) CreateBlockchain(w http.ResponseWriter, r *http.Request) {
 	var blockchain repository.Blockchain
 
-	decoder := json.NewDecoder(r.Body)
-
-	err := decoder.Decode(&blockchain)
+	err := decodeBody(r, &blockchain)
 	if err != nil {
 		rt.logError(fmt.Errorf("CreateBlockchain decode failed: %w", err))
-		jsonresponse.RespondWithError(w, http.StatusBadRequest, err.Error())
+		respondWithBodyError(w, err)
 		return
 	}
 
 	defer r.Body.Close()
 
-	fullBlockchain, err := rt.Writer.WriteBlockchain(&blockchain)
+	fullBlockchain, err := rt.blockchains().Create(r.Context(), &blockchain)
 	if err != nil {
-		rt.logError(fmt.Errorf("WriteBlockchain in CreateBlockchain failed: %w", err))
-		jsonresponse.RespondWithError(w, http.StatusInternalServerError, err.Error())
+		rt.respondWithServiceError(w, "WriteBlockchain in CreateBlockchain", err)
 		return
 	}
 
@@ -404,17 +1027,26 @@ func (rt *Router) CreateBlockchain(w http.ResponseWriter, r *http.Request) {
 }
 
 func (rt *Router) GetBlockchains(w http.ResponseWriter, r *http.Request) {
-	jsonresponse.RespondWithJSON(w, http.StatusOK, rt.Cache.GetBlockchains())
+	expr, err := service.ParseBlockchainExpression(r.URL.Query().Get("filter"))
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	blockchains, ok := paginate(rt, w, r, service.ParseBlockchainOrdering, expr.Filter(rt.blockchains().GetAll()))
+	if !ok {
+		return
+	}
+
+	jsonresponse.RespondWithJSON(w, http.StatusOK, blockchains)
 }
 
 func (rt *Router) GetLoadBalancer(w http.ResponseWriter, r *http.Request) {
-	vars := mux.Vars(r)
-
-	lb := rt.Cache.GetLoadBalancer(vars["id"])
+	id := pathParam(r, "id")
 
-	if lb == nil {
-		rt.logError(fmt.Errorf("GetLoadBalancer failed: %w", errBalancerNotFound))
-		jsonresponse.RespondWithError(w, http.StatusNotFound, errBalancerNotFound.Error())
+	lb, err := rt.loadBalancers().Get(id)
+	if err != nil {
+		rt.respondWithServiceError(w, "GetLoadBalancer", err)
 		return
 	}
 
@@ -424,95 +1056,109 @@ func (rt *Router) GetLoadBalancer(w http.ResponseWriter, r *http.Request) {
 func (rt *Router) CreateLoadBalancer(w http.ResponseWriter, r *http.Request) {
 	var lb repository.LoadBalancer
 
-	decoder := json.NewDecoder(r.Body)
-
-	err := decoder.Decode(&lb)
+	err := decodeBody(r, &lb)
 	if err != nil {
 		rt.logError(fmt.Errorf("CreateLoadBalancer Decode failed: %w", err))
-		jsonresponse.RespondWithError(w, http.StatusBadRequest, err.Error())
+		respondWithBodyError(w, err)
 		return
 	}
 
 	defer r.Body.Close()
 
-	fullLB, err := rt.Writer.WriteLoadBalancer(&lb)
+	lbs := rt.loadBalancers()
+
+	warnings := lbs.CreateWarnings(&lb)
+
+	fullLB, err := lbs.Create(r.Context(), &lb)
 	if err != nil {
-		rt.logError(fmt.Errorf("WriteLoadBalancer in CreateLoadBalancer failed: %w", err))
-		jsonresponse.RespondWithError(w, http.StatusInternalServerError, err.Error())
+		rt.respondWithServiceError(w, "WriteLoadBalancer in CreateLoadBalancer", err)
 		return
 	}
 
-	for _, appID := range fullLB.ApplicationIDs {
-		fullLB.Applications = append(fullLB.Applications, rt.Cache.GetApplication(appID))
-	}
-
-	fullLB.ApplicationIDs = nil // set to nil to avoid having two proofs of truth
-
-	jsonresponse.RespondWithJSON(w, http.StatusOK, fullLB)
+	respondWithWarnings(w, http.StatusOK, fullLB, warnings)
 }
 
 func (rt *Router) UpdateLoadBalancer(w http.ResponseWriter, r *http.Request) {
-	vars := mux.Vars(r)
+	id := pathParam(r, "id")
 
-	lb := rt.Cache.GetLoadBalancer(vars["id"])
-	if lb == nil {
-		rt.logError(fmt.Errorf("GetLoadBalancer in UpdateLoadBalancer failed: %w", errBalancerNotFound))
-		jsonresponse.RespondWithError(w, http.StatusNotFound, errBalancerNotFound.Error())
+	lbs := rt.loadBalancers()
+
+	_, err := lbs.Get(id)
+	if err != nil {
+		rt.respondWithServiceError(w, "GetLoadBalancer in UpdateLoadBalancer", err)
 		return
 	}
 
 	var updateInput repository.UpdateLoadBalancer
 
-	decoder := json.NewDecoder(r.Body)
-
-	err := decoder.Decode(&updateInput)
+	err = decodeBody(r, &updateInput)
 	if err != nil {
-		jsonresponse.RespondWithError(w, http.StatusBadRequest, err.Error())
+		respondWithBodyError(w, err)
 		return
 	}
 
 	defer r.Body.Close()
 
-	if updateInput.Remove {
-		err = rt.Writer.RemoveLoadBalancer(vars["id"])
-		if err != nil {
-			rt.logError(fmt.Errorf("RemoveLoadBalancer in UpdateLoadBalancer failed: %w", err))
-			jsonresponse.RespondWithError(w, http.StatusInternalServerError, err.Error())
-			return
-		}
+	lb, err := lbs.Update(r.Context(), id, &updateInput)
+	if err != nil {
+		rt.respondWithServiceError(w, "UpdateLoadBalancer", err)
+		return
+	}
 
-		lb.UserID = ""
-	} else {
-		err = rt.Writer.UpdateLoadBalancer(vars["id"], &updateInput)
-		if err != nil {
-			rt.logError(fmt.Errorf("UpdateLoadBalancer failed: %w", err))
-			jsonresponse.RespondWithError(w, http.StatusInternalServerError, err.Error())
-			return
-		}
+	jsonresponse.RespondWithJSON(w, http.StatusOK, lb)
+}
 
-		if updateInput.Name != "" {
-			lb.Name = updateInput.Name
-		}
-		if updateInput.StickyOptions != nil {
-			lb.StickyOptions = *updateInput.StickyOptions
-		}
+// RemoveLoadBalancer removes the load balancer from its user, as an update with the remove flag does
+// DELETE /admin/load_balancer/{id} deletes it permanently instead
+func (rt *Router) RemoveLoadBalancer(w http.ResponseWriter, r *http.Request) {
+	lb, err := rt.loadBalancers().Remove(r.Context(), pathParam(r, "id"))
+	if err != nil {
+		rt.respondWithServiceError(w, "RemoveLoadBalancer", err)
+		return
 	}
 
 	jsonresponse.RespondWithJSON(w, http.StatusOK, lb)
 }
 
 func (rt *Router) GetLoadBalancers(w http.ResponseWriter, r *http.Request) {
-	jsonresponse.RespondWithJSON(w, http.StatusOK, rt.Cache.GetLoadBalancers())
+	selectors, err := labelSelectors(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	expr, err := service.ParseLoadBalancerExpression(r.URL.Query().Get("filter"))
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	lbs := rt.loadBalancers()
+
+	var allLBs []*repository.LoadBalancer
+
+	if origin := r.URL.Query().Get("sticky_origin"); origin != "" {
+		allLBs = lbs.GetByStickyOrigin(origin)
+	} else {
+		allLBs = lbs.GetAll()
+	}
+
+	allLBs = lbs.FilterByUser(allLBs, r.URL.Query().Get("userID"))
+
+	allLBs, ok := paginate(rt, w, r, service.ParseLoadBalancerOrdering, expr.Filter(lbs.FilterByLabels(allLBs, selectors)))
+	if !ok || !rt.allowsListSize(w, r, len(allLBs)) {
+		return
+	}
+
+	jsonresponse.RespondWithJSON(w, http.StatusOK, allLBs)
 }
 
 func (rt *Router) GetPayPlan(w http.ResponseWriter, r *http.Request) {
-	vars := mux.Vars(r)
-
-	plan := rt.Cache.GetPayPlan(repository.PayPlanType(strings.ToUpper(vars["type"])))
+	planType := pathParam(r, "type")
 
-	if plan == nil {
-		rt.logError(fmt.Errorf("GetPayPlan failed: %w", errNoPayFound))
-		jsonresponse.RespondWithError(w, http.StatusNotFound, errNoPayFound.Error())
+	plan, err := service.NewPayPlanService(rt.Cache).Get(repository.PayPlanType(planType))
+	if err != nil {
+		rt.respondWithServiceError(w, "GetPayPlan", err)
 		return
 	}
 
@@ -520,27 +1166,64 @@ func (rt *Router) GetPayPlan(w http.ResponseWriter, r *http.Request) {
 }
 
 func (rt *Router) GetPayPlans(w http.ResponseWriter, r *http.Request) {
-	jsonresponse.RespondWithJSON(w, http.StatusOK, rt.Cache.GetPayPlans())
+	plans := service.NewPayPlanService(rt.Cache)
+
+	if rawDailyLimit := r.URL.Query().Get("daily_limit"); rawDailyLimit != "" {
+		dailyLimit, err := strconv.Atoi(rawDailyLimit)
+		if err != nil || dailyLimit < 0 {
+			respondWithError(w, http.StatusBadRequest, "invalid daily_limit")
+			return
+		}
+
+		jsonresponse.RespondWithJSON(w, http.StatusOK, plans.GetByDailyLimit(dailyLimit))
+		return
+	}
+
+	jsonresponse.RespondWithJSON(w, http.StatusOK, plans.GetAll())
+}
+
+// GetRedirects responds the redirects of every blockchain
+func (rt *Router) GetRedirects(w http.ResponseWriter, r *http.Request) {
+	jsonresponse.RespondWithJSON(w, http.StatusOK, rt.redirects().GetAll())
+}
+
+// GetBlockchainRedirects responds the redirects of the blockchain with given id
+func (rt *Router) GetBlockchainRedirects(w http.ResponseWriter, r *http.Request) {
+	redirects, err := rt.redirects().GetByBlockchain(pathParam(r, "id"))
+	if err != nil {
+		rt.respondWithServiceError(w, "GetBlockchainRedirects", err)
+		return
+	}
+
+	jsonresponse.RespondWithJSON(w, http.StatusOK, redirects)
+}
+
+// RemoveRedirect permanently removes the redirect with given id and responds it
+func (rt *Router) RemoveRedirect(w http.ResponseWriter, r *http.Request) {
+	redirect, err := rt.redirects().Remove(r.Context(), pathParam(r, "id"))
+	if err != nil {
+		rt.respondWithServiceError(w, "RemoveRedirect", err)
+		return
+	}
+
+	jsonresponse.RespondWithJSON(w, http.StatusOK, redirect)
 }
 
 func (rt *Router) CreateRedirect(w http.ResponseWriter, r *http.Request) {
 	var redirect repository.Redirect
 
-	decoder := json.NewDecoder(r.Body)
-
-	err := decoder.Decode(&redirect)
+	err := decodeBody(r, &redirect)
 	if err != nil {
 		rt.logError(fmt.Errorf("CreateRedirect decode failed: %w", err))
-		jsonresponse.RespondWithError(w, http.StatusBadRequest, err.Error())
+		respondWithBodyError(w, err)
 		return
 	}
 
 	defer r.Body.Close()
 
-	fullRedirect, err := rt.Writer.WriteRedirect(&redirect)
+	fullRedirect, err := rt.redirects().Create(r.Context(), &redirect)
 	if err != nil {
-		rt.logError(fmt.Errorf("WriteRedirect in CreateRedirect failed: %w", err))
-		jsonresponse.RespondWithError(w, http.StatusInternalServerError, err.Error())
+		rt.respondWithServiceError(w, "WriteRedirect in CreateRedirect", err)
 		return
 	}
 
@@ -2,6 +2,7 @@ package router
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"net/http"
@@ -9,77 +10,200 @@ import (
 	"testing"
 	"time"
 
+	"github.com/pokt-foundation/pocket-http-db/accesslog"
+	"github.com/pokt-foundation/pocket-http-db/apierrors"
 	"github.com/pokt-foundation/pocket-http-db/cache"
+	"github.com/pokt-foundation/pocket-http-db/notifier"
+	"github.com/pokt-foundation/pocket-http-db/relaymeter"
+	"github.com/pokt-foundation/pocket-http-db/service"
+	"github.com/pokt-foundation/pocket-http-db/types"
 	"github.com/pokt-foundation/portal-api-go/repository"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
+// writerMock does not match the context of the writes, expectations are set on the written values
 type writerMock struct {
 	mock.Mock
 }
 
-func (w *writerMock) WriteLoadBalancer(loadBalancer *repository.LoadBalancer) (*repository.LoadBalancer, error) {
+func (w *writerMock) WriteLoadBalancer(ctx context.Context, loadBalancer *repository.LoadBalancer) (*repository.LoadBalancer, error) {
 	args := w.Called()
 
 	return args.Get(0).(*repository.LoadBalancer), args.Error(1)
 }
 
-func (w *writerMock) UpdateLoadBalancer(id string, options *repository.UpdateLoadBalancer) error {
+func (w *writerMock) UpdateLoadBalancer(ctx context.Context, id string, options *repository.UpdateLoadBalancer) error {
 	args := w.Called()
 
 	return args.Error(0)
 }
 
-func (w *writerMock) RemoveLoadBalancer(id string) error {
+func (w *writerMock) RemoveLoadBalancer(ctx context.Context, id string) error {
 	args := w.Called()
 
 	return args.Error(0)
 }
 
-func (w *writerMock) WriteApplication(app *repository.Application) (*repository.Application, error) {
+func (w *writerMock) WriteApplication(ctx context.Context, app *repository.Application) (*repository.Application, error) {
 	args := w.Called()
 
 	return args.Get(0).(*repository.Application), args.Error(1)
 }
 
-func (w *writerMock) UpdateApplication(id string, options *repository.UpdateApplication) error {
+func (w *writerMock) UpdateApplication(ctx context.Context, id string, options *repository.UpdateApplication) error {
 	args := w.Called()
 
 	return args.Error(0)
 }
 
-func (w *writerMock) UpdateFirstDateSurpassed(firstDateSurpassed *repository.UpdateFirstDateSurpassed) error {
+func (w *writerMock) UpdateFirstDateSurpassed(ctx context.Context, firstDateSurpassed *repository.UpdateFirstDateSurpassed) error {
 	args := w.Called()
 
 	return args.Error(0)
 }
 
-func (w *writerMock) RemoveApplication(id string) error {
+func (w *writerMock) RemoveApplication(ctx context.Context, id string) error {
 	args := w.Called()
 
 	return args.Error(0)
 }
 
-func (w *writerMock) WriteBlockchain(blockchain *repository.Blockchain) (*repository.Blockchain, error) {
+func (w *writerMock) WriteBlockchain(ctx context.Context, blockchain *repository.Blockchain) (*repository.Blockchain, error) {
 	args := w.Called()
 
 	return args.Get(0).(*repository.Blockchain), args.Error(1)
 }
 
-func (w *writerMock) WriteRedirect(redirect *repository.Redirect) (*repository.Redirect, error) {
+func (w *writerMock) WriteRedirect(ctx context.Context, redirect *repository.Redirect) (*repository.Redirect, error) {
 	args := w.Called()
 
 	return args.Get(0).(*repository.Redirect), args.Error(1)
 }
 
-func (w *writerMock) ActivateBlockchain(id string, active bool) error {
+func (w *writerMock) UpdatePayPlanDailyLimit(ctx context.Context, planType repository.PayPlanType, dailyLimit int) error {
+	args := w.Called(planType, dailyLimit)
+
+	return args.Error(0)
+}
+
+func (w *writerMock) WriteRedirects(ctx context.Context, redirects []*repository.Redirect) ([]*repository.Redirect, error) {
+	args := w.Called(redirects)
+
+	return args.Get(0).([]*repository.Redirect), args.Error(1)
+}
+
+func (w *writerMock) ActivateBlockchain(ctx context.Context, id string, active bool) error {
+	args := w.Called()
+
+	return args.Error(0)
+}
+
+func (w *writerMock) UpdateApplicationsStatus(ctx context.Context, ids []string, status repository.AppStatus) error {
 	args := w.Called()
 
 	return args.Error(0)
 }
 
+func (w *writerMock) WriteAuditLogEntry(ctx context.Context, entry *types.AuditLogEntry) error {
+	args := w.Called(entry)
+
+	return args.Error(0)
+}
+
+func (w *writerMock) SetLoadBalancerApplications(ctx context.Context, lbID string, appIDs []string, version string) error {
+	args := w.Called(lbID, appIDs, version)
+
+	return args.Error(0)
+}
+
+func (w *writerMock) WriteLabels(ctx context.Context, entityType types.EntityType, entityID string, labels map[string]string) error {
+	args := w.Called(entityType, entityID, labels)
+
+	return args.Error(0)
+}
+
+func (w *writerMock) WriteApplicationFilter(ctx context.Context, filter *types.ApplicationFilter) error {
+	args := w.Called(filter)
+
+	return args.Error(0)
+}
+
+func (w *writerMock) RemoveApplicationFilter(ctx context.Context, name string) error {
+	args := w.Called(name)
+
+	return args.Error(0)
+}
+
+func (w *writerMock) RemoveRedirect(ctx context.Context, id string) error {
+	args := w.Called(id)
+
+	return args.Error(0)
+}
+
+func (w *writerMock) WritePayPlan(ctx context.Context, plan *repository.PayPlan) error {
+	args := w.Called(plan)
+
+	return args.Error(0)
+}
+
+func (w *writerMock) RemovePayPlan(ctx context.Context, planType repository.PayPlanType) error {
+	args := w.Called(planType)
+
+	return args.Error(0)
+}
+
+func (w *writerMock) RemoveBlockchain(ctx context.Context, id string) error {
+	args := w.Called(id)
+
+	return args.Error(0)
+}
+
+func (w *writerMock) UpdateBlockchainSettings(ctx context.Context, id string, settings *types.BlockchainSettings) error {
+	args := w.Called(id, settings)
+
+	return args.Error(0)
+}
+
+func (w *writerMock) DeleteLoadBalancer(ctx context.Context, id string, orphanAppIDs []string) ([]string, error) {
+	args := w.Called(id, orphanAppIDs)
+
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (w *writerMock) PurgeUser(ctx context.Context, purge *types.UserPurge, appIDs, lbIDs, emails []string, entry *types.AuditLogEntry) error {
+	args := w.Called(purge.UserID, appIDs, lbIDs, emails, entry)
+
+	if removed, ok := args.Get(0).(*types.UserPurge); ok {
+		purge.DeletedApplicationIDs = removed.DeletedApplicationIDs
+		purge.DeletedLoadBalancerIDs = removed.DeletedLoadBalancerIDs
+		purge.SharedContactApplicationIDs = removed.SharedContactApplicationIDs
+	}
+
+	return args.Error(1)
+}
+
+func (w *writerMock) BackfillUpdatedAt(ctx context.Context, entityType types.EntityType, ids []string) (int64, error) {
+	args := w.Called(entityType, ids)
+
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// grantAdminScope gives the admin scope to the API key of the test requests, keeping its other scopes
+func grantAdminScope(router *Router) {
+	if router.KeyScopes == nil {
+		router.KeyScopes = map[string]map[string]bool{}
+	}
+
+	keyID := accesslog.KeyID("")
+	if router.KeyScopes[keyID] == nil {
+		router.KeyScopes[keyID] = map[string]bool{}
+	}
+
+	router.KeyScopes[keyID][ScopeAdmin] = true
+}
+
 func newTestRouter() (*Router, error) {
 	readerMock := &cache.ReaderMock{}
 
@@ -96,12 +220,14 @@ func newTestRouter() (*Router, error) {
 
 	readerMock.On("ReadRedirects").Return([]*repository.Redirect{
 		{
+			ID:             "1",
 			BlockchainID:   "0021",
 			Alias:          "pokt-mainnet",
 			Domain:         "pokt-mainnet.gateway.network",
 			LoadBalancerID: "12345",
 		},
 		{
+			ID:             "2",
 			BlockchainID:   "0022",
 			Alias:          "eth-mainnet",
 			Domain:         "eth-mainnet.gateway.network",
@@ -121,8 +247,9 @@ func newTestRouter() (*Router, error) {
 			UserID: "60ecb2bf67774900350d9c43",
 		},
 		{
-			ID:     "5f62b7d8be3591c4dea8566f",
-			UserID: "60ecb2bf67774900350d9c44",
+			ID:         "5f62b7d8be3591c4dea8566f",
+			UserID:     "60ecb2bf67774900350d9c44",
+			GatewayAAT: repository.GatewayAAT{Address: "e2a7a3d0b9c2ec11d9cd6d6ab8b1b2c6b8f4a7ee"},
 		},
 	}, nil)
 
@@ -143,6 +270,10 @@ func newTestRouter() (*Router, error) {
 				"5f62b7d8be3591c4dea8566a",
 			},
 			UserID: "60ecb2bf67774900350d9c43",
+			StickyOptions: repository.StickyOptions{
+				StickyOrigins: []string{"https://app.example.com"},
+				Stickiness:    true,
+			},
 		},
 		{
 			ID: "60ecb2bf67774900350d9c43",
@@ -202,8 +333,9 @@ func TestRouter_GetApplications(t *testing.T) {
 			UserID: "60ecb2bf67774900350d9c43",
 		},
 		{
-			ID:     "5f62b7d8be3591c4dea8566f",
-			UserID: "60ecb2bf67774900350d9c44",
+			ID:         "5f62b7d8be3591c4dea8566f",
+			UserID:     "60ecb2bf67774900350d9c44",
+			GatewayAAT: repository.GatewayAAT{Address: "e2a7a3d0b9c2ec11d9cd6d6ab8b1b2c6b8f4a7ee"},
 		},
 	})
 	c.NoError(err)
@@ -219,6 +351,49 @@ func TestRouter_GetApplications(t *testing.T) {
 	c.Equal(http.StatusUnauthorized, rr.Code)
 }
 
+func TestRouter_GetApplicationsByPlanAndUser(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	getIDs := func(query string) []string {
+		req, err := http.NewRequest(http.MethodGet, "/application?"+query, nil)
+		c.NoError(err)
+
+		rr := httptest.NewRecorder()
+
+		router.Router.ServeHTTP(rr, req)
+
+		c.Equal(http.StatusOK, rr.Code)
+
+		var apps []*repository.Application
+
+		c.NoError(json.Unmarshal(rr.Body.Bytes(), &apps))
+
+		ids := []string{}
+		for _, app := range apps {
+			ids = append(ids, app.ID)
+		}
+
+		return ids
+	}
+
+	c.Equal([]string{"5f62b7d8be3591c4dea8566d", "5f62b7d8be3591c4dea8566a"}, getIDs("userID=60ecb2bf67774900350d9c43"))
+	c.Equal([]string{"5f62b7d8be3591c4dea8566d"}, getIDs("payPlan=freetier_v0"))
+	c.Equal([]string{"5f62b7d8be3591c4dea8566d"}, getIDs("payPlan=FREETIER_V0&userID=60ecb2bf67774900350d9c43"))
+	c.Empty(getIDs("payPlan=FREETIER_V0&userID=60ecb2bf67774900350d9c44"))
+
+	req, err := http.NewRequest(http.MethodGet, "/application?payPlan=free%20tier", nil)
+	c.NoError(err)
+
+	rr := httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusBadRequest, rr.Code)
+}
+
 func TestRouter_GetApplicationsLimits(t *testing.T) {
 	c := require.New(t)
 
@@ -263,6 +438,36 @@ func TestRouter_GetApplicationsLimits(t *testing.T) {
 	c.Equal(expectedBody, rr.Body.Bytes())
 }
 
+func TestRouter_GetApplicationByAddress(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	req, err := http.NewRequest(http.MethodGet, "/application/address/E2A7A3D0B9C2EC11D9CD6D6AB8B1B2C6B8F4A7EE", nil)
+	c.NoError(err)
+
+	rr := httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusOK, rr.Code)
+
+	var app repository.Application
+
+	c.NoError(json.Unmarshal(rr.Body.Bytes(), &app))
+	c.Equal("5f62b7d8be3591c4dea8566f", app.ID)
+
+	req, err = http.NewRequest(http.MethodGet, "/application/address/f3a7a3d0b9c2ec11d9cd6d6ab8b1b2c6b8f4a7ee", nil)
+	c.NoError(err)
+
+	rr = httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusNotFound, rr.Code)
+}
+
 func TestRouter_GetApplication(t *testing.T) {
 	c := require.New(t)
 
@@ -305,8 +510,9 @@ func TestRouter_CreateApplication(t *testing.T) {
 	c := require.New(t)
 
 	rawAppToSend := &repository.Application{
-		UserID:      "60ddc61b6e29c3003378361D",
-		PayPlanType: repository.FreetierV0,
+		UserID:               "60ddc61b6e29c3003378361D",
+		PayPlanType:          repository.FreetierV0,
+		NotificationSettings: repository.NotificationSettings{SignedUp: true},
 	}
 
 	appToSend, err := json.Marshal(rawAppToSend)
@@ -668,6 +874,7 @@ func TestRouter_GetBlockchains(t *testing.T) {
 			ID: "0021",
 			Redirects: []repository.Redirect{
 				{
+					ID:             "1",
 					BlockchainID:   "0021",
 					Alias:          "pokt-mainnet",
 					Domain:         "pokt-mainnet.gateway.network",
@@ -679,6 +886,7 @@ func TestRouter_GetBlockchains(t *testing.T) {
 			ID: "0022",
 			Redirects: []repository.Redirect{
 				{
+					ID:             "2",
 					BlockchainID:   "0022",
 					Alias:          "eth-mainnet",
 					Domain:         "eth-mainnet.gateway.network",
@@ -711,6 +919,7 @@ func TestRouter_GetBlockchain(t *testing.T) {
 		ID: "0021",
 		Redirects: []repository.Redirect{
 			{
+				ID:             "1",
 				BlockchainID:   "0021",
 				Alias:          "pokt-mainnet",
 				Domain:         "pokt-mainnet.gateway.network",
@@ -757,6 +966,40 @@ func TestRouter_GetLoadBalancers(t *testing.T) {
 	c.Equal("60ecb2bf67774900350d9c43", marshaledBody[1].ID)
 }
 
+func TestRouter_GetLoadBalancersByStickyOrigin(t *testing.T) {
+	c := require.New(t)
+
+	req, err := http.NewRequest(http.MethodGet, "/load_balancer?sticky_origin=HTTPS://app.example.com/", nil)
+	c.NoError(err)
+
+	rr := httptest.NewRecorder()
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusOK, rr.Code)
+
+	var marshaledBody []*repository.LoadBalancer
+
+	err = json.Unmarshal(rr.Body.Bytes(), &marshaledBody)
+	c.NoError(err)
+
+	c.Len(marshaledBody, 1)
+	c.Equal("60ecb2bf67774900350d9c42", marshaledBody[0].ID)
+
+	req, err = http.NewRequest(http.MethodGet, "/load_balancer?sticky_origin=https://other.example.com", nil)
+	c.NoError(err)
+
+	rr = httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusOK, rr.Code)
+	c.Equal("[]", rr.Body.String())
+}
+
 func TestRouter_GetLoadBalancer(t *testing.T) {
 	c := require.New(t)
 
@@ -961,6 +1204,89 @@ func TestRouter_RemoveLoadBalancer(t *testing.T) {
 	c.Equal(http.StatusNotFound, rr.Code)
 }
 
+func TestRouter_RemoveApplicationWithDelete(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	writerMock := &writerMock{}
+
+	writerMock.On("RemoveApplication").Return(nil).Once()
+
+	router.Writer = writerMock
+
+	req, err := http.NewRequest(http.MethodDelete, "/application/5f62b7d8be3591c4dea8566d", nil)
+	c.NoError(err)
+
+	rr := httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusOK, rr.Code)
+	c.Equal(repository.AwaitingGracePeriod, router.Cache.GetApplication("5f62b7d8be3591c4dea8566d").Status)
+
+	var app repository.Application
+
+	c.NoError(json.Unmarshal(rr.Body.Bytes(), &app))
+	c.Equal(repository.AwaitingGracePeriod, app.Status)
+
+	req, err = http.NewRequest(http.MethodDelete, "/application/5f62b7d8be3591c4dea85664", nil)
+	c.NoError(err)
+
+	rr = httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusNotFound, rr.Code)
+
+	writerMock.AssertExpectations(t)
+}
+
+func TestRouter_RemoveLoadBalancerWithDelete(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	writerMock := &writerMock{}
+
+	writerMock.On("RemoveLoadBalancer").Return(errors.New("dummy error")).Once()
+	writerMock.On("RemoveLoadBalancer").Return(nil).Once()
+
+	router.Writer = writerMock
+
+	serve := func(id string) *httptest.ResponseRecorder {
+		req, err := http.NewRequest(http.MethodDelete, "/load_balancer/"+id, nil)
+		c.NoError(err)
+
+		rr := httptest.NewRecorder()
+
+		router.Router.ServeHTTP(rr, req)
+
+		return rr
+	}
+
+	c.Equal(http.StatusInternalServerError, serve("60ecb2bf67774900350d9c42").Code)
+
+	rr := serve("60ecb2bf67774900350d9c42")
+	c.Equal(http.StatusOK, rr.Code)
+
+	var lb repository.LoadBalancer
+
+	c.NoError(json.Unmarshal(rr.Body.Bytes(), &lb))
+	c.Equal("60ecb2bf67774900350d9c42", lb.ID)
+	c.Empty(lb.UserID)
+
+	// the load balancer is kept without user
+	c.NotNil(router.Cache.GetLoadBalancer("60ecb2bf67774900350d9c42"))
+	c.Empty(router.Cache.GetLoadBalancersByUserID("60ecb2bf67774900350d9c43"))
+
+	c.Equal(http.StatusNotFound, serve("wrong").Code)
+
+	writerMock.AssertExpectations(t)
+}
+
 func TestRouter_GetPayPlans(t *testing.T) {
 	c := require.New(t)
 
@@ -1022,6 +1348,61 @@ func TestRouter_GetPayPlan(t *testing.T) {
 	router.Router.ServeHTTP(rr, req)
 
 	c.Equal(http.StatusNotFound, rr.Code)
+
+	req, err = http.NewRequest(http.MethodGet, "/pay_plan/%20freetier-v0", nil)
+	c.NoError(err)
+
+	rr = httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusOK, rr.Code)
+	c.Equal(expectedBody, rr.Body.Bytes())
+
+	req, err = http.NewRequest(http.MethodGet, "/pay_plan/free;tier", nil)
+	c.NoError(err)
+
+	rr = httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func TestRouter_GetPayPlansByDailyLimit(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	req, err := http.NewRequest(http.MethodGet, "/pay_plan?daily_limit=250000", nil)
+	c.NoError(err)
+
+	rr := httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusOK, rr.Code)
+	c.JSONEq(`[{"planType":"FREETIER_V0","dailyLimit":250000}]`, rr.Body.String())
+
+	req, err = http.NewRequest(http.MethodGet, "/pay_plan?daily_limit=1", nil)
+	c.NoError(err)
+
+	rr = httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusOK, rr.Code)
+	c.JSONEq(`[]`, rr.Body.String())
+
+	req, err = http.NewRequest(http.MethodGet, "/pay_plan?daily_limit=lots", nil)
+	c.NoError(err)
+
+	rr = httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusBadRequest, rr.Code)
 }
 
 func TestRouter_CreateBlockchain(t *testing.T) {
@@ -1181,3 +1562,500 @@ func TestRouter_ActivateBlockchain(t *testing.T) {
 
 	c.Equal(http.StatusInternalServerError, rr.Code)
 }
+
+func TestRouter_GetRedirects(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	tests := []struct {
+		path         string
+		expectedCode int
+		expectedIDs  []string
+	}{
+		{"/redirect", http.StatusOK, []string{"1", "2"}},
+		{"/blockchain/0022/redirect", http.StatusOK, []string{"2"}},
+		{"/blockchain/0099/redirect", http.StatusNotFound, nil},
+	}
+
+	for _, tt := range tests {
+		req, err := http.NewRequest(http.MethodGet, tt.path, nil)
+		c.NoError(err)
+
+		rr := httptest.NewRecorder()
+
+		router.Router.ServeHTTP(rr, req)
+
+		c.Equal(tt.expectedCode, rr.Code, tt.path)
+
+		if tt.expectedIDs == nil {
+			continue
+		}
+
+		var redirects []*repository.Redirect
+		c.NoError(json.Unmarshal(rr.Body.Bytes(), &redirects))
+
+		ids := []string{}
+		for _, redirect := range redirects {
+			ids = append(ids, redirect.ID)
+		}
+
+		c.Equal(tt.expectedIDs, ids, tt.path)
+	}
+}
+
+func TestRouter_RemoveRedirect(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	writerMock := &writerMock{}
+	writerMock.On("RemoveRedirect", "2").Return(errors.New("dummy error")).Once()
+	writerMock.On("RemoveRedirect", "2").Return(nil).Once()
+
+	router.Writer = writerMock
+
+	tests := []struct {
+		path         string
+		expectedCode int
+		expectedBody string
+	}{
+		{"/redirect/2", http.StatusInternalServerError, ""},
+		{"/redirect/2", http.StatusOK, `{"id":"2","blockchainID":"0022","alias":"eth-mainnet","domain":"eth-mainnet.gateway.network","loadBalancerID":"45678","createdAt":"0001-01-01T00:00:00Z","updatedAt":"0001-01-01T00:00:00Z"}`},
+		{"/redirect/2", http.StatusNotFound, ""},
+	}
+
+	for _, tt := range tests {
+		req, err := http.NewRequest(http.MethodDelete, tt.path, nil)
+		c.NoError(err)
+
+		rr := httptest.NewRecorder()
+
+		router.Router.ServeHTTP(rr, req)
+
+		c.Equal(tt.expectedCode, rr.Code, tt.path)
+
+		if tt.expectedBody != "" {
+			c.JSONEq(tt.expectedBody, rr.Body.String())
+		}
+	}
+
+	c.Empty(router.Cache.GetRedirects("0022"))
+	c.Empty(router.Cache.GetBlockchain("0022").Redirects)
+
+	writerMock.AssertExpectations(t)
+}
+
+func TestRouter_RemoveBlockchain(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	writerMock := &writerMock{}
+	writerMock.On("RemoveBlockchain", "0022").Return(nil).Once()
+
+	router.Writer = writerMock
+
+	tests := []struct {
+		path         string
+		expectedCode int
+		expectedBody string
+	}{
+		{"/blockchain/0022", http.StatusConflict, `{"error":"blockchain is referenced by redirects or application whitelists","code":"blockchain_referenced","redirects":["eth-mainnet.gateway.network"],"applicationIDs":null}`},
+		{"/blockchain/0022?force=maybe", http.StatusBadRequest, ""},
+		{"/blockchain/0022?force=true", http.StatusOK, ""},
+		{"/blockchain/0022?force=true", http.StatusNotFound, ""},
+	}
+
+	for _, tt := range tests {
+		req, err := http.NewRequest(http.MethodDelete, tt.path, nil)
+		c.NoError(err)
+
+		rr := httptest.NewRecorder()
+
+		router.Router.ServeHTTP(rr, req)
+
+		c.Equal(tt.expectedCode, rr.Code, tt.path)
+
+		if tt.expectedBody != "" {
+			c.JSONEq(tt.expectedBody, rr.Body.String())
+		}
+	}
+
+	c.Nil(router.Cache.GetBlockchain("0022"))
+
+	writerMock.AssertExpectations(t)
+}
+
+type accessLogSink struct {
+	bytes.Buffer
+}
+
+func (s *accessLogSink) Close() error {
+	return nil
+}
+
+func TestRouter_AccessLog(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	sink := &accessLogSink{}
+	router.AccessLog = accesslog.NewLogger(sink, 0, logrus.New())
+
+	req, err := http.NewRequest(http.MethodGet, "/application/5f62b7d8be3591c4dea8566d", nil)
+	c.NoError(err)
+
+	rr := httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusOK, rr.Code)
+
+	req.Header.Set("Authorization", "wrong")
+
+	rr = httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusUnauthorized, rr.Code)
+
+	c.NoError(router.AccessLog.Close())
+
+	decoder := json.NewDecoder(&sink.Buffer)
+
+	var record accesslog.Record
+
+	c.NoError(decoder.Decode(&record))
+	c.Equal("/application/{id}", record.Route)
+	c.Equal("/application/5f62b7d8be3591c4dea8566d", record.Path)
+	c.Equal(http.StatusOK, record.Status)
+
+	c.NoError(decoder.Decode(&record))
+	c.Equal(http.StatusUnauthorized, record.Status)
+	c.Equal(accesslog.KeyID("wrong"), record.KeyID)
+}
+
+func TestRouter_ApplicationNameUniqueness(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	router.UniqueApplicationNames = true
+	router.Cache.UpdateApplication("5f62b7d8be3591c4dea8566d", func(app *repository.Application) { app.Name = "pokt-app" })
+
+	appToSend, err := json.Marshal(&repository.Application{
+		Name:   "Pokt-App",
+		UserID: "60ecb2bf67774900350d9c43",
+	})
+	c.NoError(err)
+
+	req, err := http.NewRequest(http.MethodPost, "/application", bytes.NewBuffer(appToSend))
+	c.NoError(err)
+
+	rr := httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusConflict, rr.Code)
+	c.JSONEq(`{
+		"error":"application name already in use by user",
+		"code":"application_name_conflict",
+		"conflictingID":"5f62b7d8be3591c4dea8566d",
+		"suggestions":["Pokt-App 2","Pokt-App 3","Pokt-App 4"]
+	}`, rr.Body.String())
+}
+
+func TestRouter_LoadBalancerNameUniqueness(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	router.UniqueLoadBalancerNames = true
+	router.Cache.UpdateLoadBalancer("60ecb2bf67774900350d9c42", func(lb *repository.LoadBalancer) { lb.Name = "pokt-lb" })
+
+	writerMock := &writerMock{}
+
+	writerMock.On("WriteLoadBalancer", mock.Anything).Return(&repository.LoadBalancer{
+		ID:     "60ddc61b6e29c3003378361E",
+		Name:   "other-lb",
+		UserID: "60ecb2bf67774900350d9c43",
+	}, nil).Once()
+	writerMock.On("UpdateLoadBalancer", mock.Anything).Return(nil).Once()
+
+	router.Writer = writerMock
+
+	lbToSend, err := json.Marshal(&repository.LoadBalancer{
+		Name:   "POKT-LB ",
+		UserID: "60ecb2bf67774900350d9c43",
+	})
+	c.NoError(err)
+
+	req, err := http.NewRequest(http.MethodPost, "/load_balancer", bytes.NewBuffer(lbToSend))
+	c.NoError(err)
+
+	rr := httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusConflict, rr.Code)
+	c.JSONEq(`{"error":"load balancer name already in use by user","code":"name_conflict","conflictingID":"60ecb2bf67774900350d9c42"}`, rr.Body.String())
+
+	lbToSend, err = json.Marshal(&repository.LoadBalancer{
+		Name:   "pokt-lb",
+		UserID: "60ecb2bf67774900350d9c44",
+	})
+	c.NoError(err)
+
+	req, err = http.NewRequest(http.MethodPost, "/load_balancer", bytes.NewBuffer(lbToSend))
+	c.NoError(err)
+
+	rr = httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusOK, rr.Code)
+
+	updateToSend, err := json.Marshal(&repository.UpdateLoadBalancer{Name: "pokt-lb"})
+	c.NoError(err)
+
+	req, err = http.NewRequest(http.MethodPut, "/load_balancer/60ecb2bf67774900350d9c42", bytes.NewBuffer(updateToSend))
+	c.NoError(err)
+
+	rr = httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusOK, rr.Code)
+}
+
+func TestRouter_LoadBalancerStickyOriginUniqueness(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	router.UniqueStickyOrigins = service.StickyOriginsUniqueGlobal
+	router.Cache.SetLoadBalancerStickyOptions("60ecb2bf67774900350d9c42", repository.StickyOptions{
+		StickyOrigins: []string{"https://app.example.com"},
+	})
+
+	router.Writer = &writerMock{}
+
+	lbToSend, err := json.Marshal(&repository.LoadBalancer{
+		Name:          "other-lb",
+		UserID:        "60ecb2bf67774900350d9c44",
+		StickyOptions: repository.StickyOptions{StickyOrigins: []string{"https://app.example.com/"}},
+	})
+	c.NoError(err)
+
+	req, err := http.NewRequest(http.MethodPost, "/load_balancer", bytes.NewBuffer(lbToSend))
+	c.NoError(err)
+
+	rr := httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusConflict, rr.Code)
+	c.JSONEq(`{
+		"error":"sticky origin already in use by another load balancer",
+		"code":"sticky_origin_conflict",
+		"origin":"https://app.example.com/",
+		"conflictingID":"60ecb2bf67774900350d9c42"
+	}`, rr.Body.String())
+}
+
+func TestRouter_GetApplicationsAwaitingGracePeriod(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	router.GracePeriod = 24 * time.Hour
+
+	removedAt := time.Date(2022, time.July, 21, 0, 0, 0, 0, time.UTC)
+
+	app := router.Cache.UpdateApplication("5f62b7d8be3591c4dea8566a", func(app *repository.Application) {
+		app.Status = repository.AwaitingGracePeriod
+		app.UpdatedAt = removedAt
+	})
+
+	router.Cache.UpdateApplication("5f62b7d8be3591c4dea8566f", func(app *repository.Application) { app.Status = repository.InService })
+
+	req, err := http.NewRequest(http.MethodGet, "/application?status=AWAITING_GRACE_PERIOD&expires_before=2022-07-23T00:00:00Z", nil)
+	c.NoError(err)
+
+	rr := httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusOK, rr.Code)
+
+	expectedBody, err := json.Marshal([]service.ApplicationWithGracePeriod{
+		{
+			Application: app,
+			GracePeriod: service.GracePeriod{
+				StartedAt: removedAt,
+				ExpiresAt: removedAt.Add(24 * time.Hour),
+			},
+		},
+	})
+	c.NoError(err)
+
+	c.Equal(expectedBody, rr.Body.Bytes())
+
+	req, err = http.NewRequest(http.MethodGet, "/application?expires_before=2022-07-21T12:00:00Z", nil)
+	c.NoError(err)
+
+	rr = httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusOK, rr.Code)
+	c.Equal("[]", rr.Body.String())
+
+	req, err = http.NewRequest(http.MethodGet, "/application?status=in_service", nil)
+	c.NoError(err)
+
+	rr = httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusOK, rr.Code)
+	c.Contains(rr.Body.String(), "5f62b7d8be3591c4dea8566f")
+	c.NotContains(rr.Body.String(), "5f62b7d8be3591c4dea8566a")
+
+	for _, path := range []string{
+		"/application?status=wrong",
+		"/application?status=IN_SERVICE&expires_before=2022-07-23T00:00:00Z",
+		"/application?status=AWAITING_GRACE_PERIOD&expires_before=wrong",
+	} {
+		req, err = http.NewRequest(http.MethodGet, path, nil)
+		c.NoError(err)
+
+		rr = httptest.NewRecorder()
+
+		router.Router.ServeHTTP(rr, req)
+
+		c.Equal(http.StatusBadRequest, rr.Code)
+	}
+}
+
+func TestRouter_PushLimitsOnPlanChange(t *testing.T) {
+	c := require.New(t)
+
+	pushed := make(chan []repository.AppLimits, 1)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var limits []repository.AppLimits
+
+		c.NoError(json.NewDecoder(r.Body).Decode(&limits))
+
+		pushed <- limits
+	}))
+	defer server.Close()
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	router.RelayMeter = relaymeter.NewPusher(server.URL, "", time.Second, logrus.New())
+
+	writerMock := &writerMock{}
+
+	writerMock.On("UpdateApplication", mock.Anything).Return(nil)
+
+	router.Writer = writerMock
+
+	updateInputToSend, err := json.Marshal(&repository.UpdateApplication{PayPlanType: repository.PayAsYouGoV0})
+	c.NoError(err)
+
+	req, err := http.NewRequest(http.MethodPut, "/application/5f62b7d8be3591c4dea8566d", bytes.NewBuffer(updateInputToSend))
+	c.NoError(err)
+
+	rr := httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusOK, rr.Code)
+
+	limits := <-pushed
+	c.Len(limits, 1)
+	c.Equal("5f62b7d8be3591c4dea8566d", limits[0].AppID)
+	c.Equal(repository.PayAsYouGoV0, limits[0].PlanType)
+}
+
+type notifierMock struct {
+	notifications chan notifier.Notification
+}
+
+func (n *notifierMock) Notify(notification notifier.Notification) error {
+	n.notifications <- notification
+
+	return nil
+}
+
+func TestRouter_NotifyBlockchainDeactivated(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	mockNotifier := &notifierMock{notifications: make(chan notifier.Notification, 1)}
+
+	router.Notifier = notifier.NewDispatcher(nil, []notifier.Notifier{mockNotifier}, logrus.New())
+
+	writerMock := &writerMock{}
+
+	writerMock.On("ActivateBlockchain", mock.Anything).Return(nil)
+
+	router.Writer = writerMock
+
+	for _, active := range []string{"true", "false"} {
+		req, err := http.NewRequest(http.MethodPost, "/blockchain/0021/activate", bytes.NewBufferString(active))
+		c.NoError(err)
+
+		rr := httptest.NewRecorder()
+
+		router.Router.ServeHTTP(rr, req)
+
+		c.Equal(http.StatusOK, rr.Code)
+	}
+
+	notification := <-mockNotifier.notifications
+	c.Equal(notifier.EventBlockchainDeactivated, notification.Event)
+	c.Contains(notification.Text, "0021")
+	c.Empty(mockNotifier.notifications)
+}
+
+func TestRouter_ErrorCodes(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	for path, isCategory := range map[string]func(error) bool{
+		"/application/not-an-app":        apierrors.IsNotFound,
+		"/application?status=not-status": apierrors.IsBadRequest,
+	} {
+		req, err := http.NewRequest(http.MethodGet, path, nil)
+		c.NoError(err)
+
+		rr := httptest.NewRecorder()
+
+		router.Router.ServeHTTP(rr, req)
+
+		apiErr := apierrors.FromResponse(rr.Result())
+		c.True(isCategory(apiErr), path)
+
+		var body map[string]any
+
+		c.NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+		c.Equal(string(apierrors.CodeFromStatus(rr.Code)), body["code"], path)
+	}
+}
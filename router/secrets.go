@@ -0,0 +1,91 @@
+package router
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+
+	"github.com/pokt-foundation/pocket-http-db/secrets"
+)
+
+// ScopeSecrets is the scope of the API keys allowed to get the secret fields sealed at rest in clear
+const ScopeSecrets = "secrets"
+
+// SecretsHandler opens the sealed values of the JSON responses of secrets scoped keys,
+// the other keys get them sealed as they are stored
+func (rt *Router) SecretsHandler(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if rt.Secrets == nil || !rt.hasScope(r, ScopeSecrets) {
+			h.ServeHTTP(w, r)
+
+			return
+		}
+
+		rt.serveRewritten(w, r, h, rt.openSecrets)
+	})
+}
+
+// openSecrets opens every sealed string of the JSON document data
+func (rt *Router) openSecrets(data []byte) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var document any
+
+	err := decoder.Decode(&document)
+	if err != nil {
+		return nil, err
+	}
+
+	opened, err := rt.openValue(document, "", "")
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(opened)
+}
+
+// openValue opens the sealed strings of value, found at the path field of the entity with entityID
+// the values of an application are bound to the address of its AAT and their path in it
+func (rt *Router) openValue(value any, entityID, field string) (any, error) {
+	var err error
+
+	switch typed := value.(type) {
+	case string:
+		if secrets.IsSealed(typed) {
+			return rt.Secrets.Open(typed, entityID, field)
+		}
+
+		return typed, nil
+	case map[string]any:
+		if aat, ok := typed["gatewayAAT"].(map[string]any); ok {
+			entityID, _ = aat["address"].(string)
+			field = ""
+		}
+
+		for key, child := range typed {
+			childField := key
+			if field != "" {
+				childField = field + "." + key
+			}
+
+			typed[key], err = rt.openValue(child, entityID, childField)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return typed, nil
+	case []any:
+		for i, item := range typed {
+			typed[i], err = rt.openValue(item, entityID, field)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return typed, nil
+	default:
+		return value, nil
+	}
+}
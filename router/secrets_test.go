@@ -0,0 +1,68 @@
+package router
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pokt-foundation/pocket-http-db/accesslog"
+	"github.com/pokt-foundation/pocket-http-db/secrets"
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouter_Secrets(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	kms, err := secrets.NewLocalKMS(bytes.Repeat([]byte{1}, 32))
+	c.NoError(err)
+
+	router.Secrets = secrets.NewEnvelope(kms)
+	router.APIKeys["secrets_key"] = true
+	router.KeyScopes = map[string]map[string]bool{accesslog.KeyID("secrets_key"): {ScopeSecrets: true}}
+
+	sealed, err := router.Secrets.Seal("secret", "e2a7a3d0b9c2ec11d9cd6d6ab8b1b2c6b8f4a7ee", "gatewaySettings.secretKey")
+	c.NoError(err)
+
+	setSecretKey := func(id, secretKey string) {
+		router.Cache.UpdateApplication(id, func(app *repository.Application) { app.GatewaySettings.SecretKey = secretKey })
+	}
+
+	setSecretKey("5f62b7d8be3591c4dea8566f", sealed)
+
+	secretKey := func(id, apiKey string) string {
+		req, err := http.NewRequest(http.MethodGet, "/application/"+id, nil)
+		c.NoError(err)
+
+		req.Header.Set("Authorization", apiKey)
+
+		rr := httptest.NewRecorder()
+
+		router.Router.ServeHTTP(rr, req)
+
+		c.Equal(http.StatusOK, rr.Code)
+
+		var body struct {
+			GatewaySettings struct {
+				SecretKey string `json:"secretKey"`
+			} `json:"gatewaySettings"`
+		}
+
+		c.NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+
+		return body.GatewaySettings.SecretKey
+	}
+
+	c.Equal(sealed, secretKey("5f62b7d8be3591c4dea8566f", ""))
+	c.Equal("secret", secretKey("5f62b7d8be3591c4dea8566f", "secrets_key"))
+
+	// a value copied to another application is bound to its own, so it is not opened
+	setSecretKey("5f62b7d8be3591c4dea8566d", sealed)
+
+	c.Equal(sealed, secretKey("5f62b7d8be3591c4dea8566d", "secrets_key"))
+}
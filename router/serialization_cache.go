@@ -0,0 +1,171 @@
+package router
+
+import (
+	"bytes"
+	"net/http"
+	"sync"
+
+	"github.com/pokt-foundation/pocket-http-db/accesslog"
+	"github.com/pokt-foundation/pocket-http-db/cache"
+	"github.com/pokt-foundation/pocket-http-db/types"
+)
+
+// serializedRoutes are the route templates of the hottest full lists, whose serialized responses are cached
+var serializedRoutes = map[string]bool{
+	"/blockchain":  true,
+	"/pay_plan":    true,
+	"/application": true,
+}
+
+// serializedResponse is a response kept as written, valid for the version of the cache it was serialized from
+type serializedResponse struct {
+	version uint64
+	header  http.Header
+	body    []byte
+}
+
+// serializationCache holds the serialized responses by request, dropped on every change of the cache
+// its entries are bounded by the API keys and casing profiles, the lists being cached without query
+type serializationCache struct {
+	mutex     sync.RWMutex
+	version   uint64
+	responses map[string]*serializedResponse
+}
+
+func newSerializationCache() *serializationCache {
+	return &serializationCache{responses: map[string]*serializedResponse{}}
+}
+
+// get returns the response of key serialized from the current version of the cache, and that version
+func (s *serializationCache) get(key string) (*serializedResponse, uint64) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	response, ok := s.responses[key]
+	if !ok || response.version != s.version {
+		return nil, s.version
+	}
+
+	return response, s.version
+}
+
+// put keeps response for key, unless the cache changed since the version it was serialized from
+func (s *serializationCache) put(key string, response *serializedResponse) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if response.version == s.version {
+		s.responses[key] = response
+	}
+}
+
+// invalidate drops the responses serialized before a change of the cache
+func (s *serializationCache) invalidate(types.EntityType, cache.Operation, string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.version++
+	s.responses = map[string]*serializedResponse{}
+}
+
+// serializationRecorder keeps the response written by a handler, to be cached and sent
+type serializationRecorder struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (s *serializationRecorder) Header() http.Header {
+	return s.header
+}
+
+func (s *serializationRecorder) WriteHeader(status int) {
+	if s.status == 0 {
+		s.status = status
+	}
+}
+
+func (s *serializationRecorder) Write(b []byte) (int, error) {
+	s.WriteHeader(http.StatusOK)
+
+	return s.body.Write(b)
+}
+
+// write sends the response to w, adding its headers to the ones already set by the middlewares before
+func (s *serializedResponse) write(w http.ResponseWriter, status int) {
+	for name, values := range s.header {
+		w.Header()[name] = append(w.Header()[name], values...)
+	}
+
+	w.WriteHeader(status)
+
+	_, err := w.Write(s.body)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// serializationKey returns the key of the serialized response of r, false if it is not cached
+// the responses are cached by API key, the redaction and scopes of keys changing their body,
+// and by negotiated casing profile
+func (rt *Router) serializationKey(r *http.Request) (string, bool) {
+	if r.Method != http.MethodGet || !serializedRoutes[routeTemplate(r)] {
+		return "", false
+	}
+
+	for name := range r.URL.Query() {
+		if name != "all" {
+			return "", false
+		}
+	}
+
+	profile, ok := rt.responseProfile(r)
+	if !ok {
+		return "", false
+	}
+
+	return r.URL.RequestURI() + " " + accesslog.KeyID(r.Header.Get("Authorization")) + " " + string(profile), true
+}
+
+// SerializationCacheHandler serves the full lists of blockchains, pay plans and applications from their cached
+// serialized responses, if CacheSerializedReads is set, so repeated identical reads are copied rather than encoded
+// the responses are serialized again after any change of the cache
+func (rt *Router) SerializationCacheHandler(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !rt.CacheSerializedReads {
+			h.ServeHTTP(w, r)
+
+			return
+		}
+
+		key, ok := rt.serializationKey(r)
+		if !ok {
+			h.ServeHTTP(w, r)
+
+			return
+		}
+
+		response, version := rt.serializedResponses.get(key)
+		if response != nil {
+			response.write(w, http.StatusOK)
+
+			return
+		}
+
+		recorder := &serializationRecorder{header: http.Header{}}
+
+		h.ServeHTTP(recorder, r)
+
+		if recorder.status == 0 {
+			recorder.status = http.StatusOK
+		}
+
+		response = &serializedResponse{version: version, header: recorder.header, body: recorder.body.Bytes()}
+
+		if recorder.status == http.StatusOK {
+			rt.serializedResponses.put(key, response)
+		}
+
+		response.write(w, recorder.status)
+	})
+}
@@ -0,0 +1,100 @@
+package router
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouter_SerializationCacheHandler(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	router.CacheSerializedReads = true
+
+	get := func(target, profile string) *httptest.ResponseRecorder {
+		req, err := http.NewRequest(http.MethodGet, target, nil)
+		c.NoError(err)
+
+		if profile != "" {
+			req.Header.Set(ResponseProfileHeader, profile)
+		}
+
+		rr := httptest.NewRecorder()
+		router.Router.ServeHTTP(rr, req)
+
+		return rr
+	}
+
+	first := get("/pay_plan", "")
+	c.Equal(http.StatusOK, first.Code)
+	c.Len(router.serializedResponses.responses, 1)
+
+	second := get("/pay_plan", "")
+	c.Equal(http.StatusOK, second.Code)
+	c.Equal(first.Header(), second.Header())
+	c.Equal(first.Body.String(), second.Body.String())
+
+	// the cached bytes are served as they are
+	for _, response := range router.serializedResponses.responses {
+		response.body = []byte(`[]`)
+	}
+
+	c.Equal(`[]`, get("/pay_plan", "").Body.String())
+
+	// every negotiated profile has its own response
+	snake := get("/pay_plan", "snake")
+	c.Equal(http.StatusOK, snake.Code)
+	c.Contains(snake.Body.String(), `"plan_type"`)
+	c.Len(router.serializedResponses.responses, 2)
+
+	c.Contains(get("/pay_plan", "snake").Body.String(), `"plan_type"`)
+
+	// the responses are serialized again once the cache changes
+	router.Cache.AddPayPlan(&repository.PayPlan{PlanType: "ENTERPRISE_V0", DailyLimit: 1000000})
+
+	c.Empty(router.serializedResponses.responses)
+	c.Contains(get("/pay_plan", "").Body.String(), `"ENTERPRISE_V0"`)
+
+	// filtered lists, single entities and errors are not cached
+	c.Equal(http.StatusOK, get("/pay_plan?daily_limit=0", "").Code)
+	c.Equal(http.StatusOK, get("/blockchain/0021", "").Code)
+
+	router.MaxListSize = 10
+
+	c.Equal(http.StatusBadRequest, get("/application?all=wrong", "").Code)
+	c.Len(router.serializedResponses.responses, 1)
+
+	c.Equal(http.StatusOK, get("/application", "").Code)
+	c.Len(router.serializedResponses.responses, 2)
+
+	router.CacheSerializedReads = false
+
+	c.Contains(get("/pay_plan", "").Body.String(), `"ENTERPRISE_V0"`)
+}
+
+func TestSerializationCache_Versions(t *testing.T) {
+	c := require.New(t)
+
+	responses := newSerializationCache()
+
+	response, version := responses.get("key")
+	c.Nil(response)
+
+	// a response serialized while the cache changed is not kept
+	responses.invalidate("", "", "")
+	responses.put("key", &serializedResponse{version: version, body: []byte(`[]`)})
+
+	response, version = responses.get("key")
+	c.Nil(response)
+
+	responses.put("key", &serializedResponse{version: version, body: []byte(`[]`)})
+
+	response, _ = responses.get("key")
+	c.Equal([]byte(`[]`), response.body)
+}
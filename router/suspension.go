@@ -0,0 +1,65 @@
+package router
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/pokt-foundation/pocket-http-db/accesslog"
+	"github.com/pokt-foundation/pocket-http-db/service"
+	jsonresponse "github.com/pokt-foundation/utils-go/json-response"
+)
+
+// actor returns the actor of the request, defaulting to the ID of the API key used
+func actor(r *http.Request, inputActor string) string {
+	if inputActor != "" {
+		return inputActor
+	}
+
+	return fmt.Sprintf("api_key:%s", accesslog.KeyID(r.Header.Get("Authorization")))
+}
+
+func (rt *Router) SuspendApplication(w http.ResponseWriter, r *http.Request) {
+	rt.changeSuspension(w, r, true)
+}
+
+func (rt *Router) UnsuspendApplication(w http.ResponseWriter, r *http.Request) {
+	rt.changeSuspension(w, r, false)
+}
+
+func (rt *Router) changeSuspension(w http.ResponseWriter, r *http.Request, suspend bool) {
+	id := pathParam(r, "id")
+
+	apps := rt.applications()
+
+	_, err := apps.Get(id)
+	if err != nil {
+		rt.respondWithServiceError(w, "GetApplication in changeSuspension", err)
+		return
+	}
+
+	var input service.Suspension
+
+	err = decodeBody(r, &input)
+	if err != nil {
+		rt.logError(fmt.Errorf("changeSuspension decode failed: %w", err))
+		respondWithBodyError(w, err)
+		return
+	}
+
+	defer r.Body.Close()
+
+	input.Actor = actor(r, input.Actor)
+
+	changeSuspension := apps.Unsuspend
+	if suspend {
+		changeSuspension = apps.Suspend
+	}
+
+	app, err := changeSuspension(r.Context(), id, input)
+	if err != nil {
+		rt.respondWithServiceError(w, "changeSuspension", err)
+		return
+	}
+
+	jsonresponse.RespondWithJSON(w, http.StatusOK, app)
+}
@@ -0,0 +1,133 @@
+package router
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/pokt-foundation/pocket-http-db/types"
+	"github.com/pokt-foundation/pocket-http-db/webhook"
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouter_SuspendApplication(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	events := make(chan webhook.Event, 1)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var event webhook.Event
+
+		body, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(body, &event)
+
+		events <- event
+	}))
+	defer server.Close()
+
+	router.Webhooks = webhook.NewDispatcher([]string{server.URL}, "", time.Second, logrus.New())
+
+	router.Cache.UpdateApplication("5f62b7d8be3591c4dea8566d", func(app *repository.Application) { app.Status = repository.InService })
+
+	writerMock := &writerMock{}
+
+	writerMock.On("UpdateApplicationsStatus", mock.Anything).Return(nil)
+	writerMock.On("WriteAuditLogEntry", mock.MatchedBy(func(entry *types.AuditLogEntry) bool {
+		return entry.Action == types.AuditActionSuspend && entry.Actor == "abuse-team" &&
+			entry.Reason == "spam" && entry.EntityID == "5f62b7d8be3591c4dea8566d"
+	})).Return(nil).Once()
+
+	router.Writer = writerMock
+
+	req, err := http.NewRequest(http.MethodPost, "/application/5f62b7d8be3591c4dea8566d/suspend", bytes.NewBufferString(`{"reason":"spam","actor":"abuse-team"}`))
+	c.NoError(err)
+
+	rr := httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusOK, rr.Code)
+	c.Equal(types.AppStatusSuspended, router.Cache.GetApplication("5f62b7d8be3591c4dea8566d").Status)
+
+	event := <-events
+	c.Equal(webhook.EventApplicationSuspended, event.Type)
+	c.Equal("5f62b7d8be3591c4dea8566d", event.EntityID)
+
+	req, err = http.NewRequest(http.MethodGet, "/application/limits", nil)
+	c.NoError(err)
+
+	rr = httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	var limits []repository.AppLimits
+
+	c.NoError(json.Unmarshal(rr.Body.Bytes(), &limits))
+	c.Equal("5f62b7d8be3591c4dea8566d", limits[0].AppID)
+	c.Equal(repository.FreetierV0, limits[0].PlanType)
+	c.Zero(limits[0].DailyLimit)
+
+	req, err = http.NewRequest(http.MethodPost, "/application/5f62b7d8be3591c4dea8566d/suspend", bytes.NewBufferString(`{"reason":"spam"}`))
+	c.NoError(err)
+
+	rr = httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusConflict, rr.Code)
+
+	writerMock.On("WriteAuditLogEntry", mock.MatchedBy(func(entry *types.AuditLogEntry) bool {
+		return entry.Action == types.AuditActionUnsuspend && entry.Actor != ""
+	})).Return(errors.New("dummy error")).Once()
+
+	req, err = http.NewRequest(http.MethodPost, "/application/5f62b7d8be3591c4dea8566d/unsuspend", bytes.NewBufferString(`{"reason":"appeal accepted","status":"ORPHANED"}`))
+	c.NoError(err)
+
+	rr = httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusOK, rr.Code)
+	c.Equal(repository.Orphaned, router.Cache.GetApplication("5f62b7d8be3591c4dea8566d").Status)
+
+	event = <-events
+	c.Equal(webhook.EventApplicationUnsuspended, event.Type)
+
+	req, err = http.NewRequest(http.MethodPost, "/application/5f62b7d8be3591c4dea8566d/unsuspend", bytes.NewBufferString(`{"reason":"appeal accepted"}`))
+	c.NoError(err)
+
+	rr = httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusConflict, rr.Code)
+
+	req, err = http.NewRequest(http.MethodPost, "/application/5f62b7d8be3591c4dea8566d/suspend", bytes.NewBufferString(`{}`))
+	c.NoError(err)
+
+	rr = httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusBadRequest, rr.Code)
+
+	req, err = http.NewRequest(http.MethodPost, "/application/5f62b7d8be3591c4dea85664/suspend", bytes.NewBufferString(`{"reason":"spam"}`))
+	c.NoError(err)
+
+	rr = httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusNotFound, rr.Code)
+}
@@ -0,0 +1,32 @@
+package router
+
+import (
+	"net/http"
+
+	"github.com/pokt-foundation/pocket-http-db/tracing"
+)
+
+// TracingHandler continues the trace of the request traceparent header, or starts one, in a new span
+// sampled by the rate of the route class, the upstream sampled flag is not honored so the rates bound the traced volume
+// the span is set on the request context and the response traceparent header
+func (rt *Router) TracingHandler(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if rt.Tracing == nil {
+			h.ServeHTTP(w, r)
+
+			return
+		}
+
+		span := tracing.SpanContext{TraceID: tracing.NewTraceID()}
+		if parent, ok := tracing.Parse(r.Header.Get(tracing.Header)); ok {
+			span.TraceID = parent.TraceID
+		}
+
+		span.SpanID = tracing.NewSpanID()
+		span.Sampled = rt.Tracing.Sample(span.TraceID, routeTemplate(r), !isReadRequest(r))
+
+		w.Header().Set(tracing.Header, span.String())
+
+		h.ServeHTTP(w, r.WithContext(tracing.NewContext(r.Context(), span)))
+	})
+}
@@ -0,0 +1,59 @@
+package router
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/pokt-foundation/pocket-http-db/tracing"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouter_TracingHandler(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	serve := func(method, path, traceparent string) *httptest.ResponseRecorder {
+		req, err := http.NewRequest(method, path, strings.NewReader("{"))
+		c.NoError(err)
+
+		if traceparent != "" {
+			req.Header.Set(tracing.Header, traceparent)
+		}
+
+		rr := httptest.NewRecorder()
+
+		router.Router.ServeHTTP(rr, req)
+
+		return rr
+	}
+
+	c.Empty(serve(http.MethodGet, "/application", "").Header().Get(tracing.Header))
+
+	router.Tracing = tracing.NewSampler(0, 1)
+	router.Tracing.Routes = map[string]float64{"/blockchain": 1}
+
+	// writes are always sampled, the upstream trace is continued in a new span
+	parent := "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-00"
+
+	span, ok := tracing.Parse(serve(http.MethodPost, "/application", parent).Header().Get(tracing.Header))
+	c.True(ok)
+	c.Equal("4bf92f3577b34da6a3ce929d0e0e4736", span.TraceID)
+	c.NotEqual("00f067aa0ba902b7", span.SpanID)
+	c.True(span.Sampled)
+
+	// reads are never sampled, even if the upstream sampled them
+	span, ok = tracing.Parse(serve(http.MethodGet, "/application", strings.TrimSuffix(parent, "00")+"01").Header().Get(tracing.Header))
+	c.True(ok)
+	c.Equal("4bf92f3577b34da6a3ce929d0e0e4736", span.TraceID)
+	c.False(span.Sampled)
+
+	// a trace is started without valid traceparent, the route override applies
+	span, ok = tracing.Parse(serve(http.MethodGet, "/blockchain", "invalid").Header().Get(tracing.Header))
+	c.True(ok)
+	c.NotEqual("4bf92f3577b34da6a3ce929d0e0e4736", span.TraceID)
+	c.True(span.Sampled)
+}
@@ -0,0 +1,83 @@
+package router
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/pokt-foundation/pocket-http-db/accesslog"
+	jsonresponse "github.com/pokt-foundation/utils-go/json-response"
+)
+
+const (
+	// defaultUsageWindow is the window of usage reports without from
+	defaultUsageWindow = time.Hour
+	// usageTopRoutes is the number of routes reported for each consumer
+	usageTopRoutes = 5
+)
+
+var (
+	errUsageReportDisabled = errors.New("usage report not configured")
+	errInvalidUsageWindow  = errors.New("from and to must be RFC3339 times, from before to")
+)
+
+// usageReport is the usage of the API keys over a window
+// the window is only partially covered when oldestRecord is after from, older requests are no longer kept
+type usageReport struct {
+	From         time.Time                 `json:"from"`
+	To           time.Time                 `json:"to"`
+	OldestRecord time.Time                 `json:"oldestRecord"`
+	Consumers    []accesslog.ConsumerUsage `json:"consumers"`
+}
+
+func (rt *Router) GetUsage(w http.ResponseWriter, r *http.Request) {
+	if rt.Usage == nil {
+		respondWithError(w, http.StatusNotFound, errUsageReportDisabled.Error())
+		return
+	}
+
+	from, to, err := usageWindow(r, time.Now())
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	jsonresponse.RespondWithJSON(w, http.StatusOK, usageReport{
+		From:         from,
+		To:           to,
+		OldestRecord: rt.Usage.Oldest(),
+		Consumers:    rt.Usage.Usage(from, to, usageTopRoutes),
+	})
+}
+
+// usageWindow returns the window of the from and to query parameters, to defaulting to now
+// and from to the default window before to
+func usageWindow(r *http.Request, now time.Time) (time.Time, time.Time, error) {
+	query := r.URL.Query()
+
+	to := now.UTC()
+	if rawTo := query.Get("to"); rawTo != "" {
+		parsedTo, err := time.Parse(time.RFC3339, rawTo)
+		if err != nil {
+			return time.Time{}, time.Time{}, errInvalidUsageWindow
+		}
+
+		to = parsedTo.UTC()
+	}
+
+	from := to.Add(-defaultUsageWindow)
+	if rawFrom := query.Get("from"); rawFrom != "" {
+		parsedFrom, err := time.Parse(time.RFC3339, rawFrom)
+		if err != nil {
+			return time.Time{}, time.Time{}, errInvalidUsageWindow
+		}
+
+		from = parsedFrom.UTC()
+	}
+
+	if !from.Before(to) {
+		return time.Time{}, time.Time{}, errInvalidUsageWindow
+	}
+
+	return from, to, nil
+}
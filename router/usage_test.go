@@ -0,0 +1,74 @@
+package router
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/pokt-foundation/pocket-http-db/accesslog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouter_GetUsage(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	grantAdminScope(router)
+
+	req, err := http.NewRequest(http.MethodGet, "/admin/usage", nil)
+	c.NoError(err)
+
+	rr := httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusNotFound, rr.Code)
+
+	router.Usage = accesslog.NewRing(100)
+
+	for _, path := range []string{"/application/5f62b7d8be3591c4dea8566d", "/application/wrong", "/blockchain"} {
+		req, err := http.NewRequest(http.MethodGet, path, nil)
+		c.NoError(err)
+
+		router.Router.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	req, err = http.NewRequest(http.MethodGet, "/admin/usage", nil)
+	c.NoError(err)
+
+	rr = httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusOK, rr.Code)
+
+	var report usageReport
+	c.NoError(json.Unmarshal(rr.Body.Bytes(), &report))
+	c.Equal(time.Hour, report.To.Sub(report.From))
+	c.Len(report.Consumers, 1)
+	c.Equal(3, report.Consumers[0].Requests)
+	c.Equal(1, report.Consumers[0].Errors)
+	c.Equal(accesslog.RouteUsage{Route: "/application/{id}", Requests: 2}, report.Consumers[0].TopRoutes[0])
+
+	req, err = http.NewRequest(http.MethodGet, "/admin/usage?from=2022-06-01T10:00:00Z&to=2022-06-01T09:00:00Z", nil)
+	c.NoError(err)
+
+	rr = httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusBadRequest, rr.Code)
+
+	req, err = http.NewRequest(http.MethodGet, "/admin/usage?from=yesterday", nil)
+	c.NoError(err)
+
+	rr = httptest.NewRecorder()
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusBadRequest, rr.Code)
+}
@@ -0,0 +1,34 @@
+package router
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/pokt-foundation/pocket-http-db/service"
+	jsonresponse "github.com/pokt-foundation/utils-go/json-response"
+)
+
+// PurgeUser permanently deletes the applications and load balancers of the user for a data-deletion request,
+// records it in the audit log and responds with the purge report
+func (rt *Router) PurgeUser(w http.ResponseWriter, r *http.Request) {
+	var input service.UserPurgeInput
+
+	err := decodeBody(r, &input)
+	if err != nil {
+		rt.logError(fmt.Errorf("PurgeUser decode failed: %w", err))
+		respondWithBodyError(w, err)
+		return
+	}
+
+	defer r.Body.Close()
+
+	input.Actor = actor(r, input.Actor)
+
+	purge, err := rt.users().Purge(r.Context(), pathParam(r, "id"), input)
+	if err != nil {
+		rt.respondWithServiceError(w, "PurgeUser", err)
+		return
+	}
+
+	jsonresponse.RespondWithJSON(w, http.StatusOK, purge)
+}
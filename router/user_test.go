@@ -0,0 +1,72 @@
+package router
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/pokt-foundation/pocket-http-db/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouter_PurgeUser(t *testing.T) {
+	c := require.New(t)
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	writerMock := &writerMock{}
+	writerMock.On("PurgeUser", "60ecb2bf67774900350d9c43",
+		[]string{"5f62b7d8be3591c4dea8566d", "5f62b7d8be3591c4dea8566a"},
+		[]string{"60ecb2bf67774900350d9c42"}, []string(nil), mock.MatchedBy(func(entry *types.AuditLogEntry) bool {
+			return entry.EntityType == types.EntityUser && entry.Action == types.AuditActionPurge && entry.Actor == "support"
+		})).Return(&types.UserPurge{
+		DeletedApplicationIDs:  []string{"5f62b7d8be3591c4dea8566d", "5f62b7d8be3591c4dea8566a"},
+		DeletedLoadBalancerIDs: []string{"60ecb2bf67774900350d9c42"},
+	}, nil).Once()
+
+	router.Writer = writerMock
+
+	tests := []struct {
+		path         string
+		body         string
+		expectedCode int
+		expectedBody string
+	}{
+		{"/admin/user/60ecb2bf67774900350d9c43/purge", `{"reason":"data-deletion request"}`, http.StatusForbidden, ""},
+		{"/admin/user/60ecb2bf67774900350d9c43/purge", `{`, http.StatusBadRequest, ""},
+		{"/admin/user/60ecb2bf67774900350d9c43/purge", `{}`, http.StatusBadRequest, ""},
+		{"/admin/user/60ecb2bf67774900350d9c45/purge", `{"reason":"data-deletion request"}`, http.StatusNotFound, ""},
+		{
+			"/admin/user/60ecb2bf67774900350d9c43/purge", `{"reason":"data-deletion request","actor":"support"}`, http.StatusOK,
+			`{"userID":"60ecb2bf67774900350d9c43","deletedApplicationIDs":["5f62b7d8be3591c4dea8566d","5f62b7d8be3591c4dea8566a"],"deletedLoadBalancerIDs":["60ecb2bf67774900350d9c42"],"detachedApplicationIDs":[],"sharedContactApplicationIDs":[]}`,
+		},
+	}
+
+	for _, tt := range tests {
+		req, err := http.NewRequest(http.MethodPost, tt.path, strings.NewReader(tt.body))
+		c.NoError(err)
+
+		rr := httptest.NewRecorder()
+
+		router.Router.ServeHTTP(rr, req)
+
+		c.Equal(tt.expectedCode, rr.Code, tt.body)
+
+		if rr.Code == http.StatusForbidden {
+			grantAdminScope(router)
+		}
+
+		if tt.expectedBody != "" {
+			c.JSONEq(tt.expectedBody, rr.Body.String())
+		}
+	}
+
+	c.Nil(router.Cache.GetApplication("5f62b7d8be3591c4dea8566d"))
+	c.Nil(router.Cache.GetLoadBalancer("60ecb2bf67774900350d9c42"))
+	c.NotNil(router.Cache.GetLoadBalancer("60ecb2bf67774900350d9c43"))
+
+	writerMock.AssertExpectations(t)
+}
@@ -0,0 +1,55 @@
+package router
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+
+	jsonresponse "github.com/pokt-foundation/utils-go/json-response"
+)
+
+// respondWithWarnings responds with payload, adding the warnings array to it when there are any
+// warnings are non fatal issues of the request, the response is unchanged if there are none
+func respondWithWarnings(w http.ResponseWriter, code int, payload interface{}, warnings []string) {
+	if len(warnings) == 0 {
+		jsonresponse.RespondWithJSON(w, code, payload)
+		return
+	}
+
+	rawPayload, err := json.Marshal(payload)
+	if err != nil {
+		jsonresponse.RespondWithJSON(w, code, payload)
+		return
+	}
+
+	rawWarnings, err := json.Marshal(warnings)
+	if err != nil {
+		jsonresponse.RespondWithJSON(w, code, payload)
+		return
+	}
+
+	jsonresponse.RespondWithJSON(w, code, json.RawMessage(withWarnings(rawPayload, rawWarnings)))
+}
+
+// withWarnings returns the rawPayload object with the warnings field appended, keeping its fields order
+// rawPayload is returned unchanged if it is not a JSON object
+func withWarnings(rawPayload, rawWarnings []byte) []byte {
+	rawPayload = bytes.TrimSpace(rawPayload)
+	if len(rawPayload) < 2 || rawPayload[0] != '{' || rawPayload[len(rawPayload)-1] != '}' {
+		return rawPayload
+	}
+
+	var b bytes.Buffer
+
+	b.Write(rawPayload[:len(rawPayload)-1])
+
+	if len(bytes.TrimSpace(rawPayload[1:len(rawPayload)-1])) > 0 {
+		b.WriteByte(',')
+	}
+
+	b.WriteString(`"warnings":`)
+	b.Write(rawWarnings)
+	b.WriteByte('}')
+
+	return b.Bytes()
+}
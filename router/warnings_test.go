@@ -0,0 +1,95 @@
+package router
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouter_CreateApplicationWarnings(t *testing.T) {
+	c := require.New(t)
+
+	appToSend, err := json.Marshal(&repository.Application{
+		UserID:      "60ddc61b6e29c3003378361D",
+		PayPlanType: repository.FreetierV0,
+	})
+	c.NoError(err)
+
+	req, err := http.NewRequest(http.MethodPost, "/application", bytes.NewBuffer(appToSend))
+	c.NoError(err)
+
+	rr := httptest.NewRecorder()
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	router.PlanDeprecations = map[repository.PayPlanType]time.Time{
+		repository.FreetierV0: time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	writerMock := &writerMock{}
+
+	writerMock.On("WriteApplication", mock.Anything).Return(&repository.Application{
+		ID:          "60ddc61b6e29c3003378361E",
+		UserID:      "60ddc61b6e29c3003378361D",
+		PayPlanType: repository.FreetierV0,
+	}, nil).Once()
+
+	router.Writer = writerMock
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusOK, rr.Code)
+
+	var body struct {
+		ID       string   `json:"id"`
+		Warnings []string `json:"warnings"`
+	}
+
+	err = json.Unmarshal(rr.Body.Bytes(), &body)
+	c.NoError(err)
+
+	c.Equal("60ddc61b6e29c3003378361E", body.ID)
+	c.Equal([]string{
+		"pay plan FREETIER_V0 was deprecated on 2021-01-01",
+		"notification settings are empty, the user will not be notified about usage",
+	}, body.Warnings)
+}
+
+func TestRouter_UpdateLoadBalancerNoWarnings(t *testing.T) {
+	c := require.New(t)
+
+	req, err := http.NewRequest(http.MethodPut, "/load_balancer/60ecb2bf67774900350d9c42", bytes.NewBufferString(`{"name":"pokt"}`))
+	c.NoError(err)
+
+	rr := httptest.NewRecorder()
+
+	router, err := newTestRouter()
+	c.NoError(err)
+
+	writerMock := &writerMock{}
+
+	writerMock.On("UpdateLoadBalancer", mock.Anything, mock.Anything).Return(nil).Once()
+
+	router.Writer = writerMock
+
+	router.Router.ServeHTTP(rr, req)
+
+	c.Equal(http.StatusOK, rr.Code)
+	c.NotContains(rr.Body.String(), "warnings")
+}
+
+func TestWithWarnings(t *testing.T) {
+	c := require.New(t)
+
+	c.Equal(`{"id":"1","warnings":["w"]}`, string(withWarnings([]byte(`{"id":"1"}`), []byte(`["w"]`))))
+	c.Equal(`{"warnings":["w"]}`, string(withWarnings([]byte(`{}`), []byte(`["w"]`))))
+	c.Equal(`[1]`, string(withWarnings([]byte(`[1]`), []byte(`["w"]`))))
+}
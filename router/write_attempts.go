@@ -0,0 +1,201 @@
+package router
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/pokt-foundation/pocket-http-db/types"
+	"github.com/pokt-foundation/portal-api-go/repository"
+)
+
+// writeAttemptKey is the context key of the flag set once a request calls the writer
+type writeAttemptKey struct{}
+
+// withWriteAttempts returns a context flagging the writer calls made with it, and the flag
+func withWriteAttempts(ctx context.Context) (context.Context, *int32) {
+	attempted := new(int32)
+
+	return context.WithValue(ctx, writeAttemptKey{}, attempted), attempted
+}
+
+// writeAttempted reports whether the writer was called with a context of withWriteAttempts
+func writeAttempted(attempted *int32) bool {
+	return atomic.LoadInt32(attempted) == 1
+}
+
+// attemptingWriter flags every call made with a context of withWriteAttempts before passing it to the writer,
+// so a failed request is known to have possibly written
+type attemptingWriter struct {
+	Writer
+}
+
+// writer returns the writer of the services, flagging its calls
+func (rt *Router) writer() Writer {
+	if rt.Writer == nil {
+		return nil
+	}
+
+	return &attemptingWriter{Writer: rt.Writer}
+}
+
+func (w *attemptingWriter) attempt(ctx context.Context) {
+	if attempted, ok := ctx.Value(writeAttemptKey{}).(*int32); ok {
+		atomic.StoreInt32(attempted, 1)
+	}
+}
+
+func (w *attemptingWriter) WriteLoadBalancer(ctx context.Context, loadBalancer *repository.LoadBalancer) (*repository.LoadBalancer, error) {
+	w.attempt(ctx)
+
+	return w.Writer.WriteLoadBalancer(ctx, loadBalancer)
+}
+
+func (w *attemptingWriter) UpdateLoadBalancer(ctx context.Context, id string, options *repository.UpdateLoadBalancer) error {
+	w.attempt(ctx)
+
+	return w.Writer.UpdateLoadBalancer(ctx, id, options)
+}
+
+func (w *attemptingWriter) RemoveLoadBalancer(ctx context.Context, id string) error {
+	w.attempt(ctx)
+
+	return w.Writer.RemoveLoadBalancer(ctx, id)
+}
+
+func (w *attemptingWriter) WriteApplication(ctx context.Context, app *repository.Application) (*repository.Application, error) {
+	w.attempt(ctx)
+
+	return w.Writer.WriteApplication(ctx, app)
+}
+
+func (w *attemptingWriter) UpdateApplication(ctx context.Context, id string, options *repository.UpdateApplication) error {
+	w.attempt(ctx)
+
+	return w.Writer.UpdateApplication(ctx, id, options)
+}
+
+func (w *attemptingWriter) UpdateFirstDateSurpassed(ctx context.Context, firstDateSurpassed *repository.UpdateFirstDateSurpassed) error {
+	w.attempt(ctx)
+
+	return w.Writer.UpdateFirstDateSurpassed(ctx, firstDateSurpassed)
+}
+
+func (w *attemptingWriter) RemoveApplication(ctx context.Context, id string) error {
+	w.attempt(ctx)
+
+	return w.Writer.RemoveApplication(ctx, id)
+}
+
+func (w *attemptingWriter) WriteBlockchain(ctx context.Context, blockchain *repository.Blockchain) (*repository.Blockchain, error) {
+	w.attempt(ctx)
+
+	return w.Writer.WriteBlockchain(ctx, blockchain)
+}
+
+func (w *attemptingWriter) WriteRedirect(ctx context.Context, redirect *repository.Redirect) (*repository.Redirect, error) {
+	w.attempt(ctx)
+
+	return w.Writer.WriteRedirect(ctx, redirect)
+}
+
+func (w *attemptingWriter) WriteRedirects(ctx context.Context, redirects []*repository.Redirect) ([]*repository.Redirect, error) {
+	w.attempt(ctx)
+
+	return w.Writer.WriteRedirects(ctx, redirects)
+}
+
+func (w *attemptingWriter) RemoveRedirect(ctx context.Context, id string) error {
+	w.attempt(ctx)
+
+	return w.Writer.RemoveRedirect(ctx, id)
+}
+
+func (w *attemptingWriter) WritePayPlan(ctx context.Context, plan *repository.PayPlan) error {
+	w.attempt(ctx)
+
+	return w.Writer.WritePayPlan(ctx, plan)
+}
+
+func (w *attemptingWriter) UpdatePayPlanDailyLimit(ctx context.Context, planType repository.PayPlanType, dailyLimit int) error {
+	w.attempt(ctx)
+
+	return w.Writer.UpdatePayPlanDailyLimit(ctx, planType, dailyLimit)
+}
+
+func (w *attemptingWriter) RemovePayPlan(ctx context.Context, planType repository.PayPlanType) error {
+	w.attempt(ctx)
+
+	return w.Writer.RemovePayPlan(ctx, planType)
+}
+
+func (w *attemptingWriter) ActivateBlockchain(ctx context.Context, id string, active bool) error {
+	w.attempt(ctx)
+
+	return w.Writer.ActivateBlockchain(ctx, id, active)
+}
+
+func (w *attemptingWriter) UpdateApplicationsStatus(ctx context.Context, ids []string, status repository.AppStatus) error {
+	w.attempt(ctx)
+
+	return w.Writer.UpdateApplicationsStatus(ctx, ids, status)
+}
+
+func (w *attemptingWriter) WriteAuditLogEntry(ctx context.Context, entry *types.AuditLogEntry) error {
+	w.attempt(ctx)
+
+	return w.Writer.WriteAuditLogEntry(ctx, entry)
+}
+
+func (w *attemptingWriter) SetLoadBalancerApplications(ctx context.Context, lbID string, appIDs []string, version string) error {
+	w.attempt(ctx)
+
+	return w.Writer.SetLoadBalancerApplications(ctx, lbID, appIDs, version)
+}
+
+func (w *attemptingWriter) WriteLabels(ctx context.Context, entityType types.EntityType, entityID string, labels map[string]string) error {
+	w.attempt(ctx)
+
+	return w.Writer.WriteLabels(ctx, entityType, entityID, labels)
+}
+
+func (w *attemptingWriter) WriteApplicationFilter(ctx context.Context, filter *types.ApplicationFilter) error {
+	w.attempt(ctx)
+
+	return w.Writer.WriteApplicationFilter(ctx, filter)
+}
+
+func (w *attemptingWriter) RemoveApplicationFilter(ctx context.Context, name string) error {
+	w.attempt(ctx)
+
+	return w.Writer.RemoveApplicationFilter(ctx, name)
+}
+
+func (w *attemptingWriter) RemoveBlockchain(ctx context.Context, id string) error {
+	w.attempt(ctx)
+
+	return w.Writer.RemoveBlockchain(ctx, id)
+}
+
+func (w *attemptingWriter) UpdateBlockchainSettings(ctx context.Context, id string, settings *types.BlockchainSettings) error {
+	w.attempt(ctx)
+
+	return w.Writer.UpdateBlockchainSettings(ctx, id, settings)
+}
+
+func (w *attemptingWriter) DeleteLoadBalancer(ctx context.Context, id string, orphanAppIDs []string) ([]string, error) {
+	w.attempt(ctx)
+
+	return w.Writer.DeleteLoadBalancer(ctx, id, orphanAppIDs)
+}
+
+func (w *attemptingWriter) PurgeUser(ctx context.Context, purge *types.UserPurge, appIDs, lbIDs, emails []string, entry *types.AuditLogEntry) error {
+	w.attempt(ctx)
+
+	return w.Writer.PurgeUser(ctx, purge, appIDs, lbIDs, emails, entry)
+}
+
+func (w *attemptingWriter) BackfillUpdatedAt(ctx context.Context, entityType types.EntityType, ids []string) (int64, error) {
+	w.attempt(ctx)
+
+	return w.Writer.BackfillUpdatedAt(ctx, entityType, ids)
+}
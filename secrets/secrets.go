@@ -0,0 +1,243 @@
+// Package secrets seals secret fields with envelope encryption, so a leaked database dump does not expose them
+// every value is encrypted with its own data key, which is wrapped by a pluggable key management service
+package secrets
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/pokt-foundation/pocket-http-db/health"
+)
+
+// sealedPrefix marks sealed values, followed by the wrapped data key and the ciphertext
+const sealedPrefix = "sealed:v1:"
+
+const dataKeySize = 32
+
+// maxCachedKeys bounds the unwrapped data keys kept by an Envelope, one per sealed value opened
+const maxCachedKeys = 10000
+
+var (
+	// ErrInvalidMasterKey error when the master key of LocalKMS is not an AES-256 key
+	ErrInvalidMasterKey = errors.New("master key must be 32 bytes")
+	// ErrMalformedValue error when a sealed value cannot be parsed
+	ErrMalformedValue = errors.New("malformed sealed value")
+	// ErrAlreadySealed error when the value to seal is already sealed, it could have been copied from another field
+	ErrAlreadySealed = errors.New("value is already sealed")
+)
+
+// KMS wraps and unwraps the data keys of sealed values, such as a cloud key management service
+type KMS interface {
+	WrapKey(dataKey []byte) ([]byte, error)
+	UnwrapKey(wrappedKey []byte) ([]byte, error)
+}
+
+// LocalKMS wraps data keys with a master key held by the service
+type LocalKMS struct {
+	aead cipher.AEAD
+}
+
+// NewLocalKMS returns LocalKMS instance wrapping with the AES-256 masterKey
+func NewLocalKMS(masterKey []byte) (*LocalKMS, error) {
+	if len(masterKey) != dataKeySize {
+		return nil, ErrInvalidMasterKey
+	}
+
+	aead, err := newAEAD(masterKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LocalKMS{aead: aead}, nil
+}
+
+// WrapKey encrypts dataKey with the master key
+func (k *LocalKMS) WrapKey(dataKey []byte) ([]byte, error) {
+	return seal(k.aead, dataKey, nil)
+}
+
+// UnwrapKey decrypts a data key wrapped by WrapKey
+func (k *LocalKMS) UnwrapKey(wrappedKey []byte) ([]byte, error) {
+	return open(k.aead, wrappedKey, nil)
+}
+
+// Envelope seals and opens values with data keys wrapped by its KMS
+// the unwrapped data keys are cached, so a value read again does not call the KMS
+type Envelope struct {
+	kms KMS
+	// Probe records the outcome of the calls to the KMS, nil records nothing
+	Probe *health.Probe
+
+	keys      map[string]cipher.AEAD
+	keysMutex sync.Mutex
+}
+
+// NewEnvelope returns Envelope instance wrapping data keys with kms
+func NewEnvelope(kms KMS) *Envelope {
+	return &Envelope{
+		kms:  kms,
+		keys: map[string]cipher.AEAD{},
+	}
+}
+
+// IsSealed returns true if value was sealed by an Envelope
+func IsSealed(value string) bool {
+	return strings.HasPrefix(value, sealedPrefix)
+}
+
+// Seal encrypts value with a new data key, bound to the entity with entityID and its field holding value,
+// so it cannot be opened once moved to another entity or field
+// empty values are returned as they are, already sealed values are rejected
+func (e *Envelope) Seal(value, entityID, field string) (string, error) {
+	if value == "" {
+		return value, nil
+	}
+
+	if IsSealed(value) {
+		return "", ErrAlreadySealed
+	}
+
+	dataKey := make([]byte, dataKeySize)
+
+	_, err := io.ReadFull(rand.Reader, dataKey)
+	if err != nil {
+		return "", err
+	}
+
+	wrappedKey, err := e.kms.WrapKey(dataKey)
+	e.Probe.Record(err)
+	if err != nil {
+		return "", fmt.Errorf("wrap data key failed: %w", err)
+	}
+
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return "", err
+	}
+
+	ciphertext, err := seal(aead, []byte(value), additionalData(entityID, field))
+	if err != nil {
+		return "", err
+	}
+
+	return sealedPrefix + base64.StdEncoding.EncodeToString(wrappedKey) + ":" +
+		base64.StdEncoding.EncodeToString(ciphertext), nil
+}
+
+// Open decrypts a value sealed by Seal for the same entityID and field, values not sealed are passed through
+// as they are so the secrets stored before sealing was enabled keep working
+func (e *Envelope) Open(value, entityID, field string) (string, error) {
+	if !IsSealed(value) {
+		return value, nil
+	}
+
+	rawKey, rawCiphertext, ok := strings.Cut(strings.TrimPrefix(value, sealedPrefix), ":")
+	if !ok {
+		return "", ErrMalformedValue
+	}
+
+	wrappedKey, err := base64.StdEncoding.DecodeString(rawKey)
+	if err != nil {
+		return "", ErrMalformedValue
+	}
+
+	ciphertext, err := base64.StdEncoding.DecodeString(rawCiphertext)
+	if err != nil {
+		return "", ErrMalformedValue
+	}
+
+	aead, err := e.dataKey(wrappedKey)
+	if err != nil {
+		return "", err
+	}
+
+	plaintext, err := open(aead, ciphertext, additionalData(entityID, field))
+	if err != nil {
+		return "", err
+	}
+
+	return string(plaintext), nil
+}
+
+// dataKey returns the cipher of the data key wrapped in wrappedKey, unwrapping it only if it is not cached
+// any cached key is dropped when the cache is full, as every sealed value has its own data key
+func (e *Envelope) dataKey(wrappedKey []byte) (cipher.AEAD, error) {
+	e.keysMutex.Lock()
+	aead, ok := e.keys[string(wrappedKey)]
+	e.keysMutex.Unlock()
+
+	if ok {
+		return aead, nil
+	}
+
+	dataKey, err := e.kms.UnwrapKey(wrappedKey)
+	e.Probe.Record(err)
+	if err != nil {
+		return nil, fmt.Errorf("unwrap data key failed: %w", err)
+	}
+
+	aead, err = newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	e.keysMutex.Lock()
+	defer e.keysMutex.Unlock()
+
+	if len(e.keys) >= maxCachedKeys {
+		for cached := range e.keys {
+			delete(e.keys, cached)
+
+			break
+		}
+	}
+
+	e.keys[string(wrappedKey)] = aead
+
+	return aead, nil
+}
+
+// additionalData returns the data a sealed value is bound to, the field is a path such as gatewaySettings.secretKey
+// and cannot hold the separator, so distinct entities and fields never share it
+func additionalData(entityID, field string) []byte {
+	return []byte(field + "\x00" + entityID)
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// seal encrypts plaintext with aead, authenticating additionalData, prefixing the random nonce
+func seal(aead cipher.AEAD, plaintext, additionalData []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize())
+
+	_, err := io.ReadFull(rand.Reader, nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return aead.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+// open decrypts a ciphertext returned by seal with the same additionalData
+func open(aead cipher.AEAD, ciphertext, additionalData []byte) ([]byte, error) {
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, ErrMalformedValue
+	}
+
+	nonce, sealed := ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():]
+
+	return aead.Open(nil, nonce, sealed, additionalData)
+}
@@ -0,0 +1,113 @@
+package secrets
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/pokt-foundation/pocket-http-db/health"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvelope_SealOpen(t *testing.T) {
+	c := require.New(t)
+
+	_, err := NewLocalKMS([]byte("short"))
+	c.ErrorIs(err, ErrInvalidMasterKey)
+
+	kms, err := NewLocalKMS(bytes.Repeat([]byte{1}, 32))
+	c.NoError(err)
+
+	envelope := NewEnvelope(kms)
+
+	sealed, err := envelope.Seal("4f9b7c0e-secret", "address", "gatewaySettings.secretKey")
+	c.NoError(err)
+	c.True(IsSealed(sealed))
+	c.NotContains(sealed, "4f9b7c0e-secret")
+
+	// every value has its own data key
+	sealedAgain, err := envelope.Seal("4f9b7c0e-secret", "address", "gatewaySettings.secretKey")
+	c.NoError(err)
+	c.NotEqual(sealed, sealedAgain)
+
+	_, err = envelope.Seal(sealed, "address", "gatewaySettings.secretKey")
+	c.ErrorIs(err, ErrAlreadySealed)
+
+	opened, err := envelope.Open(sealed, "address", "gatewaySettings.secretKey")
+	c.NoError(err)
+	c.Equal("4f9b7c0e-secret", opened)
+
+	// a value moved to another entity or field cannot be opened
+	_, err = envelope.Open(sealed, "other_address", "gatewaySettings.secretKey")
+	c.Error(err)
+
+	_, err = envelope.Open(sealed, "address", "gatewayAAT.privateKey")
+	c.Error(err)
+
+	// values stored before sealing was enabled pass through
+	opened, err = envelope.Open("plain-secret", "address", "gatewaySettings.secretKey")
+	c.NoError(err)
+	c.Equal("plain-secret", opened)
+
+	empty, err := envelope.Seal("", "address", "gatewaySettings.secretKey")
+	c.NoError(err)
+	c.Empty(empty)
+
+	_, err = envelope.Open(sealedPrefix+"not-base64", "address", "gatewaySettings.secretKey")
+	c.ErrorIs(err, ErrMalformedValue)
+
+	otherKMS, err := NewLocalKMS(bytes.Repeat([]byte{2}, 32))
+	c.NoError(err)
+
+	_, err = NewEnvelope(otherKMS).Open(sealed, "address", "gatewaySettings.secretKey")
+	c.Error(err)
+
+	failing := NewEnvelope(failingKMS{})
+	failing.Probe = health.NewRegistry().Probe("kms")
+
+	_, err = failing.Seal("secret", "address", "gatewaySettings.secretKey")
+	c.ErrorContains(err, "wrap data key failed")
+	c.Equal(health.StateFailing, failing.Probe.Status().State)
+}
+
+func TestEnvelope_OpenCachesDataKeys(t *testing.T) {
+	c := require.New(t)
+
+	kms, err := NewLocalKMS(bytes.Repeat([]byte{1}, 32))
+	c.NoError(err)
+
+	counting := &countingKMS{KMS: kms}
+	envelope := NewEnvelope(counting)
+
+	sealed, err := envelope.Seal("4f9b7c0e-secret", "address", "gatewaySettings.secretKey")
+	c.NoError(err)
+
+	for i := 0; i < 3; i++ {
+		opened, err := envelope.Open(sealed, "address", "gatewaySettings.secretKey")
+		c.NoError(err)
+		c.Equal("4f9b7c0e-secret", opened)
+	}
+
+	c.Equal(1, counting.unwraps)
+}
+
+type countingKMS struct {
+	KMS
+	unwraps int
+}
+
+func (k *countingKMS) UnwrapKey(wrappedKey []byte) ([]byte, error) {
+	k.unwraps++
+
+	return k.KMS.UnwrapKey(wrappedKey)
+}
+
+type failingKMS struct{}
+
+func (failingKMS) WrapKey(dataKey []byte) ([]byte, error) {
+	return nil, errors.New("kms unavailable")
+}
+
+func (failingKMS) UnwrapKey(wrappedKey []byte) ([]byte, error) {
+	return nil, errors.New("kms unavailable")
+}
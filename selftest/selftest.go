@@ -0,0 +1,164 @@
+// Package selftest runs the pre-flight checks of a deployment against its database
+package selftest
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/pokt-foundation/pocket-http-db/cache"
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/sirupsen/logrus"
+)
+
+// Target represents the database the checks are run against
+type Target interface {
+	cache.Reader
+	Ping() error
+	CheckWrite() error
+}
+
+// Check holds the outcome of a single check
+type Check struct {
+	Name     string
+	Detail   string
+	Duration time.Duration
+	Err      error
+	Skipped  bool
+}
+
+// Report holds the outcome of the checks, in the order they were run
+type Report struct {
+	Checks []Check
+}
+
+// Failed returns true if any check failed or was skipped
+func (r *Report) Failed() bool {
+	for _, check := range r.Checks {
+		if check.Err != nil || check.Skipped {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Write writes the report to w, one line per check
+func (r *Report) Write(w io.Writer) error {
+	for _, check := range r.Checks {
+		var line string
+
+		switch {
+		case check.Skipped:
+			line = fmt.Sprintf("SKIP %s", check.Name)
+		case check.Err != nil:
+			line = fmt.Sprintf("FAIL %s (%s): %s", check.Name, check.Duration, check.Err)
+		default:
+			line = fmt.Sprintf("PASS %s (%s)", check.Name, check.Duration)
+		}
+
+		if check.Detail != "" {
+			line += " - " + check.Detail
+		}
+
+		_, err := fmt.Fprintln(w, line)
+		if err != nil {
+			return err
+		}
+	}
+
+	result := "PASSED"
+	if r.Failed() {
+		result = "FAILED"
+	}
+
+	_, err := fmt.Fprintf(w, "self test %s\n", result)
+
+	return err
+}
+
+// sampleReader reads through a Reader keeping at most size applications and load balancers
+// the rows are still read in full, only the sample is loaded in the cache
+type sampleReader struct {
+	cache.Reader
+	size int
+	// read and sampled counts, by entity
+	applications, sampledApplications int
+	lbs, sampledLBs                   int
+}
+
+func (s *sampleReader) ReadApplications() ([]*repository.Application, error) {
+	apps, err := s.Reader.ReadApplications()
+	if err != nil {
+		return nil, err
+	}
+
+	s.applications = len(apps)
+
+	if len(apps) > s.size {
+		apps = apps[:s.size]
+	}
+
+	s.sampledApplications = len(apps)
+
+	return apps, nil
+}
+
+func (s *sampleReader) ReadLoadBalancers() ([]*repository.LoadBalancer, error) {
+	lbs, err := s.Reader.ReadLoadBalancers()
+	if err != nil {
+		return nil, err
+	}
+
+	s.lbs = len(lbs)
+
+	if len(lbs) > s.size {
+		lbs = lbs[:s.size]
+	}
+
+	s.sampledLBs = len(lbs)
+
+	return lbs, nil
+}
+
+// NotificationChannel returns a channel never receiving, the sample cache must not follow the changes
+func (s *sampleReader) NotificationChannel() <-chan *repository.Notification {
+	return nil
+}
+
+// Run runs the checks against target, the ones after a failed connectivity check are skipped
+// sampleSize bounds the applications and load balancers loaded in the cache check
+func Run(target Target, sampleSize int, logger *logrus.Logger) *Report {
+	report := &Report{}
+
+	connectivity := run("database connectivity", target.Ping)
+	report.Checks = append(report.Checks, connectivity)
+
+	if connectivity.Err != nil {
+		report.Checks = append(report.Checks,
+			Check{Name: "cache load", Skipped: true},
+			Check{Name: "rolled back write", Skipped: true},
+		)
+
+		return report
+	}
+
+	reader := &sampleReader{Reader: target, size: sampleSize}
+
+	cacheLoad := run("cache load", cache.NewCache(reader, logger).SetCache)
+	cacheLoad.Detail = fmt.Sprintf("sampled %d of %d applications and %d of %d load balancers",
+		reader.sampledApplications, reader.applications, reader.sampledLBs, reader.lbs)
+
+	report.Checks = append(report.Checks, cacheLoad, run("rolled back write", target.CheckWrite))
+
+	return report
+}
+
+// run runs the check named name
+func run(name string, check func() error) Check {
+	start := time.Now()
+
+	err := check()
+
+	return Check{Name: name, Duration: time.Since(start), Err: err}
+}
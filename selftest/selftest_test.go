@@ -0,0 +1,84 @@
+package selftest
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/pokt-foundation/pocket-http-db/cache"
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+// targetMock reads through a cache.ReaderMock, failing the checks with the set errors
+type targetMock struct {
+	*cache.ReaderMock
+	pingErr  error
+	writeErr error
+}
+
+func (t *targetMock) Ping() error {
+	return t.pingErr
+}
+
+func (t *targetMock) CheckWrite() error {
+	return t.writeErr
+}
+
+func newTargetMock() *targetMock {
+	readerMock := &cache.ReaderMock{}
+
+	readerMock.On("ReadPayPlans").Return([]*repository.PayPlan{{PlanType: repository.FreetierV0}}, nil)
+	readerMock.On("ReadRedirects").Return([]*repository.Redirect{}, nil)
+	readerMock.On("ReadApplications").Return([]*repository.Application{
+		{ID: "5f62b7d8be3591c4dea8566d"},
+		{ID: "5f62b7d8be3591c4dea8566a"},
+		{ID: "5f62b7d8be3591c4dea8566f"},
+	}, nil)
+	readerMock.On("ReadBlockchains").Return([]*repository.Blockchain{}, nil)
+	readerMock.On("ReadLoadBalancers").Return([]*repository.LoadBalancer{
+		{ID: "60ecb2bf67774900350d9c42", ApplicationIDs: []string{"5f62b7d8be3591c4dea8566d"}},
+	}, nil)
+
+	return &targetMock{ReaderMock: readerMock}
+}
+
+func TestRun(t *testing.T) {
+	c := require.New(t)
+
+	report := Run(newTargetMock(), 2, logrus.New())
+	c.False(report.Failed())
+	c.Len(report.Checks, 3)
+	c.Equal("sampled 2 of 3 applications and 1 of 1 load balancers", report.Checks[1].Detail)
+
+	var output bytes.Buffer
+
+	err := report.Write(&output)
+	c.NoError(err)
+	c.Contains(output.String(), "PASS cache load")
+	c.Contains(output.String(), "self test PASSED")
+
+	target := newTargetMock()
+	target.writeErr = errors.New("permission denied")
+
+	report = Run(target, 2, logrus.New())
+	c.True(report.Failed())
+	c.Equal(target.writeErr, report.Checks[2].Err)
+
+	target = newTargetMock()
+	target.pingErr = errors.New("connection refused")
+
+	report = Run(target, 2, logrus.New())
+	c.True(report.Failed())
+	c.True(report.Checks[1].Skipped)
+	c.True(report.Checks[2].Skipped)
+
+	output.Reset()
+
+	err = report.Write(&output)
+	c.NoError(err)
+	c.Contains(output.String(), "FAIL database connectivity")
+	c.Contains(output.String(), "SKIP cache load")
+	c.Contains(output.String(), "self test FAILED")
+}
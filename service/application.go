@@ -0,0 +1,700 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/pokt-foundation/pocket-http-db/cache"
+	"github.com/pokt-foundation/pocket-http-db/metrics"
+	"github.com/pokt-foundation/pocket-http-db/notifier"
+	"github.com/pokt-foundation/pocket-http-db/relaymeter"
+	"github.com/pokt-foundation/pocket-http-db/types"
+	"github.com/pokt-foundation/pocket-http-db/webhook"
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/sirupsen/logrus"
+)
+
+// appStatusTransitions is the graph of the statuses an application can move to from its current one
+// applications without status are legacy entries and can move to any status
+var appStatusTransitions = map[repository.AppStatus][]repository.AppStatus{
+	repository.AwaitingFreetierFunds:   {repository.AwaitingFreetierStaking, repository.AwaitingGracePeriod, repository.Decomissioned, types.AppStatusSuspended},
+	repository.AwaitingFreetierStaking: {repository.InService, repository.AwaitingGracePeriod, repository.Decomissioned, types.AppStatusSuspended},
+	repository.AwaitingFunds:           {repository.AwaitingStaking, repository.AwaitingGracePeriod, repository.Decomissioned, types.AppStatusSuspended},
+	repository.AwaitingStaking:         {repository.InService, repository.AwaitingGracePeriod, repository.Decomissioned, types.AppStatusSuspended},
+	repository.AwaitingSlotFunds:       {repository.AwaitingSlotStaking, repository.Decomissioned},
+	repository.AwaitingSlotStaking:     {repository.Ready, repository.Decomissioned},
+	repository.Ready:                   {repository.InService, repository.Swappable, repository.Decomissioned},
+	repository.Swappable:               {repository.InService, repository.Ready, repository.AwaitingUnstaking},
+	repository.InService:               {repository.AwaitingGracePeriod, repository.AwaitingUnstaking, repository.Orphaned, repository.Swappable, types.AppStatusSuspended},
+	repository.Orphaned:                {repository.InService, repository.AwaitingGracePeriod, repository.AwaitingUnstaking, repository.Decomissioned, types.AppStatusSuspended},
+	repository.AwaitingGracePeriod:     {repository.InService, repository.AwaitingUnstaking, repository.Decomissioned, types.AppStatusSuspended},
+	repository.AwaitingUnstaking:       {repository.AwaitingFundsRemoval, repository.Decomissioned},
+	repository.AwaitingFundsRemoval:    {repository.Decomissioned},
+	repository.Decomissioned:           {},
+	types.AppStatusSuspended:           {repository.InService, repository.Orphaned, repository.AwaitingGracePeriod, repository.AwaitingUnstaking, repository.Decomissioned},
+}
+
+// IsValidStatusTransition returns true if an application can move from the from status to the to status
+func IsValidStatusTransition(from, to repository.AppStatus) bool {
+	if from == "" {
+		return true
+	}
+
+	for _, status := range appStatusTransitions[from] {
+		if status == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GracePeriod holds the grace window of a removed application
+type GracePeriod struct {
+	StartedAt time.Time `json:"startedAt"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// ApplicationWithGracePeriod is an application awaiting grace period along with its grace window
+type ApplicationWithGracePeriod struct {
+	*repository.Application
+	GracePeriod GracePeriod `json:"gracePeriod"`
+	// PayPlan is only set when the pay plan is expanded
+	PayPlan *repository.PayPlan `json:"payPlan,omitempty"`
+}
+
+// ApplicationWithPayPlan is an application along with its pay plan resolved from the cache
+type ApplicationWithPayPlan struct {
+	*repository.Application
+	PayPlan *repository.PayPlan `json:"payPlan"`
+}
+
+// ApplicationStatusResult is the outcome of a bulk status update for a single application
+type ApplicationStatusResult struct {
+	ID             string               `json:"id"`
+	PreviousStatus repository.AppStatus `json:"previousStatus,omitempty"`
+	Updated        bool                 `json:"updated"`
+	Error          string               `json:"error,omitempty"`
+}
+
+// Suspension holds the input of suspend and unsuspend operations
+type Suspension struct {
+	Reason string `json:"reason"`
+	Actor  string `json:"actor"`
+	// Status is the status to restore on unsuspend, defaults to IN_SERVICE
+	Status repository.AppStatus `json:"status,omitempty"`
+}
+
+// suspensionData is saved as the data of the suspension audit log entries
+type suspensionData struct {
+	PreviousStatus repository.AppStatus `json:"previousStatus"`
+	Status         repository.AppStatus `json:"status"`
+}
+
+// ApplicationService struct handler for applications operations
+type ApplicationService struct {
+	cache  *cache.Cache
+	writer Writer
+	log    *logrus.Logger
+	// GracePeriod is how long removed applications are kept before being permanently removed
+	GracePeriod time.Duration
+	// RelayMeter receives the limits of applications right after a limit-affecting change
+	RelayMeter *relaymeter.Pusher
+	// Notifier tells operators about application removals
+	Notifier *notifier.Dispatcher
+	// Webhooks receives the creation, update, removal and suspension events
+	Webhooks *webhook.Dispatcher
+	// PlanDeprecations holds the deprecation date of pay plans, warned about on creations and updates
+	PlanDeprecations map[repository.PayPlanType]time.Time
+	// Metrics receives the application changes
+	Metrics *metrics.Registry
+	// UniqueNames rejects created applications whose name is already used by another application of the same user
+	UniqueNames bool
+	// ReadThrough reads the applications missing from cache from the database before reporting them not found
+	ReadThrough bool
+}
+
+// NewApplicationService returns ApplicationService instance
+func NewApplicationService(cache *cache.Cache, writer Writer, logger *logrus.Logger) *ApplicationService {
+	return &ApplicationService{
+		cache:  cache,
+		writer: writer,
+		log:    logger,
+	}
+}
+
+func (s *ApplicationService) logError(err error) {
+	s.log.WithFields(logrus.Fields{
+		"err": err.Error(),
+	}).Error(err)
+}
+
+// GetAll returns all the applications
+func (s *ApplicationService) GetAll() []*repository.Application {
+	return s.cache.GetApplications()
+}
+
+// Get returns the application with given id
+func (s *ApplicationService) Get(id string) (*repository.Application, error) {
+	app := s.cache.GetApplication(id)
+	if app == nil && s.ReadThrough {
+		var err error
+
+		// a failed read is reported as the miss it was, the database is only a fallback here
+		app, err = s.cache.ReadApplication(id)
+		if err != nil {
+			s.logError(fmt.Errorf("Get read through failed: %w", err))
+		}
+	}
+	if app == nil {
+		return nil, ErrApplicationNotFound
+	}
+
+	return app, nil
+}
+
+// GetByAddress returns the application whose gateway AAT has given address
+func (s *ApplicationService) GetByAddress(address string) (*repository.Application, error) {
+	app := s.cache.GetApplicationByAddress(address)
+	if app == nil {
+		return nil, ErrApplicationNotFound
+	}
+
+	return app, nil
+}
+
+// GetByUserID returns the applications of the user with given id
+func (s *ApplicationService) GetByUserID(userID string) ([]*repository.Application, error) {
+	apps := s.cache.GetApplicationsByUserID(userID)
+	if len(apps) == 0 {
+		return nil, ErrApplicationNotFound
+	}
+
+	return apps, nil
+}
+
+// GetByStatus returns the applications with given status
+func (s *ApplicationService) GetByStatus(status repository.AppStatus) ([]*repository.Application, error) {
+	if !types.ValidAppStatus(status) {
+		return nil, ErrInvalidAppStatus
+	}
+
+	apps := []*repository.Application{}
+
+	for _, app := range s.cache.GetApplications() {
+		if app.Status == status {
+			apps = append(apps, app)
+		}
+	}
+
+	return apps, nil
+}
+
+// FilterByPlanAndUser returns the applications of apps with given pay plan type and user ID, blank ones match them all
+func (s *ApplicationService) FilterByPlanAndUser(apps []*repository.Application, planType repository.PayPlanType, userID string) []*repository.Application {
+	if planType == "" && userID == "" {
+		return apps
+	}
+
+	filtered := []*repository.Application{}
+
+	for _, app := range apps {
+		if MatchesPlanAndUser(app, planType, userID) {
+			filtered = append(filtered, app)
+		}
+	}
+
+	return filtered
+}
+
+// MatchesPlanAndUser returns true if app has given pay plan type and user ID, blank ones match any application
+func MatchesPlanAndUser(app *repository.Application, planType repository.PayPlanType, userID string) bool {
+	return (planType == "" || app.Limits.PlanType == planType) && (userID == "" || app.UserID == userID)
+}
+
+// GetAwaitingGracePeriod returns the applications awaiting grace period along with their grace window
+// only the ones whose grace period expires before expiresBefore are returned, unless it is zero
+func (s *ApplicationService) GetAwaitingGracePeriod(expiresBefore time.Time) []ApplicationWithGracePeriod {
+	apps := []ApplicationWithGracePeriod{}
+
+	for _, app := range s.cache.GetApplications() {
+		if app.Status != repository.AwaitingGracePeriod {
+			continue
+		}
+
+		grace := GracePeriod{
+			StartedAt: app.UpdatedAt,
+			ExpiresAt: app.UpdatedAt.Add(s.GracePeriod),
+		}
+
+		if !expiresBefore.IsZero() && !grace.ExpiresAt.Before(expiresBefore) {
+			continue
+		}
+
+		apps = append(apps, ApplicationWithGracePeriod{
+			Application: app,
+			GracePeriod: grace,
+		})
+	}
+
+	return apps
+}
+
+// PayPlan returns the pay plan of app, nil if it has none or it is unknown
+func (s *ApplicationService) PayPlan(app *repository.Application) *repository.PayPlan {
+	if app.Limits.PlanType == "" {
+		return nil
+	}
+
+	return s.cache.GetPayPlan(app.Limits.PlanType)
+}
+
+// WithPayPlan returns app along with its pay plan
+func (s *ApplicationService) WithPayPlan(app *repository.Application) ApplicationWithPayPlan {
+	return ApplicationWithPayPlan{
+		Application: app,
+		PayPlan:     s.PayPlan(app),
+	}
+}
+
+// WithPayPlans returns apps along with their pay plans
+func (s *ApplicationService) WithPayPlans(apps []*repository.Application) []ApplicationWithPayPlan {
+	appsWithPayPlan := make([]ApplicationWithPayPlan, 0, len(apps))
+
+	for _, app := range apps {
+		appsWithPayPlan = append(appsWithPayPlan, s.WithPayPlan(app))
+	}
+
+	return appsWithPayPlan
+}
+
+// GetLimits returns the limits of all the applications
+func (s *ApplicationService) GetLimits() []repository.AppLimits {
+	var appsLimits []repository.AppLimits
+
+	for _, app := range s.cache.GetApplications() {
+		appsLimits = append(appsLimits, Limits(app))
+	}
+
+	return appsLimits
+}
+
+// Limits returns the limits projection of app
+func Limits(app *repository.Application) repository.AppLimits {
+	limits := app.Limits
+
+	limits.AppID = app.ID
+	limits.AppName = app.Name
+	limits.AppUserID = app.UserID
+	limits.PublicKey = app.GatewayAAT.ApplicationPublicKey
+	limits.NotificationSettings = &app.NotificationSettings
+
+	if app.Status == types.AppStatusSuspended {
+		limits.DailyLimit = 0 // suspended applications are not allowed to relay
+	}
+
+	if !app.FirstDateSurpassed.IsZero() {
+		limits.FirstDateSurpassed = &app.FirstDateSurpassed
+	}
+
+	return limits
+}
+
+// pushLimits sends the current limits of apps to the relay meter, if the push integration is enabled
+func (s *ApplicationService) pushLimits(apps ...*repository.Application) {
+	if s.RelayMeter == nil || len(apps) == 0 {
+		return
+	}
+
+	limits := make([]repository.AppLimits, 0, len(apps))
+
+	for _, app := range apps {
+		limits = append(limits, Limits(app))
+	}
+
+	s.RelayMeter.Push(limits...)
+}
+
+// setPayPlan sets the limits of plan to app
+func setPayPlan(app *repository.Application, plan *repository.PayPlan) {
+	app.Limits = repository.AppLimits{
+		PlanType:   plan.PlanType,
+		DailyLimit: plan.DailyLimit,
+	}
+}
+
+// updateCached applies update to the cached application of app and returns the result,
+// or to app itself if the cache no longer holds it
+func (s *ApplicationService) updateCached(app *repository.Application, update func(app *repository.Application)) *repository.Application {
+	if updated := s.cache.UpdateApplication(app.ID, update); updated != nil {
+		return updated
+	}
+
+	update(app)
+
+	return app
+}
+
+// Create saves app and returns it as saved
+func (s *ApplicationService) Create(ctx context.Context, app *repository.Application) (*repository.Application, error) {
+	err := validateNotificationSettings(app.ContactEmail)
+	if err != nil {
+		return nil, err
+	}
+
+	if conflictingApp := s.conflictingApplication(app.UserID, app.Name); conflictingApp != nil {
+		return nil, &ApplicationNameConflictError{
+			ConflictingID: conflictingApp.ID,
+			Suggestions:   s.nameSuggestions(app.UserID, app.Name),
+		}
+	}
+
+	fullApp, err := s.writer.WriteApplication(ctx, app)
+	if err != nil {
+		return nil, err
+	}
+
+	observeChange(s.Metrics, metrics.EntityApplication, metrics.OperationCreated, 1)
+
+	if fullApp.PayPlanType != "" {
+		setPayPlan(fullApp, s.cache.GetPayPlan(fullApp.PayPlanType))
+
+		fullApp.PayPlanType = "" // set to empty to avoid two sources of truth
+	}
+
+	dispatchChange(s.Webhooks, webhook.EventApplicationCreated, types.EntityApplication, fullApp.ID, fullApp)
+
+	return fullApp, nil
+}
+
+// Remove removes the application with given id, which awaits its grace period before being purged, and returns it
+func (s *ApplicationService) Remove(ctx context.Context, id string) (*repository.Application, error) {
+	app, err := s.Get(id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.writer.RemoveApplication(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	observeChange(s.Metrics, metrics.EntityApplication, metrics.OperationRemoved, 1)
+
+	updatedAt := time.Now()
+
+	app = s.updateCached(app, func(app *repository.Application) {
+		app.Status = repository.AwaitingGracePeriod
+		app.UpdatedAt = updatedAt
+	})
+
+	if s.Notifier != nil {
+		s.Notifier.Notify(notifier.Notification{
+			Event:   notifier.EventApplicationRemoved,
+			Subject: "Application removed",
+			Text:    fmt.Sprintf("Application %s (%s) of user %s is awaiting its grace period.", app.Name, app.ID, app.UserID),
+		})
+	}
+
+	dispatchChange(s.Webhooks, webhook.EventApplicationRemoved, types.EntityApplication, app.ID, app)
+
+	return app, nil
+}
+
+// Update applies input to the application with given id, removing it as Remove if input says so
+func (s *ApplicationService) Update(ctx context.Context, id string, input *repository.UpdateApplication) (*repository.Application, error) {
+	if input.Remove {
+		return s.Remove(ctx, id)
+	}
+
+	app, err := s.Get(id)
+	if err != nil {
+		return nil, err
+	}
+
+	// the contact email of an update is only checked when signing up for notifications,
+	// so applications stored before the validation can still be updated otherwise
+	if input.NotificationSettings != nil && input.NotificationSettings.SignedUp {
+		err = validateNotificationSettings(app.ContactEmail)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = s.writer.UpdateApplication(ctx, id, input)
+	if err != nil {
+		return nil, err
+	}
+
+	observeChange(s.Metrics, metrics.EntityApplication, metrics.OperationUpdated, 1)
+
+	var plan *repository.PayPlan
+	if input.PayPlanType != "" {
+		plan = s.cache.GetPayPlan(input.PayPlanType)
+	}
+
+	app = s.updateCached(app, func(app *repository.Application) {
+		if input.Name != "" {
+			app.Name = input.Name
+		}
+		if input.Status != "" {
+			app.Status = input.Status
+		}
+		if plan != nil {
+			setPayPlan(app, plan)
+		}
+		if !input.FirstDateSurpassed.IsZero() {
+			app.FirstDateSurpassed = input.FirstDateSurpassed
+		}
+		if input.GatewaySettings != nil {
+			app.GatewaySettings = *input.GatewaySettings
+		}
+		if input.NotificationSettings != nil {
+			app.NotificationSettings = *input.NotificationSettings
+		}
+	})
+
+	if input.PayPlanType != "" || input.Status != "" {
+		s.pushLimits(app)
+	}
+
+	dispatchChange(s.Webhooks, webhook.EventApplicationUpdated, types.EntityApplication, app.ID, app)
+
+	return app, nil
+}
+
+// ChangePayPlan moves the application with given id to the pay plan of planType
+// applying the plan the application already has is a no-op
+func (s *ApplicationService) ChangePayPlan(ctx context.Context, id string, planType repository.PayPlanType) (*repository.Application, error) {
+	app, err := s.Get(id)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := s.cache.GetPayPlan(planType)
+	if plan == nil {
+		return nil, ErrPayPlanNotFound
+	}
+
+	if app.Limits.PlanType == plan.PlanType {
+		return app, nil
+	}
+
+	err = s.writer.UpdateApplication(ctx, app.ID, &repository.UpdateApplication{PayPlanType: plan.PlanType})
+	if err != nil {
+		return nil, err
+	}
+
+	observeChange(s.Metrics, metrics.EntityApplication, metrics.OperationUpdated, 1)
+
+	app = s.updateCached(app, func(app *repository.Application) {
+		setPayPlan(app, plan)
+	})
+
+	s.pushLimits(app)
+
+	dispatchChange(s.Webhooks, webhook.EventApplicationUpdated, types.EntityApplication, app.ID, app)
+
+	return app, nil
+}
+
+// UpdateFirstDateSurpassed sets the first date surpassed of the applications in input
+func (s *ApplicationService) UpdateFirstDateSurpassed(ctx context.Context, input *repository.UpdateFirstDateSurpassed) ([]*repository.Application, error) {
+	if len(input.ApplicationIDs) == 0 {
+		return nil, ErrNoApplicationIDs
+	}
+
+	var appsToUpdate []*repository.Application
+
+	for _, appID := range input.ApplicationIDs {
+		app := s.cache.GetApplication(appID)
+		if app == nil {
+			return nil, fmt.Errorf("%w: %s", ErrApplicationNotFound, appID)
+		}
+
+		appsToUpdate = append(appsToUpdate, app)
+	}
+
+	err := s.writer.UpdateFirstDateSurpassed(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	observeChange(s.Metrics, metrics.EntityApplication, metrics.OperationUpdated, len(appsToUpdate))
+
+	for i, app := range appsToUpdate {
+		appsToUpdate[i] = s.updateCached(app, func(app *repository.Application) {
+			app.FirstDateSurpassed = input.FirstDateSurpassed
+		})
+
+		dispatchChange(s.Webhooks, webhook.EventApplicationUpdated, types.EntityApplication, app.ID, nil)
+	}
+
+	return appsToUpdate, nil
+}
+
+// UpdateStatus moves a batch of applications to the same status
+// every valid application is updated in a single transaction, invalid ones are reported in the results
+func (s *ApplicationService) UpdateStatus(ctx context.Context, ids []string, status repository.AppStatus) ([]ApplicationStatusResult, error) {
+	if len(ids) == 0 {
+		return nil, ErrNoApplicationIDs
+	}
+
+	if status == "" || !types.ValidAppStatus(status) {
+		return nil, ErrInvalidAppStatus
+	}
+
+	results := make([]ApplicationStatusResult, 0, len(ids))
+	seen := make(map[string]bool, len(ids))
+
+	var idsToUpdate []string
+	var appsToUpdate []*repository.Application
+
+	for _, appID := range ids {
+		result := ApplicationStatusResult{ID: appID}
+
+		app := s.cache.GetApplication(appID)
+
+		switch {
+		case seen[appID]:
+			result.Error = ErrDuplicatedApplicationID.Error()
+		case app == nil:
+			result.Error = ErrApplicationNotFound.Error()
+		case app.Status == status:
+			result.PreviousStatus = app.Status
+		case !IsValidStatusTransition(app.Status, status):
+			result.PreviousStatus = app.Status
+			result.Error = fmt.Sprintf("%s: %s to %s", ErrInvalidStatusTransition, app.Status, status)
+		default:
+			result.PreviousStatus = app.Status
+			result.Updated = true
+
+			idsToUpdate = append(idsToUpdate, appID)
+			appsToUpdate = append(appsToUpdate, app)
+		}
+
+		seen[appID] = true
+		results = append(results, result)
+	}
+
+	if len(idsToUpdate) > 0 {
+		err := s.writer.UpdateApplicationsStatus(ctx, idsToUpdate, status)
+		if err != nil {
+			return nil, err
+		}
+
+		observeChange(s.Metrics, metrics.EntityApplication, metrics.OperationUpdated, len(idsToUpdate))
+	}
+
+	updatedAt := time.Now()
+
+	var limitsChanged []*repository.Application
+
+	for _, app := range appsToUpdate {
+		previousStatus := app.Status
+
+		app = s.updateCached(app, func(app *repository.Application) {
+			app.Status = status
+			app.UpdatedAt = updatedAt
+		})
+
+		if previousStatus == types.AppStatusSuspended || status == types.AppStatusSuspended {
+			limitsChanged = append(limitsChanged, app)
+		}
+
+		dispatchChange(s.Webhooks, webhook.EventApplicationUpdated, types.EntityApplication, app.ID, nil)
+	}
+
+	s.pushLimits(limitsChanged...)
+
+	return results, nil
+}
+
+// Suspend suspends the application with given id, recording it on the audit log
+func (s *ApplicationService) Suspend(ctx context.Context, id string, input Suspension) (*repository.Application, error) {
+	return s.changeSuspension(ctx, id, input, true)
+}
+
+// Unsuspend restores the application with given id to the status in input, recording it on the audit log
+func (s *ApplicationService) Unsuspend(ctx context.Context, id string, input Suspension) (*repository.Application, error) {
+	return s.changeSuspension(ctx, id, input, false)
+}
+
+func (s *ApplicationService) changeSuspension(ctx context.Context, id string, input Suspension, suspend bool) (*repository.Application, error) {
+	app, err := s.Get(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Reason == "" {
+		return nil, ErrMissingReason
+	}
+
+	action, eventType, status := types.AuditActionSuspend, webhook.EventApplicationSuspended, types.AppStatusSuspended
+
+	if suspend && app.Status == types.AppStatusSuspended {
+		return nil, ErrApplicationSuspended
+	}
+
+	if !suspend {
+		if app.Status != types.AppStatusSuspended {
+			return nil, ErrApplicationActive
+		}
+
+		action, eventType, status = types.AuditActionUnsuspend, webhook.EventApplicationUnsuspended, repository.InService
+		if input.Status != "" {
+			status = input.Status
+		}
+	}
+
+	if !types.ValidAppStatus(status) {
+		return nil, ErrInvalidAppStatus
+	}
+
+	if !IsValidStatusTransition(app.Status, status) {
+		return nil, fmt.Errorf("%w: %s to %s", ErrInvalidStatusTransition, app.Status, status)
+	}
+
+	err = s.writer.UpdateApplicationsStatus(ctx, []string{app.ID}, status)
+	if err != nil {
+		return nil, err
+	}
+
+	observeChange(s.Metrics, metrics.EntityApplication, metrics.OperationUpdated, 1)
+
+	data := suspensionData{
+		PreviousStatus: app.Status,
+		Status:         status,
+	}
+
+	updatedAt := time.Now()
+
+	app = s.updateCached(app, func(app *repository.Application) {
+		app.Status = status
+		app.UpdatedAt = updatedAt
+	})
+
+	s.pushLimits(app)
+
+	rawData, _ := json.Marshal(data)
+
+	err = s.writer.WriteAuditLogEntry(ctx, &types.AuditLogEntry{
+		EntityType: types.EntityApplication,
+		EntityID:   app.ID,
+		Action:     action,
+		Actor:      input.Actor,
+		Reason:     input.Reason,
+		Data:       rawData,
+	})
+	if err != nil {
+		// the status change is already applied, a failed audit entry must not hide it from the caller
+		s.logError(fmt.Errorf("WriteAuditLogEntry in changeSuspension failed: %w", err))
+	}
+
+	// suspensions are sent as their own events rather than as updates
+	dispatchChange(s.Webhooks, eventType, types.EntityApplication, app.ID, data)
+
+	return app, nil
+}
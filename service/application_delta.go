@@ -0,0 +1,65 @@
+package service
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pokt-foundation/pocket-http-db/types"
+	"github.com/pokt-foundation/portal-api-go/repository"
+)
+
+// ApplicationWithVersion holds an application along with its version
+type ApplicationWithVersion struct {
+	*repository.Application
+	Version string `json:"version"`
+}
+
+// ApplicationDelta holds the applications changed since a consumer last synced them and the ones removed since
+type ApplicationDelta struct {
+	Changed []ApplicationWithVersion `json:"changed"`
+	Removed []string                 `json:"removed"`
+}
+
+// knownVersionChanged returns true if app changed since known, either its version, as sent in the ETag,
+// or its last update time in RFC3339
+func knownVersionChanged(app *repository.Application, known string) bool {
+	known = strings.Trim(strings.TrimPrefix(strings.TrimSpace(known), "W/"), `"`)
+
+	if updatedAt, err := time.Parse(time.RFC3339Nano, known); err == nil {
+		return app.UpdatedAt.After(updatedAt)
+	}
+
+	return types.ApplicationVersion(app) != known
+}
+
+// Delta returns the applications of known, a map of application IDs to the version or last update time the
+// consumer holds, that changed since, and the IDs of the ones no longer found
+func (s *ApplicationService) Delta(known map[string]string) ApplicationDelta {
+	delta := ApplicationDelta{
+		Changed: []ApplicationWithVersion{},
+		Removed: []string{},
+	}
+
+	for id, version := range known {
+		app, err := s.Get(id)
+		if err != nil {
+			delta.Removed = append(delta.Removed, id)
+			continue
+		}
+
+		if knownVersionChanged(app, version) {
+			delta.Changed = append(delta.Changed, ApplicationWithVersion{
+				Application: app,
+				Version:     types.ApplicationVersion(app),
+			})
+		}
+	}
+
+	sort.Slice(delta.Changed, func(i, j int) bool {
+		return delta.Changed[i].ID < delta.Changed[j].ID
+	})
+	sort.Strings(delta.Removed)
+
+	return delta
+}
@@ -0,0 +1,55 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pokt-foundation/pocket-http-db/types"
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplicationService_Delta(t *testing.T) {
+	c := require.New(t)
+
+	testCache := newTestCache(t)
+	apps := NewApplicationService(testCache, &writerMock{}, logrus.New())
+
+	app := testCache.GetApplication("5f62b7d8be3591c4dea8566d")
+	version := types.ApplicationVersion(app)
+
+	delta := apps.Delta(map[string]string{
+		"5f62b7d8be3591c4dea8566d": `"` + version + `"`,
+		"5f62b7d8be3591c4dea8566a": "0123456789abcdef",
+		"5f62b7d8be3591c4dea8566f": version,
+	})
+
+	c.Len(delta.Changed, 1)
+	c.Equal("5f62b7d8be3591c4dea8566a", delta.Changed[0].ID)
+	c.Equal(types.ApplicationVersion(delta.Changed[0].Application), delta.Changed[0].Version)
+	c.Equal([]string{"5f62b7d8be3591c4dea8566f"}, delta.Removed)
+
+	// last update times are compared with the update time of the applications
+	updatedAt := time.Date(2022, time.July, 21, 0, 0, 0, 0, time.UTC)
+
+	testCache.UpdateApplication("5f62b7d8be3591c4dea8566d", func(app *repository.Application) {
+		app.UpdatedAt = updatedAt
+	})
+
+	delta = apps.Delta(map[string]string{
+		"5f62b7d8be3591c4dea8566d": updatedAt.Add(-time.Second).Format(time.RFC3339),
+		"5f62b7d8be3591c4dea8566a": updatedAt.Format(time.RFC3339Nano),
+	})
+
+	c.Len(delta.Changed, 1)
+	c.Equal("5f62b7d8be3591c4dea8566d", delta.Changed[0].ID)
+	c.Empty(delta.Removed)
+
+	// the version changes along with the application
+	c.NotEqual(version, delta.Changed[0].Version)
+
+	delta = apps.Delta(nil)
+	c.Empty(delta.Changed)
+	c.Empty(delta.Removed)
+}
@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pokt-foundation/pocket-http-db/types"
+	"github.com/pokt-foundation/portal-api-go/repository"
+)
+
+// GetFilters returns every named filter of applications
+func (s *ApplicationService) GetFilters() []*types.ApplicationFilter {
+	return s.cache.GetApplicationFilters()
+}
+
+// GetFilter returns the named filter of applications with given name
+func (s *ApplicationService) GetFilter(name string) (*types.ApplicationFilter, error) {
+	filter := s.cache.GetApplicationFilter(name)
+	if filter == nil {
+		return nil, ErrApplicationFilterNotFound
+	}
+
+	return filter, nil
+}
+
+// SetFilter validates filter and saves it, replacing the filter with the same name
+// returns filter as saved, with its status and pay plan type normalized
+func (s *ApplicationService) SetFilter(ctx context.Context, filter *types.ApplicationFilter) (*types.ApplicationFilter, error) {
+	// filter names follow the format of label keys, such as enterprise-apps
+	if !labelKeyPattern.MatchString(filter.Name) {
+		return nil, ErrInvalidFilterName
+	}
+
+	saved := &types.ApplicationFilter{
+		Name:   filter.Name,
+		Status: repository.AppStatus(strings.ToUpper(strings.TrimSpace(string(filter.Status)))),
+		Labels: filter.Labels,
+	}
+
+	if saved.Status != "" && !types.ValidAppStatus(saved.Status) {
+		return nil, ErrInvalidAppStatus
+	}
+
+	if filter.PayPlanType != "" {
+		planType, err := NormalizePlanType(string(filter.PayPlanType))
+		if err != nil {
+			return nil, err
+		}
+
+		saved.PayPlanType = planType
+	}
+
+	if _, err := ParseLabelSelectors(saved.Labels); err != nil {
+		return nil, err
+	}
+
+	err := s.writer.WriteApplicationFilter(ctx, saved)
+	if err != nil {
+		return nil, err
+	}
+
+	s.cache.SetApplicationFilter(saved)
+
+	return saved, nil
+}
+
+// RemoveFilter removes the named filter of applications with given name and returns it
+func (s *ApplicationService) RemoveFilter(ctx context.Context, name string) (*types.ApplicationFilter, error) {
+	filter, err := s.GetFilter(name)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.writer.RemoveApplicationFilter(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	s.cache.RemoveApplicationFilter(name)
+
+	return filter, nil
+}
+
+// GetByFilter returns the applications matching every criterion of the named filter with given name
+func (s *ApplicationService) GetByFilter(name string) ([]*repository.Application, error) {
+	filter, err := s.GetFilter(name)
+	if err != nil {
+		return nil, err
+	}
+
+	selectors, err := ParseLabelSelectors(filter.Labels)
+	if err != nil {
+		return nil, err
+	}
+
+	apps := s.GetAll()
+
+	if filter.Status != "" {
+		apps, err = s.GetByStatus(filter.Status)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	filtered := []*repository.Application{}
+
+	for _, app := range s.FilterByLabels(apps, selectors) {
+		if filter.PayPlanType == "" || app.Limits.PlanType == filter.PayPlanType {
+			filtered = append(filtered, app)
+		}
+	}
+
+	return filtered, nil
+}
@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pokt-foundation/pocket-http-db/types"
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplicationService_Filters(t *testing.T) {
+	c := require.New(t)
+
+	writerMock := &writerMock{}
+	apps := NewApplicationService(newTestCache(t), writerMock, logrus.New())
+
+	expectedFilter := &types.ApplicationFilter{
+		Name:        "freetier-apps",
+		Status:      repository.InService,
+		PayPlanType: repository.FreetierV0,
+	}
+
+	writerMock.On("WriteApplicationFilter", expectedFilter).Return(nil).Once()
+
+	filter, err := apps.SetFilter(context.Background(), &types.ApplicationFilter{
+		Name:        "freetier-apps",
+		Status:      "in_service",
+		PayPlanType: "freetier-v0",
+	})
+	c.NoError(err)
+	c.Equal(expectedFilter, filter)
+
+	filteredApps, err := apps.GetByFilter("freetier-apps")
+	c.NoError(err)
+	c.Len(filteredApps, 1)
+	c.Equal("5f62b7d8be3591c4dea8566d", filteredApps[0].ID)
+
+	c.Len(apps.GetFilters(), 1)
+
+	_, err = apps.SetFilter(context.Background(), &types.ApplicationFilter{Name: "bad name"})
+	c.ErrorIs(err, ErrInvalidFilterName)
+
+	_, err = apps.SetFilter(context.Background(), &types.ApplicationFilter{Name: "apps", Status: "WRONG"})
+	c.ErrorIs(err, ErrInvalidAppStatus)
+
+	_, err = apps.SetFilter(context.Background(), &types.ApplicationFilter{Name: "apps", PayPlanType: "wrong plan"})
+	c.ErrorIs(err, ErrInvalidPlanType)
+
+	_, err = apps.SetFilter(context.Background(), &types.ApplicationFilter{Name: "apps", Labels: []string{":infra"}})
+	c.ErrorIs(err, ErrInvalidLabelSelector)
+
+	writerMock.On("RemoveApplicationFilter", "freetier-apps").Return(nil).Once()
+
+	removedFilter, err := apps.RemoveFilter(context.Background(), "freetier-apps")
+	c.NoError(err)
+	c.Equal(expectedFilter, removedFilter)
+
+	_, err = apps.GetByFilter("freetier-apps")
+	c.ErrorIs(err, ErrApplicationFilterNotFound)
+
+	_, err = apps.RemoveFilter(context.Background(), "freetier-apps")
+	c.ErrorIs(err, ErrApplicationFilterNotFound)
+
+	writerMock.AssertExpectations(t)
+}
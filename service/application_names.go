@@ -0,0 +1,52 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pokt-foundation/portal-api-go/repository"
+)
+
+// nameSuggestionsCount is the number of alternative names returned on an application name conflict
+const nameSuggestionsCount = 3
+
+// conflictingApplication returns the application of the user already named name
+// always returns nil if names uniqueness is not enforced
+func (s *ApplicationService) conflictingApplication(userID, name string) *repository.Application {
+	if !s.UniqueNames || userID == "" || name == "" {
+		return nil
+	}
+
+	for _, app := range s.cache.GetApplicationsByUserID(userID) {
+		if nameKey(app.Name) == nameKey(name) {
+			return app
+		}
+	}
+
+	return nil
+}
+
+// nameSuggestions returns names not used by the applications of the user, made by numbering name
+func (s *ApplicationService) nameSuggestions(userID, name string) []string {
+	usedNames := map[string]bool{}
+	for _, app := range s.cache.GetApplicationsByUserID(userID) {
+		usedNames[nameKey(app.Name)] = true
+	}
+
+	base := strings.TrimSpace(name)
+	suggestions := make([]string, 0, nameSuggestionsCount)
+
+	for i := 2; len(suggestions) < nameSuggestionsCount; i++ {
+		suggestion := fmt.Sprintf("%s %d", base, i)
+		if !usedNames[nameKey(suggestion)] {
+			suggestions = append(suggestions, suggestion)
+		}
+	}
+
+	return suggestions
+}
+
+// nameKey returns the key names are compared by, case and surrounding spaces insensitive
+func nameKey(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
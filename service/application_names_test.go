@@ -0,0 +1,47 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplicationService_CreateUniqueNames(t *testing.T) {
+	c := require.New(t)
+
+	testCache := newTestCache(t)
+	testCache.UpdateApplication("5f62b7d8be3591c4dea8566d", func(app *repository.Application) { app.Name = "pokt-app" })
+	testCache.UpdateApplication("5f62b7d8be3591c4dea8566a", func(app *repository.Application) { app.Name = "Pokt-App 2" })
+
+	writerMock := &writerMock{}
+	apps := NewApplicationService(testCache, writerMock, logrus.New())
+
+	app := &repository.Application{Name: " POKT-APP ", UserID: "60ecb2bf67774900350d9c43"}
+
+	writerMock.On("WriteApplication", app).Return(&repository.Application{ID: "5f62b7d8be3591c4dea8566b"}, nil).Once()
+
+	_, err := apps.Create(context.Background(), app)
+	c.NoError(err)
+
+	apps.UniqueNames = true
+
+	var conflict *ApplicationNameConflictError
+
+	_, err = apps.Create(context.Background(), app)
+	c.ErrorIs(err, ErrApplicationNameUsed)
+	c.ErrorAs(err, &conflict)
+	c.Equal("5f62b7d8be3591c4dea8566d", conflict.ConflictingID)
+	c.Equal([]string{"POKT-APP 3", "POKT-APP 4", "POKT-APP 5"}, conflict.Suggestions)
+
+	otherUserApp := &repository.Application{Name: "pokt-app", UserID: "60ecb2bf67774900350d9c44"}
+
+	writerMock.On("WriteApplication", otherUserApp).Return(&repository.Application{ID: "5f62b7d8be3591c4dea8566c"}, nil).Once()
+
+	_, err = apps.Create(context.Background(), otherUserApp)
+	c.NoError(err)
+
+	writerMock.AssertExpectations(t)
+}
@@ -0,0 +1,278 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/pokt-foundation/pocket-http-db/cache"
+	"github.com/pokt-foundation/pocket-http-db/types"
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsValidStatusTransition(t *testing.T) {
+	c := require.New(t)
+
+	c.True(IsValidStatusTransition("", repository.Decomissioned))
+	c.True(IsValidStatusTransition(repository.InService, repository.AwaitingGracePeriod))
+	c.True(IsValidStatusTransition(repository.AwaitingGracePeriod, repository.InService))
+	c.False(IsValidStatusTransition(repository.InService, repository.AwaitingFunds))
+	c.False(IsValidStatusTransition(repository.Decomissioned, repository.InService))
+}
+
+func TestApplicationService_Get(t *testing.T) {
+	c := require.New(t)
+
+	apps := NewApplicationService(newTestCache(t), nil, logrus.New())
+
+	app, err := apps.Get("5f62b7d8be3591c4dea8566d")
+	c.NoError(err)
+	c.Equal(repository.FreetierV0, app.Limits.PlanType)
+
+	_, err = apps.Get("wrong")
+	c.ErrorIs(err, ErrApplicationNotFound)
+
+	userApps, err := apps.GetByUserID("60ecb2bf67774900350d9c43")
+	c.NoError(err)
+	c.Len(userApps, 2)
+
+	_, err = apps.GetByUserID("wrong")
+	c.ErrorIs(err, ErrApplicationNotFound)
+
+	inService, err := apps.GetByStatus(repository.InService)
+	c.NoError(err)
+	c.Len(inService, 1)
+
+	_, err = apps.GetByStatus("WRONG")
+	c.ErrorIs(err, ErrInvalidAppStatus)
+}
+
+func TestApplicationService_GetReadThrough(t *testing.T) {
+	c := require.New(t)
+
+	readerMock := &cache.ReaderMock{}
+
+	readerMock.On("ReadPayPlans").Return([]*repository.PayPlan{{PlanType: repository.FreetierV0, DailyLimit: 250000}}, nil)
+	readerMock.On("ReadRedirects").Return([]*repository.Redirect{}, nil)
+	readerMock.On("ReadApplications").Return([]*repository.Application{}, nil)
+	readerMock.On("ReadBlockchains").Return([]*repository.Blockchain{}, nil)
+	readerMock.On("ReadLoadBalancers").Return([]*repository.LoadBalancer{}, nil)
+
+	readerMock.On("ReadApplication", "5f62b7d8be3591c4dea8566d").Return(&repository.Application{
+		ID:          "5f62b7d8be3591c4dea8566d",
+		PayPlanType: repository.FreetierV0,
+	}, nil).Once()
+	readerMock.On("ReadApplication", "5f62b7d8be3591c4dea8566a").Return(nil, errors.New("dummy error")).Once()
+
+	appCache := cache.NewCache(&cache.ApplicationReaderMock{ReaderMock: readerMock}, logrus.New())
+	c.NoError(appCache.SetCache())
+
+	apps := NewApplicationService(appCache, nil, logrus.New())
+
+	// disabled by default
+	_, err := apps.Get("5f62b7d8be3591c4dea8566d")
+	c.ErrorIs(err, ErrApplicationNotFound)
+
+	apps.ReadThrough = true
+
+	app, err := apps.Get("5f62b7d8be3591c4dea8566d")
+	c.NoError(err)
+	c.Equal(250000, app.Limits.DailyLimit)
+
+	// failed reads are misses
+	_, err = apps.Get("5f62b7d8be3591c4dea8566a")
+	c.ErrorIs(err, ErrApplicationNotFound)
+
+	readerMock.AssertExpectations(t)
+}
+
+func TestApplicationService_GetAwaitingGracePeriod(t *testing.T) {
+	c := require.New(t)
+
+	apps := NewApplicationService(newTestCache(t), nil, logrus.New())
+	apps.GracePeriod = 24 * time.Hour
+
+	removedAt := time.Date(2022, time.July, 21, 0, 0, 0, 0, time.UTC)
+
+	app := apps.cache.UpdateApplication("5f62b7d8be3591c4dea8566a", func(app *repository.Application) {
+		app.Status = repository.AwaitingGracePeriod
+		app.UpdatedAt = removedAt
+	})
+
+	c.Equal([]ApplicationWithGracePeriod{
+		{
+			Application: app,
+			GracePeriod: GracePeriod{StartedAt: removedAt, ExpiresAt: removedAt.Add(24 * time.Hour)},
+		},
+	}, apps.GetAwaitingGracePeriod(time.Time{}))
+	c.Len(apps.GetAwaitingGracePeriod(removedAt.Add(25*time.Hour)), 1)
+	c.Empty(apps.GetAwaitingGracePeriod(removedAt.Add(24 * time.Hour)))
+}
+
+func TestApplicationService_Update(t *testing.T) {
+	c := require.New(t)
+
+	writerMock := &writerMock{}
+	apps := NewApplicationService(newTestCache(t), writerMock, logrus.New())
+
+	input := &repository.UpdateApplication{Name: "new-name", PayPlanType: repository.PayAsYouGoV0}
+
+	writerMock.On("UpdateApplication", "5f62b7d8be3591c4dea8566d", input).Return(nil).Once()
+
+	app, err := apps.Update(context.Background(), "5f62b7d8be3591c4dea8566d", input)
+	c.NoError(err)
+	c.Equal("new-name", app.Name)
+	c.Equal(repository.PayAsYouGoV0, app.Limits.PlanType)
+
+	writerMock.On("RemoveApplication", "5f62b7d8be3591c4dea8566d").Return(nil).Once()
+
+	app, err = apps.Update(context.Background(), "5f62b7d8be3591c4dea8566d", &repository.UpdateApplication{Remove: true})
+	c.NoError(err)
+	c.Equal(repository.AwaitingGracePeriod, app.Status)
+
+	errWriter := errors.New("dummy error")
+
+	writerMock.On("UpdateApplication", "5f62b7d8be3591c4dea8566d", mock.Anything).Return(errWriter).Once()
+
+	_, err = apps.Update(context.Background(), "5f62b7d8be3591c4dea8566d", &repository.UpdateApplication{Name: "other-name"})
+	c.ErrorIs(err, errWriter)
+	c.Equal("new-name", app.Name)
+
+	_, err = apps.Update(context.Background(), "wrong", &repository.UpdateApplication{})
+	c.ErrorIs(err, ErrApplicationNotFound)
+
+	writerMock.AssertExpectations(t)
+}
+
+func TestApplicationService_Remove(t *testing.T) {
+	c := require.New(t)
+
+	writerMock := &writerMock{}
+	apps := NewApplicationService(newTestCache(t), writerMock, logrus.New())
+
+	errWriter := errors.New("dummy error")
+
+	writerMock.On("RemoveApplication", "5f62b7d8be3591c4dea8566d").Return(errWriter).Once()
+
+	_, err := apps.Remove(context.Background(), "5f62b7d8be3591c4dea8566d")
+	c.ErrorIs(err, errWriter)
+
+	app, err := apps.Get("5f62b7d8be3591c4dea8566d")
+	c.NoError(err)
+	c.Equal(repository.InService, app.Status)
+
+	writerMock.On("RemoveApplication", "5f62b7d8be3591c4dea8566d").Return(nil).Once()
+
+	app, err = apps.Remove(context.Background(), "5f62b7d8be3591c4dea8566d")
+	c.NoError(err)
+	c.Equal(repository.AwaitingGracePeriod, app.Status)
+
+	app, err = apps.Get("5f62b7d8be3591c4dea8566d")
+	c.NoError(err)
+	c.Equal(repository.AwaitingGracePeriod, app.Status)
+
+	_, err = apps.Remove(context.Background(), "wrong")
+	c.ErrorIs(err, ErrApplicationNotFound)
+
+	writerMock.AssertExpectations(t)
+}
+
+func TestApplicationService_ChangePayPlan(t *testing.T) {
+	c := require.New(t)
+
+	writerMock := &writerMock{}
+	apps := NewApplicationService(newTestCache(t), writerMock, logrus.New())
+
+	app, err := apps.ChangePayPlan(context.Background(), "5f62b7d8be3591c4dea8566d", repository.FreetierV0)
+	c.NoError(err)
+	c.Equal(repository.FreetierV0, app.Limits.PlanType)
+
+	writerMock.On("UpdateApplication", "5f62b7d8be3591c4dea8566d",
+		&repository.UpdateApplication{PayPlanType: repository.PayAsYouGoV0}).Return(nil).Once()
+
+	app, err = apps.ChangePayPlan(context.Background(), "5f62b7d8be3591c4dea8566d", repository.PayAsYouGoV0)
+	c.NoError(err)
+	c.Equal(repository.PayAsYouGoV0, app.Limits.PlanType)
+
+	_, err = apps.ChangePayPlan(context.Background(), "5f62b7d8be3591c4dea8566d", "WRONG")
+	c.ErrorIs(err, ErrPayPlanNotFound)
+
+	writerMock.AssertExpectations(t)
+}
+
+func TestApplicationService_UpdateStatus(t *testing.T) {
+	c := require.New(t)
+
+	writerMock := &writerMock{}
+	apps := NewApplicationService(newTestCache(t), writerMock, logrus.New())
+
+	writerMock.On("UpdateApplicationsStatus", []string{"5f62b7d8be3591c4dea8566d"}, repository.Orphaned).Return(nil).Once()
+
+	results, err := apps.UpdateStatus(context.Background(), []string{"5f62b7d8be3591c4dea8566d", "5f62b7d8be3591c4dea8566a", "wrong"}, repository.Orphaned)
+	c.NoError(err)
+	c.Equal([]ApplicationStatusResult{
+		{ID: "5f62b7d8be3591c4dea8566d", PreviousStatus: repository.InService, Updated: true},
+		{ID: "5f62b7d8be3591c4dea8566a", PreviousStatus: repository.Decomissioned, Error: "invalid status transition: DECOMISSIONED to ORPHANED"},
+		{ID: "wrong", Error: ErrApplicationNotFound.Error()},
+	}, results)
+
+	_, err = apps.UpdateStatus(context.Background(), nil, repository.Orphaned)
+	c.ErrorIs(err, ErrNoApplicationIDs)
+
+	_, err = apps.UpdateStatus(context.Background(), []string{"5f62b7d8be3591c4dea8566d"}, "WRONG")
+	c.ErrorIs(err, ErrInvalidAppStatus)
+
+	writerMock.AssertExpectations(t)
+}
+
+func TestApplicationService_Suspend(t *testing.T) {
+	c := require.New(t)
+
+	writerMock := &writerMock{}
+	apps := NewApplicationService(newTestCache(t), writerMock, logrus.New())
+
+	_, err := apps.Suspend(context.Background(), "5f62b7d8be3591c4dea8566d", Suspension{})
+	c.ErrorIs(err, ErrMissingReason)
+
+	_, err = apps.Unsuspend(context.Background(), "5f62b7d8be3591c4dea8566d", Suspension{Reason: "cleared"})
+	c.ErrorIs(err, ErrApplicationActive)
+
+	writerMock.On("UpdateApplicationsStatus", []string{"5f62b7d8be3591c4dea8566d"}, types.AppStatusSuspended).Return(nil).Once()
+	writerMock.On("WriteAuditLogEntry", mock.MatchedBy(func(entry *types.AuditLogEntry) bool {
+		return entry.Action == types.AuditActionSuspend && entry.Actor == "support" && entry.Reason == "abuse"
+	})).Return(errors.New("dummy error")).Once()
+
+	app, err := apps.Suspend(context.Background(), "5f62b7d8be3591c4dea8566d", Suspension{Reason: "abuse", Actor: "support"})
+	c.NoError(err)
+	c.Equal(types.AppStatusSuspended, app.Status)
+	c.Zero(Limits(app).DailyLimit)
+
+	_, err = apps.Suspend(context.Background(), "5f62b7d8be3591c4dea8566d", Suspension{Reason: "abuse"})
+	c.ErrorIs(err, ErrApplicationSuspended)
+
+	_, err = apps.Unsuspend(context.Background(), "5f62b7d8be3591c4dea8566d", Suspension{Reason: "cleared", Status: repository.AwaitingFunds})
+	c.ErrorIs(err, ErrInvalidStatusTransition)
+
+	writerMock.AssertExpectations(t)
+}
+
+func TestApplicationService_WithPayPlan(t *testing.T) {
+	c := require.New(t)
+
+	apps := NewApplicationService(newTestCache(t), &writerMock{}, logrus.New())
+
+	app, err := apps.Get("5f62b7d8be3591c4dea8566d")
+	c.NoError(err)
+
+	appWithPayPlan := apps.WithPayPlan(app)
+	c.Equal(app, appWithPayPlan.Application)
+	c.Equal(app.Limits.PlanType, appWithPayPlan.PayPlan.PlanType)
+	c.Equal(app.Limits.DailyLimit, appWithPayPlan.PayPlan.DailyLimit)
+
+	c.Nil(apps.PayPlan(&repository.Application{}))
+	c.Len(apps.WithPayPlans([]*repository.Application{app, {}}), 2)
+}
@@ -0,0 +1,247 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pokt-foundation/pocket-http-db/cache"
+	"github.com/pokt-foundation/pocket-http-db/types"
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/sirupsen/logrus"
+)
+
+// DefaultBackfillBatchSize is the number of entities persisted at once when BatchSize is not set
+const DefaultBackfillBatchSize = 100
+
+// BackfillUpdatedAt is the backfill of the updatedAt of applications and load balancers saved without it
+const BackfillUpdatedAt = "updated_at"
+
+// backfillTarget holds the IDs of the entities of a type missing a derived field
+type backfillTarget struct {
+	entityType types.EntityType
+	ids        []string
+}
+
+// backfill recomputes and persists a derived field
+type backfill struct {
+	// pending returns the entities missing the field
+	pending func(c *cache.Cache) []backfillTarget
+	// persist saves the field of the entities in ids, returning how many were updated
+	persist func(ctx context.Context, w Writer, entityType types.EntityType, ids []string) (int64, error)
+	// apply sets the field of the cached entities in ids, once persisted
+	apply func(c *cache.Cache, entityType types.EntityType, ids []string)
+}
+
+// backfills are the derived fields that can be backfilled, by field name
+var backfills = map[string]backfill{
+	BackfillUpdatedAt: {
+		pending: pendingUpdatedAt,
+		persist: func(ctx context.Context, w Writer, entityType types.EntityType, ids []string) (int64, error) {
+			return w.BackfillUpdatedAt(ctx, entityType, ids)
+		},
+		apply: applyUpdatedAt,
+	},
+}
+
+func pendingUpdatedAt(c *cache.Cache) []backfillTarget {
+	apps := backfillTarget{entityType: types.EntityApplication}
+	for _, app := range c.GetApplications() {
+		if app.UpdatedAt.IsZero() {
+			apps.ids = append(apps.ids, app.ID)
+		}
+	}
+
+	lbs := backfillTarget{entityType: types.EntityLoadBalancer}
+	for _, lb := range c.GetLoadBalancers() {
+		if lb.UpdatedAt.IsZero() {
+			lbs.ids = append(lbs.ids, lb.ID)
+		}
+	}
+
+	return []backfillTarget{apps, lbs}
+}
+
+func applyUpdatedAt(c *cache.Cache, entityType types.EntityType, ids []string) {
+	now := time.Now()
+
+	updatedAt := func(createdAt time.Time) time.Time {
+		if createdAt.IsZero() {
+			return now
+		}
+
+		return createdAt
+	}
+
+	for _, id := range ids {
+		switch entityType {
+		case types.EntityApplication:
+			c.UpdateApplication(id, func(app *repository.Application) {
+				if app.UpdatedAt.IsZero() {
+					app.UpdatedAt = updatedAt(app.CreatedAt)
+				}
+			})
+		case types.EntityLoadBalancer:
+			c.UpdateLoadBalancer(id, func(lb *repository.LoadBalancer) {
+				if lb.UpdatedAt.IsZero() {
+					lb.UpdatedAt = updatedAt(lb.CreatedAt)
+				}
+			})
+		}
+	}
+}
+
+// BackfillFields returns the names of the fields that can be backfilled, sorted
+func BackfillFields() []string {
+	fields := make([]string, 0, len(backfills))
+	for field := range backfills {
+		fields = append(fields, field)
+	}
+
+	sort.Strings(fields)
+
+	return fields
+}
+
+// BackfillStatus holds the progress of the last backfill of a field
+type BackfillStatus struct {
+	Field      string    `json:"field"`
+	InProgress bool      `json:"inProgress"`
+	StartedAt  time.Time `json:"startedAt"`
+	FinishedAt time.Time `json:"finishedAt"`
+	BatchSize  int       `json:"batchSize"`
+	// Total is the number of entities missing the field when the backfill started
+	Total     int `json:"total"`
+	Processed int `json:"processed"`
+	// Updated is the number of entities persisted, entities updated since the backfill started are not counted
+	Updated   int64  `json:"updated"`
+	Batches   int    `json:"batches"`
+	LastError string `json:"lastError,omitempty"`
+}
+
+// Backfiller recomputes and persists derived fields across all the entities in batches, in the background
+// a single backfill of each field runs at once
+type Backfiller struct {
+	cache  *cache.Cache
+	writer Writer
+	log    *logrus.Logger
+	// BatchSize is the number of entities persisted at once, DefaultBackfillBatchSize if zero
+	BatchSize int
+	runs      map[string]*BackfillStatus
+	mutex     sync.Mutex
+}
+
+// NewBackfiller returns Backfiller instance
+func NewBackfiller(cache *cache.Cache, writer Writer, logger *logrus.Logger) *Backfiller {
+	return &Backfiller{
+		cache:  cache,
+		writer: writer,
+		log:    logger,
+		runs:   map[string]*BackfillStatus{},
+	}
+}
+
+// Start starts the backfill of field unless one is in progress, returns its status and whether it was started
+func (b *Backfiller) Start(field string) (BackfillStatus, bool, error) {
+	fill, ok := backfills[field]
+	if !ok {
+		return BackfillStatus{}, false, ErrBackfillFieldNotFound
+	}
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if run := b.runs[field]; run != nil && run.InProgress {
+		return *run, false, nil
+	}
+
+	batchSize := b.BatchSize
+	if batchSize <= 0 {
+		batchSize = DefaultBackfillBatchSize
+	}
+
+	targets := fill.pending(b.cache)
+
+	run := &BackfillStatus{
+		Field:      field,
+		InProgress: true,
+		StartedAt:  time.Now(),
+		BatchSize:  batchSize,
+	}
+
+	for _, target := range targets {
+		run.Total += len(target.ids)
+	}
+
+	b.runs[field] = run
+
+	go b.run(fill, run, targets)
+
+	return *run, true, nil
+}
+
+// Status returns the status of the last backfill of field, with only the field set if none ran
+func (b *Backfiller) Status(field string) (BackfillStatus, error) {
+	if _, ok := backfills[field]; !ok {
+		return BackfillStatus{}, ErrBackfillFieldNotFound
+	}
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if run := b.runs[field]; run != nil {
+		return *run, nil
+	}
+
+	return BackfillStatus{Field: field}, nil
+}
+
+// run persists the field of targets batch by batch, stopping at the first failed batch
+func (b *Backfiller) run(fill backfill, run *BackfillStatus, targets []backfillTarget) {
+	defer func() {
+		b.mutex.Lock()
+		defer b.mutex.Unlock()
+
+		run.InProgress = false
+		run.FinishedAt = time.Now()
+	}()
+
+	// the backfill outlives the request starting it
+	ctx := context.Background()
+
+	for _, target := range targets {
+		for start := 0; start < len(target.ids); start += run.BatchSize {
+			end := start + run.BatchSize
+			if end > len(target.ids) {
+				end = len(target.ids)
+			}
+
+			batch := target.ids[start:end]
+
+			updated, err := fill.persist(ctx, b.writer, target.entityType, batch)
+			if err != nil {
+				err = fmt.Errorf("backfill %s of %s failed: %w", run.Field, target.entityType, err)
+
+				b.log.WithFields(logrus.Fields{
+					"err": err.Error(),
+				}).Error(err)
+
+				b.mutex.Lock()
+				run.LastError = err.Error()
+				b.mutex.Unlock()
+
+				return
+			}
+
+			fill.apply(b.cache, target.entityType, batch)
+
+			b.mutex.Lock()
+			run.Processed += len(batch)
+			run.Updated += updated
+			run.Batches++
+			b.mutex.Unlock()
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package service
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/pokt-foundation/pocket-http-db/types"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackfiller_UpdatedAt(t *testing.T) {
+	c := require.New(t)
+
+	testCache := newTestCache(t)
+	writerMock := &writerMock{}
+
+	backfiller := NewBackfiller(testCache, writerMock, logrus.New())
+	backfiller.BatchSize = 1
+
+	_, _, err := backfiller.Start("created_at")
+	c.ErrorIs(err, ErrBackfillFieldNotFound)
+
+	status, err := backfiller.Status(BackfillUpdatedAt)
+	c.NoError(err)
+	c.False(status.InProgress)
+	c.Zero(status.Total)
+
+	release := make(chan time.Time)
+
+	writerMock.On("BackfillUpdatedAt", types.EntityApplication, []string{"5f62b7d8be3591c4dea8566d"}).
+		WaitUntil(release).Return(int64(1), nil).Once()
+	writerMock.On("BackfillUpdatedAt", types.EntityApplication, []string{"5f62b7d8be3591c4dea8566a"}).
+		Return(int64(1), nil).Once()
+	writerMock.On("BackfillUpdatedAt", types.EntityLoadBalancer, []string{"60ecb2bf67774900350d9c42"}).
+		Return(int64(0), nil).Once()
+
+	status, started, err := backfiller.Start(BackfillUpdatedAt)
+	c.NoError(err)
+	c.True(started)
+	c.True(status.InProgress)
+	c.Equal(3, status.Total)
+
+	// a backfill in progress is not started again
+	_, started, err = backfiller.Start(BackfillUpdatedAt)
+	c.NoError(err)
+	c.False(started)
+
+	close(release)
+
+	c.Eventually(func() bool {
+		status, _ = backfiller.Status(BackfillUpdatedAt)
+		return !status.InProgress
+	}, time.Second, 5*time.Millisecond)
+
+	c.Equal(3, status.Processed)
+	c.Equal(int64(2), status.Updated)
+	c.Equal(3, status.Batches)
+	c.Empty(status.LastError)
+
+	c.False(testCache.GetApplication("5f62b7d8be3591c4dea8566d").UpdatedAt.IsZero())
+	c.False(testCache.GetLoadBalancer("60ecb2bf67774900350d9c42").UpdatedAt.IsZero())
+
+	writerMock.AssertExpectations(t)
+}
+
+func TestBackfiller_Error(t *testing.T) {
+	c := require.New(t)
+
+	testCache := newTestCache(t)
+	writerMock := &writerMock{}
+
+	backfiller := NewBackfiller(testCache, writerMock, logrus.New())
+
+	writerMock.On("BackfillUpdatedAt", types.EntityApplication, mock.Anything).
+		Return(int64(0), errors.New("dummy error")).Once()
+
+	_, started, err := backfiller.Start(BackfillUpdatedAt)
+	c.NoError(err)
+	c.True(started)
+
+	var status BackfillStatus
+
+	c.Eventually(func() bool {
+		status, _ = backfiller.Status(BackfillUpdatedAt)
+		return !status.InProgress
+	}, time.Second, 5*time.Millisecond)
+
+	c.Equal(DefaultBackfillBatchSize, status.BatchSize)
+	c.Zero(status.Processed)
+	c.Contains(status.LastError, "dummy error")
+	c.True(testCache.GetApplication("5f62b7d8be3591c4dea8566d").UpdatedAt.IsZero())
+
+	writerMock.AssertExpectations(t)
+}
@@ -0,0 +1,46 @@
+package service
+
+import "github.com/pokt-foundation/portal-api-go/repository"
+
+// MaxBatchIDs is the number of IDs a batch read is limited to
+const MaxBatchIDs = 1000
+
+// getMany returns the entities with given ids found by get, in the order of ids, and the ids not found
+// repeated ids are returned once
+func getMany[T any](ids []string, get func(id string) (T, error)) ([]T, []string, error) {
+	if len(ids) > MaxBatchIDs {
+		return nil, nil, ErrTooManyIDs
+	}
+
+	found := []T{}
+	missing := []string{}
+	seen := make(map[string]bool, len(ids))
+
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+
+		seen[id] = true
+
+		entity, err := get(id)
+		if err != nil {
+			missing = append(missing, id)
+			continue
+		}
+
+		found = append(found, entity)
+	}
+
+	return found, missing, nil
+}
+
+// GetMany returns the cached applications with given ids and the ids of the ones not found
+func (s *ApplicationService) GetMany(ids []string) ([]*repository.Application, []string, error) {
+	return getMany(ids, s.Get)
+}
+
+// GetMany returns the cached load balancers with given ids and the ids of the ones not found
+func (s *LoadBalancerService) GetMany(ids []string) ([]*repository.LoadBalancer, []string, error) {
+	return getMany(ids, s.Get)
+}
@@ -0,0 +1,29 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplicationService_GetMany(t *testing.T) {
+	c := require.New(t)
+
+	apps := NewApplicationService(newTestCache(t), nil, logrus.New())
+
+	found, missing, err := apps.GetMany([]string{"5f62b7d8be3591c4dea8566a", "wrong", "5f62b7d8be3591c4dea8566d", "5f62b7d8be3591c4dea8566a"})
+	c.NoError(err)
+	c.Len(found, 2)
+	c.Equal("5f62b7d8be3591c4dea8566a", found[0].ID)
+	c.Equal("5f62b7d8be3591c4dea8566d", found[1].ID)
+	c.Equal([]string{"wrong"}, missing)
+
+	found, missing, err = apps.GetMany(nil)
+	c.NoError(err)
+	c.Empty(found)
+	c.Empty(missing)
+
+	_, _, err = apps.GetMany(make([]string, MaxBatchIDs+1))
+	c.ErrorIs(err, ErrTooManyIDs)
+}
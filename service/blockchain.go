@@ -0,0 +1,168 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pokt-foundation/pocket-http-db/cache"
+	"github.com/pokt-foundation/pocket-http-db/metrics"
+	"github.com/pokt-foundation/pocket-http-db/notifier"
+	"github.com/pokt-foundation/pocket-http-db/types"
+	"github.com/pokt-foundation/pocket-http-db/webhook"
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/sirupsen/logrus"
+)
+
+// BlockchainService struct handler for blockchains operations
+type BlockchainService struct {
+	cache  *cache.Cache
+	writer Writer
+	log    *logrus.Logger
+	// Notifier tells operators about blockchain deactivations
+	Notifier *notifier.Dispatcher
+	// Metrics receives the blockchain changes
+	Metrics *metrics.Registry
+	// Webhooks receives the creation, update and removal events
+	Webhooks *webhook.Dispatcher
+}
+
+// NewBlockchainService returns BlockchainService instance
+func NewBlockchainService(cache *cache.Cache, writer Writer, logger *logrus.Logger) *BlockchainService {
+	return &BlockchainService{
+		cache:  cache,
+		writer: writer,
+		log:    logger,
+	}
+}
+
+// GetAll returns all the blockchains
+func (s *BlockchainService) GetAll() []*repository.Blockchain {
+	return s.cache.GetBlockchains()
+}
+
+// Get returns the blockchain with given id
+func (s *BlockchainService) Get(id string) (*repository.Blockchain, error) {
+	blockchain := s.cache.GetBlockchain(id)
+	if blockchain == nil {
+		return nil, ErrBlockchainNotFound
+	}
+
+	return blockchain, nil
+}
+
+// Create saves blockchain and returns it as saved
+func (s *BlockchainService) Create(ctx context.Context, blockchain *repository.Blockchain) (*repository.Blockchain, error) {
+	fullBlockchain, err := s.writer.WriteBlockchain(ctx, blockchain)
+	if err != nil {
+		return nil, err
+	}
+
+	observeChange(s.Metrics, metrics.EntityBlockchain, metrics.OperationCreated, 1)
+
+	dispatchChange(s.Webhooks, webhook.EventBlockchainCreated, types.EntityBlockchain, fullBlockchain.ID, fullBlockchain)
+
+	return fullBlockchain, nil
+}
+
+// Activate sets whether the blockchain with given id is active
+func (s *BlockchainService) Activate(ctx context.Context, id string, active bool) error {
+	err := s.writer.ActivateBlockchain(ctx, id, active)
+	if err != nil {
+		return err
+	}
+
+	observeChange(s.Metrics, metrics.EntityBlockchain, metrics.OperationUpdated, 1)
+
+	if !active && s.Notifier != nil {
+		s.Notifier.Notify(notifier.Notification{
+			Event:   notifier.EventBlockchainDeactivated,
+			Subject: "Blockchain deactivated",
+			Text:    fmt.Sprintf("Blockchain %s has been deactivated.", id),
+		})
+	}
+
+	dispatchChange(s.Webhooks, webhook.EventBlockchainUpdated, types.EntityBlockchain, id, s.cache.GetBlockchain(id))
+
+	return nil
+}
+
+// Remove permanently removes the blockchain with given id along with its redirects and returns it
+// returns a *BlockchainReferencedError if redirects or application whitelists reference it, unless force is set
+// forced removals keep the blockchain ID in the application whitelists
+func (s *BlockchainService) Remove(ctx context.Context, id string, force bool) (*repository.Blockchain, error) {
+	blockchain, err := s.Get(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !force {
+		if referenced := s.references(blockchain); referenced != nil {
+			return nil, referenced
+		}
+	}
+
+	err = s.writer.RemoveBlockchain(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	observeChange(s.Metrics, metrics.EntityBlockchain, metrics.OperationRemoved, 1)
+
+	s.cache.RemoveBlockchain(id)
+
+	if s.Notifier != nil {
+		s.Notifier.Notify(notifier.Notification{
+			Event:   notifier.EventBlockchainRemoved,
+			Subject: "Blockchain removed",
+			Text:    fmt.Sprintf("Blockchain %s (%s) has been removed.", blockchain.Blockchain, id),
+		})
+	}
+
+	dispatchChange(s.Webhooks, webhook.EventBlockchainRemoved, types.EntityBlockchain, id, blockchain)
+
+	return blockchain, nil
+}
+
+// references returns the redirects and whitelisting applications of blockchain, nil if there are none
+func (s *BlockchainService) references(blockchain *repository.Blockchain) *BlockchainReferencedError {
+	referenced := &BlockchainReferencedError{}
+
+	for _, redirect := range s.cache.GetRedirects(blockchain.ID) {
+		referenced.Redirects = append(referenced.Redirects, redirect.Domain)
+	}
+
+	for _, app := range s.cache.GetApplications() {
+		if whitelistsBlockchain(app.GatewaySettings, blockchain.ID) {
+			referenced.ApplicationIDs = append(referenced.ApplicationIDs, app.ID)
+		}
+	}
+
+	if len(referenced.Redirects) == 0 && len(referenced.ApplicationIDs) == 0 {
+		return nil
+	}
+
+	return referenced
+}
+
+// whitelistsBlockchain returns true if settings whitelist the blockchain, its contracts or its methods
+func whitelistsBlockchain(settings repository.GatewaySettings, blockchainID string) bool {
+	for _, id := range settings.WhitelistBlockchains {
+		if id == blockchainID {
+			return true
+		}
+	}
+
+	for _, contract := range settings.WhitelistContracts {
+		if contract.BlockchainID == blockchainID {
+			return true
+		}
+	}
+
+	for _, method := range settings.WhitelistMethods {
+		if method.BlockchainID == blockchainID {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,171 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pokt-foundation/pocket-http-db/metrics"
+	"github.com/pokt-foundation/pocket-http-db/types"
+	"github.com/pokt-foundation/pocket-http-db/webhook"
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// maxLogLimitBlocks is the largest block range a log query of a blockchain can be limited to
+	maxLogLimitBlocks = 1000000
+	// maxBlockchainRequestTimeout is the longest relay timeout of a blockchain, in milliseconds
+	maxBlockchainRequestTimeout = 120000
+)
+
+// enforceResults are the formats the results of the relays to a blockchain can be enforced to have
+var enforceResults = map[string]bool{
+	"":     true,
+	"JSON": true,
+}
+
+// BlockchainSettingsError is returned when the operational settings of a blockchain are out of their valid ranges
+type BlockchainSettingsError struct {
+	// Details maps the invalid fields to the reason they are invalid
+	Details map[string]string
+}
+
+func (e *BlockchainSettingsError) Error() string {
+	return detailedError(ErrInvalidBlockchainSettings, e.Details)
+}
+
+// Is makes BlockchainSettingsError match ErrInvalidBlockchainSettings
+func (e *BlockchainSettingsError) Is(target error) bool {
+	return target == ErrInvalidBlockchainSettings
+}
+
+// BlockchainSettingsUpdate changes the operational settings of a blockchain, the fields not set keep their value
+// Reason and Actor are kept in the audit log
+type BlockchainSettingsUpdate struct {
+	LogLimitBlocks *int    `json:"logLimitBlocks"`
+	RequestTimeout *int    `json:"requestTimeout"`
+	EnforceResult  *string `json:"enforceResult"`
+	Reason         string  `json:"reason"`
+	Actor          string  `json:"actor"`
+}
+
+// blockchainSettingsData is saved as the data of the settings audit log entries
+type blockchainSettingsData struct {
+	PreviousSettings types.BlockchainSettings `json:"previousSettings"`
+	Settings         types.BlockchainSettings `json:"settings"`
+}
+
+// blockchainSettings returns the operational settings of blockchain
+func blockchainSettings(blockchain *repository.Blockchain) *types.BlockchainSettings {
+	return &types.BlockchainSettings{
+		LogLimitBlocks: blockchain.LogLimitBlocks,
+		RequestTimeout: blockchain.RequestTimeout,
+		EnforceResult:  blockchain.EnforceResult,
+	}
+}
+
+// validateBlockchainSettings returns a *BlockchainSettingsError if any of settings is out of its valid range
+func validateBlockchainSettings(settings *types.BlockchainSettings) error {
+	details := map[string]string{}
+
+	if settings.LogLimitBlocks < 0 || settings.LogLimitBlocks > maxLogLimitBlocks {
+		details["logLimitBlocks"] = fmt.Sprintf("must be between 0 and %d", maxLogLimitBlocks)
+	}
+
+	if settings.RequestTimeout < 0 || settings.RequestTimeout > maxBlockchainRequestTimeout {
+		details["requestTimeout"] = fmt.Sprintf("must be between 0 and %d", maxBlockchainRequestTimeout)
+	}
+
+	if !enforceResults[settings.EnforceResult] {
+		details["enforceResult"] = "must be JSON or empty"
+	}
+
+	if len(details) > 0 {
+		return &BlockchainSettingsError{Details: details}
+	}
+
+	return nil
+}
+
+// GetSettings returns the operational settings of the blockchain with given id
+func (s *BlockchainService) GetSettings(id string) (*types.BlockchainSettings, error) {
+	blockchain, err := s.Get(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return blockchainSettings(blockchain), nil
+}
+
+// UpdateSettings changes the operational settings of the blockchain with given id and returns them as saved,
+// leaving its identity fields untouched, the change is recorded in the audit log with the previous settings
+// returns a *BlockchainSettingsError if the resulting settings are out of their valid ranges
+func (s *BlockchainService) UpdateSettings(ctx context.Context, id string, input BlockchainSettingsUpdate) (*types.BlockchainSettings, error) {
+	blockchain, err := s.Get(id)
+	if err != nil {
+		return nil, err
+	}
+
+	previous := blockchainSettings(blockchain)
+	settings := blockchainSettings(blockchain)
+
+	if input.LogLimitBlocks != nil {
+		settings.LogLimitBlocks = *input.LogLimitBlocks
+	}
+
+	if input.RequestTimeout != nil {
+		settings.RequestTimeout = *input.RequestTimeout
+	}
+
+	if input.EnforceResult != nil {
+		settings.EnforceResult = strings.ToUpper(strings.TrimSpace(*input.EnforceResult))
+	}
+
+	err = validateBlockchainSettings(settings)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.writer.UpdateBlockchainSettings(ctx, id, settings)
+	if err != nil {
+		return nil, err
+	}
+
+	observeChange(s.Metrics, metrics.EntityBlockchain, metrics.OperationUpdated, 1)
+
+	updatedAt := time.Now()
+
+	s.cache.UpdateBlockchain(id, func(blockchain *repository.Blockchain) {
+		blockchain.LogLimitBlocks = settings.LogLimitBlocks
+		blockchain.RequestTimeout = settings.RequestTimeout
+		blockchain.EnforceResult = settings.EnforceResult
+		blockchain.UpdatedAt = updatedAt
+	})
+
+	rawData, _ := json.Marshal(blockchainSettingsData{
+		PreviousSettings: *previous,
+		Settings:         *settings,
+	})
+
+	err = s.writer.WriteAuditLogEntry(ctx, &types.AuditLogEntry{
+		EntityType: types.EntityBlockchain,
+		EntityID:   id,
+		Action:     types.AuditActionUpdateSettings,
+		Actor:      input.Actor,
+		Reason:     input.Reason,
+		Data:       rawData,
+	})
+	if err != nil {
+		// the settings are already saved, a failed audit entry must not hide it from the caller
+		s.log.WithFields(logrus.Fields{
+			"err": err.Error(),
+		}).Error(fmt.Errorf("WriteAuditLogEntry in UpdateSettings failed: %w", err))
+	}
+
+	dispatchChange(s.Webhooks, webhook.EventBlockchainUpdated, types.EntityBlockchain, id, s.cache.GetBlockchain(id))
+
+	return settings, nil
+}
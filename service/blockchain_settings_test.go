@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/pokt-foundation/pocket-http-db/types"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockchainService_UpdateSettings(t *testing.T) {
+	c := require.New(t)
+
+	writerMock := &writerMock{}
+	blockchains := NewBlockchainService(newTestCache(t), writerMock, logrus.New())
+
+	logLimit, timeout, enforce := 100000, 5000, " json "
+
+	settings := &types.BlockchainSettings{LogLimitBlocks: 100000, RequestTimeout: 5000, EnforceResult: "JSON"}
+
+	writerMock.On("UpdateBlockchainSettings", "0021", settings).Return(nil).Once()
+	writerMock.On("WriteAuditLogEntry", mock.MatchedBy(func(entry *types.AuditLogEntry) bool {
+		var data blockchainSettingsData
+
+		return json.Unmarshal(entry.Data, &data) == nil && data.PreviousSettings == types.BlockchainSettings{} &&
+			data.Settings == *settings && entry.Action == types.AuditActionUpdateSettings &&
+			entry.EntityType == types.EntityBlockchain && entry.EntityID == "0021" && entry.Actor == "chain-ops"
+	})).Return(errors.New("dummy error")).Once()
+
+	updated, err := blockchains.UpdateSettings(context.Background(), "0021", BlockchainSettingsUpdate{
+		LogLimitBlocks: &logLimit,
+		RequestTimeout: &timeout,
+		EnforceResult:  &enforce,
+		Actor:          "chain-ops",
+	})
+	c.NoError(err)
+	c.Equal(settings, updated)
+
+	blockchain, err := blockchains.Get("0021")
+	c.NoError(err)
+	c.Equal(100000, blockchain.LogLimitBlocks)
+	c.Equal("JSON", blockchain.EnforceResult)
+
+	// the settings not set keep their value
+	timeout = 10000
+
+	writerMock.On("UpdateBlockchainSettings", "0021", &types.BlockchainSettings{
+		LogLimitBlocks: 100000, RequestTimeout: 10000, EnforceResult: "JSON",
+	}).Return(nil).Once()
+	writerMock.On("WriteAuditLogEntry", mock.Anything).Return(nil).Once()
+
+	updated, err = blockchains.UpdateSettings(context.Background(), "0021", BlockchainSettingsUpdate{RequestTimeout: &timeout})
+	c.NoError(err)
+	c.Equal(10000, updated.RequestTimeout)
+
+	current, err := blockchains.GetSettings("0021")
+	c.NoError(err)
+	c.Equal(updated, current)
+
+	// invalid settings are rejected with every invalid field
+	logLimit, timeout, enforce = -1, 120001, "XML"
+
+	_, err = blockchains.UpdateSettings(context.Background(), "0021", BlockchainSettingsUpdate{
+		LogLimitBlocks: &logLimit,
+		RequestTimeout: &timeout,
+		EnforceResult:  &enforce,
+	})
+	c.ErrorIs(err, ErrInvalidBlockchainSettings)
+
+	var settingsErr *BlockchainSettingsError
+	c.ErrorAs(err, &settingsErr)
+	c.Len(settingsErr.Details, 3)
+	c.EqualError(err, "invalid blockchain settings: enforceResult must be JSON or empty, "+
+		"logLimitBlocks must be between 0 and 1000000, requestTimeout must be between 0 and 120000")
+
+	_, err = blockchains.UpdateSettings(context.Background(), "wrong", BlockchainSettingsUpdate{})
+	c.ErrorIs(err, ErrBlockchainNotFound)
+
+	_, err = blockchains.GetSettings("wrong")
+	c.ErrorIs(err, ErrBlockchainNotFound)
+
+	writerMock.AssertExpectations(t)
+}
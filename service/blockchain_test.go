@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockchainService_Activate(t *testing.T) {
+	c := require.New(t)
+
+	writerMock := &writerMock{}
+	blockchains := NewBlockchainService(newTestCache(t), writerMock, logrus.New())
+
+	blockchain, err := blockchains.Get("0021")
+	c.NoError(err)
+	c.Len(blockchain.Redirects, 1)
+
+	_, err = blockchains.Get("wrong")
+	c.ErrorIs(err, ErrBlockchainNotFound)
+
+	writerMock.On("ActivateBlockchain", "0021", false).Return(nil).Once()
+
+	c.NoError(blockchains.Activate(context.Background(), "0021", false))
+
+	errWriter := errors.New("dummy error")
+
+	writerMock.On("ActivateBlockchain", "0021", true).Return(errWriter).Once()
+
+	c.ErrorIs(blockchains.Activate(context.Background(), "0021", true), errWriter)
+
+	writerMock.AssertExpectations(t)
+}
+
+func TestBlockchainService_Remove(t *testing.T) {
+	c := require.New(t)
+
+	cache := newTestCache(t)
+
+	writerMock := &writerMock{}
+	blockchains := NewBlockchainService(cache, writerMock, logrus.New())
+
+	cache.UpdateApplication("5f62b7d8be3591c4dea8566a", func(app *repository.Application) {
+		app.GatewaySettings.WhitelistMethods = []repository.WhitelistMethod{
+			{BlockchainID: "0021", Methods: []string{"eth_call"}},
+		}
+	})
+
+	_, err := blockchains.Remove(context.Background(), "0021", false)
+	c.ErrorIs(err, ErrBlockchainReferenced)
+
+	var referenced *BlockchainReferencedError
+	c.ErrorAs(err, &referenced)
+	c.Equal([]string{"pokt-mainnet.gateway.network"}, referenced.Redirects)
+	c.Equal([]string{"5f62b7d8be3591c4dea8566a"}, referenced.ApplicationIDs)
+
+	writerMock.On("RemoveBlockchain", "0021").Return(errors.New("dummy error")).Once()
+
+	_, err = blockchains.Remove(context.Background(), "0021", true)
+	c.EqualError(err, "dummy error")
+
+	writerMock.On("RemoveBlockchain", "0021").Return(nil).Once()
+
+	blockchain, err := blockchains.Remove(context.Background(), "0021", true)
+	c.NoError(err)
+	c.Equal("0021", blockchain.ID)
+
+	_, err = blockchains.Get("0021")
+	c.ErrorIs(err, ErrBlockchainNotFound)
+
+	_, err = blockchains.Remove(context.Background(), "0021", true)
+	c.ErrorIs(err, ErrBlockchainNotFound)
+
+	writerMock.AssertExpectations(t)
+}
@@ -0,0 +1,109 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pokt-foundation/pocket-http-db/query"
+	"github.com/pokt-foundation/portal-api-go/repository"
+)
+
+// fieldValues maps the fields a filter expression can compare to the functions returning their value on an entity
+type fieldValues[T any] map[string]func(T) string
+
+// applicationFields are the application fields of filter expressions, contact emails and secrets are left out
+var applicationFields = fieldValues[*repository.Application]{
+	"id":     func(app *repository.Application) string { return app.ID },
+	"userID": func(app *repository.Application) string { return app.UserID },
+	"name":   func(app *repository.Application) string { return app.Name },
+	"status": func(app *repository.Application) string { return string(app.Status) },
+	"plan":   func(app *repository.Application) string { return string(app.Limits.PlanType) },
+	"dummy":  func(app *repository.Application) string { return strconv.FormatBool(app.Dummy) },
+}
+
+// loadBalancerFields are the load balancer fields of filter expressions
+var loadBalancerFields = fieldValues[*repository.LoadBalancer]{
+	"id":        func(lb *repository.LoadBalancer) string { return lb.ID },
+	"userID":    func(lb *repository.LoadBalancer) string { return lb.UserID },
+	"name":      func(lb *repository.LoadBalancer) string { return lb.Name },
+	"gigastake": func(lb *repository.LoadBalancer) string { return strconv.FormatBool(lb.Gigastake) },
+}
+
+// blockchainFields are the blockchain fields of filter expressions
+var blockchainFields = fieldValues[*repository.Blockchain]{
+	"id":         func(blockchain *repository.Blockchain) string { return blockchain.ID },
+	"blockchain": func(blockchain *repository.Blockchain) string { return blockchain.Blockchain },
+	"network":    func(blockchain *repository.Blockchain) string { return blockchain.Network },
+	"ticker":     func(blockchain *repository.Blockchain) string { return blockchain.Ticker },
+	"active":     func(blockchain *repository.Blockchain) string { return strconv.FormatBool(blockchain.Active) },
+}
+
+// Expression is a filter expression parsed over the allowlisted fields of an entity,
+// such as status=="IN_SERVICE" && plan!="FREETIER_V0" for applications
+type Expression[T any] struct {
+	expr   query.Expr
+	fields fieldValues[T]
+}
+
+// ParseApplicationExpression parses a filter expression of applications, a blank expression matches all of them
+func ParseApplicationExpression(rawExpression string) (*Expression[*repository.Application], error) {
+	return parseExpression(rawExpression, applicationFields)
+}
+
+// ParseLoadBalancerExpression parses a filter expression of load balancers, a blank expression matches all of them
+func ParseLoadBalancerExpression(rawExpression string) (*Expression[*repository.LoadBalancer], error) {
+	return parseExpression(rawExpression, loadBalancerFields)
+}
+
+// ParseBlockchainExpression parses a filter expression of blockchains, a blank expression matches all of them
+func ParseBlockchainExpression(rawExpression string) (*Expression[*repository.Blockchain], error) {
+	return parseExpression(rawExpression, blockchainFields)
+}
+
+// parseExpression returns ErrInvalidFilterExpression if rawExpression is invalid or compares a field out of fields
+func parseExpression[T any](rawExpression string, fields fieldValues[T]) (*Expression[T], error) {
+	names := make(map[string]bool, len(fields))
+	for name := range fields {
+		names[name] = true
+	}
+
+	expr, err := query.Parse(rawExpression, names)
+	if errors.Is(err, query.ErrUnknownField) {
+		sortedNames := make([]string, 0, len(names))
+		for name := range names {
+			sortedNames = append(sortedNames, name)
+		}
+
+		sort.Strings(sortedNames)
+
+		return nil, fmt.Errorf("%w: %s, fields are %s", ErrInvalidFilterExpression, err, strings.Join(sortedNames, ", "))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidFilterExpression, err)
+	}
+
+	return &Expression[T]{expr: expr, fields: fields}, nil
+}
+
+// Match returns true if entity matches the expression
+func (e *Expression[T]) Match(entity T) bool {
+	return e.expr.Match(func(field string) string {
+		return e.fields[field](entity)
+	})
+}
+
+// Filter returns the entities matching the expression
+func (e *Expression[T]) Filter(entities []T) []T {
+	filtered := make([]T, 0, len(entities))
+
+	for _, entity := range entities {
+		if e.Match(entity) {
+			filtered = append(filtered, entity)
+		}
+	}
+
+	return filtered
+}
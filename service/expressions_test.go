@@ -0,0 +1,62 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseApplicationExpression(t *testing.T) {
+	c := require.New(t)
+
+	apps := NewApplicationService(newTestCache(t), nil, logrus.New())
+
+	expr, err := ParseApplicationExpression(`status=="IN_SERVICE" && plan!="PAY_AS_YOU_GO_V0"`)
+	c.NoError(err)
+
+	filtered := expr.Filter(apps.GetAll())
+	c.Len(filtered, 1)
+	c.Equal("5f62b7d8be3591c4dea8566d", filtered[0].ID)
+
+	expr, err = ParseApplicationExpression("")
+	c.NoError(err)
+	c.Len(expr.Filter(apps.GetAll()), 2)
+
+	expr, err = ParseApplicationExpression(`userID=="60ecb2bf67774900350d9c44" || dummy==true`)
+	c.NoError(err)
+	c.Empty(expr.Filter(apps.GetAll()))
+
+	_, err = ParseApplicationExpression(`contactEmail=="a@b.c"`)
+	c.ErrorIs(err, ErrInvalidFilterExpression)
+	c.Contains(err.Error(), "fields are dummy, id, name, plan, status, userID")
+
+	_, err = ParseApplicationExpression(`status==`)
+	c.ErrorIs(err, ErrInvalidFilterExpression)
+}
+
+func TestParseLoadBalancerExpression(t *testing.T) {
+	c := require.New(t)
+
+	lbs := NewLoadBalancerService(newTestCache(t), nil)
+
+	expr, err := ParseLoadBalancerExpression(`name=="pokt" && gigastake==false`)
+	c.NoError(err)
+	c.Len(expr.Filter(lbs.GetAll()), 1)
+
+	_, err = ParseLoadBalancerExpression(`plan=="FREETIER_V0"`)
+	c.ErrorIs(err, ErrInvalidFilterExpression)
+}
+
+func TestParseBlockchainExpression(t *testing.T) {
+	c := require.New(t)
+
+	blockchains := NewBlockchainService(newTestCache(t), nil, logrus.New())
+
+	expr, err := ParseBlockchainExpression(`id=="0021" && active!=true`)
+	c.NoError(err)
+	c.Len(expr.Filter(blockchains.GetAll()), 1)
+
+	_, err = ParseBlockchainExpression(`altruist=="http://altruist"`)
+	c.ErrorIs(err, ErrInvalidFilterExpression)
+}
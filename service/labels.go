@@ -0,0 +1,166 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/pokt-foundation/pocket-http-db/cache"
+	"github.com/pokt-foundation/pocket-http-db/types"
+	"github.com/pokt-foundation/portal-api-go/repository"
+)
+
+const (
+	maxLabels           = 32
+	maxLabelValueLength = 255
+)
+
+// labelKeyPattern is the format of label keys, such as team or cost.center
+var labelKeyPattern = regexp.MustCompile(`^[A-Za-z0-9]([A-Za-z0-9_.-]{0,62}[A-Za-z0-9])?$`)
+
+// LabelSelector selects the entities with the Key label, with the Value value unless AnyValue is set
+type LabelSelector struct {
+	Key      string
+	Value    string
+	AnyValue bool
+}
+
+// ParseLabelSelectors parses selectors as key:value, or key alone to select any value
+func ParseLabelSelectors(rawSelectors []string) ([]LabelSelector, error) {
+	selectors := make([]LabelSelector, 0, len(rawSelectors))
+
+	for _, rawSelector := range rawSelectors {
+		key, value, found := strings.Cut(rawSelector, ":")
+		if !labelKeyPattern.MatchString(key) {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidLabelSelector, rawSelector)
+		}
+
+		selectors = append(selectors, LabelSelector{Key: key, Value: value, AnyValue: !found})
+	}
+
+	return selectors, nil
+}
+
+// matchLabels returns true if labels match every selector
+func matchLabels(labels map[string]string, selectors []LabelSelector) bool {
+	for _, selector := range selectors {
+		value, ok := labels[selector.Key]
+		if !ok || (!selector.AnyValue && value != selector.Value) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// validateLabels returns ErrInvalidLabels if labels are too many or have invalid keys or too long values
+func validateLabels(labels map[string]string) error {
+	if len(labels) > maxLabels {
+		return fmt.Errorf("%w: more than %d labels", ErrInvalidLabels, maxLabels)
+	}
+
+	for key, value := range labels {
+		if !labelKeyPattern.MatchString(key) {
+			return fmt.Errorf("%w: invalid key %q", ErrInvalidLabels, key)
+		}
+
+		if len(value) > maxLabelValueLength {
+			return fmt.Errorf("%w: value of %s longer than %d characters", ErrInvalidLabels, key, maxLabelValueLength)
+		}
+	}
+
+	return nil
+}
+
+// setLabels validates labels and replaces the labels of the entity with them
+func setLabels(ctx context.Context, c *cache.Cache, writer Writer, entityType types.EntityType, entityID string, labels map[string]string) error {
+	err := validateLabels(labels)
+	if err != nil {
+		return err
+	}
+
+	err = writer.WriteLabels(ctx, entityType, entityID, labels)
+	if err != nil {
+		return err
+	}
+
+	c.SetLabels(entityType, entityID, labels)
+
+	return nil
+}
+
+// GetLabels returns the labels of the application with given id
+func (s *ApplicationService) GetLabels(id string) (map[string]string, error) {
+	if _, err := s.Get(id); err != nil {
+		return nil, err
+	}
+
+	return s.cache.GetLabels(types.EntityApplication, id), nil
+}
+
+// SetLabels replaces the labels of the application with given id
+func (s *ApplicationService) SetLabels(ctx context.Context, id string, labels map[string]string) error {
+	if _, err := s.Get(id); err != nil {
+		return err
+	}
+
+	return setLabels(ctx, s.cache, s.writer, types.EntityApplication, id, labels)
+}
+
+// MatchLabels returns true if the labels of the application with given id match every selector
+func (s *ApplicationService) MatchLabels(id string, selectors []LabelSelector) bool {
+	return matchLabels(s.cache.GetLabels(types.EntityApplication, id), selectors)
+}
+
+// FilterByLabels returns the applications of apps whose labels match every selector
+func (s *ApplicationService) FilterByLabels(apps []*repository.Application, selectors []LabelSelector) []*repository.Application {
+	if len(selectors) == 0 {
+		return apps
+	}
+
+	filtered := []*repository.Application{}
+
+	for _, app := range apps {
+		if s.MatchLabels(app.ID, selectors) {
+			filtered = append(filtered, app)
+		}
+	}
+
+	return filtered
+}
+
+// GetLabels returns the labels of the load balancer with given id
+func (s *LoadBalancerService) GetLabels(id string) (map[string]string, error) {
+	if _, err := s.Get(id); err != nil {
+		return nil, err
+	}
+
+	return s.cache.GetLabels(types.EntityLoadBalancer, id), nil
+}
+
+// SetLabels replaces the labels of the load balancer with given id
+func (s *LoadBalancerService) SetLabels(ctx context.Context, id string, labels map[string]string) error {
+	if _, err := s.Get(id); err != nil {
+		return err
+	}
+
+	return setLabels(ctx, s.cache, s.writer, types.EntityLoadBalancer, id, labels)
+}
+
+// FilterByLabels returns the load balancers of lbs whose labels match every selector
+func (s *LoadBalancerService) FilterByLabels(lbs []*repository.LoadBalancer, selectors []LabelSelector) []*repository.LoadBalancer {
+	if len(selectors) == 0 {
+		return lbs
+	}
+
+	filtered := []*repository.LoadBalancer{}
+
+	for _, lb := range lbs {
+		if matchLabels(s.cache.GetLabels(types.EntityLoadBalancer, lb.ID), selectors) {
+			filtered = append(filtered, lb)
+		}
+	}
+
+	return filtered
+}
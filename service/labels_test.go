@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pokt-foundation/pocket-http-db/types"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLabelSelectors(t *testing.T) {
+	c := require.New(t)
+
+	selectors, err := ParseLabelSelectors([]string{"team:infra", "env", "owner:"})
+	c.NoError(err)
+	c.Equal([]LabelSelector{
+		{Key: "team", Value: "infra"},
+		{Key: "env", AnyValue: true},
+		{Key: "owner"},
+	}, selectors)
+
+	_, err = ParseLabelSelectors([]string{":infra"})
+	c.ErrorIs(err, ErrInvalidLabelSelector)
+}
+
+func TestApplicationService_Labels(t *testing.T) {
+	c := require.New(t)
+
+	writerMock := &writerMock{}
+	apps := NewApplicationService(newTestCache(t), writerMock, logrus.New())
+
+	labels := map[string]string{"team": "infra", "cost.center": "42"}
+
+	writerMock.On("WriteLabels", types.EntityApplication, "5f62b7d8be3591c4dea8566d", labels).Return(nil).Once()
+
+	err := apps.SetLabels(context.Background(), "5f62b7d8be3591c4dea8566d", labels)
+	c.NoError(err)
+
+	savedLabels, err := apps.GetLabels("5f62b7d8be3591c4dea8566d")
+	c.NoError(err)
+	c.Equal(labels, savedLabels)
+
+	selectors, err := ParseLabelSelectors([]string{"team:infra"})
+	c.NoError(err)
+
+	filtered := apps.FilterByLabels(apps.GetAll(), selectors)
+	c.Len(filtered, 1)
+	c.Equal("5f62b7d8be3591c4dea8566d", filtered[0].ID)
+	c.Len(apps.FilterByLabels(apps.GetAll(), nil), 2)
+
+	err = apps.SetLabels(context.Background(), "5f62b7d8be3591c4dea8566d", map[string]string{"team infra": "x"})
+	c.ErrorIs(err, ErrInvalidLabels)
+
+	err = apps.SetLabels(context.Background(), "wrong", labels)
+	c.ErrorIs(err, ErrApplicationNotFound)
+
+	writerMock.AssertExpectations(t)
+}
+
+func TestLoadBalancerService_Labels(t *testing.T) {
+	c := require.New(t)
+
+	writerMock := &writerMock{}
+	lbs := NewLoadBalancerService(newTestCache(t), writerMock)
+
+	labels := map[string]string{"team": "infra"}
+
+	writerMock.On("WriteLabels", types.EntityLoadBalancer, "60ecb2bf67774900350d9c42", labels).Return(nil).Once()
+
+	err := lbs.SetLabels(context.Background(), "60ecb2bf67774900350d9c42", labels)
+	c.NoError(err)
+
+	savedLabels, err := lbs.GetLabels("60ecb2bf67774900350d9c42")
+	c.NoError(err)
+	c.Equal(labels, savedLabels)
+
+	selectors, err := ParseLabelSelectors([]string{"team:relays"})
+	c.NoError(err)
+	c.Empty(lbs.FilterByLabels(lbs.GetAll(), selectors))
+
+	_, err = lbs.GetLabels("wrong")
+	c.ErrorIs(err, ErrLoadBalancerNotFound)
+
+	writerMock.AssertExpectations(t)
+}
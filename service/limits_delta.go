@@ -0,0 +1,145 @@
+package service
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sort"
+	"sync"
+
+	"github.com/pokt-foundation/portal-api-go/repository"
+)
+
+// DefaultMaxLimitsVersions is the number of limits versions held at once when no limit is set
+const DefaultMaxLimitsVersions = 10
+
+// LimitsDelta holds the application limits changed since a previous version of the limits, and the applications removed
+// Full is set when the previous version is not held, Changed then holds all the limits and Removed is empty
+type LimitsDelta struct {
+	Version string                 `json:"version"`
+	Full    bool                   `json:"full"`
+	Changed []repository.AppLimits `json:"changed"`
+	Removed []string               `json:"removed"`
+}
+
+// jsonVersion returns the version of value as serialized to JSON
+func jsonVersion(value any) string {
+	rawValue, _ := json.Marshal(value)
+
+	hash := sha256.Sum256(rawValue)
+
+	return hex.EncodeToString(hash[:8])
+}
+
+// limitsEntries returns the versions of the limits of every application of limits, by application ID
+func limitsEntries(limits []repository.AppLimits) map[string]string {
+	entries := make(map[string]string, len(limits))
+	for _, appLimits := range limits {
+		entries[appLimits.AppID] = jsonVersion(appLimits)
+	}
+
+	return entries
+}
+
+// LimitsVersion returns the version of a list of application limits, regardless of their order, used as its ETag
+func LimitsVersion(limits []repository.AppLimits) string {
+	return jsonVersion(limitsEntries(limits))
+}
+
+// LimitsVersions holds the last versions of the application limits served, as the versions of every application limits,
+// so pollers only get what changed since the version they hold
+type LimitsVersions struct {
+	max      int
+	order    []string
+	versions map[string]map[string]string
+	mutex    sync.Mutex
+}
+
+// NewLimitsVersions returns LimitsVersions instance holding up to max versions, max <= 0 uses DefaultMaxLimitsVersions
+func NewLimitsVersions(max int) *LimitsVersions {
+	if max <= 0 {
+		max = DefaultMaxLimitsVersions
+	}
+
+	return &LimitsVersions{
+		max:      max,
+		versions: map[string]map[string]string{},
+	}
+}
+
+// hold keeps the versions of the limits of every application of version, dropping the oldest version held if full
+func (v *LimitsVersions) hold(version string, entries map[string]string) {
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+
+	if _, ok := v.versions[version]; ok {
+		return
+	}
+
+	if len(v.order) >= v.max {
+		delete(v.versions, v.order[0])
+		v.order = v.order[1:]
+	}
+
+	v.order = append(v.order, version)
+	v.versions[version] = entries
+}
+
+// get returns the versions of the limits of every application of version, false if it is not held
+func (v *LimitsVersions) get(version string) (map[string]string, bool) {
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+
+	entries, ok := v.versions[version]
+
+	return entries, ok
+}
+
+// Delta returns the limits of limits changed since the version since and the IDs of the applications removed since,
+// all of limits if since is empty or not held on v, limits becomes a version held so it can be asked for later
+// nothing is held on a nil v, every delta is then full
+func (v *LimitsVersions) Delta(limits []repository.AppLimits, since string) LimitsDelta {
+	entries := limitsEntries(limits)
+
+	delta := LimitsDelta{
+		Version: jsonVersion(entries),
+		Changed: []repository.AppLimits{},
+		Removed: []string{},
+	}
+
+	var (
+		previous map[string]string
+		held     bool
+	)
+
+	if v != nil {
+		v.hold(delta.Version, entries)
+
+		if since != "" {
+			previous, held = v.get(since)
+		}
+	}
+
+	if !held {
+		delta.Full = true
+		delta.Changed = append(delta.Changed, limits...)
+
+		return delta
+	}
+
+	for _, appLimits := range limits {
+		if previous[appLimits.AppID] != entries[appLimits.AppID] {
+			delta.Changed = append(delta.Changed, appLimits)
+		}
+	}
+
+	for appID := range previous {
+		if _, ok := entries[appID]; !ok {
+			delta.Removed = append(delta.Removed, appID)
+		}
+	}
+
+	sort.Strings(delta.Removed)
+
+	return delta
+}
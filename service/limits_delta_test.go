@@ -0,0 +1,56 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLimitsVersions_Delta(t *testing.T) {
+	c := require.New(t)
+
+	versions := NewLimitsVersions(2)
+
+	limits := []repository.AppLimits{
+		{AppID: "1", PlanType: repository.FreetierV0, DailyLimit: 250000},
+		{AppID: "2", PlanType: repository.FreetierV0, DailyLimit: 250000},
+		{AppID: "3", PlanType: repository.PayAsYouGoV0},
+	}
+
+	first := versions.Delta(limits, "")
+	c.True(first.Full)
+	c.Len(first.Changed, 3)
+	c.Equal(LimitsVersion(limits), first.Version)
+
+	// the version does not depend on the order of the limits
+	c.Equal(first.Version, LimitsVersion([]repository.AppLimits{limits[2], limits[0], limits[1]}))
+
+	changed := []repository.AppLimits{
+		{AppID: "1", PlanType: repository.FreetierV0, DailyLimit: 250000},
+		{AppID: "3", PlanType: repository.PayAsYouGoV0, DailyLimit: 1000},
+		{AppID: "4", PlanType: repository.FreetierV0, DailyLimit: 250000},
+	}
+
+	delta := versions.Delta(changed, first.Version)
+	c.False(delta.Full)
+	c.Equal([]string{"3", "4"}, []string{delta.Changed[0].AppID, delta.Changed[1].AppID})
+	c.Equal([]string{"2"}, delta.Removed)
+	c.NotEqual(first.Version, delta.Version)
+
+	unchanged := versions.Delta(changed, delta.Version)
+	c.False(unchanged.Full)
+	c.Empty(unchanged.Changed)
+	c.Empty(unchanged.Removed)
+	c.Equal(delta.Version, unchanged.Version)
+
+	// the oldest versions are dropped once full
+	versions.Delta(limits[:1], "")
+
+	c.True(versions.Delta(changed, first.Version).Full)
+
+	// nothing is held on nil versions
+	var noVersions *LimitsVersions
+
+	c.True(noVersions.Delta(changed, delta.Version).Full)
+}
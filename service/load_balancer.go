@@ -0,0 +1,281 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pokt-foundation/pocket-http-db/cache"
+	"github.com/pokt-foundation/pocket-http-db/metrics"
+	"github.com/pokt-foundation/pocket-http-db/types"
+	"github.com/pokt-foundation/pocket-http-db/webhook"
+	"github.com/pokt-foundation/portal-api-go/repository"
+)
+
+// LoadBalancerService struct handler for load balancers operations
+type LoadBalancerService struct {
+	cache  *cache.Cache
+	writer Writer
+	// Metrics receives the load balancer changes
+	Metrics *metrics.Registry
+	// Webhooks receives the creation, update and removal events
+	Webhooks *webhook.Dispatcher
+	// UniqueNames rejects load balancers whose name is already used by another load balancer of the same user
+	UniqueNames bool
+	// UniqueStickyOrigins rejects load balancers whose sticky origins are used by other load balancers in the scope
+	UniqueStickyOrigins StickyOriginScope
+}
+
+// NewLoadBalancerService returns LoadBalancerService instance
+func NewLoadBalancerService(cache *cache.Cache, writer Writer) *LoadBalancerService {
+	return &LoadBalancerService{
+		cache:  cache,
+		writer: writer,
+	}
+}
+
+// GetAll returns all the load balancers
+func (s *LoadBalancerService) GetAll() []*repository.LoadBalancer {
+	return s.cache.GetLoadBalancers()
+}
+
+// Get returns the load balancer with given id
+func (s *LoadBalancerService) Get(id string) (*repository.LoadBalancer, error) {
+	lb := s.cache.GetLoadBalancer(id)
+	if lb == nil {
+		return nil, ErrLoadBalancerNotFound
+	}
+
+	return lb, nil
+}
+
+// GetByUserID returns the load balancers of the user with given id
+func (s *LoadBalancerService) GetByUserID(userID string) ([]*repository.LoadBalancer, error) {
+	lbs := s.cache.GetLoadBalancersByUserID(userID)
+	if len(lbs) == 0 {
+		return nil, ErrLoadBalancerNotFound
+	}
+
+	return lbs, nil
+}
+
+// GetByStickyOrigin returns the load balancers whose sticky origins include origin
+func (s *LoadBalancerService) GetByStickyOrigin(origin string) []*repository.LoadBalancer {
+	lbs := s.cache.GetLoadBalancersByStickyOrigin(origin)
+	if lbs == nil {
+		return []*repository.LoadBalancer{}
+	}
+
+	return lbs
+}
+
+// FilterByUser returns the load balancers of lbs owned by the user with given id, all of them if userID is blank
+func (s *LoadBalancerService) FilterByUser(lbs []*repository.LoadBalancer, userID string) []*repository.LoadBalancer {
+	if userID == "" {
+		return lbs
+	}
+
+	filtered := []*repository.LoadBalancer{}
+
+	for _, lb := range lbs {
+		if lb.UserID == userID {
+			filtered = append(filtered, lb)
+		}
+	}
+
+	return filtered
+}
+
+// Create saves lb and returns it as saved, with its applications
+func (s *LoadBalancerService) Create(ctx context.Context, lb *repository.LoadBalancer) (*repository.LoadBalancer, error) {
+	if conflictingLB := s.conflictingLoadBalancer(lb.UserID, lb.Name, ""); conflictingLB != nil {
+		return nil, &NameConflictError{ConflictingID: conflictingLB.ID}
+	}
+
+	err := s.checkStickyOrigins(lb.UserID, lb.StickyOptions.StickyOrigins, "")
+	if err != nil {
+		return nil, err
+	}
+
+	fullLB, err := s.writer.WriteLoadBalancer(ctx, lb)
+	if err != nil {
+		return nil, err
+	}
+
+	observeChange(s.Metrics, metrics.EntityLoadBalancer, metrics.OperationCreated, 1)
+
+	for _, appID := range fullLB.ApplicationIDs {
+		fullLB.Applications = append(fullLB.Applications, s.cache.GetApplication(appID))
+	}
+
+	fullLB.ApplicationIDs = nil // set to nil to avoid having two proofs of truth
+
+	dispatchChange(s.Webhooks, webhook.EventLoadBalancerCreated, types.EntityLoadBalancer, fullLB.ID, fullLB)
+
+	return fullLB, nil
+}
+
+// Remove removes the load balancer with given id from its user, keeping it along with its applications, and returns it
+func (s *LoadBalancerService) Remove(ctx context.Context, id string) (*repository.LoadBalancer, error) {
+	lb, err := s.Get(id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.writer.RemoveLoadBalancer(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	observeChange(s.Metrics, metrics.EntityLoadBalancer, metrics.OperationRemoved, 1)
+
+	s.cache.TransferLoadBalancer(id, "")
+	lb.UserID = ""
+
+	dispatchChange(s.Webhooks, webhook.EventLoadBalancerRemoved, types.EntityLoadBalancer, lb.ID, lb)
+
+	return lb, nil
+}
+
+// Update applies input to the load balancer with given id, removing it as Remove if input says so
+func (s *LoadBalancerService) Update(ctx context.Context, id string, input *repository.UpdateLoadBalancer) (*repository.LoadBalancer, error) {
+	if input.Remove {
+		return s.Remove(ctx, id)
+	}
+
+	lb, err := s.Get(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if conflictingLB := s.conflictingLoadBalancer(lb.UserID, input.Name, lb.ID); conflictingLB != nil {
+		return nil, &NameConflictError{ConflictingID: conflictingLB.ID}
+	}
+
+	if input.StickyOptions != nil {
+		err = s.checkStickyOrigins(lb.UserID, input.StickyOptions.StickyOrigins, lb.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = s.writer.UpdateLoadBalancer(ctx, id, input)
+	if err != nil {
+		return nil, err
+	}
+
+	observeChange(s.Metrics, metrics.EntityLoadBalancer, metrics.OperationUpdated, 1)
+
+	if input.Name != "" {
+		lb.Name = input.Name
+
+		s.cache.UpdateLoadBalancer(id, func(lb *repository.LoadBalancer) {
+			lb.Name = input.Name
+		})
+	}
+	if input.StickyOptions != nil {
+		lb.StickyOptions = *input.StickyOptions
+
+		s.cache.SetLoadBalancerStickyOptions(id, *input.StickyOptions)
+	}
+
+	dispatchChange(s.Webhooks, webhook.EventLoadBalancerUpdated, types.EntityLoadBalancer, lb.ID, lb)
+
+	return lb, nil
+}
+
+// GetApplications returns the IDs of the applications of the load balancer with given id and their version
+// the version must be sent back on SetApplications, so concurrent changes are detected
+func (s *LoadBalancerService) GetApplications(id string) ([]string, string, error) {
+	lb, err := s.Get(id)
+	if err != nil {
+		return nil, "", err
+	}
+
+	appIDs := []string{}
+	for _, app := range lb.Applications {
+		if app != nil {
+			appIDs = append(appIDs, app.ID)
+		}
+	}
+
+	return appIDs, types.LoadBalancerAppsVersion(appIDs), nil
+}
+
+// SetApplications replaces the applications of the load balancer with given id if they are still at version
+// returns a *types.LoadBalancerAppsConflictError with the current applications if they changed since
+func (s *LoadBalancerService) SetApplications(ctx context.Context, id string, appIDs []string, version string) error {
+	if version == "" {
+		return ErrMissingVersion
+	}
+
+	if _, err := s.Get(id); err != nil {
+		return err
+	}
+
+	err := s.writer.SetLoadBalancerApplications(ctx, id, appIDs, version)
+	if err != nil {
+		return err
+	}
+
+	observeChange(s.Metrics, metrics.EntityLoadBalancer, metrics.OperationUpdated, 1)
+
+	s.cache.SetLoadBalancerApplications(id, appIDs)
+
+	dispatchChange(s.Webhooks, webhook.EventLoadBalancerUpdated, types.EntityLoadBalancer, id, s.cache.GetLoadBalancer(id))
+
+	return nil
+}
+
+// ReorderApplications sets the order of the applications of the load balancer with given id, the gateway fails over
+// in this order, appIDs must hold every application of the load balancer once and they must still be at version
+func (s *LoadBalancerService) ReorderApplications(ctx context.Context, id string, appIDs []string, version string) error {
+	if version == "" {
+		return ErrMissingVersion
+	}
+
+	currentIDs, currentVersion, err := s.GetApplications(id)
+	if err != nil {
+		return err
+	}
+
+	if currentVersion != version {
+		return &types.LoadBalancerAppsConflictError{ApplicationIDs: currentIDs, Version: currentVersion}
+	}
+
+	current := make(map[string]bool, len(currentIDs))
+	for _, appID := range currentIDs {
+		current[appID] = true
+	}
+
+	if len(appIDs) != len(currentIDs) {
+		return ErrInvalidApplicationOrder
+	}
+
+	for _, appID := range appIDs {
+		if !current[appID] {
+			return ErrInvalidApplicationOrder
+		}
+
+		delete(current, appID) // an application listed twice is missing from its second occurrence
+	}
+
+	return s.SetApplications(ctx, id, appIDs, version)
+}
+
+// conflictingLoadBalancer returns the load balancer of the user already named name, ignoring excludeID
+// always returns nil if names uniqueness is not enforced
+// names are only unique in the cache, there is no database constraint behind this check, so two concurrent writes
+// or a write made by another instance before the next refresh are not caught
+func (s *LoadBalancerService) conflictingLoadBalancer(userID, name, excludeID string) *repository.LoadBalancer {
+	if !s.UniqueNames || userID == "" || name == "" {
+		return nil
+	}
+
+	for _, lb := range s.cache.GetLoadBalancersByUserID(userID) {
+		if lb.ID != excludeID && strings.EqualFold(strings.TrimSpace(lb.Name), strings.TrimSpace(name)) {
+			return lb
+		}
+	}
+
+	return nil
+}
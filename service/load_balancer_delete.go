@@ -0,0 +1,113 @@
+package service
+
+import (
+	"context"
+
+	"github.com/pokt-foundation/pocket-http-db/metrics"
+	"github.com/pokt-foundation/pocket-http-db/types"
+	"github.com/pokt-foundation/pocket-http-db/webhook"
+	"github.com/pokt-foundation/portal-api-go/repository"
+)
+
+// OrphanPolicy is what a load balancer hard delete does with the applications no other load balancer holds
+type OrphanPolicy string
+
+const (
+	// OrphansDetach keeps the orphaned applications, only detached from the load balancer
+	OrphansDetach OrphanPolicy = "detach"
+	// OrphansDelete permanently deletes the orphaned applications along with the load balancer
+	OrphansDelete OrphanPolicy = "delete"
+)
+
+// LoadBalancerDeletion summarizes the entities affected by a load balancer hard delete
+type LoadBalancerDeletion struct {
+	LoadBalancerID         string   `json:"loadBalancerID"`
+	DetachedApplicationIDs []string `json:"detachedApplicationIDs"`
+	DeletedApplicationIDs  []string `json:"deletedApplicationIDs"`
+}
+
+// Delete permanently deletes the load balancer with given id whatever its state, such as for account purges,
+// its applications held by other load balancers are detached and the orphaned ones are handled as orphans says
+func (s *LoadBalancerService) Delete(ctx context.Context, id string, orphans OrphanPolicy) (*LoadBalancerDeletion, error) {
+	if orphans != OrphansDetach && orphans != OrphansDelete {
+		return nil, ErrInvalidOrphanPolicy
+	}
+
+	lb, err := s.Get(id)
+	if err != nil {
+		return nil, err
+	}
+
+	var orphanAppIDs []string
+
+	if orphans == OrphansDelete {
+		orphanAppIDs = s.orphanApplications(lb)
+	}
+
+	deletedAppIDs, err := s.writer.DeleteLoadBalancer(ctx, id, orphanAppIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	deleted := make(map[string]bool, len(deletedAppIDs))
+	for _, appID := range deletedAppIDs {
+		deleted[appID] = true
+	}
+
+	deletion := &LoadBalancerDeletion{
+		LoadBalancerID:         id,
+		DetachedApplicationIDs: []string{},
+		DeletedApplicationIDs:  append([]string{}, deletedAppIDs...),
+	}
+
+	for _, app := range lb.Applications {
+		if app != nil && !deleted[app.ID] {
+			deletion.DetachedApplicationIDs = append(deletion.DetachedApplicationIDs, app.ID)
+		}
+	}
+
+	observeChange(s.Metrics, metrics.EntityLoadBalancer, metrics.OperationRemoved, 1)
+	observeChange(s.Metrics, metrics.EntityApplication, metrics.OperationRemoved, len(deletedAppIDs))
+
+	s.cache.RemoveLoadBalancers(id)
+	s.cache.SetLabels(types.EntityLoadBalancer, id, nil)
+
+	s.cache.RemoveApplications(deletedAppIDs...)
+	for _, appID := range deletedAppIDs {
+		s.cache.SetLabels(types.EntityApplication, appID, nil)
+	}
+
+	dispatchChange(s.Webhooks, webhook.EventLoadBalancerRemoved, types.EntityLoadBalancer, id, nil)
+	for _, appID := range deletedAppIDs {
+		dispatchChange(s.Webhooks, webhook.EventApplicationRemoved, types.EntityApplication, appID, nil)
+	}
+
+	return deletion, nil
+}
+
+// orphanApplications returns the IDs of the applications of lb no other load balancer holds
+func (s *LoadBalancerService) orphanApplications(lb *repository.LoadBalancer) []string {
+	held := make(map[string]bool)
+
+	for _, otherLB := range s.cache.GetLoadBalancers() {
+		if otherLB.ID == lb.ID {
+			continue
+		}
+
+		for _, app := range otherLB.Applications {
+			if app != nil {
+				held[app.ID] = true
+			}
+		}
+	}
+
+	var orphanAppIDs []string
+
+	for _, app := range lb.Applications {
+		if app != nil && !held[app.ID] {
+			orphanAppIDs = append(orphanAppIDs, app.ID)
+		}
+	}
+
+	return orphanAppIDs
+}
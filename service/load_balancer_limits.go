@@ -0,0 +1,66 @@
+package service
+
+import (
+	"sort"
+
+	"github.com/pokt-foundation/pocket-http-db/types"
+	"github.com/pokt-foundation/portal-api-go/repository"
+)
+
+// LoadBalancerLimits aggregates the limits of the applications of a load balancer
+type LoadBalancerLimits struct {
+	LoadBalancerID string `json:"loadBalancerID"`
+	// DailyLimit is the sum of the daily limits of the applications, meaningless if Unlimited is set
+	DailyLimit int `json:"dailyLimit"`
+	// Unlimited is set if any application relays without daily limit
+	Unlimited bool `json:"unlimited"`
+	// PlanTypes are the distinct pay plans of the applications, MixedPlans is set if there are more than one
+	PlanTypes    []repository.PayPlanType `json:"planTypes"`
+	MixedPlans   bool                     `json:"mixedPlans"`
+	Applications []repository.AppLimits   `json:"applications"`
+}
+
+// GetLimits returns the limits of the applications of the load balancer with given id, aggregated
+// suspended applications count with no daily limit, as the relay meter gets them
+func (s *LoadBalancerService) GetLimits(id string) (*LoadBalancerLimits, error) {
+	lb, err := s.Get(id)
+	if err != nil {
+		return nil, err
+	}
+
+	lbLimits := &LoadBalancerLimits{
+		LoadBalancerID: lb.ID,
+		PlanTypes:      []repository.PayPlanType{},
+		Applications:   []repository.AppLimits{},
+	}
+
+	planTypes := map[repository.PayPlanType]bool{}
+
+	for _, app := range lb.Applications {
+		if app == nil {
+			continue
+		}
+
+		limits := Limits(app)
+
+		lbLimits.Applications = append(lbLimits.Applications, limits)
+		lbLimits.DailyLimit += limits.DailyLimit
+
+		if limits.DailyLimit == 0 && app.Status != types.AppStatusSuspended {
+			lbLimits.Unlimited = true
+		}
+
+		if !planTypes[limits.PlanType] {
+			planTypes[limits.PlanType] = true
+			lbLimits.PlanTypes = append(lbLimits.PlanTypes, limits.PlanType)
+		}
+	}
+
+	sort.Slice(lbLimits.PlanTypes, func(i, j int) bool {
+		return lbLimits.PlanTypes[i] < lbLimits.PlanTypes[j]
+	})
+
+	lbLimits.MixedPlans = len(lbLimits.PlanTypes) > 1
+
+	return lbLimits, nil
+}
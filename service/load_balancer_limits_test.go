@@ -0,0 +1,46 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/pokt-foundation/pocket-http-db/types"
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadBalancerService_GetLimits(t *testing.T) {
+	c := require.New(t)
+
+	cache := newTestCache(t)
+	lbs := NewLoadBalancerService(cache, nil)
+
+	limits, err := lbs.GetLimits("60ecb2bf67774900350d9c42")
+	c.NoError(err)
+	c.Equal(250000, limits.DailyLimit)
+	c.False(limits.Unlimited)
+	c.Equal([]repository.PayPlanType{repository.FreetierV0}, limits.PlanTypes)
+	c.False(limits.MixedPlans)
+	c.Len(limits.Applications, 1)
+	c.Equal("5f62b7d8be3591c4dea8566d", limits.Applications[0].AppID)
+
+	cache.SetLoadBalancerApplications("60ecb2bf67774900350d9c42", []string{"5f62b7d8be3591c4dea8566d", "5f62b7d8be3591c4dea8566a"})
+
+	limits, err = lbs.GetLimits("60ecb2bf67774900350d9c42")
+	c.NoError(err)
+	c.True(limits.Unlimited)
+	c.True(limits.MixedPlans)
+	c.Len(limits.Applications, 2)
+
+	// suspended applications relay nothing, they are not unlimited
+	cache.UpdateApplication("5f62b7d8be3591c4dea8566a", func(app *repository.Application) {
+		app.Status = types.AppStatusSuspended
+	})
+
+	limits, err = lbs.GetLimits("60ecb2bf67774900350d9c42")
+	c.NoError(err)
+	c.False(limits.Unlimited)
+	c.Equal(250000, limits.DailyLimit)
+
+	_, err = lbs.GetLimits("wrong")
+	c.ErrorIs(err, ErrLoadBalancerNotFound)
+}
@@ -0,0 +1,65 @@
+package service
+
+import (
+	"strings"
+)
+
+// StickyOriginScope is where the sticky origins of load balancers must be unique,
+// as load balancers sharing an origin make the gateway route its sessions unpredictably
+type StickyOriginScope string
+
+const (
+	// StickyOriginsShared lets load balancers share sticky origins
+	StickyOriginsShared StickyOriginScope = ""
+	// StickyOriginsUniqueGlobal rejects sticky origins used by any other load balancer
+	StickyOriginsUniqueGlobal StickyOriginScope = "global"
+	// StickyOriginsUniquePerUser rejects sticky origins used by another load balancer of the same user
+	StickyOriginsUniquePerUser StickyOriginScope = "user"
+)
+
+// ParseStickyOriginScope returns the scope named name, empty or "none" for shared origins
+func ParseStickyOriginScope(name string) (StickyOriginScope, bool) {
+	scope := StickyOriginScope(strings.ToLower(strings.TrimSpace(name)))
+
+	switch scope {
+	case "none":
+		return StickyOriginsShared, true
+	case StickyOriginsShared, StickyOriginsUniqueGlobal, StickyOriginsUniquePerUser:
+		return scope, true
+	default:
+		return "", false
+	}
+}
+
+// checkStickyOrigins returns a *StickyOriginConflictError if any of origins is used by a load balancer other than
+// excludeID in the uniqueness scope, with userID the owner of the load balancer the origins are set to
+// removed load balancers route nothing, so their origins are free
+func (s *LoadBalancerService) checkStickyOrigins(userID string, origins []string, excludeID string) error {
+	if s.UniqueStickyOrigins == StickyOriginsShared {
+		return nil
+	}
+
+	if s.UniqueStickyOrigins == StickyOriginsUniquePerUser && userID == "" {
+		return nil
+	}
+
+	for _, origin := range origins {
+		if strings.TrimSpace(origin) == "" {
+			continue
+		}
+
+		for _, lb := range s.cache.GetLoadBalancersByStickyOrigin(origin) {
+			if lb.ID == excludeID || lb.UserID == "" {
+				continue
+			}
+
+			if s.UniqueStickyOrigins == StickyOriginsUniquePerUser && lb.UserID != userID {
+				continue
+			}
+
+			return &StickyOriginConflictError{Origin: origin, ConflictingID: lb.ID}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pokt-foundation/pocket-http-db/cache"
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseStickyOriginScope(t *testing.T) {
+	c := require.New(t)
+
+	tests := []struct {
+		name  string
+		scope StickyOriginScope
+		ok    bool
+	}{
+		{"", StickyOriginsShared, true},
+		{"none", StickyOriginsShared, true},
+		{" Global ", StickyOriginsUniqueGlobal, true},
+		{"user", StickyOriginsUniquePerUser, true},
+		{"wrong", "", false},
+	}
+
+	for _, tt := range tests {
+		scope, ok := ParseStickyOriginScope(tt.name)
+		c.Equal(tt.ok, ok, tt.name)
+		c.Equal(tt.scope, scope, tt.name)
+	}
+}
+
+func TestLoadBalancerService_UniqueStickyOrigins(t *testing.T) {
+	c := require.New(t)
+
+	testCache := newTestCache(t)
+	testCache.SetLoadBalancerStickyOptions("60ecb2bf67774900350d9c42", repository.StickyOptions{
+		StickyOrigins: []string{"https://app.example.com"},
+		Stickiness:    true,
+	})
+
+	writerMock := &writerMock{}
+	lbs := NewLoadBalancerService(testCache, writerMock)
+	lbs.UniqueStickyOrigins = StickyOriginsUniqueGlobal
+
+	lb := &repository.LoadBalancer{
+		Name:          "eth",
+		UserID:        "60ecb2bf67774900350d9c45",
+		StickyOptions: repository.StickyOptions{StickyOrigins: []string{"HTTPS://app.example.com/"}},
+	}
+
+	var conflict *StickyOriginConflictError
+
+	_, err := lbs.Create(context.Background(), lb)
+	c.ErrorIs(err, ErrStickyOriginUsed)
+	c.ErrorAs(err, &conflict)
+	c.Equal("HTTPS://app.example.com/", conflict.Origin)
+	c.Equal("60ecb2bf67774900350d9c42", conflict.ConflictingID)
+
+	// per user, the origins of other users are free
+	lbs.UniqueStickyOrigins = StickyOriginsUniquePerUser
+
+	writerMock.On("WriteLoadBalancer", lb).Return(&repository.LoadBalancer{ID: "60ecb2bf67774900350d9c44"}, nil).Once()
+
+	_, err = lbs.Create(context.Background(), lb)
+	c.NoError(err)
+
+	lb.UserID = "60ecb2bf67774900350d9c43"
+
+	_, err = lbs.Create(context.Background(), lb)
+	c.ErrorIs(err, ErrStickyOriginUsed)
+
+	// a load balancer keeps its own origins
+	input := &repository.UpdateLoadBalancer{StickyOptions: &repository.StickyOptions{
+		StickyOrigins: []string{"https://app.example.com", "https://other.example.com"},
+	}}
+
+	writerMock.On("UpdateLoadBalancer", "60ecb2bf67774900350d9c42", input).Return(nil).Once()
+
+	_, err = lbs.Update(context.Background(), "60ecb2bf67774900350d9c42", input)
+	c.NoError(err)
+
+	// removed load balancers free their origins
+	testCache.TransferLoadBalancer("60ecb2bf67774900350d9c42", "")
+
+	writerMock.On("WriteLoadBalancer", lb).Return(&repository.LoadBalancer{ID: "60ecb2bf67774900350d9c46"}, nil).Once()
+
+	_, err = lbs.Create(context.Background(), lb)
+	c.NoError(err)
+
+	writerMock.AssertExpectations(t)
+}
+
+func TestLoadBalancerService_UpdateUniqueStickyOrigins(t *testing.T) {
+	c := require.New(t)
+
+	readerMock := &cache.ReaderMock{}
+
+	readerMock.On("ReadPayPlans").Return([]*repository.PayPlan{}, nil)
+	readerMock.On("ReadRedirects").Return([]*repository.Redirect{}, nil)
+	readerMock.On("ReadApplications").Return([]*repository.Application{}, nil)
+	readerMock.On("ReadBlockchains").Return([]*repository.Blockchain{}, nil)
+	readerMock.On("ReadLoadBalancers").Return([]*repository.LoadBalancer{
+		{
+			ID:            "60ecb2bf67774900350d9c42",
+			UserID:        "60ecb2bf67774900350d9c43",
+			StickyOptions: repository.StickyOptions{StickyOrigins: []string{"https://app.example.com"}},
+		},
+		{
+			ID:     "60ecb2bf67774900350d9c44",
+			UserID: "60ecb2bf67774900350d9c43",
+		},
+	}, nil)
+
+	testCache := cache.NewCache(readerMock, logrus.New())
+	c.NoError(testCache.SetCache())
+
+	writerMock := &writerMock{}
+	lbs := NewLoadBalancerService(testCache, writerMock)
+
+	input := &repository.UpdateLoadBalancer{StickyOptions: &repository.StickyOptions{
+		StickyOrigins: []string{"https://app.example.com"},
+	}}
+
+	lbs.UniqueStickyOrigins = StickyOriginsUniquePerUser
+
+	var conflict *StickyOriginConflictError
+
+	_, err := lbs.Update(context.Background(), "60ecb2bf67774900350d9c44", input)
+	c.ErrorAs(err, &conflict)
+	c.Equal("60ecb2bf67774900350d9c42", conflict.ConflictingID)
+
+	// rejected updates change nothing
+	c.Empty(testCache.GetLoadBalancer("60ecb2bf67774900350d9c44").StickyOptions.StickyOrigins)
+
+	// shared origins are allowed by default
+	lbs.UniqueStickyOrigins = StickyOriginsShared
+
+	writerMock.On("UpdateLoadBalancer", "60ecb2bf67774900350d9c44", input).Return(nil).Once()
+
+	_, err = lbs.Update(context.Background(), "60ecb2bf67774900350d9c44", input)
+	c.NoError(err)
+	c.Len(lbs.GetByStickyOrigin("https://app.example.com"), 2)
+
+	writerMock.AssertExpectations(t)
+}
@@ -0,0 +1,288 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/pokt-foundation/pocket-http-db/types"
+	"github.com/pokt-foundation/pocket-http-db/webhook"
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadBalancerService_Create(t *testing.T) {
+	c := require.New(t)
+
+	writerMock := &writerMock{}
+	lbs := NewLoadBalancerService(newTestCache(t), writerMock)
+	lbs.UniqueNames = true
+
+	var conflict *NameConflictError
+
+	_, err := lbs.Create(context.Background(), &repository.LoadBalancer{Name: " POKT ", UserID: "60ecb2bf67774900350d9c43"})
+	c.ErrorAs(err, &conflict)
+	c.Equal("60ecb2bf67774900350d9c42", conflict.ConflictingID)
+
+	lb := &repository.LoadBalancer{Name: "eth", UserID: "60ecb2bf67774900350d9c43"}
+
+	writerMock.On("WriteLoadBalancer", lb).Return(&repository.LoadBalancer{
+		ID:             "60ecb2bf67774900350d9c44",
+		Name:           "eth",
+		ApplicationIDs: []string{"5f62b7d8be3591c4dea8566d"},
+	}, nil).Once()
+
+	fullLB, err := lbs.Create(context.Background(), lb)
+	c.NoError(err)
+	c.Nil(fullLB.ApplicationIDs)
+	c.Len(fullLB.Applications, 1)
+	c.Equal("5f62b7d8be3591c4dea8566d", fullLB.Applications[0].ID)
+
+	writerMock.AssertExpectations(t)
+}
+
+func TestLoadBalancerService_Update(t *testing.T) {
+	c := require.New(t)
+
+	writerMock := &writerMock{}
+	lbs := NewLoadBalancerService(newTestCache(t), writerMock)
+
+	input := &repository.UpdateLoadBalancer{Name: "pokt-mainnet"}
+
+	writerMock.On("UpdateLoadBalancer", "60ecb2bf67774900350d9c42", input).Return(nil).Once()
+
+	lb, err := lbs.Update(context.Background(), "60ecb2bf67774900350d9c42", input)
+	c.NoError(err)
+	c.Equal("pokt-mainnet", lb.Name)
+
+	stickyInput := &repository.UpdateLoadBalancer{StickyOptions: &repository.StickyOptions{StickyOrigins: []string{"https://app.example.com"}}}
+
+	writerMock.On("UpdateLoadBalancer", "60ecb2bf67774900350d9c42", stickyInput).Return(nil).Once()
+
+	_, err = lbs.Update(context.Background(), "60ecb2bf67774900350d9c42", stickyInput)
+	c.NoError(err)
+
+	stickyLBs := lbs.GetByStickyOrigin("https://app.example.com")
+	c.Len(stickyLBs, 1)
+	c.Equal("60ecb2bf67774900350d9c42", stickyLBs[0].ID)
+	c.Empty(lbs.GetByStickyOrigin("https://other.example.com"))
+
+	writerMock.On("RemoveLoadBalancer", "60ecb2bf67774900350d9c42").Return(nil).Once()
+
+	lb, err = lbs.Update(context.Background(), "60ecb2bf67774900350d9c42", &repository.UpdateLoadBalancer{Remove: true})
+	c.NoError(err)
+	c.Empty(lb.UserID)
+
+	_, err = lbs.GetByUserID("60ecb2bf67774900350d9c43")
+	c.ErrorIs(err, ErrLoadBalancerNotFound)
+
+	_, err = lbs.Update(context.Background(), "wrong", input)
+	c.ErrorIs(err, ErrLoadBalancerNotFound)
+
+	_, err = lbs.GetByUserID("wrong")
+	c.ErrorIs(err, ErrLoadBalancerNotFound)
+
+	writerMock.AssertExpectations(t)
+}
+
+func TestLoadBalancerService_Remove(t *testing.T) {
+	c := require.New(t)
+
+	writerMock := &writerMock{}
+	lbs := NewLoadBalancerService(newTestCache(t), writerMock)
+
+	writerMock.On("RemoveLoadBalancer", "60ecb2bf67774900350d9c42").Return(nil).Once()
+
+	lb, err := lbs.Remove(context.Background(), "60ecb2bf67774900350d9c42")
+	c.NoError(err)
+	c.Empty(lb.UserID)
+	c.Len(lb.Applications, 1)
+
+	// removed load balancers are kept without user
+	lb, err = lbs.Get("60ecb2bf67774900350d9c42")
+	c.NoError(err)
+	c.Empty(lb.UserID)
+
+	_, err = lbs.GetByUserID("60ecb2bf67774900350d9c43")
+	c.ErrorIs(err, ErrLoadBalancerNotFound)
+
+	_, err = lbs.Remove(context.Background(), "wrong")
+	c.ErrorIs(err, ErrLoadBalancerNotFound)
+
+	writerMock.AssertExpectations(t)
+}
+
+func TestLoadBalancerService_SetApplications(t *testing.T) {
+	c := require.New(t)
+
+	writerMock := &writerMock{}
+	lbs := NewLoadBalancerService(newTestCache(t), writerMock)
+
+	appIDs, version, err := lbs.GetApplications("60ecb2bf67774900350d9c42")
+	c.NoError(err)
+	c.Equal([]string{"5f62b7d8be3591c4dea8566d"}, appIDs)
+	c.Equal(types.LoadBalancerAppsVersion(appIDs), version)
+
+	newIDs := []string{"5f62b7d8be3591c4dea8566d", "5f62b7d8be3591c4dea8566a"}
+
+	writerMock.On("SetLoadBalancerApplications", "60ecb2bf67774900350d9c42", newIDs, version).Return(nil).Once()
+
+	err = lbs.SetApplications(context.Background(), "60ecb2bf67774900350d9c42", newIDs, version)
+	c.NoError(err)
+
+	appIDs, newVersion, err := lbs.GetApplications("60ecb2bf67774900350d9c42")
+	c.NoError(err)
+	c.Equal(newIDs, appIDs)
+	c.NotEqual(version, newVersion)
+
+	conflict := &types.LoadBalancerAppsConflictError{ApplicationIDs: newIDs, Version: newVersion}
+
+	writerMock.On("SetLoadBalancerApplications", "60ecb2bf67774900350d9c42", newIDs[:1], version).Return(conflict).Once()
+
+	err = lbs.SetApplications(context.Background(), "60ecb2bf67774900350d9c42", newIDs[:1], version)
+	c.ErrorIs(err, types.ErrLoadBalancerAppsConflict)
+
+	appIDs, _, err = lbs.GetApplications("60ecb2bf67774900350d9c42")
+	c.NoError(err)
+	c.Equal(newIDs, appIDs)
+
+	err = lbs.SetApplications(context.Background(), "60ecb2bf67774900350d9c42", newIDs, "")
+	c.ErrorIs(err, ErrMissingVersion)
+
+	err = lbs.SetApplications(context.Background(), "wrong", newIDs, version)
+	c.ErrorIs(err, ErrLoadBalancerNotFound)
+
+	_, _, err = lbs.GetApplications("wrong")
+	c.ErrorIs(err, ErrLoadBalancerNotFound)
+
+	writerMock.AssertExpectations(t)
+}
+
+func TestLoadBalancerService_ReorderApplications(t *testing.T) {
+	c := require.New(t)
+
+	writerMock := &writerMock{}
+	lbs := NewLoadBalancerService(newTestCache(t), writerMock)
+
+	appIDs := []string{"5f62b7d8be3591c4dea8566d", "5f62b7d8be3591c4dea8566a"}
+	version := types.LoadBalancerAppsVersion(appIDs)
+
+	writerMock.On("SetLoadBalancerApplications", "60ecb2bf67774900350d9c42", appIDs, types.LoadBalancerAppsVersion(appIDs[:1])).Return(nil).Once()
+	c.NoError(lbs.SetApplications(context.Background(), "60ecb2bf67774900350d9c42", appIDs, types.LoadBalancerAppsVersion(appIDs[:1])))
+
+	reordered := []string{"5f62b7d8be3591c4dea8566a", "5f62b7d8be3591c4dea8566d"}
+
+	writerMock.On("SetLoadBalancerApplications", "60ecb2bf67774900350d9c42", reordered, version).Return(nil).Once()
+
+	err := lbs.ReorderApplications(context.Background(), "60ecb2bf67774900350d9c42", reordered, version)
+	c.NoError(err)
+
+	currentIDs, currentVersion, err := lbs.GetApplications("60ecb2bf67774900350d9c42")
+	c.NoError(err)
+	c.Equal(reordered, currentIDs)
+	c.Equal(version, currentVersion)
+
+	// the applications cannot be changed, only their order
+	for _, invalid := range [][]string{
+		appIDs[:1],
+		{"5f62b7d8be3591c4dea8566a", "5f62b7d8be3591c4dea8566a"},
+		{"5f62b7d8be3591c4dea8566a", "5f62b7d8be3591c4dea8566f"},
+	} {
+		err = lbs.ReorderApplications(context.Background(), "60ecb2bf67774900350d9c42", invalid, version)
+		c.ErrorIs(err, ErrInvalidApplicationOrder)
+	}
+
+	err = lbs.ReorderApplications(context.Background(), "60ecb2bf67774900350d9c42", appIDs, "stale")
+	c.ErrorIs(err, types.ErrLoadBalancerAppsConflict)
+
+	err = lbs.ReorderApplications(context.Background(), "60ecb2bf67774900350d9c42", appIDs, "")
+	c.ErrorIs(err, ErrMissingVersion)
+
+	err = lbs.ReorderApplications(context.Background(), "wrong", appIDs, version)
+	c.ErrorIs(err, ErrLoadBalancerNotFound)
+
+	writerMock.AssertExpectations(t)
+}
+
+func TestLoadBalancerService_Delete(t *testing.T) {
+	c := require.New(t)
+
+	writerMock := &writerMock{}
+	lbs := NewLoadBalancerService(newTestCache(t), writerMock)
+
+	_, err := lbs.Delete(context.Background(), "60ecb2bf67774900350d9c42", "purge")
+	c.ErrorIs(err, ErrInvalidOrphanPolicy)
+
+	_, err = lbs.Delete(context.Background(), "wrong", OrphansDetach)
+	c.ErrorIs(err, ErrLoadBalancerNotFound)
+
+	writerMock.On("DeleteLoadBalancer", "60ecb2bf67774900350d9c42", []string(nil)).
+		Return([]string(nil), errors.New("dummy error")).Once()
+
+	_, err = lbs.Delete(context.Background(), "60ecb2bf67774900350d9c42", OrphansDetach)
+	c.EqualError(err, "dummy error")
+
+	writerMock.On("DeleteLoadBalancer", "60ecb2bf67774900350d9c42", []string{"5f62b7d8be3591c4dea8566d"}).
+		Return([]string{"5f62b7d8be3591c4dea8566d"}, nil).Once()
+
+	deletion, err := lbs.Delete(context.Background(), "60ecb2bf67774900350d9c42", OrphansDelete)
+	c.NoError(err)
+	c.Equal(&LoadBalancerDeletion{
+		LoadBalancerID:         "60ecb2bf67774900350d9c42",
+		DetachedApplicationIDs: []string{},
+		DeletedApplicationIDs:  []string{"5f62b7d8be3591c4dea8566d"},
+	}, deletion)
+
+	_, err = lbs.Get("60ecb2bf67774900350d9c42")
+	c.ErrorIs(err, ErrLoadBalancerNotFound)
+
+	writerMock.AssertExpectations(t)
+}
+
+func TestLoadBalancerService_Webhooks(t *testing.T) {
+	c := require.New(t)
+
+	events := make(chan webhook.Event, 2)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var event webhook.Event
+
+		_ = json.NewDecoder(r.Body).Decode(&event)
+
+		events <- event
+	}))
+	defer server.Close()
+
+	writerMock := &writerMock{}
+	lbs := NewLoadBalancerService(newTestCache(t), writerMock)
+	lbs.Webhooks = webhook.NewDispatcher([]string{server.URL}, "", time.Second, logrus.New())
+
+	lb := &repository.LoadBalancer{Name: "eth", UserID: "60ecb2bf67774900350d9c43"}
+
+	writerMock.On("WriteLoadBalancer", lb).Return(&repository.LoadBalancer{ID: "60ecb2bf67774900350d9c44", Name: "eth"}, nil).Once()
+
+	_, err := lbs.Create(context.Background(), lb)
+	c.NoError(err)
+
+	event := <-events
+	c.Equal(webhook.EventLoadBalancerCreated, event.Type)
+	c.Equal(types.EntityLoadBalancer, event.EntityType)
+	c.Equal("60ecb2bf67774900350d9c44", event.EntityID)
+	c.Equal("eth", event.Data.(map[string]any)["name"])
+
+	writerMock.On("RemoveLoadBalancer", "60ecb2bf67774900350d9c42").Return(nil).Once()
+
+	_, err = lbs.Remove(context.Background(), "60ecb2bf67774900350d9c42")
+	c.NoError(err)
+
+	event = <-events
+	c.Equal(webhook.EventLoadBalancerRemoved, event.Type)
+	c.Equal("60ecb2bf67774900350d9c42", event.EntityID)
+
+	writerMock.AssertExpectations(t)
+}
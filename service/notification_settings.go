@@ -0,0 +1,64 @@
+package service
+
+import (
+	"fmt"
+	"net/mail"
+	"sort"
+	"strings"
+)
+
+// NotificationSettingsError is returned when the notification settings of an application are invalid,
+// such as a malformed contact email, the notifications are sent to
+type NotificationSettingsError struct {
+	// Details maps the invalid fields to the reason they are invalid
+	Details map[string]string
+}
+
+func (e *NotificationSettingsError) Error() string {
+	return detailedError(ErrInvalidNotificationSettings, e.Details)
+}
+
+// Is makes NotificationSettingsError match ErrInvalidNotificationSettings
+func (e *NotificationSettingsError) Is(target error) bool {
+	return target == ErrInvalidNotificationSettings
+}
+
+// detailedError returns the message of err followed by the reason each field of details is invalid, sorted by field
+func detailedError(err error, details map[string]string) string {
+	fields := make([]string, 0, len(details))
+	for field := range details {
+		fields = append(fields, field)
+	}
+
+	sort.Strings(fields)
+
+	reasons := make([]string, 0, len(fields))
+	for _, field := range fields {
+		reasons = append(reasons, fmt.Sprintf("%s %s", field, details[field]))
+	}
+
+	return fmt.Sprintf("%s: %s", err, strings.Join(reasons, ", "))
+}
+
+// validEmail returns true if email is a bare address, such as owner@pokt.network
+func validEmail(email string) bool {
+	address, err := mail.ParseAddress(email)
+
+	return err == nil && address.Address == email
+}
+
+// validateNotificationSettings returns a *NotificationSettingsError if the contact email notifications are sent to
+// is set but malformed
+func validateNotificationSettings(contactEmail string) error {
+	details := map[string]string{}
+
+	if contactEmail != "" && !validEmail(contactEmail) {
+		details["contactEmail"] = "is not a valid email address"
+	}
+
+	if len(details) > 0 {
+		return &NotificationSettingsError{Details: details}
+	}
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidEmail(t *testing.T) {
+	c := require.New(t)
+
+	c.True(validEmail("owner@pokt.network"))
+	c.True(validEmail("owner+alerts@pokt.network"))
+
+	for _, email := range []string{"owner", "owner@", "@pokt.network", "Owner <owner@pokt.network>", " owner@pokt.network", "owner@pokt.network,other@pokt.network"} {
+		c.False(validEmail(email), email)
+	}
+}
+
+func TestApplicationService_NotificationSettingsValidation(t *testing.T) {
+	c := require.New(t)
+
+	cache := newTestCache(t)
+
+	writerMock := &writerMock{}
+	apps := NewApplicationService(cache, writerMock, logrus.New())
+
+	_, err := apps.Create(context.Background(), &repository.Application{UserID: "60ecb2bf67774900350d9c43", ContactEmail: "owner@"})
+	c.ErrorIs(err, ErrInvalidNotificationSettings)
+
+	var settingsErr *NotificationSettingsError
+	c.ErrorAs(err, &settingsErr)
+	c.Equal(map[string]string{"contactEmail": "is not a valid email address"}, settingsErr.Details)
+	c.EqualError(err, "invalid notification settings: contactEmail is not a valid email address")
+
+	// an application stored with a malformed email can be updated, but not signed up for notifications
+	cache.UpdateApplication("5f62b7d8be3591c4dea8566d", func(app *repository.Application) { app.ContactEmail = "owner@" })
+
+	writerMock.On("UpdateApplication", "5f62b7d8be3591c4dea8566d", mock.Anything).Return(nil).Once()
+
+	_, err = apps.Update(context.Background(), "5f62b7d8be3591c4dea8566d", &repository.UpdateApplication{Name: "new-name"})
+	c.NoError(err)
+
+	_, err = apps.Update(context.Background(), "5f62b7d8be3591c4dea8566d", &repository.UpdateApplication{
+		NotificationSettings: &repository.NotificationSettings{SignedUp: true, Half: true},
+	})
+	c.ErrorIs(err, ErrInvalidNotificationSettings)
+
+	writerMock.AssertExpectations(t)
+}
@@ -0,0 +1,80 @@
+package service
+
+import (
+	"github.com/pokt-foundation/portal-api-go/repository"
+)
+
+// NotificationThreshold is a fraction of the daily limit the owners of an application can be notified at
+type NotificationThreshold string
+
+const (
+	ThresholdQuarter       NotificationThreshold = "quarter"
+	ThresholdHalf          NotificationThreshold = "half"
+	ThresholdThreeQuarters NotificationThreshold = "threeQuarters"
+	ThresholdFull          NotificationThreshold = "full"
+)
+
+// notificationThresholds are the thresholds in quarters of the daily limit, with whether settings enable them
+var notificationThresholds = []struct {
+	threshold NotificationThreshold
+	quarters  int64
+	enabled   func(settings repository.NotificationSettings) bool
+}{
+	{ThresholdQuarter, 1, func(settings repository.NotificationSettings) bool { return settings.Quarter }},
+	{ThresholdHalf, 2, func(settings repository.NotificationSettings) bool { return settings.Half }},
+	{ThresholdThreeQuarters, 3, func(settings repository.NotificationSettings) bool { return settings.ThreeQuarters }},
+	{ThresholdFull, 4, func(settings repository.NotificationSettings) bool { return settings.Full }},
+}
+
+// NotificationEvaluation reports the notification thresholds crossed by the relay usage of an application
+type NotificationEvaluation struct {
+	ApplicationID string `json:"applicationID"`
+	Usage         int64  `json:"usage"`
+	// DailyLimit is the limit of the application when evaluated, 0 is unlimited and crosses no threshold
+	DailyLimit int `json:"dailyLimit"`
+	// Crossed are the thresholds reached by the usage, whether their notifications are enabled or not
+	Crossed []NotificationThreshold `json:"crossed"`
+	// Notify are the crossed thresholds the owners of the application signed up to be notified of
+	Notify []NotificationThreshold `json:"notify"`
+}
+
+// EvaluateNotifications returns the notification thresholds crossed by usage relays on the application with given id,
+// according to its limits and notification settings, so the relay meter and the notifier share the same rules
+func (s *ApplicationService) EvaluateNotifications(id string, usage int64) (*NotificationEvaluation, error) {
+	if usage < 0 {
+		return nil, ErrInvalidUsage
+	}
+
+	app, err := s.Get(id)
+	if err != nil {
+		return nil, err
+	}
+
+	limits := Limits(app)
+
+	evaluation := &NotificationEvaluation{
+		ApplicationID: app.ID,
+		Usage:         usage,
+		DailyLimit:    limits.DailyLimit,
+		Crossed:       []NotificationThreshold{},
+		Notify:        []NotificationThreshold{},
+	}
+
+	if limits.DailyLimit <= 0 {
+		return evaluation, nil
+	}
+
+	for _, threshold := range notificationThresholds {
+		if usage*4 < int64(limits.DailyLimit)*threshold.quarters {
+			break
+		}
+
+		evaluation.Crossed = append(evaluation.Crossed, threshold.threshold)
+
+		if app.NotificationSettings.SignedUp && threshold.enabled(app.NotificationSettings) {
+			evaluation.Notify = append(evaluation.Notify, threshold.threshold)
+		}
+	}
+
+	return evaluation, nil
+}
@@ -0,0 +1,60 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplicationService_EvaluateNotifications(t *testing.T) {
+	c := require.New(t)
+
+	apps := NewApplicationService(newTestCache(t), nil, logrus.New())
+
+	apps.cache.UpdateApplication("5f62b7d8be3591c4dea8566d", func(app *repository.Application) {
+		app.NotificationSettings = repository.NotificationSettings{SignedUp: true, Half: true, Full: true}
+	})
+
+	evaluation, err := apps.EvaluateNotifications("5f62b7d8be3591c4dea8566d", 187500)
+	c.NoError(err)
+	c.Equal(&NotificationEvaluation{
+		ApplicationID: "5f62b7d8be3591c4dea8566d",
+		Usage:         187500,
+		DailyLimit:    250000,
+		Crossed:       []NotificationThreshold{ThresholdQuarter, ThresholdHalf, ThresholdThreeQuarters},
+		Notify:        []NotificationThreshold{ThresholdHalf},
+	}, evaluation)
+
+	evaluation, err = apps.EvaluateNotifications("5f62b7d8be3591c4dea8566d", 62499)
+	c.NoError(err)
+	c.Empty(evaluation.Crossed)
+
+	evaluation, err = apps.EvaluateNotifications("5f62b7d8be3591c4dea8566d", 300000)
+	c.NoError(err)
+	c.Len(evaluation.Crossed, 4)
+	c.Equal([]NotificationThreshold{ThresholdHalf, ThresholdFull}, evaluation.Notify)
+
+	// owners not signed up are never notified
+	apps.cache.UpdateApplication("5f62b7d8be3591c4dea8566d", func(app *repository.Application) {
+		app.NotificationSettings.SignedUp = false
+	})
+
+	evaluation, err = apps.EvaluateNotifications("5f62b7d8be3591c4dea8566d", 300000)
+	c.NoError(err)
+	c.Len(evaluation.Crossed, 4)
+	c.Empty(evaluation.Notify)
+
+	// applications without daily limit cross no threshold
+	evaluation, err = apps.EvaluateNotifications("5f62b7d8be3591c4dea8566a", 300000)
+	c.NoError(err)
+	c.Equal(0, evaluation.DailyLimit)
+	c.Empty(evaluation.Crossed)
+
+	_, err = apps.EvaluateNotifications("5f62b7d8be3591c4dea8566d", -1)
+	c.ErrorIs(err, ErrInvalidUsage)
+
+	_, err = apps.EvaluateNotifications("wrong", 1)
+	c.ErrorIs(err, ErrApplicationNotFound)
+}
@@ -0,0 +1,304 @@
+package service
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pokt-foundation/pocket-http-db/casing"
+	"github.com/pokt-foundation/portal-api-go/repository"
+)
+
+// sortTimeLayout formats times so their strings sort as the times do
+const sortTimeLayout = "2006-01-02T15:04:05.000000000Z"
+
+// sortTime returns t as a sort value
+func sortTime(t time.Time) string {
+	return t.UTC().Format(sortTimeLayout)
+}
+
+// applicationSortFields are the application fields lists can be sorted by
+var applicationSortFields = fieldValues[*repository.Application]{
+	"id":                 func(app *repository.Application) string { return app.ID },
+	"userID":             func(app *repository.Application) string { return app.UserID },
+	"name":               func(app *repository.Application) string { return app.Name },
+	"status":             func(app *repository.Application) string { return string(app.Status) },
+	"plan":               func(app *repository.Application) string { return string(app.Limits.PlanType) },
+	"createdAt":          func(app *repository.Application) string { return sortTime(app.CreatedAt) },
+	"updatedAt":          func(app *repository.Application) string { return sortTime(app.UpdatedAt) },
+	"firstDateSurpassed": func(app *repository.Application) string { return sortTime(app.FirstDateSurpassed) },
+}
+
+// loadBalancerSortFields are the load balancer fields lists can be sorted by
+var loadBalancerSortFields = fieldValues[*repository.LoadBalancer]{
+	"id":        func(lb *repository.LoadBalancer) string { return lb.ID },
+	"userID":    func(lb *repository.LoadBalancer) string { return lb.UserID },
+	"name":      func(lb *repository.LoadBalancer) string { return lb.Name },
+	"createdAt": func(lb *repository.LoadBalancer) string { return sortTime(lb.CreatedAt) },
+	"updatedAt": func(lb *repository.LoadBalancer) string { return sortTime(lb.UpdatedAt) },
+}
+
+// blockchainSortFields are the blockchain fields lists can be sorted by
+var blockchainSortFields = fieldValues[*repository.Blockchain]{
+	"id":         func(blockchain *repository.Blockchain) string { return blockchain.ID },
+	"blockchain": func(blockchain *repository.Blockchain) string { return blockchain.Blockchain },
+	"network":    func(blockchain *repository.Blockchain) string { return blockchain.Network },
+	"ticker":     func(blockchain *repository.Blockchain) string { return blockchain.Ticker },
+	"createdAt":  func(blockchain *repository.Blockchain) string { return sortTime(blockchain.CreatedAt) },
+	"updatedAt":  func(blockchain *repository.Blockchain) string { return sortTime(blockchain.UpdatedAt) },
+}
+
+// appLimitsSortFields are the application limits fields lists can be sorted by
+var appLimitsSortFields = fieldValues[repository.AppLimits]{
+	"id":     func(limits repository.AppLimits) string { return limits.AppID },
+	"userID": func(limits repository.AppLimits) string { return limits.AppUserID },
+	"name":   func(limits repository.AppLimits) string { return limits.AppName },
+	"plan":   func(limits repository.AppLimits) string { return string(limits.PlanType) },
+}
+
+// sortKey is a field of an ordering, in descending order if set
+type sortKey struct {
+	field      string
+	descending bool
+}
+
+// Ordering sorts lists by sort keys, such as plan,-createdAt, ties always broken by ascending ID
+// so every entity has a single position and pages after a cursor never skip nor repeat entities
+type Ordering[T any] struct {
+	keys   []sortKey
+	fields fieldValues[T]
+	// Scope identifies the paginated list, such as its route and filters, cursors of other lists are rejected
+	Scope string
+	// Generation identifies the cache snapshot the entities were read from
+	Generation string
+	// Snapshots holds the sorted lists of traversals, nil only serves next pages while the generation is unchanged
+	Snapshots *PageSnapshots
+}
+
+// cursor is the position after the last entity of a page, as the values of its sort keys, the ID being the last one,
+// in the traversal of a list started on a cache generation, with the snapshot held for it if any
+type cursor struct {
+	Sort       string   `json:"sort"`
+	Scope      string   `json:"scope,omitempty"`
+	Generation string   `json:"generation,omitempty"`
+	Snapshot   string   `json:"snapshot,omitempty"`
+	Values     []string `json:"values"`
+}
+
+// ParseApplicationOrdering parses a sort parameter of applications, a blank parameter sorts by ID
+func ParseApplicationOrdering(rawSort string) (*Ordering[*repository.Application], error) {
+	return parseOrdering(rawSort, applicationSortFields)
+}
+
+// ParseLoadBalancerOrdering parses a sort parameter of load balancers, a blank parameter sorts by ID
+func ParseLoadBalancerOrdering(rawSort string) (*Ordering[*repository.LoadBalancer], error) {
+	return parseOrdering(rawSort, loadBalancerSortFields)
+}
+
+// ParseBlockchainOrdering parses a sort parameter of blockchains, a blank parameter sorts by ID
+func ParseBlockchainOrdering(rawSort string) (*Ordering[*repository.Blockchain], error) {
+	return parseOrdering(rawSort, blockchainSortFields)
+}
+
+// ParseAppLimitsOrdering parses a sort parameter of application limits, a blank parameter sorts by application ID
+func ParseAppLimitsOrdering(rawSort string) (*Ordering[repository.AppLimits], error) {
+	return parseOrdering(rawSort, appLimitsSortFields)
+}
+
+// parseOrdering parses rawSort as comma separated fields, prefixed with - for descending order
+// fields are camelCase as in the responses, snake_case is accepted too
+// returns ErrInvalidSort if a field is repeated or out of fields
+func parseOrdering[T any](rawSort string, fields fieldValues[T]) (*Ordering[T], error) {
+	ordering := &Ordering[T]{fields: fields}
+
+	if strings.TrimSpace(rawSort) == "" {
+		return ordering, nil
+	}
+
+	seen := map[string]bool{}
+
+	for _, rawKey := range strings.Split(rawSort, ",") {
+		rawKey = strings.TrimSpace(rawKey)
+
+		key := sortKey{field: rawKey}
+		if strings.HasPrefix(rawKey, "-") {
+			key = sortKey{field: rawKey[1:], descending: true}
+		}
+
+		key.field = casing.ToCamel(key.field)
+
+		if _, ok := fields[key.field]; !ok {
+			return nil, fmt.Errorf("%w: unknown field %q", ErrInvalidSort, rawKey)
+		}
+
+		if seen[key.field] {
+			return nil, fmt.Errorf("%w: repeated field %q", ErrInvalidSort, rawKey)
+		}
+
+		seen[key.field] = true
+
+		ordering.keys = append(ordering.keys, key)
+	}
+
+	return ordering, nil
+}
+
+// String returns the ordering as a sort parameter
+func (o *Ordering[T]) String() string {
+	keys := make([]string, 0, len(o.keys))
+
+	for _, key := range o.keys {
+		if key.descending {
+			keys = append(keys, "-"+key.field)
+			continue
+		}
+
+		keys = append(keys, key.field)
+	}
+
+	return strings.Join(keys, ",")
+}
+
+// values returns the sort values of entity, its ID being the last one
+func (o *Ordering[T]) values(entity T) []string {
+	values := make([]string, 0, len(o.keys)+1)
+
+	for _, key := range o.keys {
+		values = append(values, o.fields[key.field](entity))
+	}
+
+	return append(values, o.fields["id"](entity))
+}
+
+// compare returns a negative number if the a values go before the b values, positive if after and 0 if equal
+func (o *Ordering[T]) compare(a, b []string) int {
+	for i, key := range o.keys {
+		result := strings.Compare(a[i], b[i])
+		if key.descending {
+			result = -result
+		}
+
+		if result != 0 {
+			return result
+		}
+	}
+
+	return strings.Compare(a[len(a)-1], b[len(b)-1])
+}
+
+// Sort returns a sorted copy of entities, so the cache snapshot they were read from is left as is
+func (o *Ordering[T]) Sort(entities []T) []T {
+	values := make([][]string, len(entities))
+	indexes := make([]int, len(entities))
+
+	for i, entity := range entities {
+		values[i] = o.values(entity)
+		indexes[i] = i
+	}
+
+	sort.Slice(indexes, func(i, j int) bool {
+		return o.compare(values[indexes[i]], values[indexes[j]]) < 0
+	})
+
+	sorted := make([]T, len(entities))
+	for i, index := range indexes {
+		sorted[i] = entities[index]
+	}
+
+	return sorted
+}
+
+// Page returns the first limit sorted entities after rawCursor, all of them if limit <= 0, and the cursor after them
+// the cursor is empty on the last page, a blank rawCursor starts from the first entity
+// next pages are served from the snapshot held for the traversal, or from entities while the cache generation is the
+// one of the first page, returns ErrCursorExpired otherwise so the client restarts the traversal
+// returns ErrInvalidCursor if rawCursor is malformed or was returned for another ordering or list
+func (o *Ordering[T]) Page(entities []T, rawCursor string, limit int) ([]T, string, error) {
+	position := cursor{Sort: o.String(), Scope: o.Scope, Generation: o.Generation}
+
+	var sorted []T
+
+	if rawCursor == "" {
+		sorted = o.Sort(entities)
+	} else {
+		after, err := o.decodeCursor(rawCursor)
+		if err != nil {
+			return nil, "", err
+		}
+
+		position.Generation = after.Generation
+
+		held, ok := o.Snapshots.get(after.Snapshot).([]T)
+		switch {
+		case ok:
+			position.Snapshot, sorted = after.Snapshot, held
+		case after.Generation == o.Generation:
+			sorted = o.Sort(entities)
+		default:
+			return nil, "", ErrCursorExpired
+		}
+
+		start := sort.Search(len(sorted), func(i int) bool {
+			return o.compare(o.values(sorted[i]), after.Values) > 0
+		})
+
+		sorted = sorted[start:]
+	}
+
+	if limit <= 0 || len(sorted) <= limit {
+		return sorted, "", nil
+	}
+
+	// the rest of the sorted list is held once per traversal, the first page it is not held on
+	if position.Snapshot == "" {
+		position.Snapshot = o.Snapshots.hold(sorted)
+	}
+
+	page := sorted[:limit]
+	position.Values = o.values(page[limit-1])
+
+	return page, o.encodeCursor(position), nil
+}
+
+// Offset returns the limit sorted entities after the first offset ones, an empty list past the last entity
+// entities are sorted on every call, so numbered pages are only consistent while the entities are unchanged
+func (o *Ordering[T]) Offset(entities []T, offset, limit int) []T {
+	sorted := o.Sort(entities)
+
+	if offset >= len(sorted) {
+		return []T{}
+	}
+
+	sorted = sorted[offset:]
+
+	if len(sorted) > limit {
+		sorted = sorted[:limit]
+	}
+
+	return sorted
+}
+
+func (o *Ordering[T]) encodeCursor(position cursor) string {
+	rawCursor, _ := json.Marshal(position)
+
+	return base64.RawURLEncoding.EncodeToString(rawCursor)
+}
+
+func (o *Ordering[T]) decodeCursor(rawCursor string) (cursor, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(rawCursor)
+	if err != nil {
+		return cursor{}, ErrInvalidCursor
+	}
+
+	var c cursor
+
+	err = json.Unmarshal(decoded, &c)
+	if err != nil || c.Sort != o.String() || c.Scope != o.Scope || len(c.Values) != len(o.keys)+1 {
+		return cursor{}, ErrInvalidCursor
+	}
+
+	return c, nil
+}
@@ -0,0 +1,208 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrdering_Sort(t *testing.T) {
+	c := require.New(t)
+
+	createdAt := time.Date(2022, time.July, 21, 10, 0, 0, 0, time.UTC)
+
+	apps := []*repository.Application{
+		{ID: "4", Limits: repository.AppLimits{PlanType: repository.FreetierV0}, CreatedAt: createdAt},
+		{ID: "3", Limits: repository.AppLimits{PlanType: repository.PayAsYouGoV0}, CreatedAt: createdAt},
+		{ID: "2", Limits: repository.AppLimits{PlanType: repository.FreetierV0}, CreatedAt: createdAt.Add(time.Hour)},
+		{ID: "1", Limits: repository.AppLimits{PlanType: repository.FreetierV0}, CreatedAt: createdAt},
+	}
+
+	ordering, err := ParseApplicationOrdering("plan,-created_at")
+	c.NoError(err)
+	c.Equal("plan,-createdAt", ordering.String())
+
+	sorted := ordering.Sort(apps)
+	c.Equal([]string{"2", "1", "4", "3"}, appIDs(sorted))
+
+	// the sorted list is a copy
+	c.Equal("4", apps[0].ID)
+
+	ordering, err = ParseApplicationOrdering("")
+	c.NoError(err)
+	c.Equal([]string{"1", "2", "3", "4"}, appIDs(ordering.Sort(apps)))
+
+	for _, rawSort := range []string{"contactEmail", "plan,-plan", "plan,,id"} {
+		_, err = ParseApplicationOrdering(rawSort)
+		c.ErrorIs(err, ErrInvalidSort, rawSort)
+	}
+}
+
+func TestOrdering_Page(t *testing.T) {
+	c := require.New(t)
+
+	lbs := []*repository.LoadBalancer{
+		{ID: "1", Name: "b"},
+		{ID: "2", Name: "a"},
+		{ID: "3", Name: "b"},
+		{ID: "4", Name: "c"},
+	}
+
+	ordering, err := ParseLoadBalancerOrdering("name")
+	c.NoError(err)
+
+	page, cursor, err := ordering.Page(lbs, "", 2)
+	c.NoError(err)
+	c.Equal([]string{"2", "1"}, lbIDs(page))
+	c.NotEmpty(cursor)
+
+	// entities changed between pages neither skip nor repeat the others
+	lbs = append(lbs[1:], &repository.LoadBalancer{ID: "0", Name: "a"})
+
+	page, cursor, err = ordering.Page(lbs, cursor, 2)
+	c.NoError(err)
+	c.Equal([]string{"3", "4"}, lbIDs(page))
+	c.Empty(cursor)
+
+	page, cursor, err = ordering.Page(lbs, "", 0)
+	c.NoError(err)
+	c.Len(page, 4)
+	c.Empty(cursor)
+
+	_, cursor, err = ordering.Page(lbs, "", 1)
+	c.NoError(err)
+
+	otherOrdering, err := ParseLoadBalancerOrdering("-name")
+	c.NoError(err)
+
+	_, _, err = otherOrdering.Page(lbs, cursor, 1)
+	c.ErrorIs(err, ErrInvalidCursor)
+
+	_, _, err = ordering.Page(lbs, "not a cursor", 1)
+	c.ErrorIs(err, ErrInvalidCursor)
+}
+
+func TestOrdering_Offset(t *testing.T) {
+	c := require.New(t)
+
+	lbs := []*repository.LoadBalancer{
+		{ID: "1", Name: "b"},
+		{ID: "2", Name: "a"},
+		{ID: "3", Name: "b"},
+		{ID: "4", Name: "c"},
+	}
+
+	ordering, err := ParseLoadBalancerOrdering("-name")
+	c.NoError(err)
+
+	c.Equal([]string{"4", "1"}, lbIDs(ordering.Offset(lbs, 0, 2)))
+	c.Equal([]string{"3", "2"}, lbIDs(ordering.Offset(lbs, 2, 2)))
+	c.Equal([]string{"2"}, lbIDs(ordering.Offset(lbs, 3, 2)))
+	c.Empty(ordering.Offset(lbs, 4, 2))
+
+	// the entities are left in their order
+	c.Equal("1", lbs[0].ID)
+
+	limitsOrdering, err := ParseAppLimitsOrdering("plan")
+	c.NoError(err)
+
+	limits := limitsOrdering.Offset([]repository.AppLimits{
+		{AppID: "2", PlanType: repository.FreetierV0},
+		{AppID: "1", PlanType: repository.PayAsYouGoV0},
+		{AppID: "0", PlanType: repository.FreetierV0},
+	}, 0, 2)
+	c.Equal("0", limits[0].AppID)
+	c.Equal("2", limits[1].AppID)
+}
+
+func appIDs(apps []*repository.Application) []string {
+	ids := []string{}
+	for _, app := range apps {
+		ids = append(ids, app.ID)
+	}
+
+	return ids
+}
+
+func lbIDs(lbs []*repository.LoadBalancer) []string {
+	ids := []string{}
+	for _, lb := range lbs {
+		ids = append(ids, lb.ID)
+	}
+
+	return ids
+}
+
+func TestOrdering_PageSnapshots(t *testing.T) {
+	c := require.New(t)
+
+	now := time.Date(2022, time.July, 21, 10, 0, 0, 0, time.UTC)
+
+	snapshots := NewPageSnapshots(time.Minute, 0)
+	snapshots.now = func() time.Time { return now }
+
+	lbs := []*repository.LoadBalancer{{ID: "1"}, {ID: "2"}, {ID: "3"}}
+
+	ordering, err := ParseLoadBalancerOrdering("")
+	c.NoError(err)
+
+	ordering.Scope, ordering.Generation, ordering.Snapshots = "/load_balancer?", "1", snapshots
+
+	page, cursor, err := ordering.Page(lbs, "", 1)
+	c.NoError(err)
+	c.Equal([]string{"1"}, lbIDs(page))
+
+	// the cache refreshed without the second load balancer, the held snapshot still has it
+	ordering.Generation = "2"
+	lbs = []*repository.LoadBalancer{{ID: "1"}, {ID: "3"}}
+
+	page, nextCursor, err := ordering.Page(lbs, cursor, 1)
+	c.NoError(err)
+	c.Equal([]string{"2"}, lbIDs(page))
+
+	page, _, err = ordering.Page(lbs, nextCursor, 1)
+	c.NoError(err)
+	c.Equal([]string{"3"}, lbIDs(page))
+
+	// once the snapshot expires the traversal must restart
+	now = now.Add(time.Minute)
+
+	_, _, err = ordering.Page(lbs, cursor, 1)
+	c.ErrorIs(err, ErrCursorExpired)
+
+	// without snapshot, next pages are served while the generation is unchanged
+	ordering.Snapshots = nil
+
+	_, cursor, err = ordering.Page(lbs, "", 1)
+	c.NoError(err)
+
+	page, _, err = ordering.Page(lbs, cursor, 1)
+	c.NoError(err)
+	c.Equal([]string{"3"}, lbIDs(page))
+
+	ordering.Generation = "3"
+
+	_, _, err = ordering.Page(lbs, cursor, 1)
+	c.ErrorIs(err, ErrCursorExpired)
+
+	// cursors of other lists are rejected
+	ordering.Scope = "/user/60ecb2bf67774900350d9c43/load_balancer?"
+
+	_, _, err = ordering.Page(lbs, cursor, 1)
+	c.ErrorIs(err, ErrInvalidCursor)
+}
+
+func TestPageSnapshots_Max(t *testing.T) {
+	c := require.New(t)
+
+	snapshots := NewPageSnapshots(time.Minute, 1)
+
+	id := snapshots.hold([]string{"a"})
+	c.NotEmpty(id)
+	c.Equal([]string{"a"}, snapshots.get(id))
+
+	c.Empty(snapshots.hold([]string{"b"}))
+	c.Nil(snapshots.get("unknown"))
+}
@@ -0,0 +1,93 @@
+package service
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"sync"
+	"time"
+)
+
+// DefaultMaxPageSnapshots is the number of snapshots held at once when no limit is set
+const DefaultMaxPageSnapshots = 1000
+
+// pageSnapshot is a sorted list held for a traversal
+type pageSnapshot struct {
+	entities  any
+	expiresAt time.Time
+}
+
+// PageSnapshots holds the sorted lists of paginated traversals for a while,
+// so their next pages are served from the same snapshot even if the cache is refreshed in between
+type PageSnapshots struct {
+	ttl       time.Duration
+	max       int
+	snapshots map[string]pageSnapshot
+	now       func() time.Time
+	mutex     sync.Mutex
+}
+
+// NewPageSnapshots returns PageSnapshots instance holding up to max snapshots for ttl each
+// max <= 0 uses DefaultMaxPageSnapshots
+func NewPageSnapshots(ttl time.Duration, max int) *PageSnapshots {
+	if max <= 0 {
+		max = DefaultMaxPageSnapshots
+	}
+
+	return &PageSnapshots{
+		ttl:       ttl,
+		max:       max,
+		snapshots: map[string]pageSnapshot{},
+		now:       time.Now,
+	}
+}
+
+// hold keeps entities and returns their snapshot ID, empty if nothing is held on s or it is full
+func (s *PageSnapshots) hold(entities any) string {
+	if s == nil {
+		return ""
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	now := s.now()
+
+	for id, snapshot := range s.snapshots {
+		if !now.Before(snapshot.expiresAt) {
+			delete(s.snapshots, id)
+		}
+	}
+
+	if len(s.snapshots) >= s.max {
+		return ""
+	}
+
+	rawID := make([]byte, 8)
+
+	_, err := rand.Read(rawID)
+	if err != nil {
+		return ""
+	}
+
+	id := hex.EncodeToString(rawID)
+	s.snapshots[id] = pageSnapshot{entities: entities, expiresAt: now.Add(s.ttl)}
+
+	return id
+}
+
+// get returns the entities held with given snapshot ID, nil if they expired
+func (s *PageSnapshots) get(id string) any {
+	if s == nil || id == "" {
+		return nil
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	snapshot, ok := s.snapshots[id]
+	if !ok || !s.now().Before(snapshot.expiresAt) {
+		return nil
+	}
+
+	return snapshot.entities
+}
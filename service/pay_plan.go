@@ -0,0 +1,72 @@
+package service
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/pokt-foundation/pocket-http-db/cache"
+	"github.com/pokt-foundation/portal-api-go/repository"
+)
+
+// planTypePattern is the format of pay plan types once normalized, such as FREETIER_V0
+var planTypePattern = regexp.MustCompile(`^[A-Z][A-Z0-9]*(_[A-Z0-9]+)*$`)
+
+// maxPlanTypeLength is the length limit of pay plan types
+const maxPlanTypeLength = 64
+
+// PayPlanService struct handler for pay plans operations
+type PayPlanService struct {
+	cache *cache.Cache
+}
+
+// NewPayPlanService returns PayPlanService instance
+func NewPayPlanService(cache *cache.Cache) *PayPlanService {
+	return &PayPlanService{
+		cache: cache,
+	}
+}
+
+// GetAll returns all the pay plans
+func (s *PayPlanService) GetAll() []*repository.PayPlan {
+	return s.cache.GetPayPlans()
+}
+
+// Get returns the pay plan of given type, normalized by NormalizePlanType
+func (s *PayPlanService) Get(planType repository.PayPlanType) (*repository.PayPlan, error) {
+	normalizedType, err := NormalizePlanType(string(planType))
+	if err != nil {
+		return nil, err
+	}
+
+	plan := s.cache.GetPayPlan(normalizedType)
+	if plan == nil {
+		return nil, ErrPayPlanNotFound
+	}
+
+	return plan, nil
+}
+
+// GetByDailyLimit returns the pay plans with given daily limit, several plans may share one
+func (s *PayPlanService) GetByDailyLimit(dailyLimit int) []*repository.PayPlan {
+	plans := []*repository.PayPlan{}
+
+	for _, plan := range s.cache.GetPayPlans() {
+		if plan.DailyLimit == dailyLimit {
+			plans = append(plans, plan)
+		}
+	}
+
+	return plans
+}
+
+// NormalizePlanType returns rawType trimmed, uppercased and with dashes as underscores,
+// ErrInvalidPlanType if the result does not have the format of a pay plan type
+func NormalizePlanType(rawType string) (repository.PayPlanType, error) {
+	planType := strings.ReplaceAll(strings.ToUpper(strings.TrimSpace(rawType)), "-", "_")
+
+	if len(planType) > maxPlanTypeLength || !planTypePattern.MatchString(planType) {
+		return "", ErrInvalidPlanType
+	}
+
+	return repository.PayPlanType(planType), nil
+}
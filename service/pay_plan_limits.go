@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+
+	"github.com/pokt-foundation/pocket-http-db/metrics"
+	"github.com/pokt-foundation/pocket-http-db/types"
+	"github.com/pokt-foundation/pocket-http-db/webhook"
+	"github.com/pokt-foundation/portal-api-go/repository"
+)
+
+// PayPlanLimitUpdate reports the change of the daily limit of a pay plan
+type PayPlanLimitUpdate struct {
+	PayPlan *repository.PayPlan `json:"payPlan"`
+	// Propagated is true if the limit was applied right away to the cached applications of the plan,
+	// they get it on the next cache refresh otherwise
+	Propagated bool `json:"propagated"`
+	// ApplicationIDs are the applications the limit was propagated to
+	ApplicationIDs []string `json:"applicationIDs"`
+}
+
+// UpdatePayPlanLimit sets the daily limit of the pay plan of planType, normalized by NormalizePlanType
+// with propagate, the limit is applied right away to the cached applications of the plan and their limits are pushed
+// to the relay meter, instead of waiting for the next cache refresh, a pay plan updated event is sent either way
+func (s *ApplicationService) UpdatePayPlanLimit(ctx context.Context, planType repository.PayPlanType, dailyLimit int, propagate bool) (*PayPlanLimitUpdate, error) {
+	if dailyLimit < 0 {
+		return nil, ErrInvalidDailyLimit
+	}
+
+	plan, err := NewPayPlanService(s.cache).Get(planType)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.writer.UpdatePayPlanDailyLimit(ctx, plan.PlanType, dailyLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	apps := s.cache.SetPayPlanDailyLimit(plan.PlanType, dailyLimit, propagate)
+
+	update := &PayPlanLimitUpdate{
+		PayPlan:        s.cache.GetPayPlan(plan.PlanType),
+		Propagated:     propagate,
+		ApplicationIDs: make([]string, 0, len(apps)),
+	}
+
+	for _, app := range apps {
+		update.ApplicationIDs = append(update.ApplicationIDs, app.ID)
+	}
+
+	if propagate {
+		observeChange(s.Metrics, metrics.EntityApplication, metrics.OperationUpdated, len(apps))
+
+		s.pushLimits(apps...)
+	}
+
+	if s.Webhooks != nil {
+		s.Webhooks.Dispatch(webhook.Event{
+			Type:       webhook.EventPayPlanUpdated,
+			EntityType: types.EntityPayPlan,
+			EntityID:   string(plan.PlanType),
+			Data:       update,
+		})
+	}
+
+	return update, nil
+}
@@ -0,0 +1,51 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplicationService_UpdatePayPlanLimit(t *testing.T) {
+	c := require.New(t)
+
+	cache := newTestCache(t)
+
+	writerMock := &writerMock{}
+	apps := NewApplicationService(cache, writerMock, logrus.New())
+
+	_, err := apps.UpdatePayPlanLimit(context.Background(), repository.FreetierV0, -1, true)
+	c.ErrorIs(err, ErrInvalidDailyLimit)
+
+	_, err = apps.UpdatePayPlanLimit(context.Background(), "WRONG_V0", 1, true)
+	c.ErrorIs(err, ErrPayPlanNotFound)
+
+	writerMock.On("UpdatePayPlanDailyLimit", repository.FreetierV0, 300000).Return(nil).Once()
+
+	update, err := apps.UpdatePayPlanLimit(context.Background(), "freetier_v0", 300000, false)
+	c.NoError(err)
+	c.Equal(&repository.PayPlan{PlanType: repository.FreetierV0, DailyLimit: 300000}, update.PayPlan)
+	c.False(update.Propagated)
+	c.Empty(update.ApplicationIDs)
+	c.Equal(250000, cache.GetApplication("5f62b7d8be3591c4dea8566d").Limits.DailyLimit)
+
+	writerMock.On("UpdatePayPlanDailyLimit", repository.FreetierV0, 500000).Return(nil).Once()
+
+	update, err = apps.UpdatePayPlanLimit(context.Background(), repository.FreetierV0, 500000, true)
+	c.NoError(err)
+	c.True(update.Propagated)
+	c.Equal([]string{"5f62b7d8be3591c4dea8566d"}, update.ApplicationIDs)
+	c.Equal(500000, cache.GetApplication("5f62b7d8be3591c4dea8566d").Limits.DailyLimit)
+
+	writerMock.On("UpdatePayPlanDailyLimit", repository.FreetierV0, 1).Return(errors.New("dummy error")).Once()
+
+	_, err = apps.UpdatePayPlanLimit(context.Background(), repository.FreetierV0, 1, true)
+	c.EqualError(err, "dummy error")
+	c.Equal(500000, cache.GetPayPlan(repository.FreetierV0).DailyLimit)
+
+	writerMock.AssertExpectations(t)
+}
@@ -0,0 +1,60 @@
+package service
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPayPlanService_Get(t *testing.T) {
+	c := require.New(t)
+
+	plans := NewPayPlanService(newTestCache(t))
+
+	c.Len(plans.GetAll(), 2)
+
+	plan, err := plans.Get("freetier_v0")
+	c.NoError(err)
+	c.Equal(repository.FreetierV0, plan.PlanType)
+
+	plan, err = plans.Get(" pay-as-you-go_v0 ")
+	c.NoError(err)
+	c.Equal(repository.PayAsYouGoV0, plan.PlanType)
+
+	_, err = plans.Get("wrong")
+	c.ErrorIs(err, ErrPayPlanNotFound)
+
+	_, err = plans.Get("free tier")
+	c.ErrorIs(err, ErrInvalidPlanType)
+
+	c.Len(plans.GetByDailyLimit(250000), 1)
+	c.Empty(plans.GetByDailyLimit(1))
+}
+
+func TestNormalizePlanType(t *testing.T) {
+	c := require.New(t)
+
+	tests := []struct {
+		rawType          string
+		expectedPlanType repository.PayPlanType
+		err              error
+	}{
+		{rawType: "freetier_v0", expectedPlanType: repository.FreetierV0},
+		{rawType: " Test-Plan-10k ", expectedPlanType: repository.TestPlan10K},
+		{rawType: "TEST_PLAN_V0", expectedPlanType: repository.TestPlanV0},
+		{rawType: "", err: ErrInvalidPlanType},
+		{rawType: "_FREETIER", err: ErrInvalidPlanType},
+		{rawType: "FREETIER__V0", err: ErrInvalidPlanType},
+		{rawType: "0_PLAN", err: ErrInvalidPlanType},
+		{rawType: "../admin", err: ErrInvalidPlanType},
+		{rawType: strings.Repeat("A", 65), err: ErrInvalidPlanType},
+	}
+
+	for _, tt := range tests {
+		planType, err := NormalizePlanType(tt.rawType)
+		c.ErrorIs(err, tt.err)
+		c.Equal(tt.expectedPlanType, planType)
+	}
+}
@@ -0,0 +1,64 @@
+package service
+
+import (
+	"context"
+
+	"github.com/pokt-foundation/pocket-http-db/types"
+	"github.com/pokt-foundation/pocket-http-db/webhook"
+	"github.com/pokt-foundation/portal-api-go/repository"
+)
+
+// CreatePayPlan saves a new pay plan, its type normalized by NormalizePlanType, and returns it as saved
+func (s *ApplicationService) CreatePayPlan(ctx context.Context, plan *repository.PayPlan) (*repository.PayPlan, error) {
+	planType, err := NormalizePlanType(string(plan.PlanType))
+	if err != nil {
+		return nil, err
+	}
+
+	if plan.DailyLimit < 0 {
+		return nil, ErrInvalidDailyLimit
+	}
+
+	if s.cache.GetPayPlan(planType) != nil {
+		return nil, ErrPayPlanExists
+	}
+
+	saved := &repository.PayPlan{PlanType: planType, DailyLimit: plan.DailyLimit}
+
+	err = s.writer.WritePayPlan(ctx, saved)
+	if err != nil {
+		return nil, err
+	}
+
+	s.cache.AddPayPlan(saved)
+
+	dispatchChange(s.Webhooks, webhook.EventPayPlanCreated, types.EntityPayPlan, string(planType), saved)
+
+	return saved, nil
+}
+
+// RemovePayPlan permanently removes the pay plan of planType, normalized by NormalizePlanType, and returns it
+// the plans of cached applications are not removed, ErrPayPlanInUse is returned instead
+func (s *ApplicationService) RemovePayPlan(ctx context.Context, planType repository.PayPlanType) (*repository.PayPlan, error) {
+	plan, err := NewPayPlanService(s.cache).Get(planType)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, app := range s.cache.GetApplications() {
+		if app.Limits.PlanType == plan.PlanType {
+			return nil, ErrPayPlanInUse
+		}
+	}
+
+	err = s.writer.RemovePayPlan(ctx, plan.PlanType)
+	if err != nil {
+		return nil, err
+	}
+
+	s.cache.RemovePayPlan(plan.PlanType)
+
+	dispatchChange(s.Webhooks, webhook.EventPayPlanRemoved, types.EntityPayPlan, string(plan.PlanType), plan)
+
+	return plan, nil
+}
@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplicationService_CreatePayPlan(t *testing.T) {
+	c := require.New(t)
+
+	cache := newTestCache(t)
+
+	writerMock := &writerMock{}
+	apps := NewApplicationService(cache, writerMock, logrus.New())
+
+	_, err := apps.CreatePayPlan(context.Background(), &repository.PayPlan{PlanType: "wrong type"})
+	c.ErrorIs(err, ErrInvalidPlanType)
+
+	_, err = apps.CreatePayPlan(context.Background(), &repository.PayPlan{PlanType: "ENTERPRISE_V0", DailyLimit: -1})
+	c.ErrorIs(err, ErrInvalidDailyLimit)
+
+	_, err = apps.CreatePayPlan(context.Background(), &repository.PayPlan{PlanType: "freetier-v0", DailyLimit: 1})
+	c.ErrorIs(err, ErrPayPlanExists)
+
+	enterprise := &repository.PayPlan{PlanType: "ENTERPRISE_V0", DailyLimit: 1000000}
+
+	writerMock.On("WritePayPlan", enterprise).Return(errors.New("dummy error")).Once()
+
+	_, err = apps.CreatePayPlan(context.Background(), &repository.PayPlan{PlanType: "enterprise-v0", DailyLimit: 1000000})
+	c.EqualError(err, "dummy error")
+	c.Nil(cache.GetPayPlan("ENTERPRISE_V0"))
+
+	writerMock.On("WritePayPlan", enterprise).Return(nil).Once()
+
+	plan, err := apps.CreatePayPlan(context.Background(), &repository.PayPlan{PlanType: "enterprise-v0", DailyLimit: 1000000})
+	c.NoError(err)
+	c.Equal(enterprise, plan)
+	c.Equal(enterprise, cache.GetPayPlan("ENTERPRISE_V0"))
+
+	writerMock.AssertExpectations(t)
+}
+
+func TestApplicationService_RemovePayPlan(t *testing.T) {
+	c := require.New(t)
+
+	cache := newTestCache(t)
+
+	writerMock := &writerMock{}
+	apps := NewApplicationService(cache, writerMock, logrus.New())
+
+	_, err := apps.RemovePayPlan(context.Background(), "WRONG_V0")
+	c.ErrorIs(err, ErrPayPlanNotFound)
+
+	_, err = apps.RemovePayPlan(context.Background(), repository.FreetierV0)
+	c.ErrorIs(err, ErrPayPlanInUse)
+
+	writerMock.On("RemovePayPlan", repository.PayAsYouGoV0).Return(nil).Once()
+
+	plan, err := apps.RemovePayPlan(context.Background(), "pay_as_you_go_v0")
+	c.NoError(err)
+	c.Equal(repository.PayAsYouGoV0, plan.PlanType)
+	c.Nil(cache.GetPayPlan(repository.PayAsYouGoV0))
+	c.NotNil(cache.GetPayPlan(repository.FreetierV0))
+
+	writerMock.AssertExpectations(t)
+}
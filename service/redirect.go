@@ -0,0 +1,166 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pokt-foundation/pocket-http-db/cache"
+	"github.com/pokt-foundation/pocket-http-db/types"
+	"github.com/pokt-foundation/pocket-http-db/webhook"
+	"github.com/pokt-foundation/portal-api-go/repository"
+)
+
+// MaxBulkRedirects is the number of redirects a bulk creation is limited to
+const MaxBulkRedirects = 100
+
+// RedirectService struct handler for redirects operations
+type RedirectService struct {
+	cache  *cache.Cache
+	writer Writer
+	// Webhooks receives the creation and removal events
+	Webhooks *webhook.Dispatcher
+}
+
+// RedirectResult is the outcome of a bulk creation for a single redirect, at its index on input
+type RedirectResult struct {
+	Index    int                  `json:"index"`
+	Redirect *repository.Redirect `json:"redirect,omitempty"`
+	Error    string               `json:"error,omitempty"`
+}
+
+// NewRedirectService returns RedirectService instance
+func NewRedirectService(cache *cache.Cache, writer Writer) *RedirectService {
+	return &RedirectService{
+		cache:  cache,
+		writer: writer,
+	}
+}
+
+// GetAll returns the redirects of every blockchain
+func (s *RedirectService) GetAll() []*repository.Redirect {
+	return s.cache.GetAllRedirects()
+}
+
+// GetByBlockchain returns the redirects of the blockchain with given id
+func (s *RedirectService) GetByBlockchain(blockchainID string) ([]*repository.Redirect, error) {
+	if s.cache.GetBlockchain(blockchainID) == nil {
+		return nil, ErrBlockchainNotFound
+	}
+
+	redirects := s.cache.GetRedirects(blockchainID)
+	if redirects == nil {
+		redirects = []*repository.Redirect{}
+	}
+
+	return redirects, nil
+}
+
+// Create saves redirect and returns it as saved
+func (s *RedirectService) Create(ctx context.Context, redirect *repository.Redirect) (*repository.Redirect, error) {
+	fullRedirect, err := s.writer.WriteRedirect(ctx, redirect)
+	if err != nil {
+		return nil, err
+	}
+
+	s.cache.AddRedirects(fullRedirect)
+
+	dispatchChange(s.Webhooks, webhook.EventRedirectCreated, types.EntityRedirect, fullRedirect.ID, fullRedirect)
+
+	return fullRedirect, nil
+}
+
+// CreateMany saves all the redirects in a single transaction, such as the ones of a blockchain being onboarded
+// if any redirect is invalid none is saved and ErrInvalidRedirects is returned with the results of every redirect
+func (s *RedirectService) CreateMany(ctx context.Context, redirects []*repository.Redirect) ([]RedirectResult, error) {
+	if len(redirects) == 0 {
+		return nil, ErrNoRedirects
+	}
+
+	if len(redirects) > MaxBulkRedirects {
+		return nil, ErrTooManyRedirects
+	}
+
+	redirected := s.redirectedDomains()
+	results := make([]RedirectResult, len(redirects))
+	seen := make(map[string]bool, len(redirects))
+	invalid := false
+
+	for i, redirect := range redirects {
+		results[i].Index = i
+
+		var err error
+
+		switch {
+		case redirect == nil || redirect.Domain == "":
+			err = ErrMissingDomain
+		case seen[redirect.Domain]:
+			err = ErrDuplicatedDomain
+		case redirected[redirect.Domain]:
+			err = ErrDomainRedirected
+		case s.cache.GetBlockchain(redirect.BlockchainID) == nil:
+			err = fmt.Errorf("%w: %s", ErrBlockchainNotFound, redirect.BlockchainID)
+		case redirect.LoadBalancerID != "" && s.cache.GetLoadBalancer(redirect.LoadBalancerID) == nil:
+			err = fmt.Errorf("%w: %s", ErrLoadBalancerNotFound, redirect.LoadBalancerID)
+		}
+
+		if err != nil {
+			results[i].Error = err.Error()
+			invalid = true
+		}
+
+		if redirect != nil {
+			seen[redirect.Domain] = true
+		}
+	}
+
+	if invalid {
+		return results, ErrInvalidRedirects
+	}
+
+	saved, err := s.writer.WriteRedirects(ctx, redirects)
+	if err != nil {
+		return nil, err
+	}
+
+	s.cache.AddRedirects(saved...)
+
+	for i, redirect := range saved {
+		results[i].Redirect = redirect
+
+		dispatchChange(s.Webhooks, webhook.EventRedirectCreated, types.EntityRedirect, redirect.ID, redirect)
+	}
+
+	return results, nil
+}
+
+// Remove permanently removes the redirect with given id and returns it
+func (s *RedirectService) Remove(ctx context.Context, id string) (*repository.Redirect, error) {
+	redirect := s.cache.GetRedirect(id)
+	if redirect == nil {
+		return nil, ErrRedirectNotFound
+	}
+
+	err := s.writer.RemoveRedirect(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	s.cache.RemoveRedirect(id)
+
+	dispatchChange(s.Webhooks, webhook.EventRedirectRemoved, types.EntityRedirect, id, redirect)
+
+	return redirect, nil
+}
+
+// redirectedDomains returns the domains of the cached redirects of every blockchain
+func (s *RedirectService) redirectedDomains() map[string]bool {
+	domains := map[string]bool{}
+
+	for _, blockchain := range s.cache.GetBlockchains() {
+		for _, redirect := range s.cache.GetRedirects(blockchain.ID) {
+			domains[redirect.Domain] = true
+		}
+	}
+
+	return domains
+}
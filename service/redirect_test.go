@@ -0,0 +1,142 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedirectService_Create(t *testing.T) {
+	c := require.New(t)
+
+	writerMock := &writerMock{}
+	redirects := NewRedirectService(newTestCache(t), writerMock)
+
+	redirect := &repository.Redirect{BlockchainID: "0021", Alias: "pokt"}
+
+	writerMock.On("WriteRedirect", redirect).Return(&repository.Redirect{ID: "1", BlockchainID: "0021", Alias: "pokt"}, nil).Once()
+
+	fullRedirect, err := redirects.Create(context.Background(), redirect)
+	c.NoError(err)
+	c.Equal("1", fullRedirect.ID)
+
+	// the saved redirect is cached with its ID
+	c.Equal("pokt", redirects.cache.GetRedirect("1").Alias)
+
+	writerMock.AssertExpectations(t)
+}
+
+func TestRedirectService_CreateMany(t *testing.T) {
+	c := require.New(t)
+
+	writerMock := &writerMock{}
+	redirects := NewRedirectService(newTestCache(t), writerMock)
+
+	_, err := redirects.CreateMany(context.Background(), nil)
+	c.ErrorIs(err, ErrNoRedirects)
+
+	_, err = redirects.CreateMany(context.Background(), make([]*repository.Redirect, MaxBulkRedirects+1))
+	c.ErrorIs(err, ErrTooManyRedirects)
+
+	invalid := []*repository.Redirect{
+		{BlockchainID: "0021", Domain: "pokt-archival.gateway.network"},
+		{BlockchainID: "0021"},
+		{BlockchainID: "0021", Domain: "pokt-archival.gateway.network"},
+		{BlockchainID: "0021", Domain: "pokt-mainnet.gateway.network"},
+		{BlockchainID: "0099", Domain: "new.gateway.network"},
+		{BlockchainID: "0021", Domain: "lb.gateway.network", LoadBalancerID: "wrong"},
+	}
+
+	results, err := redirects.CreateMany(context.Background(), invalid)
+	c.ErrorIs(err, ErrInvalidRedirects)
+	c.Equal([]RedirectResult{
+		{Index: 0},
+		{Index: 1, Error: ErrMissingDomain.Error()},
+		{Index: 2, Error: ErrDuplicatedDomain.Error()},
+		{Index: 3, Error: ErrDomainRedirected.Error()},
+		{Index: 4, Error: "blockchain not found: 0099"},
+		{Index: 5, Error: "load balancer not found: wrong"},
+	}, results)
+
+	valid := []*repository.Redirect{
+		{BlockchainID: "0021", Alias: "pokt-archival", Domain: "pokt-archival.gateway.network"},
+		{BlockchainID: "0021", Alias: "pokt-trace", Domain: "pokt-trace.gateway.network", LoadBalancerID: "60ecb2bf67774900350d9c42"},
+	}
+
+	writerMock.On("WriteRedirects", valid).Return([]*repository.Redirect{
+		{ID: "1", BlockchainID: "0021", Alias: "pokt-archival", Domain: "pokt-archival.gateway.network"},
+		{ID: "2", BlockchainID: "0021", Alias: "pokt-trace", Domain: "pokt-trace.gateway.network", LoadBalancerID: "60ecb2bf67774900350d9c42"},
+	}, nil).Once()
+
+	results, err = redirects.CreateMany(context.Background(), valid)
+	c.NoError(err)
+	c.Len(results, 2)
+	c.Equal("1", results[0].Redirect.ID)
+	c.Equal(1, results[1].Index)
+	c.Equal("2", results[1].Redirect.ID)
+
+	c.Len(redirects.GetAll(), 3)
+
+	// the saved domains are already redirected
+	results, err = redirects.CreateMany(context.Background(), valid)
+	c.ErrorIs(err, ErrInvalidRedirects)
+	c.Equal(ErrDomainRedirected.Error(), results[0].Error)
+
+	failing := []*repository.Redirect{
+		{BlockchainID: "0021", Alias: "pokt-debug", Domain: "pokt-debug.gateway.network"},
+	}
+
+	writerMock.On("WriteRedirects", failing).Return([]*repository.Redirect(nil), errors.New("dummy error")).Once()
+
+	_, err = redirects.CreateMany(context.Background(), failing)
+	c.EqualError(err, "dummy error")
+
+	writerMock.AssertExpectations(t)
+}
+
+func TestRedirectService_GetByBlockchain(t *testing.T) {
+	c := require.New(t)
+
+	redirects := NewRedirectService(newTestCache(t), &writerMock{})
+
+	blockchainRedirects, err := redirects.GetByBlockchain("0021")
+	c.NoError(err)
+	c.Len(blockchainRedirects, 1)
+	c.Equal("10", blockchainRedirects[0].ID)
+
+	_, err = redirects.GetByBlockchain("0099")
+	c.ErrorIs(err, ErrBlockchainNotFound)
+}
+
+func TestRedirectService_Remove(t *testing.T) {
+	c := require.New(t)
+
+	writerMock := &writerMock{}
+	redirects := NewRedirectService(newTestCache(t), writerMock)
+
+	writerMock.On("RemoveRedirect", "10").Return(errors.New("dummy error")).Once()
+
+	_, err := redirects.Remove(context.Background(), "10")
+	c.EqualError(err, "dummy error")
+	c.Len(redirects.GetAll(), 1)
+
+	writerMock.On("RemoveRedirect", "10").Return(nil).Once()
+
+	removed, err := redirects.Remove(context.Background(), "10")
+	c.NoError(err)
+	c.Equal("pokt-mainnet.gateway.network", removed.Domain)
+	c.Empty(redirects.GetAll())
+
+	blockchainRedirects, err := redirects.GetByBlockchain("0021")
+	c.NoError(err)
+	c.NotNil(blockchainRedirects)
+	c.Empty(blockchainRedirects)
+
+	_, err = redirects.Remove(context.Background(), "10")
+	c.ErrorIs(err, ErrRedirectNotFound)
+
+	writerMock.AssertExpectations(t)
+}
@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+
+	"github.com/pokt-foundation/pocket-http-db/secrets"
+	"github.com/pokt-foundation/portal-api-go/repository"
+)
+
+// The secret fields are sealed bound to their path in the application and to the address of its AAT,
+// the one identifier an application has before it is written, which cannot be updated afterwards
+const (
+	fieldSecretKey            = "gatewaySettings.secretKey"
+	fieldApplicationSignature = "gatewayAAT.applicationSignature"
+	fieldPrivateKey           = "gatewayAAT.privateKey"
+)
+
+// sealingWriter seals the secret fields of applications before they reach the wrapped writer
+type sealingWriter struct {
+	Writer
+	envelope        *secrets.Envelope
+	readApplication func(id string) (*repository.Application, error)
+}
+
+// NewSealingWriter returns a Writer storing gateway secret keys and AAT signatures and private keys
+// sealed by envelope, the other writes are passed to writer as they are
+// the fields are sealed in place, so the cache holds the same values as the database
+// readApplication returns the stored application with an ID, to seal the secret key of its updates
+func NewSealingWriter(writer Writer, envelope *secrets.Envelope, readApplication func(id string) (*repository.Application, error)) Writer {
+	return &sealingWriter{
+		Writer:          writer,
+		envelope:        envelope,
+		readApplication: readApplication,
+	}
+}
+
+// WriteApplication seals the secret fields of app and saves it
+func (w *sealingWriter) WriteApplication(ctx context.Context, app *repository.Application) (*repository.Application, error) {
+	err := w.sealGatewaySettings(&app.GatewaySettings, app.GatewayAAT.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	err = w.sealGatewayAAT(&app.GatewayAAT)
+	if err != nil {
+		return nil, err
+	}
+
+	return w.Writer.WriteApplication(ctx, app)
+}
+
+// UpdateApplication seals the secret fields of options and applies them
+func (w *sealingWriter) UpdateApplication(ctx context.Context, id string, options *repository.UpdateApplication) error {
+	if options.GatewaySettings != nil && options.GatewaySettings.SecretKey != "" {
+		app, err := w.readApplication(id)
+		if err != nil {
+			return err
+		}
+
+		err = w.sealGatewaySettings(options.GatewaySettings, app.GatewayAAT.Address)
+		if err != nil {
+			return err
+		}
+	}
+
+	return w.Writer.UpdateApplication(ctx, id, options)
+}
+
+func (w *sealingWriter) sealGatewaySettings(settings *repository.GatewaySettings, address string) error {
+	sealed, err := w.seal(settings.SecretKey, address, fieldSecretKey)
+	if err != nil {
+		return err
+	}
+
+	settings.SecretKey = sealed
+
+	return nil
+}
+
+func (w *sealingWriter) sealGatewayAAT(aat *repository.GatewayAAT) error {
+	fields := map[string]*string{
+		fieldApplicationSignature: &aat.ApplicationSignature,
+		fieldPrivateKey:           &aat.PrivateKey,
+	}
+
+	for field, value := range fields {
+		sealed, err := w.seal(*value, aat.Address, field)
+		if err != nil {
+			return err
+		}
+
+		*value = sealed
+	}
+
+	return nil
+}
+
+// seal seals value of field, the values sent already sealed are rejected, as they could be copied from
+// another application
+func (w *sealingWriter) seal(value, address, field string) (string, error) {
+	if secrets.IsSealed(value) {
+		return "", ErrSealedSecret
+	}
+
+	return w.envelope.Seal(value, address, field)
+}
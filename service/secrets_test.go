@@ -0,0 +1,87 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/pokt-foundation/pocket-http-db/secrets"
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSealingWriter(t *testing.T) {
+	c := require.New(t)
+
+	kms, err := secrets.NewLocalKMS(bytes.Repeat([]byte{1}, 32))
+	c.NoError(err)
+
+	envelope := secrets.NewEnvelope(kms)
+	writerMock := &writerMock{}
+	writer := NewSealingWriter(writerMock, envelope, func(id string) (*repository.Application, error) {
+		if id != "5f62b7d8be3591c4dea8566d" {
+			return nil, ErrApplicationNotFound
+		}
+
+		return &repository.Application{ID: id, GatewayAAT: repository.GatewayAAT{Address: "address"}}, nil
+	})
+
+	app := &repository.Application{
+		Name:            "pokt",
+		GatewaySettings: repository.GatewaySettings{SecretKey: "secret"},
+		GatewayAAT:      repository.GatewayAAT{Address: "address", ApplicationSignature: "signature", ApplicationPublicKey: "public"},
+	}
+
+	writerMock.On("WriteApplication", mock.MatchedBy(func(app *repository.Application) bool {
+		return secrets.IsSealed(app.GatewaySettings.SecretKey) && secrets.IsSealed(app.GatewayAAT.ApplicationSignature) &&
+			app.GatewayAAT.PrivateKey == "" && app.GatewayAAT.ApplicationPublicKey == "public"
+	})).Return(app, nil).Once()
+
+	savedApp, err := writer.WriteApplication(context.Background(), app)
+	c.NoError(err)
+
+	secretKey, err := envelope.Open(savedApp.GatewaySettings.SecretKey, "address", fieldSecretKey)
+	c.NoError(err)
+	c.Equal("secret", secretKey)
+
+	signature, err := envelope.Open(savedApp.GatewayAAT.ApplicationSignature, "address", fieldApplicationSignature)
+	c.NoError(err)
+	c.Equal("signature", signature)
+
+	// sealed values sent by clients are rejected, they could be copied from another application
+	_, err = writer.WriteApplication(context.Background(), &repository.Application{
+		GatewaySettings: repository.GatewaySettings{SecretKey: savedApp.GatewaySettings.SecretKey},
+	})
+	c.ErrorIs(err, ErrSealedSecret)
+
+	input := &repository.UpdateApplication{GatewaySettings: &repository.GatewaySettings{SecretKey: "new-secret"}}
+
+	writerMock.On("UpdateApplication", "5f62b7d8be3591c4dea8566d", mock.MatchedBy(func(input *repository.UpdateApplication) bool {
+		return secrets.IsSealed(input.GatewaySettings.SecretKey)
+	})).Return(nil).Once()
+	writerMock.On("UpdateApplication", "5f62b7d8be3591c4dea8566d", &repository.UpdateApplication{Name: "eth"}).
+		Return(nil).Once()
+
+	c.NoError(writer.UpdateApplication(context.Background(), "5f62b7d8be3591c4dea8566d", input))
+
+	secretKey, err = envelope.Open(input.GatewaySettings.SecretKey, "address", fieldSecretKey)
+	c.NoError(err)
+	c.Equal("new-secret", secretKey)
+
+	err = writer.UpdateApplication(context.Background(), "5f62b7d8be3591c4dea8566d",
+		&repository.UpdateApplication{GatewaySettings: &repository.GatewaySettings{SecretKey: savedApp.GatewaySettings.SecretKey}})
+	c.ErrorIs(err, ErrSealedSecret)
+
+	err = writer.UpdateApplication(context.Background(), "5f62b7d8be3591c4dea8566a",
+		&repository.UpdateApplication{GatewaySettings: &repository.GatewaySettings{SecretKey: "new-secret"}})
+	c.ErrorIs(err, ErrApplicationNotFound)
+	c.NoError(writer.UpdateApplication(context.Background(), "5f62b7d8be3591c4dea8566d", &repository.UpdateApplication{Name: "eth"}))
+
+	// the other writes are passed through
+	writerMock.On("RemoveApplication", "5f62b7d8be3591c4dea8566d").Return(nil).Once()
+
+	c.NoError(writer.RemoveApplication(context.Background(), "5f62b7d8be3591c4dea8566d"))
+
+	writerMock.AssertExpectations(t)
+}
@@ -0,0 +1,185 @@
+// Package service holds the business logic applied over the cache and the writer,
+// so other Go programs can embed it without going through the HTTP router
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/pokt-foundation/pocket-http-db/metrics"
+	"github.com/pokt-foundation/pocket-http-db/types"
+	"github.com/pokt-foundation/pocket-http-db/webhook"
+	"github.com/pokt-foundation/portal-api-go/repository"
+)
+
+var (
+	ErrPayPlanNotFound             = errors.New("pay plan not found")
+	ErrLoadBalancerNotFound        = errors.New("load balancer not found")
+	ErrBlockchainNotFound          = errors.New("blockchain not found")
+	ErrApplicationNotFound         = errors.New("applications not found")
+	ErrLoadBalancerNameUsed        = errors.New("load balancer name already in use by user")
+	ErrInvalidAppStatus            = errors.New("invalid application status")
+	ErrExpiresBeforeStatus         = errors.New("expires_before is only supported for AWAITING_GRACE_PERIOD applications")
+	ErrInvalidStatusTransition     = errors.New("invalid status transition")
+	ErrDuplicatedApplicationID     = errors.New("duplicated application ID")
+	ErrNoApplicationIDs            = errors.New("no application IDs on input")
+	ErrMissingReason               = errors.New("reason is required")
+	ErrApplicationSuspended        = errors.New("application is already suspended")
+	ErrApplicationActive           = errors.New("application is not suspended")
+	ErrMissingVersion              = errors.New("version is required")
+	ErrApplicationNameUsed         = errors.New("application name already in use by user")
+	ErrInvalidPlanType             = errors.New("invalid pay plan type")
+	ErrInvalidLabels               = errors.New("invalid labels")
+	ErrInvalidLabelSelector        = errors.New("invalid label selector")
+	ErrApplicationFilterNotFound   = errors.New("application filter not found")
+	ErrInvalidFilterName           = errors.New("invalid filter name")
+	ErrBlockchainReferenced        = errors.New("blockchain is referenced by redirects or application whitelists")
+	ErrInvalidOrphanPolicy         = errors.New("invalid orphans policy, must be detach or delete")
+	ErrUserNotFound                = errors.New("user not found")
+	ErrBackfillFieldNotFound       = errors.New("backfill field not found")
+	ErrInvalidFilterExpression     = errors.New("invalid filter expression")
+	ErrInvalidSort                 = errors.New("invalid sort")
+	ErrInvalidCursor               = errors.New("invalid cursor")
+	ErrInvalidUsage                = errors.New("usage must not be negative")
+	ErrCursorExpired               = errors.New("cursor expired, the list changed since its first page, restart from it")
+	ErrInvalidNotificationSettings = errors.New("invalid notification settings")
+	ErrInvalidDailyLimit           = errors.New("daily limit must not be negative")
+	ErrNoRedirects                 = errors.New("no redirects on input")
+	ErrTooManyRedirects            = fmt.Errorf("more than %d redirects on input", MaxBulkRedirects)
+	ErrInvalidRedirects            = errors.New("invalid redirects, none was created")
+	ErrTooManyIDs                  = fmt.Errorf("more than %d IDs on input", MaxBatchIDs)
+	ErrMissingDomain               = errors.New("domain is required")
+	ErrDuplicatedDomain            = errors.New("duplicated domain")
+	ErrDomainRedirected            = errors.New("domain already redirected")
+	ErrRedirectNotFound            = errors.New("redirect not found")
+	ErrPayPlanExists               = errors.New("pay plan already exists")
+	ErrPayPlanInUse                = errors.New("pay plan is used by applications")
+	ErrStickyOriginUsed            = errors.New("sticky origin already in use by another load balancer")
+	ErrInvalidBlockchainSettings   = errors.New("invalid blockchain settings")
+	ErrInvalidApplicationOrder     = errors.New("order must hold every application of the load balancer once")
+	ErrSealedSecret                = errors.New("secret fields must not be sent sealed")
+)
+
+// Writer represents the implementation of writer interface
+// ctx is the context of the request asking for the write, writes are cancelled along with it
+type Writer interface {
+	WriteLoadBalancer(ctx context.Context, loadBalancer *repository.LoadBalancer) (*repository.LoadBalancer, error)
+	UpdateLoadBalancer(ctx context.Context, id string, options *repository.UpdateLoadBalancer) error
+	RemoveLoadBalancer(ctx context.Context, id string) error
+	WriteApplication(ctx context.Context, app *repository.Application) (*repository.Application, error)
+	UpdateApplication(ctx context.Context, id string, options *repository.UpdateApplication) error
+	UpdateFirstDateSurpassed(ctx context.Context, firstDateSurpassed *repository.UpdateFirstDateSurpassed) error
+	RemoveApplication(ctx context.Context, id string) error
+	WriteBlockchain(ctx context.Context, blockchain *repository.Blockchain) (*repository.Blockchain, error)
+	WriteRedirect(ctx context.Context, redirect *repository.Redirect) (*repository.Redirect, error)
+	// WriteRedirects saves all the redirects in a single transaction, none of them if any fails
+	WriteRedirects(ctx context.Context, redirects []*repository.Redirect) ([]*repository.Redirect, error)
+	RemoveRedirect(ctx context.Context, id string) error
+	WritePayPlan(ctx context.Context, plan *repository.PayPlan) error
+	UpdatePayPlanDailyLimit(ctx context.Context, planType repository.PayPlanType, dailyLimit int) error
+	RemovePayPlan(ctx context.Context, planType repository.PayPlanType) error
+	ActivateBlockchain(ctx context.Context, id string, active bool) error
+	UpdateApplicationsStatus(ctx context.Context, ids []string, status repository.AppStatus) error
+	WriteAuditLogEntry(ctx context.Context, entry *types.AuditLogEntry) error
+	// SetLoadBalancerApplications replaces the applications of a load balancer if they are still at version,
+	// returns a *types.LoadBalancerAppsConflictError otherwise
+	SetLoadBalancerApplications(ctx context.Context, lbID string, appIDs []string, version string) error
+	WriteLabels(ctx context.Context, entityType types.EntityType, entityID string, labels map[string]string) error
+	WriteApplicationFilter(ctx context.Context, filter *types.ApplicationFilter) error
+	RemoveApplicationFilter(ctx context.Context, name string) error
+	RemoveBlockchain(ctx context.Context, id string) error
+	UpdateBlockchainSettings(ctx context.Context, id string, settings *types.BlockchainSettings) error
+	DeleteLoadBalancer(ctx context.Context, id string, orphanAppIDs []string) ([]string, error)
+	// PurgeUser fills purge, of the user with the ID it holds, with the entities it removed, and saves entry
+	// with the purge as its data in the same transaction
+	PurgeUser(ctx context.Context, purge *types.UserPurge, appIDs, lbIDs, emails []string, entry *types.AuditLogEntry) error
+	BackfillUpdatedAt(ctx context.Context, entityType types.EntityType, ids []string) (int64, error)
+}
+
+// observeChange records count changes of entity on registry, if metrics are enabled
+func observeChange(registry *metrics.Registry, entity string, operation metrics.Operation, count int) {
+	if registry == nil {
+		return
+	}
+
+	registry.ObserveEntityChange(entity, operation, count)
+}
+
+// dispatchChange sends the event of a change of the entity with given id to webhooks, if they are configured
+// data is the entity as changed, nil for changes made in bulk
+func dispatchChange(webhooks *webhook.Dispatcher, eventType webhook.EventType, entityType types.EntityType, id string, data any) {
+	if webhooks == nil {
+		return
+	}
+
+	webhooks.Dispatch(webhook.Event{
+		Type:       eventType,
+		EntityType: entityType,
+		EntityID:   id,
+		Data:       data,
+	})
+}
+
+// NameConflictError is returned when a load balancer name is already used by another load balancer of the same user
+type NameConflictError struct {
+	ConflictingID string
+}
+
+func (e *NameConflictError) Error() string {
+	return ErrLoadBalancerNameUsed.Error()
+}
+
+// Is makes NameConflictError match ErrLoadBalancerNameUsed
+func (e *NameConflictError) Is(target error) bool {
+	return target == ErrLoadBalancerNameUsed
+}
+
+// StickyOriginConflictError is returned when a sticky origin is already used by another load balancer
+// in the scope origins must be unique in
+type StickyOriginConflictError struct {
+	Origin        string
+	ConflictingID string
+}
+
+func (e *StickyOriginConflictError) Error() string {
+	return ErrStickyOriginUsed.Error()
+}
+
+// Is makes StickyOriginConflictError match ErrStickyOriginUsed
+func (e *StickyOriginConflictError) Is(target error) bool {
+	return target == ErrStickyOriginUsed
+}
+
+// BlockchainReferencedError is returned when removing a blockchain still referenced without forcing it
+type BlockchainReferencedError struct {
+	// Redirects are the domains redirecting to the blockchain
+	Redirects []string
+	// ApplicationIDs are the applications whitelisting the blockchain
+	ApplicationIDs []string
+}
+
+func (e *BlockchainReferencedError) Error() string {
+	return ErrBlockchainReferenced.Error()
+}
+
+// Is makes BlockchainReferencedError match ErrBlockchainReferenced
+func (e *BlockchainReferencedError) Is(target error) bool {
+	return target == ErrBlockchainReferenced
+}
+
+// ApplicationNameConflictError is returned when an application name is already used by another application of the same user
+type ApplicationNameConflictError struct {
+	ConflictingID string
+	// Suggestions are alternative names not used by the user
+	Suggestions []string
+}
+
+func (e *ApplicationNameConflictError) Error() string {
+	return ErrApplicationNameUsed.Error()
+}
+
+// Is makes ApplicationNameConflictError match ErrApplicationNameUsed
+func (e *ApplicationNameConflictError) Is(target error) bool {
+	return target == ErrApplicationNameUsed
+}
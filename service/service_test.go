@@ -0,0 +1,251 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/pokt-foundation/pocket-http-db/cache"
+	"github.com/pokt-foundation/pocket-http-db/types"
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// writerMock does not match the context of the writes, expectations are set on the written values
+type writerMock struct {
+	mock.Mock
+}
+
+func (w *writerMock) WriteLoadBalancer(ctx context.Context, loadBalancer *repository.LoadBalancer) (*repository.LoadBalancer, error) {
+	args := w.Called(loadBalancer)
+
+	return args.Get(0).(*repository.LoadBalancer), args.Error(1)
+}
+
+func (w *writerMock) UpdateLoadBalancer(ctx context.Context, id string, options *repository.UpdateLoadBalancer) error {
+	args := w.Called(id, options)
+
+	return args.Error(0)
+}
+
+func (w *writerMock) RemoveLoadBalancer(ctx context.Context, id string) error {
+	args := w.Called(id)
+
+	return args.Error(0)
+}
+
+func (w *writerMock) WriteApplication(ctx context.Context, app *repository.Application) (*repository.Application, error) {
+	args := w.Called(app)
+
+	return args.Get(0).(*repository.Application), args.Error(1)
+}
+
+func (w *writerMock) UpdateApplication(ctx context.Context, id string, options *repository.UpdateApplication) error {
+	args := w.Called(id, options)
+
+	return args.Error(0)
+}
+
+func (w *writerMock) UpdateFirstDateSurpassed(ctx context.Context, firstDateSurpassed *repository.UpdateFirstDateSurpassed) error {
+	args := w.Called(firstDateSurpassed)
+
+	return args.Error(0)
+}
+
+func (w *writerMock) RemoveApplication(ctx context.Context, id string) error {
+	args := w.Called(id)
+
+	return args.Error(0)
+}
+
+func (w *writerMock) WriteBlockchain(ctx context.Context, blockchain *repository.Blockchain) (*repository.Blockchain, error) {
+	args := w.Called(blockchain)
+
+	return args.Get(0).(*repository.Blockchain), args.Error(1)
+}
+
+func (w *writerMock) WriteRedirect(ctx context.Context, redirect *repository.Redirect) (*repository.Redirect, error) {
+	args := w.Called(redirect)
+
+	return args.Get(0).(*repository.Redirect), args.Error(1)
+}
+
+func (w *writerMock) UpdatePayPlanDailyLimit(ctx context.Context, planType repository.PayPlanType, dailyLimit int) error {
+	args := w.Called(planType, dailyLimit)
+
+	return args.Error(0)
+}
+
+func (w *writerMock) WriteRedirects(ctx context.Context, redirects []*repository.Redirect) ([]*repository.Redirect, error) {
+	args := w.Called(redirects)
+
+	return args.Get(0).([]*repository.Redirect), args.Error(1)
+}
+
+func (w *writerMock) ActivateBlockchain(ctx context.Context, id string, active bool) error {
+	args := w.Called(id, active)
+
+	return args.Error(0)
+}
+
+func (w *writerMock) UpdateApplicationsStatus(ctx context.Context, ids []string, status repository.AppStatus) error {
+	args := w.Called(ids, status)
+
+	return args.Error(0)
+}
+
+func (w *writerMock) WriteAuditLogEntry(ctx context.Context, entry *types.AuditLogEntry) error {
+	args := w.Called(entry)
+
+	return args.Error(0)
+}
+
+func (w *writerMock) SetLoadBalancerApplications(ctx context.Context, lbID string, appIDs []string, version string) error {
+	args := w.Called(lbID, appIDs, version)
+
+	return args.Error(0)
+}
+
+func (w *writerMock) WriteLabels(ctx context.Context, entityType types.EntityType, entityID string, labels map[string]string) error {
+	args := w.Called(entityType, entityID, labels)
+
+	return args.Error(0)
+}
+
+func (w *writerMock) WriteApplicationFilter(ctx context.Context, filter *types.ApplicationFilter) error {
+	args := w.Called(filter)
+
+	return args.Error(0)
+}
+
+func (w *writerMock) RemoveApplicationFilter(ctx context.Context, name string) error {
+	args := w.Called(name)
+
+	return args.Error(0)
+}
+
+func (w *writerMock) RemoveRedirect(ctx context.Context, id string) error {
+	args := w.Called(id)
+
+	return args.Error(0)
+}
+
+func (w *writerMock) WritePayPlan(ctx context.Context, plan *repository.PayPlan) error {
+	args := w.Called(plan)
+
+	return args.Error(0)
+}
+
+func (w *writerMock) RemovePayPlan(ctx context.Context, planType repository.PayPlanType) error {
+	args := w.Called(planType)
+
+	return args.Error(0)
+}
+
+func (w *writerMock) RemoveBlockchain(ctx context.Context, id string) error {
+	args := w.Called(id)
+
+	return args.Error(0)
+}
+
+func (w *writerMock) UpdateBlockchainSettings(ctx context.Context, id string, settings *types.BlockchainSettings) error {
+	args := w.Called(id, settings)
+
+	return args.Error(0)
+}
+
+func (w *writerMock) DeleteLoadBalancer(ctx context.Context, id string, orphanAppIDs []string) ([]string, error) {
+	args := w.Called(id, orphanAppIDs)
+
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (w *writerMock) PurgeUser(ctx context.Context, purge *types.UserPurge, appIDs, lbIDs, emails []string, entry *types.AuditLogEntry) error {
+	args := w.Called(purge.UserID, appIDs, lbIDs, emails, entry)
+
+	if removed, ok := args.Get(0).(*types.UserPurge); ok {
+		purge.DeletedApplicationIDs = removed.DeletedApplicationIDs
+		purge.DeletedLoadBalancerIDs = removed.DeletedLoadBalancerIDs
+		purge.SharedContactApplicationIDs = removed.SharedContactApplicationIDs
+	}
+
+	return args.Error(1)
+}
+
+func (w *writerMock) BackfillUpdatedAt(ctx context.Context, entityType types.EntityType, ids []string) (int64, error) {
+	args := w.Called(entityType, ids)
+
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func newTestCache(t *testing.T) *cache.Cache {
+	readerMock := &cache.ReaderMock{}
+
+	readerMock.On("ReadPayPlans").Return([]*repository.PayPlan{
+		{
+			PlanType:   repository.FreetierV0,
+			DailyLimit: 250000,
+		},
+		{
+			PlanType:   repository.PayAsYouGoV0,
+			DailyLimit: 0,
+		},
+	}, nil)
+
+	readerMock.On("ReadRedirects").Return([]*repository.Redirect{
+		{
+			ID:             "10",
+			BlockchainID:   "0021",
+			Alias:          "pokt-mainnet",
+			Domain:         "pokt-mainnet.gateway.network",
+			LoadBalancerID: "60ecb2bf67774900350d9c42",
+		},
+	}, nil)
+
+	readerMock.On("ReadApplications").Return([]*repository.Application{
+		{
+			ID:          "5f62b7d8be3591c4dea8566d",
+			UserID:      "60ecb2bf67774900350d9c43",
+			PayPlanType: repository.FreetierV0,
+			Status:      repository.InService,
+		},
+		{
+			ID:     "5f62b7d8be3591c4dea8566a",
+			UserID: "60ecb2bf67774900350d9c43",
+			Status: repository.Decomissioned,
+		},
+	}, nil)
+
+	readerMock.On("ReadBlockchains").Return([]*repository.Blockchain{
+		{
+			ID: "0021",
+		},
+	}, nil)
+
+	readerMock.On("ReadLoadBalancers").Return([]*repository.LoadBalancer{
+		{
+			ID:             "60ecb2bf67774900350d9c42",
+			Name:           "pokt",
+			ApplicationIDs: []string{"5f62b7d8be3591c4dea8566d"},
+			UserID:         "60ecb2bf67774900350d9c43",
+		},
+	}, nil)
+
+	c := cache.NewCache(readerMock, logrus.New())
+
+	require.NoError(t, c.SetCache())
+
+	return c
+}
+
+func TestNameConflictError(t *testing.T) {
+	c := require.New(t)
+
+	var err error = &NameConflictError{ConflictingID: "60ecb2bf67774900350d9c42"}
+
+	c.ErrorIs(err, ErrLoadBalancerNameUsed)
+	c.False(errors.Is(err, ErrLoadBalancerNotFound))
+	c.Equal(ErrLoadBalancerNameUsed.Error(), err.Error())
+}
@@ -0,0 +1,153 @@
+package service
+
+import (
+	"context"
+
+	"github.com/pokt-foundation/pocket-http-db/cache"
+	"github.com/pokt-foundation/pocket-http-db/metrics"
+	"github.com/pokt-foundation/pocket-http-db/types"
+	"github.com/pokt-foundation/pocket-http-db/webhook"
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/sirupsen/logrus"
+)
+
+// UserService struct handler for operations over all the entities of a user
+type UserService struct {
+	cache  *cache.Cache
+	writer Writer
+	log    *logrus.Logger
+	// Metrics receives the entity changes
+	Metrics *metrics.Registry
+	// Webhooks receives the removal events of the entities purged, without their data
+	Webhooks *webhook.Dispatcher
+}
+
+// NewUserService returns UserService instance
+func NewUserService(cache *cache.Cache, writer Writer, logger *logrus.Logger) *UserService {
+	return &UserService{
+		cache:  cache,
+		writer: writer,
+		log:    logger,
+	}
+}
+
+// UserPurgeInput holds who asked for a user data purge and why, both kept in the audit log
+type UserPurgeInput struct {
+	Reason string `json:"reason"`
+	Actor  string `json:"actor"`
+}
+
+// Purge permanently deletes the applications and load balancers of the user with given id, for data-deletion requests
+// applications of its load balancers held by load balancers of other users are detached instead,
+// and the applications of other users still using the contact emails of the deleted ones are reported, not changed
+func (s *UserService) Purge(ctx context.Context, userID string, input UserPurgeInput) (*types.UserPurge, error) {
+	if input.Reason == "" {
+		return nil, ErrMissingReason
+	}
+
+	apps := s.cache.GetApplicationsByUserID(userID)
+	lbs := s.cache.GetLoadBalancersByUserID(userID)
+
+	if len(apps) == 0 && len(lbs) == 0 {
+		return nil, ErrUserNotFound
+	}
+
+	userLBs := make(map[string]bool, len(lbs))
+	for _, lb := range lbs {
+		userLBs[lb.ID] = true
+	}
+
+	held := make(map[string]bool)
+
+	for _, lb := range s.cache.GetLoadBalancers() {
+		if userLBs[lb.ID] {
+			continue
+		}
+
+		for _, app := range lb.Applications {
+			if app != nil {
+				held[app.ID] = true
+			}
+		}
+	}
+
+	var appIDs, lbIDs, emails, detachedAppIDs []string
+
+	deleting := make(map[string]bool)
+
+	deleteApp := func(app *repository.Application) {
+		deleting[app.ID] = true
+		appIDs = append(appIDs, app.ID)
+
+		if app.ContactEmail != "" {
+			emails = append(emails, app.ContactEmail)
+		}
+	}
+
+	for _, app := range apps {
+		deleteApp(app)
+	}
+
+	for _, lb := range lbs {
+		lbIDs = append(lbIDs, lb.ID)
+
+		for _, app := range lb.Applications {
+			switch {
+			case app == nil || deleting[app.ID]:
+			case held[app.ID]:
+				detachedAppIDs = append(detachedAppIDs, app.ID)
+			default:
+				deleteApp(app)
+			}
+		}
+	}
+
+	purge := &types.UserPurge{UserID: userID, DetachedApplicationIDs: nonNilIDs(detachedAppIDs)}
+
+	// the audit entry is saved with the purge, so no purge is left unrecorded
+	err := s.writer.PurgeUser(ctx, purge, appIDs, lbIDs, emails, &types.AuditLogEntry{
+		EntityType: types.EntityUser,
+		EntityID:   userID,
+		Action:     types.AuditActionPurge,
+		Actor:      input.Actor,
+		Reason:     input.Reason,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	purge.DeletedApplicationIDs = nonNilIDs(purge.DeletedApplicationIDs)
+	purge.DeletedLoadBalancerIDs = nonNilIDs(purge.DeletedLoadBalancerIDs)
+	purge.SharedContactApplicationIDs = nonNilIDs(purge.SharedContactApplicationIDs)
+
+	observeChange(s.Metrics, metrics.EntityApplication, metrics.OperationRemoved, len(purge.DeletedApplicationIDs))
+	observeChange(s.Metrics, metrics.EntityLoadBalancer, metrics.OperationRemoved, len(purge.DeletedLoadBalancerIDs))
+
+	s.cache.RemoveLoadBalancers(purge.DeletedLoadBalancerIDs...)
+	for _, lbID := range purge.DeletedLoadBalancerIDs {
+		s.cache.SetLabels(types.EntityLoadBalancer, lbID, nil)
+	}
+
+	s.cache.RemoveApplications(purge.DeletedApplicationIDs...)
+	for _, appID := range purge.DeletedApplicationIDs {
+		s.cache.SetLabels(types.EntityApplication, appID, nil)
+	}
+
+	for _, lbID := range purge.DeletedLoadBalancerIDs {
+		dispatchChange(s.Webhooks, webhook.EventLoadBalancerRemoved, types.EntityLoadBalancer, lbID, nil)
+	}
+	for _, appID := range purge.DeletedApplicationIDs {
+		dispatchChange(s.Webhooks, webhook.EventApplicationRemoved, types.EntityApplication, appID, nil)
+	}
+
+	return purge, nil
+}
+
+// nonNilIDs returns ids, or an empty slice if it is nil so it is not encoded as null
+func nonNilIDs(ids []string) []string {
+	if ids == nil {
+		return []string{}
+	}
+
+	return ids
+}
@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/pokt-foundation/pocket-http-db/types"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserService_Purge(t *testing.T) {
+	c := require.New(t)
+
+	testCache := newTestCache(t)
+	writerMock := &writerMock{}
+	users := NewUserService(testCache, writerMock, logrus.New())
+
+	_, err := users.Purge(context.Background(), "60ecb2bf67774900350d9c43", UserPurgeInput{})
+	c.ErrorIs(err, ErrMissingReason)
+
+	_, err = users.Purge(context.Background(), "60ecb2bf67774900350d9c44", UserPurgeInput{Reason: "data-deletion request"})
+	c.ErrorIs(err, ErrUserNotFound)
+
+	// the purge is rolled back when its audit entry cannot be saved
+	writerMock.On("PurgeUser", "60ecb2bf67774900350d9c43", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, errors.New("dummy error")).Once()
+
+	_, err = users.Purge(context.Background(), "60ecb2bf67774900350d9c43", UserPurgeInput{Reason: "data-deletion request"})
+	c.EqualError(err, "dummy error")
+	c.NotNil(testCache.GetApplication("5f62b7d8be3591c4dea8566d"))
+
+	writerMock.On("PurgeUser", "60ecb2bf67774900350d9c43",
+		[]string{"5f62b7d8be3591c4dea8566d", "5f62b7d8be3591c4dea8566a"},
+		[]string{"60ecb2bf67774900350d9c42"}, []string(nil), mock.MatchedBy(func(entry *types.AuditLogEntry) bool {
+			return entry.EntityType == types.EntityUser && entry.EntityID == "60ecb2bf67774900350d9c43" &&
+				entry.Action == types.AuditActionPurge && entry.Actor == "admin" && entry.Reason == "data-deletion request"
+		})).Return(&types.UserPurge{
+		DeletedApplicationIDs:  []string{"5f62b7d8be3591c4dea8566d", "5f62b7d8be3591c4dea8566a"},
+		DeletedLoadBalancerIDs: []string{"60ecb2bf67774900350d9c42"},
+	}, nil).Once()
+
+	purge, err := users.Purge(context.Background(), "60ecb2bf67774900350d9c43", UserPurgeInput{Reason: "data-deletion request", Actor: "admin"})
+	c.NoError(err)
+	c.Equal([]string{"60ecb2bf67774900350d9c42"}, purge.DeletedLoadBalancerIDs)
+	c.Empty(purge.DetachedApplicationIDs)
+	c.NotNil(purge.SharedContactApplicationIDs)
+
+	c.Nil(testCache.GetApplication("5f62b7d8be3591c4dea8566d"))
+	c.Nil(testCache.GetLoadBalancer("60ecb2bf67774900350d9c42"))
+	c.Empty(testCache.GetApplicationsByUserID("60ecb2bf67774900350d9c43"))
+
+	writerMock.AssertExpectations(t)
+}
@@ -0,0 +1,77 @@
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pokt-foundation/portal-api-go/repository"
+)
+
+// PlanDeprecationWindow is how long before its deprecation date a pay plan is warned about
+const PlanDeprecationWindow = 30 * 24 * time.Hour
+
+// CreateWarnings returns the non fatal issues of app, to be surfaced along with its creation
+func (s *ApplicationService) CreateWarnings(app *repository.Application) []string {
+	var warnings []string
+
+	if warning := s.planDeprecationWarning(app.PayPlanType); warning != "" {
+		warnings = append(warnings, warning)
+	}
+
+	if isEmptyNotificationSettings(app.NotificationSettings) {
+		warnings = append(warnings, "notification settings are empty, the user will not be notified about usage")
+	}
+
+	return warnings
+}
+
+// UpdateWarnings returns the non fatal issues of input, to be surfaced along with the update
+func (s *ApplicationService) UpdateWarnings(input *repository.UpdateApplication) []string {
+	var warnings []string
+
+	if warning := s.planDeprecationWarning(input.PayPlanType); warning != "" {
+		warnings = append(warnings, warning)
+	}
+
+	if input.NotificationSettings != nil && isEmptyNotificationSettings(*input.NotificationSettings) {
+		warnings = append(warnings, "notification settings are empty, the user will not be notified about usage")
+	}
+
+	return warnings
+}
+
+// planDeprecationWarning returns the warning of planType if it is deprecated or about to be, empty otherwise
+func (s *ApplicationService) planDeprecationWarning(planType repository.PayPlanType) string {
+	deprecation, ok := s.PlanDeprecations[planType]
+	if !ok || planType == "" {
+		return ""
+	}
+
+	now := time.Now()
+
+	if !now.Before(deprecation) {
+		return fmt.Sprintf("pay plan %s was deprecated on %s", planType, deprecation.Format("2006-01-02"))
+	}
+
+	if deprecation.Sub(now) <= PlanDeprecationWindow {
+		return fmt.Sprintf("pay plan %s will be deprecated on %s", planType, deprecation.Format("2006-01-02"))
+	}
+
+	return ""
+}
+
+// isEmptyNotificationSettings returns true if settings has no notification enabled
+func isEmptyNotificationSettings(settings repository.NotificationSettings) bool {
+	return !settings.SignedUp && !settings.Quarter && !settings.Half && !settings.ThreeQuarters && !settings.Full
+}
+
+// CreateWarnings returns the non fatal issues of lb, to be surfaced along with its creation
+func (s *LoadBalancerService) CreateWarnings(lb *repository.LoadBalancer) []string {
+	var warnings []string
+
+	if lb.GigastakeRedirect {
+		warnings = append(warnings, "gigastakeRedirect is deprecated, gigastake applications should be used instead")
+	}
+
+	return warnings
+}
@@ -0,0 +1,63 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplicationService_CreateWarnings(t *testing.T) {
+	c := require.New(t)
+
+	apps := NewApplicationService(newTestCache(t), &writerMock{}, nil)
+
+	c.Equal([]string{"notification settings are empty, the user will not be notified about usage"},
+		apps.CreateWarnings(&repository.Application{PayPlanType: repository.FreetierV0}))
+
+	apps.PlanDeprecations = map[repository.PayPlanType]time.Time{
+		repository.FreetierV0:   time.Now().Add(24 * time.Hour),
+		repository.PayAsYouGoV0: time.Now().Add(2 * PlanDeprecationWindow),
+	}
+
+	warnings := apps.CreateWarnings(&repository.Application{
+		PayPlanType:          repository.FreetierV0,
+		NotificationSettings: repository.NotificationSettings{Full: true},
+	})
+	c.Len(warnings, 1)
+	c.Contains(warnings[0], "pay plan FREETIER_V0 will be deprecated on")
+
+	c.Empty(apps.CreateWarnings(&repository.Application{
+		PayPlanType:          repository.PayAsYouGoV0,
+		NotificationSettings: repository.NotificationSettings{Full: true},
+	}))
+}
+
+func TestApplicationService_UpdateWarnings(t *testing.T) {
+	c := require.New(t)
+
+	apps := NewApplicationService(newTestCache(t), &writerMock{}, nil)
+	apps.PlanDeprecations = map[repository.PayPlanType]time.Time{
+		repository.FreetierV0: time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	c.Empty(apps.UpdateWarnings(&repository.UpdateApplication{Name: "pokt"}))
+
+	c.Equal([]string{
+		"pay plan FREETIER_V0 was deprecated on 2021-01-01",
+		"notification settings are empty, the user will not be notified about usage",
+	}, apps.UpdateWarnings(&repository.UpdateApplication{
+		PayPlanType:          repository.FreetierV0,
+		NotificationSettings: &repository.NotificationSettings{},
+	}))
+}
+
+func TestLoadBalancerService_CreateWarnings(t *testing.T) {
+	c := require.New(t)
+
+	lbs := NewLoadBalancerService(newTestCache(t), &writerMock{})
+
+	c.Empty(lbs.CreateWarnings(&repository.LoadBalancer{Name: "pokt"}))
+	c.Len(lbs.CreateWarnings(&repository.LoadBalancer{Name: "pokt", GigastakeRedirect: true}), 1)
+}
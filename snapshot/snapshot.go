@@ -0,0 +1,168 @@
+// Package snapshot defines the full data export used to cold start the service
+// and verifies stored snapshots against the data currently served
+package snapshot
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/pokt-foundation/pocket-http-db/cache"
+	"github.com/pokt-foundation/pocket-http-db/types"
+	"github.com/pokt-foundation/portal-api-go/repository"
+)
+
+// Snapshot represents a full export of the entities
+type Snapshot struct {
+	CreatedAt          time.Time                  `json:"createdAt"`
+	Applications       []*repository.Application  `json:"applications"`
+	Blockchains        []*repository.Blockchain   `json:"blockchains"`
+	LoadBalancers      []*repository.LoadBalancer `json:"loadBalancers"`
+	PayPlans           []*repository.PayPlan      `json:"payPlans"`
+	Redirects          []*repository.Redirect     `json:"redirects"`
+	Labels             []*types.EntityLabels      `json:"labels,omitempty"`
+	ApplicationFilters []*types.ApplicationFilter `json:"applicationFilters,omitempty"`
+}
+
+// Checksum holds the row count and content hash of an entity type
+type Checksum struct {
+	Rows int    `json:"rows"`
+	Hash string `json:"hash"`
+}
+
+// Drift holds the comparison of an entity type between the current export and a snapshot
+type Drift struct {
+	EntityType types.EntityType `json:"entityType"`
+	Current    Checksum         `json:"current"`
+	Snapshot   Checksum         `json:"snapshot"`
+	RowDrift   int              `json:"rowDrift"`
+	HashMatch  bool             `json:"hashMatch"`
+}
+
+// FromCache returns the export of the entities currently in cache
+func FromCache(c *cache.Cache) *Snapshot {
+	s := &Snapshot{
+		CreatedAt:          time.Now().UTC(),
+		Applications:       c.GetApplications(),
+		Blockchains:        c.GetBlockchains(),
+		PayPlans:           c.GetPayPlans(),
+		Labels:             c.GetAllLabels(),
+		ApplicationFilters: c.GetApplicationFilters(),
+	}
+
+	for _, lb := range c.GetLoadBalancers() {
+		exported := *lb
+
+		// the cache links applications instead of keeping their IDs
+		exported.ApplicationIDs = make([]string, 0, len(lb.Applications))
+		for _, app := range lb.Applications {
+			if app != nil {
+				exported.ApplicationIDs = append(exported.ApplicationIDs, app.ID)
+			}
+		}
+
+		exported.Applications = nil
+
+		s.LoadBalancers = append(s.LoadBalancers, &exported)
+	}
+
+	for _, blockchain := range s.Blockchains {
+		for i := range blockchain.Redirects {
+			s.Redirects = append(s.Redirects, &blockchain.Redirects[i])
+		}
+	}
+
+	return s
+}
+
+// Checksums returns the checksum of every entity type in s
+func (s *Snapshot) Checksums() (map[types.EntityType]Checksum, error) {
+	entities := map[types.EntityType]any{
+		types.EntityApplication:  s.Applications,
+		types.EntityBlockchain:   s.Blockchains,
+		types.EntityLoadBalancer: s.LoadBalancers,
+		types.EntityPayPlan:      s.PayPlans,
+		types.EntityRedirect:     s.Redirects,
+	}
+
+	checksums := make(map[types.EntityType]Checksum, len(entities))
+
+	for entityType, rows := range entities {
+		checksum, err := checksumRows(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		checksums[entityType] = checksum
+	}
+
+	return checksums, nil
+}
+
+// checksumRows hashes the JSON of every row in sorted order, so the hash does not depend on row order
+func checksumRows(rows any) (Checksum, error) {
+	rawRows, err := json.Marshal(rows)
+	if err != nil {
+		return Checksum{}, err
+	}
+
+	var splitRows []json.RawMessage
+
+	err = json.Unmarshal(rawRows, &splitRows)
+	if err != nil {
+		return Checksum{}, err
+	}
+
+	encoded := make([]string, 0, len(splitRows))
+	for _, row := range splitRows {
+		encoded = append(encoded, string(row))
+	}
+
+	sort.Strings(encoded)
+
+	hash := sha256.New()
+	for _, row := range encoded {
+		hash.Write([]byte(row))
+		hash.Write([]byte{'\n'})
+	}
+
+	return Checksum{
+		Rows: len(encoded),
+		Hash: hex.EncodeToString(hash.Sum(nil)),
+	}, nil
+}
+
+// Compare returns the drift of every entity type between current and stored, sorted by entity type
+func Compare(current, stored *Snapshot) ([]Drift, error) {
+	currentChecksums, err := current.Checksums()
+	if err != nil {
+		return nil, err
+	}
+
+	storedChecksums, err := stored.Checksums()
+	if err != nil {
+		return nil, err
+	}
+
+	drifts := make([]Drift, 0, len(currentChecksums))
+
+	for entityType, currentChecksum := range currentChecksums {
+		storedChecksum := storedChecksums[entityType]
+
+		drifts = append(drifts, Drift{
+			EntityType: entityType,
+			Current:    currentChecksum,
+			Snapshot:   storedChecksum,
+			RowDrift:   currentChecksum.Rows - storedChecksum.Rows,
+			HashMatch:  currentChecksum.Hash == storedChecksum.Hash,
+		})
+	}
+
+	sort.Slice(drifts, func(i, j int) bool {
+		return drifts[i].EntityType < drifts[j].EntityType
+	})
+
+	return drifts, nil
+}
@@ -0,0 +1,53 @@
+package snapshot
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+// ErrNotFound error when there is no stored snapshot
+var ErrNotFound = errors.New("snapshot not found")
+
+// Store represents where the last snapshot is kept
+type Store interface {
+	Load() (*Snapshot, error)
+}
+
+// LocalStore struct handler for a snapshot kept in the local filesystem
+type LocalStore struct {
+	path string
+}
+
+// NewLocalStore returns LocalStore instance reading the snapshot in path
+func NewLocalStore(path string) *LocalStore {
+	return &LocalStore{path: path}
+}
+
+// Load reads the snapshot file
+func (s *LocalStore) Load() (*Snapshot, error) {
+	file, err := os.Open(s.path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, ErrNotFound
+		}
+
+		return nil, err
+	}
+	defer file.Close()
+
+	return decode(file)
+}
+
+func decode(r io.Reader) (*Snapshot, error) {
+	var snapshot Snapshot
+
+	err := json.NewDecoder(r).Decode(&snapshot)
+	if err != nil {
+		return nil, fmt.Errorf("snapshot decode failed: %w", err)
+	}
+
+	return &snapshot, nil
+}
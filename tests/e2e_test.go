@@ -12,6 +12,8 @@ import (
 
 	"github.com/gojektech/heimdall/httpclient"
 	"github.com/lib/pq"
+	"github.com/pokt-foundation/pocket-http-db/postgres"
+	"github.com/pokt-foundation/pocket-http-db/writertest"
 	postgresdriver "github.com/pokt-foundation/portal-api-go/postgres-driver"
 	"github.com/pokt-foundation/portal-api-go/repository"
 	"github.com/stretchr/testify/suite"
@@ -61,6 +63,22 @@ func TestE2E_RunSuite(t *testing.T) {
 	suite.Run(t, new(PHDTestSuite))
 }
 
+func TestE2E_WriterConformance(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping end to end test")
+	}
+
+	listener := pq.NewListener(connectionString, 10*time.Second, time.Minute, nil)
+
+	driver, err := postgres.NewDriverFromConnectionString(connectionString, listener)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the database is shared by the tests, which only look at the entities they write
+	writertest.Run(t, func(t *testing.T) writertest.Backend { return driver })
+}
+
 /*
 To run the E2E suite use the command `make test_e2e` from the repository root.
 The E2E suite also runs on all Pull Requests to the main or staging branches.
@@ -156,6 +156,7 @@ CREATE TABLE IF NOT EXISTS lb_apps (
 	id INT GENERATED ALWAYS AS IDENTITY,
 	lb_id VARCHAR NOT NULL,
 	app_id VARCHAR NOT NULL,
+	position INT,
 	UNIQUE(lb_id, app_id),
 	PRIMARY KEY (id),
 	CONSTRAINT fk_lb
@@ -166,6 +167,55 @@ CREATE TABLE IF NOT EXISTS lb_apps (
 	  	REFERENCES applications(application_id)
 );
 
+-- Audit Log
+CREATE TABLE IF NOT EXISTS audit_log (
+	id INT GENERATED ALWAYS AS IDENTITY,
+	entity_type VARCHAR NOT NULL,
+	entity_id VARCHAR NOT NULL,
+	action VARCHAR NOT NULL,
+	actor VARCHAR,
+	reason TEXT,
+	data JSONB,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY (id)
+);
+
+CREATE INDEX IF NOT EXISTS audit_log_entity_idx ON audit_log (entity_type, entity_id);
+
+-- Instances
+CREATE TABLE IF NOT EXISTS instances (
+	instance_id VARCHAR NOT NULL,
+	cache_generation BIGINT NOT NULL,
+	cache_refreshed_at TIMESTAMP,
+	last_seen TIMESTAMP NOT NULL,
+	PRIMARY KEY (instance_id)
+);
+
+CREATE TABLE IF NOT EXISTS request_nonces (
+	nonce VARCHAR NOT NULL,
+	expires_at TIMESTAMP NOT NULL,
+	PRIMARY KEY (nonce)
+);
+
+CREATE INDEX IF NOT EXISTS request_nonces_expires_at_idx ON request_nonces (expires_at);
+
+CREATE TABLE IF NOT EXISTS entity_labels (
+	entity_type VARCHAR NOT NULL,
+	entity_id VARCHAR NOT NULL,
+	label_key VARCHAR NOT NULL,
+	label_value VARCHAR NOT NULL,
+	PRIMARY KEY (entity_type, entity_id, label_key)
+);
+
+CREATE TABLE IF NOT EXISTS application_filters (
+	name VARCHAR NOT NULL,
+	status VARCHAR,
+	pay_plan_type VARCHAR,
+	label_selectors VARCHAR[],
+	updated_at TIMESTAMP NOT NULL,
+	PRIMARY KEY (name)
+);
+
 -- Insert Rows
 INSERT INTO pay_plans (plan_type, daily_limit)
 VALUES
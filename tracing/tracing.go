@@ -0,0 +1,161 @@
+// Package tracing propagates W3C trace context and decides which requests are traced,
+// sampling writes and reads at their own rates so high-volume polling does not drown the tracing backend
+package tracing
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// Header is the W3C trace context header
+const Header = "traceparent"
+
+// version is the only trace context version written
+const version = "00"
+
+// flagSampled is the trace flag telling the request is traced
+const flagSampled = 0x01
+
+// SpanContext identifies a span of a trace
+type SpanContext struct {
+	// TraceID is the 32 lowercase hex characters trace ID
+	TraceID string
+	// SpanID is the 16 lowercase hex characters span ID
+	SpanID  string
+	Sampled bool
+}
+
+// Parse returns the span context of a traceparent header value, false if it is not valid
+func Parse(traceparent string) (SpanContext, bool) {
+	parts := strings.Split(strings.TrimSpace(traceparent), "-")
+	if len(parts) < 4 || len(parts[0]) != 2 || parts[0] == "ff" || (parts[0] == version && len(parts) != 4) {
+		return SpanContext{}, false
+	}
+
+	if !isHex(parts[0]) || !isID(parts[1], 32) || !isID(parts[2], 16) || len(parts[3]) != 2 || !isHex(parts[3]) {
+		return SpanContext{}, false
+	}
+
+	flags, _ := hex.DecodeString(parts[3])
+
+	return SpanContext{
+		TraceID: parts[1],
+		SpanID:  parts[2],
+		Sampled: flags[0]&flagSampled != 0,
+	}, true
+}
+
+// String returns the span context as a traceparent header value
+func (s SpanContext) String() string {
+	flags := "00"
+	if s.Sampled {
+		flags = "01"
+	}
+
+	return fmt.Sprintf("%s-%s-%s-%s", version, s.TraceID, s.SpanID, flags)
+}
+
+// isID returns true if value is a non zero lowercase hex ID of given length
+func isID(value string, length int) bool {
+	return len(value) == length && isHex(value) && strings.Trim(value, "0") != ""
+}
+
+func isHex(value string) bool {
+	for _, char := range value {
+		if !(char >= '0' && char <= '9' || char >= 'a' && char <= 'f') {
+			return false
+		}
+	}
+
+	return true
+}
+
+// NewTraceID returns a random trace ID
+func NewTraceID() string {
+	return randomID(16)
+}
+
+// NewSpanID returns a random span ID
+func NewSpanID() string {
+	return randomID(8)
+}
+
+func randomID(size int) string {
+	id := make([]byte, size)
+
+	_, err := rand.Read(id)
+	if err != nil {
+		panic(err)
+	}
+
+	return hex.EncodeToString(id)
+}
+
+// contextKey is the context key of the span context of a request
+type contextKey struct{}
+
+// NewContext returns a copy of ctx holding span
+func NewContext(ctx context.Context, span SpanContext) context.Context {
+	return context.WithValue(ctx, contextKey{}, span)
+}
+
+// FromContext returns the span context held by ctx, false if there is none
+func FromContext(ctx context.Context) (SpanContext, bool) {
+	span, ok := ctx.Value(contextKey{}).(SpanContext)
+
+	return span, ok
+}
+
+// Sampler decides which requests are traced by the rate of their route class, from 0 (never) to 1 (always)
+type Sampler struct {
+	reads  float64
+	writes float64
+	// Routes overrides the rate of the route templates it holds, for both reads and writes
+	Routes map[string]float64
+}
+
+// NewSampler returns Sampler instance tracing reads and writes at given rates
+func NewSampler(reads, writes float64) *Sampler {
+	return &Sampler{
+		reads:  reads,
+		writes: writes,
+	}
+}
+
+// Rate returns the sampling rate of requests to route, a route template such as /application/{id}
+func (s *Sampler) Rate(route string, write bool) float64 {
+	if rate, ok := s.Routes[route]; ok {
+		return rate
+	}
+
+	if write {
+		return s.writes
+	}
+
+	return s.reads
+}
+
+// Sample returns true if the trace with given ID is traced on route
+// the decision is derived from the trace ID, so every service sampling at the same rate agrees on it
+func (s *Sampler) Sample(traceID, route string, write bool) bool {
+	rate := s.Rate(route, write)
+
+	switch {
+	case rate >= 1:
+		return true
+	case rate <= 0:
+		return false
+	}
+
+	rawID, err := hex.DecodeString(traceID)
+	if err != nil || len(rawID) != 16 {
+		return false
+	}
+
+	// the last 8 bytes of the trace ID are the random ones, as the OpenTelemetry ratio sampler reads them
+	return binary.BigEndian.Uint64(rawID[8:])>>1 < uint64(rate*(1<<63))
+}
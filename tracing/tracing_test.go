@@ -0,0 +1,84 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	c := require.New(t)
+
+	span, ok := Parse("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	c.True(ok)
+	c.Equal(SpanContext{TraceID: "4bf92f3577b34da6a3ce929d0e0e4736", SpanID: "00f067aa0ba902b7", Sampled: true}, span)
+	c.Equal("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", span.String())
+
+	span, ok = Parse("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-00")
+	c.True(ok)
+	c.False(span.Sampled)
+
+	// later versions may append fields
+	_, ok = Parse("01-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-extra")
+	c.True(ok)
+
+	for _, invalid := range []string{
+		"",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-extra",
+		"ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		"00-00000000000000000000000000000000-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-0000000000000000-01",
+		"00-4BF92F3577B34DA6A3CE929D0E0E4736-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e473-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-zz",
+	} {
+		_, ok := Parse(invalid)
+		c.False(ok, invalid)
+	}
+}
+
+func TestContext(t *testing.T) {
+	c := require.New(t)
+
+	_, ok := FromContext(context.Background())
+	c.False(ok)
+
+	span := SpanContext{TraceID: NewTraceID(), SpanID: NewSpanID()}
+	c.Len(span.TraceID, 32)
+	c.Len(span.SpanID, 16)
+
+	ctxSpan, ok := FromContext(NewContext(context.Background(), span))
+	c.True(ok)
+	c.Equal(span, ctxSpan)
+}
+
+func TestSampler_Sample(t *testing.T) {
+	c := require.New(t)
+
+	sampler := NewSampler(0.01, 1)
+	sampler.Routes = map[string]float64{"/application/limits": 0}
+
+	c.Equal(0.01, sampler.Rate("/application/{id}", false))
+	c.Equal(float64(1), sampler.Rate("/application/{id}", true))
+	c.Equal(float64(0), sampler.Rate("/application/limits", false))
+
+	lowTraceID := "4bf92f3577b34da60000000000000001"
+	highTraceID := "4bf92f3577b34da6ffffffffffffffff"
+
+	c.True(sampler.Sample(highTraceID, "/application", true))
+	c.True(sampler.Sample(lowTraceID, "/application", false))
+	c.False(sampler.Sample(highTraceID, "/application", false))
+	c.False(sampler.Sample(lowTraceID, "/application/limits", false))
+	c.False(sampler.Sample("not a trace ID", "/application", false))
+
+	sampled := 0
+	for i := 0; i < 10000; i++ {
+		if sampler.Sample(NewTraceID(), "/application", false) {
+			sampled++
+		}
+	}
+
+	c.InDelta(100, sampled, 60)
+}
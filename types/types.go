@@ -0,0 +1,142 @@
+// Package types holds the entities and values owned by this service
+// that are not part of the portal-api-go repository package
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pokt-foundation/portal-api-go/repository"
+)
+
+// AppStatusSuspended is the status of applications suspended for abuse
+const AppStatusSuspended repository.AppStatus = "SUSPENDED"
+
+// ValidAppStatus returns true if status is a known application status
+func ValidAppStatus(status repository.AppStatus) bool {
+	return repository.ValidAppStatuses[status] || status == AppStatusSuspended
+}
+
+// EntityType represents the kind of entity an operation was applied to
+type EntityType string
+
+const (
+	EntityApplication       EntityType = "application"
+	EntityBlockchain        EntityType = "blockchain"
+	EntityLoadBalancer      EntityType = "load_balancer"
+	EntityPayPlan           EntityType = "pay_plan"
+	EntityRedirect          EntityType = "redirect"
+	EntityLabel             EntityType = "label"
+	EntityApplicationFilter EntityType = "application_filter"
+	EntityUser              EntityType = "user"
+)
+
+// AuditAction represents an action recorded in the audit log
+type AuditAction string
+
+const (
+	AuditActionSuspend   AuditAction = "suspend"
+	AuditActionUnsuspend AuditAction = "unsuspend"
+	AuditActionPurge     AuditAction = "purge"
+	// AuditActionUpdateSettings is the change of the operational settings of an entity
+	AuditActionUpdateSettings AuditAction = "update_settings"
+)
+
+// AuditLogEntry represents a single record of the audit log
+type AuditLogEntry struct {
+	EntityType EntityType      `json:"entityType"`
+	EntityID   string          `json:"entityID"`
+	Action     AuditAction     `json:"action"`
+	Actor      string          `json:"actor"`
+	Reason     string          `json:"reason,omitempty"`
+	Data       json.RawMessage `json:"data,omitempty"`
+	CreatedAt  time.Time       `json:"createdAt"`
+}
+
+// BlockchainSettings are the operational settings of a blockchain, tuned apart from the fields identifying it
+type BlockchainSettings struct {
+	// LogLimitBlocks is the block range limit of log queries, 0 for no limit
+	LogLimitBlocks int `json:"logLimitBlocks"`
+	// RequestTimeout is the timeout of the relays to the blockchain in milliseconds, 0 for the default one
+	RequestTimeout int `json:"requestTimeout"`
+	// EnforceResult is the format the relay results must have, such as JSON, empty to accept any result
+	EnforceResult string `json:"enforceResult"`
+}
+
+// UserPurge reports the entities removed by the data purge of a user
+type UserPurge struct {
+	UserID                 string   `json:"userID"`
+	DeletedApplicationIDs  []string `json:"deletedApplicationIDs"`
+	DeletedLoadBalancerIDs []string `json:"deletedLoadBalancerIDs"`
+	DetachedApplicationIDs []string `json:"detachedApplicationIDs"`
+	// SharedContactApplicationIDs are the applications of other users still using a contact email of the purged user,
+	// left unchanged as the address can be shared, such as a team inbox
+	SharedContactApplicationIDs []string `json:"sharedContactApplicationIDs"`
+}
+
+// Instance represents a running instance of the service, as last reported by its heartbeat
+type Instance struct {
+	ID               string    `json:"id"`
+	CacheGeneration  uint64    `json:"cacheGeneration"`
+	CacheRefreshedAt time.Time `json:"cacheRefreshedAt"`
+	LastSeen         time.Time `json:"lastSeen"`
+}
+
+// ErrLoadBalancerAppsConflict error when the applications of a load balancer changed since the version a write was based on
+var ErrLoadBalancerAppsConflict = errors.New("load balancer applications changed concurrently")
+
+// LoadBalancerAppsConflictError holds the current applications of a load balancer whose write was based on an old version
+type LoadBalancerAppsConflictError struct {
+	ApplicationIDs []string
+	Version        string
+}
+
+func (e *LoadBalancerAppsConflictError) Error() string {
+	return ErrLoadBalancerAppsConflict.Error()
+}
+
+// Is makes LoadBalancerAppsConflictError match ErrLoadBalancerAppsConflict
+func (e *LoadBalancerAppsConflictError) Is(target error) bool {
+	return target == ErrLoadBalancerAppsConflict
+}
+
+// LoadBalancerAppsVersion returns the version of a load balancer applications set, regardless of their order
+func LoadBalancerAppsVersion(appIDs []string) string {
+	sortedIDs := append([]string{}, appIDs...)
+	sort.Strings(sortedIDs)
+
+	hash := sha256.Sum256([]byte(strings.Join(sortedIDs, ",")))
+
+	return hex.EncodeToString(hash[:8])
+}
+
+// ApplicationVersion returns the version of an application, changing with any of its fields
+// it is sent as the ETag of the application
+func ApplicationVersion(app *repository.Application) string {
+	rawApp, _ := json.Marshal(app)
+
+	hash := sha256.Sum256(rawApp)
+
+	return hex.EncodeToString(hash[:8])
+}
+
+// EntityLabels holds the free-form labels of an entity, such as the team owning it
+type EntityLabels struct {
+	EntityType EntityType        `json:"entityType"`
+	EntityID   string            `json:"entityID"`
+	Labels     map[string]string `json:"labels"`
+}
+
+// ApplicationFilter is a named filter of applications, an application must match every set criterion
+// labels are label selectors, as key:value or key alone to select any value
+type ApplicationFilter struct {
+	Name        string                 `json:"name"`
+	Status      repository.AppStatus   `json:"status,omitempty"`
+	PayPlanType repository.PayPlanType `json:"payPlanType,omitempty"`
+	Labels      []string               `json:"labels,omitempty"`
+}
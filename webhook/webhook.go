@@ -0,0 +1,232 @@
+// Package webhook delivers entity events to the HTTP endpoints configured by operators
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pokt-foundation/pocket-http-db/health"
+	"github.com/pokt-foundation/pocket-http-db/types"
+	"github.com/sirupsen/logrus"
+)
+
+// SignatureHeader is the header holding the hex HMAC-SHA256 of the body, set when a secret is configured
+const SignatureHeader = "X-PHD-Signature"
+
+var (
+	errResponseNotOK  = errors.New("webhook response not ok")
+	errResponseFailed = errors.New("webhook response rejected the event")
+)
+
+// EventType represents the kind of event sent to webhooks
+type EventType string
+
+const (
+	EventApplicationSuspended   EventType = "application.suspended"
+	EventApplicationUnsuspended EventType = "application.unsuspended"
+	// EventPayPlanUpdated is sent when the daily limit of a pay plan changes
+	EventPayPlanUpdated EventType = "pay_plan.updated"
+	EventPayPlanCreated EventType = "pay_plan.created"
+	EventPayPlanRemoved EventType = "pay_plan.removed"
+
+	EventApplicationCreated  EventType = "application.created"
+	EventApplicationUpdated  EventType = "application.updated"
+	EventApplicationRemoved  EventType = "application.removed"
+	EventLoadBalancerCreated EventType = "load_balancer.created"
+	EventLoadBalancerUpdated EventType = "load_balancer.updated"
+	EventLoadBalancerRemoved EventType = "load_balancer.removed"
+	EventBlockchainCreated   EventType = "blockchain.created"
+	EventBlockchainUpdated   EventType = "blockchain.updated"
+	EventBlockchainRemoved   EventType = "blockchain.removed"
+	EventRedirectCreated     EventType = "redirect.created"
+	EventRedirectRemoved     EventType = "redirect.removed"
+)
+
+// Event represents the payload sent to webhooks
+type Event struct {
+	Type       EventType        `json:"type"`
+	EntityType types.EntityType `json:"entityType"`
+	EntityID   string           `json:"entityID"`
+	Data       any              `json:"data,omitempty"`
+	Time       time.Time        `json:"time"`
+}
+
+// DeadLetter is an event whose delivery to URL failed on every attempt
+type DeadLetter struct {
+	Time     time.Time       `json:"time"`
+	URL      string          `json:"url"`
+	Error    string          `json:"error"`
+	Attempts int             `json:"attempts"`
+	Event    json.RawMessage `json:"event"`
+}
+
+// Dispatcher struct handler for webhook deliveries
+type Dispatcher struct {
+	urls   []string
+	secret []byte
+	client *http.Client
+	log    *logrus.Logger
+	// Probe records the outcome of the deliveries, nil records nothing
+	// no event is delivered while it is disabled
+	Probe *health.Probe
+	// MaxRetries is how many times a failed delivery is retried, the first retry waits RetryBackoff
+	// and each next one twice as long, responses rejecting the event with a 4xx status are not retried
+	MaxRetries   int
+	RetryBackoff time.Duration
+	// DeadLetters receives the events failing every attempt as JSON lines of DeadLetter, nil logs them instead
+	DeadLetters      io.Writer
+	deadLettersMutex sync.Mutex
+}
+
+// NewDispatcher returns Dispatcher instance sending events to all urls
+func NewDispatcher(urls []string, secret string, timeout time.Duration, logger *logrus.Logger) *Dispatcher {
+	return &Dispatcher{
+		urls:   urls,
+		secret: []byte(secret),
+		client: &http.Client{Timeout: timeout},
+		log:    logger,
+	}
+}
+
+// Dispatch sends event to every webhook in the background, the event is dropped if the webhooks are disabled
+func (d *Dispatcher) Dispatch(event Event) {
+	if !d.Probe.Enabled() {
+		return
+	}
+
+	if event.Time.IsZero() {
+		event.Time = time.Now().UTC()
+	}
+
+	body, err := json.Marshal(event)
+	if err != nil {
+		d.logError(fmt.Errorf("webhook marshal failed: %w", err))
+		return
+	}
+
+	for _, url := range d.urls {
+		go d.deliver(url, event.Type, body)
+	}
+}
+
+// deliver sends body to url, retrying as configured, and records it as a dead letter if every attempt fails
+func (d *Dispatcher) deliver(url string, eventType EventType, body []byte) {
+	backoff := d.RetryBackoff
+
+	var err error
+
+	attempts := 0
+
+	for attempts <= d.MaxRetries {
+		if attempts > 0 {
+			time.Sleep(backoff)
+			backoff *= 2
+		}
+
+		attempts++
+
+		err = d.send(url, body)
+		if err == nil || errors.Is(err, errResponseFailed) {
+			break
+		}
+	}
+
+	d.Probe.Record(err)
+
+	if err == nil {
+		return
+	}
+
+	d.logError(fmt.Errorf("webhook %s delivery failed after %d attempts: %w", eventType, attempts, err))
+	d.deadLetter(DeadLetter{
+		Time:     time.Now().UTC(),
+		URL:      url,
+		Error:    err.Error(),
+		Attempts: attempts,
+		Event:    body,
+	})
+}
+
+// deadLetter writes letter to DeadLetters, or logs it if they are not configured
+func (d *Dispatcher) deadLetter(letter DeadLetter) {
+	if d.DeadLetters == nil {
+		d.log.WithFields(logrus.Fields{
+			"url":   letter.URL,
+			"event": string(letter.Event),
+		}).Error("webhook dead letter")
+
+		return
+	}
+
+	line, err := json.Marshal(letter)
+	if err != nil {
+		d.logError(fmt.Errorf("webhook dead letter marshal failed: %w", err))
+		return
+	}
+
+	d.deadLettersMutex.Lock()
+	defer d.deadLettersMutex.Unlock()
+
+	_, err = d.DeadLetters.Write(append(line, '\n'))
+	if err != nil {
+		d.logError(fmt.Errorf("webhook dead letter write failed: %w", err))
+	}
+}
+
+// Sign returns the signature of body with secret
+func Sign(secret, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (d *Dispatcher) send(url string, body []byte) error {
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	if len(d.secret) > 0 {
+		req.Header.Set(SignatureHeader, Sign(d.secret, body))
+	}
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	// a 4xx response would reject the same event again, except timeouts and rate limits
+	if resp.StatusCode >= http.StatusBadRequest && resp.StatusCode < http.StatusInternalServerError &&
+		resp.StatusCode != http.StatusRequestTimeout && resp.StatusCode != http.StatusTooManyRequests {
+		return fmt.Errorf("%w: status %d", errResponseFailed, resp.StatusCode)
+	}
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("%w: status %d", errResponseNotOK, resp.StatusCode)
+	}
+
+	return nil
+}
+
+func (d *Dispatcher) logError(err error) {
+	fields := logrus.Fields{
+		"err": err.Error(),
+	}
+
+	d.log.WithFields(fields).Error(err)
+}
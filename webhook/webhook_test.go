@@ -0,0 +1,128 @@
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pokt-foundation/pocket-http-db/types"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDispatcher_Dispatch(t *testing.T) {
+	c := require.New(t)
+
+	type delivery struct {
+		body      []byte
+		signature string
+	}
+
+	deliveries := make(chan delivery, 2)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		deliveries <- delivery{body: body, signature: r.Header.Get(SignatureHeader)}
+	}))
+	defer server.Close()
+
+	dispatcher := NewDispatcher([]string{server.URL, server.URL}, "secret", time.Second, logrus.New())
+
+	dispatcher.Dispatch(Event{
+		Type:       EventApplicationSuspended,
+		EntityType: types.EntityApplication,
+		EntityID:   "5f62b7d8be3591c4dea8566d",
+	})
+
+	for i := 0; i < 2; i++ {
+		d := <-deliveries
+
+		c.Contains(string(d.body), `"type":"application.suspended"`)
+		c.Equal(Sign([]byte("secret"), d.body), d.signature)
+	}
+}
+
+func TestDispatcher_send(t *testing.T) {
+	c := require.New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Empty(r.Header.Get(SignatureHeader))
+
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	dispatcher := NewDispatcher([]string{server.URL}, "", time.Second, logrus.New())
+
+	err := dispatcher.send(server.URL, []byte(`{}`))
+	c.ErrorIs(err, errResponseNotOK)
+}
+
+func TestDispatcher_deliver(t *testing.T) {
+	c := require.New(t)
+
+	var attempts int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+
+	var deadLetters bytes.Buffer
+
+	dispatcher := NewDispatcher([]string{server.URL}, "", time.Second, logrus.New())
+	dispatcher.MaxRetries = 2
+	dispatcher.RetryBackoff = time.Millisecond
+	dispatcher.DeadLetters = &deadLetters
+
+	dispatcher.deliver(server.URL, EventApplicationCreated, []byte(`{}`))
+
+	c.Equal(int32(3), atomic.LoadInt32(&attempts))
+	c.Empty(deadLetters.String())
+
+	atomic.StoreInt32(&attempts, 0)
+	dispatcher.MaxRetries = 1
+
+	dispatcher.deliver(server.URL, EventApplicationCreated, []byte(`{"type":"application.created"}`))
+
+	c.Equal(int32(2), atomic.LoadInt32(&attempts))
+
+	var letter DeadLetter
+	c.NoError(json.Unmarshal(deadLetters.Bytes(), &letter))
+	c.Equal(server.URL, letter.URL)
+	c.Equal(2, letter.Attempts)
+	c.JSONEq(`{"type":"application.created"}`, string(letter.Event))
+	c.Contains(letter.Error, "status 503")
+}
+
+func TestDispatcher_deliverRejected(t *testing.T) {
+	c := require.New(t)
+
+	var attempts int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	var deadLetters bytes.Buffer
+
+	dispatcher := NewDispatcher([]string{server.URL}, "", time.Second, logrus.New())
+	dispatcher.MaxRetries = 3
+	dispatcher.RetryBackoff = time.Millisecond
+	dispatcher.DeadLetters = &deadLetters
+
+	dispatcher.deliver(server.URL, EventLoadBalancerRemoved, []byte(`{}`))
+
+	c.Equal(int32(1), atomic.LoadInt32(&attempts))
+	c.Contains(deadLetters.String(), `"attempts":1`)
+}
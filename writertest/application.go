@@ -0,0 +1,195 @@
+package writertest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pokt-foundation/pocket-http-db/types"
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/stretchr/testify/require"
+)
+
+// writeTestApplication saves a new in service application of userID and returns it as saved
+func writeTestApplication(t *testing.T, backend Backend, userID string) *repository.Application {
+	app, err := backend.WriteApplication(context.Background(), &repository.Application{
+		UserID:      userID,
+		Name:        "app-" + newTestID(t),
+		Status:      repository.InService,
+		PayPlanType: repository.FreetierV0,
+	})
+	require.NoError(t, err)
+
+	return app
+}
+
+func testWriteApplication(t *testing.T, backend Backend) {
+	c := require.New(t)
+
+	userID := newTestID(t)
+
+	app, err := backend.WriteApplication(context.Background(), &repository.Application{
+		UserID:       userID,
+		Name:         "pokt-app",
+		ContactEmail: "owner@example.com",
+		Status:       repository.InService,
+		PayPlanType:  repository.FreetierV0,
+		GatewaySettings: repository.GatewaySettings{
+			SecretKey:         "secret",
+			SecretKeyRequired: true,
+			WhitelistOrigins:  []string{"https://app.example.com"},
+		},
+		NotificationSettings: repository.NotificationSettings{SignedUp: true, Half: true},
+	})
+	c.NoError(err)
+
+	// the returned application has its new ID and creation time
+	c.NotEmpty(app.ID)
+	c.False(app.CreatedAt.IsZero())
+	c.False(app.UpdatedAt.IsZero())
+
+	saved := readApplication(t, backend, app.ID)
+	c.NotNil(saved)
+	c.Equal(userID, saved.UserID)
+	c.Equal("pokt-app", saved.Name)
+	c.Equal("owner@example.com", saved.ContactEmail)
+	c.Equal(repository.InService, saved.Status)
+	c.Equal(repository.FreetierV0, saved.PayPlanType)
+	c.Equal("secret", saved.GatewaySettings.SecretKey)
+	c.True(saved.GatewaySettings.SecretKeyRequired)
+	c.Equal([]string{"https://app.example.com"}, saved.GatewaySettings.WhitelistOrigins)
+	c.Equal(repository.NotificationSettings{SignedUp: true, Half: true}, saved.NotificationSettings)
+
+	// every write saves a new application, even with the same fields
+	other := writeTestApplication(t, backend, userID)
+	c.NotEqual(app.ID, other.ID)
+	c.Len(readUserApplications(t, backend, userID), 2)
+
+	_, err = backend.WriteApplication(context.Background(), &repository.Application{
+		UserID:      userID,
+		Status:      "WRONG",
+		PayPlanType: repository.FreetierV0,
+	})
+	c.Error(err)
+
+	_, err = backend.WriteApplication(context.Background(), &repository.Application{
+		UserID:      userID,
+		Status:      repository.InService,
+		PayPlanType: "WRONG",
+	})
+	c.Error(err)
+
+	// failed writes save nothing
+	c.Len(readUserApplications(t, backend, userID), 2)
+}
+
+func testUpdateApplication(t *testing.T, backend Backend) {
+	c := require.New(t)
+
+	app := writeTestApplication(t, backend, newTestID(t))
+	saved := readApplication(t, backend, app.ID)
+
+	// only the fields set are updated
+	err := backend.UpdateApplication(context.Background(), app.ID, &repository.UpdateApplication{Name: "papolo"})
+	c.NoError(err)
+
+	updated := readApplication(t, backend, app.ID)
+	c.Equal("papolo", updated.Name)
+	c.Equal(repository.InService, updated.Status)
+	c.Equal(repository.FreetierV0, updated.PayPlanType)
+	c.False(updated.UpdatedAt.Before(saved.UpdatedAt))
+
+	err = backend.UpdateApplication(context.Background(), app.ID, &repository.UpdateApplication{
+		Status:               repository.Orphaned,
+		PayPlanType:          repository.PayAsYouGoV0,
+		GatewaySettings:      &repository.GatewaySettings{WhitelistOrigins: []string{"https://app.example.com"}},
+		NotificationSettings: &repository.NotificationSettings{SignedUp: true, Full: true},
+	})
+	c.NoError(err)
+
+	updated = readApplication(t, backend, app.ID)
+	c.Equal("papolo", updated.Name)
+	c.Equal(repository.Orphaned, updated.Status)
+	c.Equal(repository.PayAsYouGoV0, updated.PayPlanType)
+	c.Equal([]string{"https://app.example.com"}, updated.GatewaySettings.WhitelistOrigins)
+	c.Equal(repository.NotificationSettings{SignedUp: true, Full: true}, updated.NotificationSettings)
+
+	// the settings are replaced as a whole
+	err = backend.UpdateApplication(context.Background(), app.ID, &repository.UpdateApplication{
+		GatewaySettings: &repository.GatewaySettings{WhitelistUserAgents: []string{"pokt"}},
+	})
+	c.NoError(err)
+
+	updated = readApplication(t, backend, app.ID)
+	c.Empty(updated.GatewaySettings.WhitelistOrigins)
+	c.Equal([]string{"pokt"}, updated.GatewaySettings.WhitelistUserAgents)
+
+	c.Error(backend.UpdateApplication(context.Background(), "", &repository.UpdateApplication{Name: "papolo"}))
+	c.Error(backend.UpdateApplication(context.Background(), app.ID, nil))
+	c.Error(backend.UpdateApplication(context.Background(), app.ID, &repository.UpdateApplication{Status: "WRONG"}))
+	c.Error(backend.UpdateApplication(context.Background(), app.ID, &repository.UpdateApplication{PayPlanType: "WRONG"}))
+
+	// failed updates change nothing
+	c.Equal(repository.Orphaned, readApplication(t, backend, app.ID).Status)
+}
+
+func testUpdateFirstDateSurpassed(t *testing.T, backend Backend) {
+	c := require.New(t)
+
+	userID := newTestID(t)
+
+	first := writeTestApplication(t, backend, userID)
+	second := writeTestApplication(t, backend, userID)
+	untouched := writeTestApplication(t, backend, userID)
+
+	surpassedAt := time.Date(2022, time.July, 21, 0, 0, 0, 0, time.UTC)
+
+	err := backend.UpdateFirstDateSurpassed(context.Background(), &repository.UpdateFirstDateSurpassed{
+		ApplicationIDs:     []string{first.ID, second.ID},
+		FirstDateSurpassed: surpassedAt,
+	})
+	c.NoError(err)
+
+	c.WithinDuration(surpassedAt, readApplication(t, backend, first.ID).FirstDateSurpassed, 0)
+	c.WithinDuration(surpassedAt, readApplication(t, backend, second.ID).FirstDateSurpassed, 0)
+	c.True(readApplication(t, backend, untouched.ID).FirstDateSurpassed.IsZero())
+}
+
+func testRemoveApplication(t *testing.T, backend Backend) {
+	c := require.New(t)
+
+	app := writeTestApplication(t, backend, newTestID(t))
+
+	// removed applications are kept, awaiting their grace period
+	c.NoError(backend.RemoveApplication(context.Background(), app.ID))
+
+	removed := readApplication(t, backend, app.ID)
+	c.NotNil(removed)
+	c.Equal(repository.AwaitingGracePeriod, removed.Status)
+	c.Equal(app.UserID, removed.UserID)
+
+	c.Error(backend.RemoveApplication(context.Background(), ""))
+}
+
+func testUpdateApplicationsStatus(t *testing.T, backend Backend) {
+	c := require.New(t)
+
+	userID := newTestID(t)
+
+	first := writeTestApplication(t, backend, userID)
+	second := writeTestApplication(t, backend, userID)
+	untouched := writeTestApplication(t, backend, userID)
+
+	err := backend.UpdateApplicationsStatus(context.Background(), []string{first.ID, second.ID}, types.AppStatusSuspended)
+	c.NoError(err)
+
+	c.Equal(types.AppStatusSuspended, readApplication(t, backend, first.ID).Status)
+	c.Equal(types.AppStatusSuspended, readApplication(t, backend, second.ID).Status)
+	c.Equal(repository.InService, readApplication(t, backend, untouched.ID).Status)
+
+	c.Error(backend.UpdateApplicationsStatus(context.Background(), nil, repository.Orphaned))
+	c.Error(backend.UpdateApplicationsStatus(context.Background(), []string{first.ID}, ""))
+	c.Error(backend.UpdateApplicationsStatus(context.Background(), []string{first.ID}, "WRONG"))
+
+	c.Equal(types.AppStatusSuspended, readApplication(t, backend, first.ID).Status)
+}
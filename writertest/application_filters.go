@@ -0,0 +1,64 @@
+package writertest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pokt-foundation/pocket-http-db/cache"
+	"github.com/pokt-foundation/pocket-http-db/types"
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/stretchr/testify/require"
+)
+
+func readApplicationFilter(t *testing.T, reader cache.ApplicationFilterReader, name string) *types.ApplicationFilter {
+	filters, err := reader.ReadApplicationFilters()
+	require.NoError(t, err)
+
+	for _, filter := range filters {
+		if filter.Name == name {
+			return filter
+		}
+	}
+
+	return nil
+}
+
+func testApplicationFilters(t *testing.T, backend Backend) {
+	reader, ok := backend.(cache.ApplicationFilterReader)
+	if !ok {
+		t.Skip("backend does not read application filters")
+	}
+
+	c := require.New(t)
+
+	name := "filter-" + newTestID(t)
+
+	c.NoError(backend.WriteApplicationFilter(context.Background(), &types.ApplicationFilter{
+		Name:   name,
+		Status: repository.InService,
+		Labels: []string{"team=relays"},
+	}))
+
+	c.Equal(&types.ApplicationFilter{
+		Name:   name,
+		Status: repository.InService,
+		Labels: []string{"team=relays"},
+	}, readApplicationFilter(t, reader, name))
+
+	// a name is written once, writing it again replaces the filter
+	c.NoError(backend.WriteApplicationFilter(context.Background(), &types.ApplicationFilter{
+		Name:        name,
+		PayPlanType: repository.FreetierV0,
+	}))
+
+	c.Equal(&types.ApplicationFilter{
+		Name:        name,
+		PayPlanType: repository.FreetierV0,
+	}, readApplicationFilter(t, reader, name))
+
+	c.NoError(backend.RemoveApplicationFilter(context.Background(), name))
+	c.Nil(readApplicationFilter(t, reader, name))
+
+	c.Error(backend.WriteApplicationFilter(context.Background(), &types.ApplicationFilter{Status: repository.InService}))
+	c.Error(backend.RemoveApplicationFilter(context.Background(), ""))
+}
@@ -0,0 +1,253 @@
+package writertest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pokt-foundation/pocket-http-db/types"
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/stretchr/testify/require"
+)
+
+// writeTestBlockchain saves a new active blockchain with a random ID and returns it as saved
+func writeTestBlockchain(t *testing.T, backend Backend) *repository.Blockchain {
+	blockchain, err := backend.WriteBlockchain(context.Background(), &repository.Blockchain{
+		ID:         newTestID(t),
+		Blockchain: "pokt-" + newTestID(t),
+		Ticker:     "POKT",
+		Active:     true,
+	})
+	require.NoError(t, err)
+
+	return blockchain
+}
+
+func testWriteBlockchain(t *testing.T, backend Backend) {
+	c := require.New(t)
+
+	id := newTestID(t)
+
+	blockchain, err := backend.WriteBlockchain(context.Background(), &repository.Blockchain{
+		ID:                id,
+		Blockchain:        "pokt-mainnet",
+		BlockchainAliases: []string{"pokt-mainnet"},
+		ChainID:           "21",
+		Description:       "Pocket Network Mainnet",
+		Ticker:            "POKT",
+		Active:            true,
+	})
+	c.NoError(err)
+
+	// blockchains keep the ID they are written with
+	c.Equal(id, blockchain.ID)
+	c.False(blockchain.CreatedAt.IsZero())
+	c.False(blockchain.UpdatedAt.IsZero())
+
+	saved := readBlockchain(t, backend, id)
+	c.NotNil(saved)
+	c.Equal("pokt-mainnet", saved.Blockchain)
+	c.Equal([]string{"pokt-mainnet"}, saved.BlockchainAliases)
+	c.Equal("21", saved.ChainID)
+	c.Equal("POKT", saved.Ticker)
+	c.True(saved.Active)
+
+	// an ID is written once, the blockchain saved first is kept
+	_, err = backend.WriteBlockchain(context.Background(), &repository.Blockchain{
+		ID:          id,
+		Blockchain:  "pokt-testnet",
+		Description: "Pocket Network Testnet",
+	})
+	c.Error(err)
+
+	c.Equal("Pocket Network Mainnet", readBlockchain(t, backend, id).Description)
+}
+
+func testActivateBlockchain(t *testing.T, backend Backend) {
+	c := require.New(t)
+
+	blockchain := writeTestBlockchain(t, backend)
+
+	c.NoError(backend.ActivateBlockchain(context.Background(), blockchain.ID, false))
+	c.False(readBlockchain(t, backend, blockchain.ID).Active)
+
+	c.NoError(backend.ActivateBlockchain(context.Background(), blockchain.ID, true))
+	c.True(readBlockchain(t, backend, blockchain.ID).Active)
+
+	c.Error(backend.ActivateBlockchain(context.Background(), "", true))
+}
+
+func testRemoveBlockchain(t *testing.T, backend Backend) {
+	c := require.New(t)
+
+	blockchain := writeTestBlockchain(t, backend)
+	other := writeTestBlockchain(t, backend)
+
+	_, err := backend.WriteRedirects(context.Background(), []*repository.Redirect{
+		{BlockchainID: blockchain.ID, Alias: "pokt", Domain: "pokt.example.com", LoadBalancerID: newTestID(t)},
+		{BlockchainID: other.ID, Alias: "pokt", Domain: "pokt.example.com", LoadBalancerID: newTestID(t)},
+	})
+	c.NoError(err)
+
+	// blockchains are deleted along with their redirects
+	c.NoError(backend.RemoveBlockchain(context.Background(), blockchain.ID))
+
+	c.Nil(readBlockchain(t, backend, blockchain.ID))
+	c.Empty(readBlockchainRedirects(t, backend, blockchain.ID))
+	c.NotNil(readBlockchain(t, backend, other.ID))
+	c.Len(readBlockchainRedirects(t, backend, other.ID), 1)
+
+	c.Error(backend.RemoveBlockchain(context.Background(), ""))
+}
+
+func testUpdateBlockchainSettings(t *testing.T, backend Backend) {
+	c := require.New(t)
+
+	blockchain := writeTestBlockchain(t, backend)
+	saved := readBlockchain(t, backend, blockchain.ID)
+
+	err := backend.UpdateBlockchainSettings(context.Background(), blockchain.ID, &types.BlockchainSettings{
+		LogLimitBlocks: 100000,
+		RequestTimeout: 5000,
+		EnforceResult:  "JSON",
+	})
+	c.NoError(err)
+
+	// only the settings change, the identity fields are kept
+	updated := readBlockchain(t, backend, blockchain.ID)
+	c.Equal(100000, updated.LogLimitBlocks)
+	c.Equal(5000, updated.RequestTimeout)
+	c.Equal("JSON", updated.EnforceResult)
+	c.Equal(saved.Blockchain, updated.Blockchain)
+	c.Equal(saved.Ticker, updated.Ticker)
+	c.True(updated.Active)
+	c.False(updated.UpdatedAt.Before(saved.UpdatedAt))
+
+	// the settings are replaced as a whole
+	err = backend.UpdateBlockchainSettings(context.Background(), blockchain.ID, &types.BlockchainSettings{LogLimitBlocks: 10000})
+	c.NoError(err)
+
+	updated = readBlockchain(t, backend, blockchain.ID)
+	c.Equal(10000, updated.LogLimitBlocks)
+	c.Zero(updated.RequestTimeout)
+	c.Empty(updated.EnforceResult)
+
+	c.Error(backend.UpdateBlockchainSettings(context.Background(), "", &types.BlockchainSettings{}))
+	c.Error(backend.UpdateBlockchainSettings(context.Background(), blockchain.ID, nil))
+}
+
+func testWriteRedirect(t *testing.T, backend Backend) {
+	c := require.New(t)
+
+	blockchain := writeTestBlockchain(t, backend)
+	lbID := newTestID(t)
+
+	redirect, err := backend.WriteRedirect(context.Background(), &repository.Redirect{
+		BlockchainID:   blockchain.ID,
+		Alias:          "pokt",
+		Domain:         "pokt.example.com",
+		LoadBalancerID: lbID,
+	})
+	c.NoError(err)
+
+	// the returned redirect has its new ID and creation time
+	c.NotEmpty(redirect.ID)
+	c.False(redirect.CreatedAt.IsZero())
+
+	redirects := readBlockchainRedirects(t, backend, blockchain.ID)
+	c.Len(redirects, 1)
+	c.Equal("pokt", redirects[0].Alias)
+	c.Equal("pokt.example.com", redirects[0].Domain)
+	c.Equal(lbID, redirects[0].LoadBalancerID)
+
+	// a domain is redirected once per blockchain, to existing blockchains only
+	_, err = backend.WriteRedirect(context.Background(), &repository.Redirect{
+		BlockchainID:   blockchain.ID,
+		Alias:          "other",
+		Domain:         "pokt.example.com",
+		LoadBalancerID: lbID,
+	})
+	c.Error(err)
+
+	_, err = backend.WriteRedirect(context.Background(), &repository.Redirect{
+		BlockchainID:   newTestID(t),
+		Alias:          "pokt",
+		Domain:         "pokt.example.com",
+		LoadBalancerID: lbID,
+	})
+	c.Error(err)
+
+	c.Len(readBlockchainRedirects(t, backend, blockchain.ID), 1)
+}
+
+func testWriteRedirects(t *testing.T, backend Backend) {
+	c := require.New(t)
+
+	blockchain := writeTestBlockchain(t, backend)
+	lbID := newTestID(t)
+
+	redirects, err := backend.WriteRedirects(context.Background(), []*repository.Redirect{
+		{BlockchainID: blockchain.ID, Alias: "pokt", Domain: "pokt.example.com", LoadBalancerID: lbID},
+		{BlockchainID: blockchain.ID, Alias: "pokt", Domain: "pokt.example.org", LoadBalancerID: lbID},
+	})
+	c.NoError(err)
+
+	// the redirects are returned in order, each one with its new ID
+	c.Len(redirects, 2)
+	c.Equal("pokt.example.com", redirects[0].Domain)
+	c.Equal("pokt.example.org", redirects[1].Domain)
+	c.NotEmpty(redirects[0].ID)
+	c.NotEqual(redirects[0].ID, redirects[1].ID)
+	c.False(redirects[0].CreatedAt.IsZero())
+
+	c.Len(readBlockchainRedirects(t, backend, blockchain.ID), 2)
+
+	// none of the redirects is saved if any fails
+	_, err = backend.WriteRedirects(context.Background(), []*repository.Redirect{
+		{BlockchainID: blockchain.ID, Alias: "pokt", Domain: "pokt.example.net", LoadBalancerID: lbID},
+		{BlockchainID: blockchain.ID, Alias: "pokt", Domain: "pokt.example.com", LoadBalancerID: lbID},
+	})
+	c.Error(err)
+
+	_, err = backend.WriteRedirects(context.Background(), []*repository.Redirect{
+		{BlockchainID: blockchain.ID, Alias: "pokt", Domain: "pokt.example.net", LoadBalancerID: lbID},
+		{BlockchainID: blockchain.ID, Alias: "pokt", Domain: "pokt.example.net", LoadBalancerID: lbID},
+	})
+	c.Error(err)
+
+	c.Len(readBlockchainRedirects(t, backend, blockchain.ID), 2)
+}
+
+func testRemoveRedirect(t *testing.T, backend Backend) {
+	c := require.New(t)
+
+	blockchain := writeTestBlockchain(t, backend)
+
+	redirects, err := backend.WriteRedirects(context.Background(), []*repository.Redirect{
+		{BlockchainID: blockchain.ID, Alias: "pokt", Domain: "pokt.example.com"},
+		{BlockchainID: blockchain.ID, Alias: "pokt", Domain: "pokt.example.org"},
+	})
+	c.NoError(err)
+
+	// the redirects are read back with the IDs they were saved with
+	saved := readBlockchainRedirects(t, backend, blockchain.ID)
+	c.Len(saved, 2)
+	c.ElementsMatch([]string{redirects[0].ID, redirects[1].ID}, []string{saved[0].ID, saved[1].ID})
+
+	c.NoError(backend.RemoveRedirect(context.Background(), redirects[0].ID))
+
+	saved = readBlockchainRedirects(t, backend, blockchain.ID)
+	c.Len(saved, 1)
+	c.Equal(redirects[1].ID, saved[0].ID)
+	c.Equal("pokt.example.org", saved[0].Domain)
+
+	// the domain of a removed redirect can be redirected again
+	_, err = backend.WriteRedirect(context.Background(), &repository.Redirect{
+		BlockchainID: blockchain.ID,
+		Alias:        "pokt",
+		Domain:       "pokt.example.com",
+	})
+	c.NoError(err)
+
+	c.NoError(backend.RemoveRedirect(context.Background(), redirects[0].ID))
+	c.Error(backend.RemoveRedirect(context.Background(), ""))
+}
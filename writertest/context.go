@@ -0,0 +1,41 @@
+package writertest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/stretchr/testify/require"
+)
+
+func testCancelledContext(t *testing.T, backend Backend) {
+	c := require.New(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	userID := newTestID(t)
+
+	// writes with a done context save nothing
+	_, err := backend.WriteApplication(ctx, &repository.Application{
+		UserID:      userID,
+		Status:      repository.InService,
+		PayPlanType: repository.FreetierV0,
+	})
+	c.ErrorIs(err, context.Canceled)
+	c.Empty(readUserApplications(t, backend, userID))
+
+	app := writeTestApplication(t, backend, userID)
+
+	err = backend.UpdateApplication(ctx, app.ID, &repository.UpdateApplication{Name: "papolo"})
+	c.ErrorIs(err, context.Canceled)
+	c.Equal(app.Name, readApplication(t, backend, app.ID).Name)
+
+	blockchain := writeTestBlockchain(t, backend)
+
+	_, err = backend.WriteRedirects(ctx, []*repository.Redirect{
+		{BlockchainID: blockchain.ID, Alias: "pokt", Domain: "pokt.example.com", LoadBalancerID: newTestID(t)},
+	})
+	c.ErrorIs(err, context.Canceled)
+	c.Empty(readBlockchainRedirects(t, backend, blockchain.ID))
+}
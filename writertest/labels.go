@@ -0,0 +1,51 @@
+package writertest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pokt-foundation/pocket-http-db/cache"
+	"github.com/pokt-foundation/pocket-http-db/types"
+	"github.com/stretchr/testify/require"
+)
+
+func readLabels(t *testing.T, reader cache.LabelReader, entityType types.EntityType, entityID string) map[string]string {
+	entityLabels, err := reader.ReadLabels()
+	require.NoError(t, err)
+
+	for _, labels := range entityLabels {
+		if labels.EntityType == entityType && labels.EntityID == entityID {
+			return labels.Labels
+		}
+	}
+
+	return nil
+}
+
+func testWriteLabels(t *testing.T, backend Backend) {
+	reader, ok := backend.(cache.LabelReader)
+	if !ok {
+		t.Skip("backend does not read labels")
+	}
+
+	c := require.New(t)
+
+	app := writeTestApplication(t, backend, newTestID(t))
+
+	err := backend.WriteLabels(context.Background(), types.EntityApplication, app.ID, map[string]string{"team": "relays", "env": "prod"})
+	c.NoError(err)
+	c.Equal(map[string]string{"team": "relays", "env": "prod"}, readLabels(t, reader, types.EntityApplication, app.ID))
+
+	// labels are replaced as a whole, per entity type
+	c.NoError(backend.WriteLabels(context.Background(), types.EntityApplication, app.ID, map[string]string{"team": "portal"}))
+	c.NoError(backend.WriteLabels(context.Background(), types.EntityLoadBalancer, app.ID, map[string]string{"env": "dev"}))
+
+	c.Equal(map[string]string{"team": "portal"}, readLabels(t, reader, types.EntityApplication, app.ID))
+	c.Equal(map[string]string{"env": "dev"}, readLabels(t, reader, types.EntityLoadBalancer, app.ID))
+
+	// no labels removes them
+	c.NoError(backend.WriteLabels(context.Background(), types.EntityApplication, app.ID, nil))
+	c.Empty(readLabels(t, reader, types.EntityApplication, app.ID))
+
+	c.Error(backend.WriteLabels(context.Background(), types.EntityApplication, "", map[string]string{"team": "relays"}))
+}
@@ -0,0 +1,226 @@
+package writertest
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/pokt-foundation/pocket-http-db/cache"
+	"github.com/pokt-foundation/pocket-http-db/types"
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/stretchr/testify/require"
+)
+
+// writeTestLoadBalancer saves a new load balancer of userID holding appIDs and returns it as saved
+func writeTestLoadBalancer(t *testing.T, backend Backend, userID string, appIDs ...string) *repository.LoadBalancer {
+	lb, err := backend.WriteLoadBalancer(context.Background(), &repository.LoadBalancer{
+		UserID:         userID,
+		Name:           "lb-" + newTestID(t),
+		ApplicationIDs: appIDs,
+	})
+	require.NoError(t, err)
+
+	return lb
+}
+
+func testWriteLoadBalancer(t *testing.T, backend Backend) {
+	c := require.New(t)
+
+	userID := newTestID(t)
+
+	first := writeTestApplication(t, backend, userID)
+	second := writeTestApplication(t, backend, userID)
+
+	lb, err := backend.WriteLoadBalancer(context.Background(), &repository.LoadBalancer{
+		UserID:         userID,
+		Name:           "pokt-lb",
+		RequestTimeout: 5000,
+		ApplicationIDs: []string{first.ID, second.ID},
+		StickyOptions: repository.StickyOptions{
+			Duration:      "60",
+			StickyOrigins: []string{"https://app.example.com"},
+			StickyMax:     300,
+			Stickiness:    true,
+		},
+	})
+	c.NoError(err)
+
+	// the returned load balancer has its new ID and creation time
+	c.NotEmpty(lb.ID)
+	c.False(lb.CreatedAt.IsZero())
+	c.False(lb.UpdatedAt.IsZero())
+
+	saved := readLoadBalancer(t, backend, lb.ID)
+	c.NotNil(saved)
+	c.Equal(userID, saved.UserID)
+	c.Equal("pokt-lb", saved.Name)
+	c.Equal(5000, saved.RequestTimeout)
+	c.ElementsMatch([]string{first.ID, second.ID}, saved.ApplicationIDs)
+	c.Equal([]string{"https://app.example.com"}, saved.StickyOptions.StickyOrigins)
+	c.True(saved.StickyOptions.Stickiness)
+	c.Equal(300, saved.StickyOptions.StickyMax)
+
+	other := writeTestLoadBalancer(t, backend, userID, first.ID)
+	c.NotEqual(lb.ID, other.ID)
+
+	// load balancers only hold existing applications, once each
+	_, err = backend.WriteLoadBalancer(context.Background(), &repository.LoadBalancer{
+		UserID:         userID,
+		ApplicationIDs: []string{first.ID, first.ID},
+	})
+	c.Error(err)
+
+	_, err = backend.WriteLoadBalancer(context.Background(), &repository.LoadBalancer{
+		UserID:         userID,
+		ApplicationIDs: []string{newTestID(t)},
+	})
+	c.Error(err)
+
+	c.Len(readUserLoadBalancers(t, backend, userID), 2)
+}
+
+func testUpdateLoadBalancer(t *testing.T, backend Backend) {
+	c := require.New(t)
+
+	app := writeTestApplication(t, backend, newTestID(t))
+	lb := writeTestLoadBalancer(t, backend, app.UserID, app.ID)
+	saved := readLoadBalancer(t, backend, lb.ID)
+
+	err := backend.UpdateLoadBalancer(context.Background(), lb.ID, &repository.UpdateLoadBalancer{Name: "papolo"})
+	c.NoError(err)
+
+	updated := readLoadBalancer(t, backend, lb.ID)
+	c.Equal("papolo", updated.Name)
+	c.Equal(app.UserID, updated.UserID)
+	c.Equal([]string{app.ID}, updated.ApplicationIDs)
+	c.False(updated.UpdatedAt.Before(saved.UpdatedAt))
+
+	err = backend.UpdateLoadBalancer(context.Background(), lb.ID, &repository.UpdateLoadBalancer{
+		StickyOptions: &repository.StickyOptions{StickyOrigins: []string{"https://app.example.com"}, Stickiness: true},
+	})
+	c.NoError(err)
+
+	updated = readLoadBalancer(t, backend, lb.ID)
+	c.Equal("papolo", updated.Name)
+	c.Equal([]string{"https://app.example.com"}, updated.StickyOptions.StickyOrigins)
+	c.True(updated.StickyOptions.Stickiness)
+
+	c.Error(backend.UpdateLoadBalancer(context.Background(), "", &repository.UpdateLoadBalancer{Name: "papolo"}))
+	c.Error(backend.UpdateLoadBalancer(context.Background(), lb.ID, nil))
+}
+
+func testRemoveLoadBalancer(t *testing.T, backend Backend) {
+	c := require.New(t)
+
+	app := writeTestApplication(t, backend, newTestID(t))
+	lb := writeTestLoadBalancer(t, backend, app.UserID, app.ID)
+
+	// removed load balancers are kept without user, along with their applications
+	c.NoError(backend.RemoveLoadBalancer(context.Background(), lb.ID))
+
+	removed := readLoadBalancer(t, backend, lb.ID)
+	c.NotNil(removed)
+	c.Empty(removed.UserID)
+	c.Equal([]string{app.ID}, removed.ApplicationIDs)
+	c.Empty(readUserLoadBalancers(t, backend, app.UserID))
+	c.NotNil(readApplication(t, backend, app.ID))
+
+	c.Error(backend.RemoveLoadBalancer(context.Background(), ""))
+}
+
+func testSetLoadBalancerApplications(t *testing.T, backend Backend) {
+	c := require.New(t)
+
+	userID := newTestID(t)
+
+	first := writeTestApplication(t, backend, userID)
+	second := writeTestApplication(t, backend, userID)
+	third := writeTestApplication(t, backend, userID)
+
+	lb := writeTestLoadBalancer(t, backend, userID, first.ID, second.ID)
+	version := types.LoadBalancerAppsVersion([]string{second.ID, first.ID})
+
+	// duplicated IDs are held once
+	err := backend.SetLoadBalancerApplications(context.Background(), lb.ID, []string{second.ID, third.ID, third.ID}, version)
+	c.NoError(err)
+	c.ElementsMatch([]string{second.ID, third.ID}, readLoadBalancer(t, backend, lb.ID).ApplicationIDs)
+
+	// changes based on an old version are rejected with the current applications
+	err = backend.SetLoadBalancerApplications(context.Background(), lb.ID, []string{first.ID}, version)
+	c.ErrorIs(err, types.ErrLoadBalancerAppsConflict)
+
+	var conflict *types.LoadBalancerAppsConflictError
+	c.True(errors.As(err, &conflict))
+	c.ElementsMatch([]string{second.ID, third.ID}, conflict.ApplicationIDs)
+	c.Equal(types.LoadBalancerAppsVersion([]string{second.ID, third.ID}), conflict.Version)
+
+	// unknown applications fail the whole change
+	err = backend.SetLoadBalancerApplications(context.Background(), lb.ID, []string{first.ID, newTestID(t)}, conflict.Version)
+	c.Error(err)
+
+	c.ElementsMatch([]string{second.ID, third.ID}, readLoadBalancer(t, backend, lb.ID).ApplicationIDs)
+
+	c.Error(backend.SetLoadBalancerApplications(context.Background(), "", []string{first.ID}, conflict.Version))
+}
+
+func testLoadBalancerApplicationOrder(t *testing.T, backend Backend) {
+	reader, ok := backend.(cache.LoadBalancerAppOrderReader)
+	if !ok {
+		t.Skip("backend does not read the order of load balancers applications")
+	}
+
+	c := require.New(t)
+
+	userID := newTestID(t)
+
+	first := writeTestApplication(t, backend, userID)
+	second := writeTestApplication(t, backend, userID)
+	third := writeTestApplication(t, backend, userID)
+
+	lb := writeTestLoadBalancer(t, backend, userID, first.ID, second.ID)
+	version := types.LoadBalancerAppsVersion([]string{first.ID, second.ID})
+
+	readOrder := func() []string {
+		orders, err := reader.ReadLoadBalancerApplicationOrders()
+		c.NoError(err)
+
+		return orders[lb.ID]
+	}
+
+	c.NoError(backend.SetLoadBalancerApplications(context.Background(), lb.ID, []string{third.ID, second.ID, first.ID}, version))
+	c.Equal([]string{third.ID, second.ID, first.ID}, readOrder())
+
+	// reordering the same applications keeps their version
+	version = types.LoadBalancerAppsVersion([]string{first.ID, second.ID, third.ID})
+
+	c.NoError(backend.SetLoadBalancerApplications(context.Background(), lb.ID, []string{second.ID, first.ID, third.ID}, version))
+	c.Equal([]string{second.ID, first.ID, third.ID}, readOrder())
+
+	c.NoError(backend.SetLoadBalancerApplications(context.Background(), lb.ID, []string{first.ID, second.ID}, version))
+	c.Equal([]string{first.ID, second.ID}, readOrder())
+}
+
+func testDeleteLoadBalancer(t *testing.T, backend Backend) {
+	c := require.New(t)
+
+	userID := newTestID(t)
+
+	orphan := writeTestApplication(t, backend, userID)
+	shared := writeTestApplication(t, backend, userID)
+
+	lb := writeTestLoadBalancer(t, backend, userID, orphan.ID, shared.ID)
+	other := writeTestLoadBalancer(t, backend, userID, shared.ID)
+
+	// only the applications no other load balancer holds are deleted
+	deleted, err := backend.DeleteLoadBalancer(context.Background(), lb.ID, []string{orphan.ID, shared.ID})
+	c.NoError(err)
+	c.Equal([]string{orphan.ID}, deleted)
+
+	c.Nil(readLoadBalancer(t, backend, lb.ID))
+	c.Nil(readApplication(t, backend, orphan.ID))
+	c.NotNil(readApplication(t, backend, shared.ID))
+	c.Equal([]string{shared.ID}, readLoadBalancer(t, backend, other.ID).ApplicationIDs)
+
+	_, err = backend.DeleteLoadBalancer(context.Background(), "", nil)
+	c.Error(err)
+}
@@ -0,0 +1,1068 @@
+package writertest
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pokt-foundation/pocket-http-db/types"
+	"github.com/pokt-foundation/portal-api-go/repository"
+)
+
+const idLength = 24
+
+var (
+	// ErrMissingID error when ID is missing
+	ErrMissingID = errors.New("missing id")
+	// ErrNoFieldsToUpdate error when an update has no fields
+	ErrNoFieldsToUpdate = errors.New("no fields to update")
+	// ErrInvalidAppStatus error when the application status is unknown
+	ErrInvalidAppStatus = errors.New("invalid application status")
+	// ErrInvalidPayPlanType error when the pay plan type is unknown
+	ErrInvalidPayPlanType = errors.New("invalid pay plan type")
+	// ErrNotFound error when a write references an entity that does not exist
+	ErrNotFound = errors.New("referenced entity not found")
+	// ErrDuplicated error when a write would save an entity that already exists
+	ErrDuplicated = errors.New("entity already exists")
+	// ErrReferenced error when a removal would leave entities referencing the removed one
+	ErrReferenced = errors.New("entity is referenced")
+	// ErrMissingAuditAction error when the audit log entry has no action
+	ErrMissingAuditAction = errors.New("missing audit action")
+	// ErrUnsupportedEntity error when an operation is not supported for the entity type
+	ErrUnsupportedEntity = errors.New("unsupported entity type")
+)
+
+// Memory is an in-memory Backend following the semantics of the Postgres driver, the conformance tests are checked
+// against it. It also implements the cache readers, so it can back a cache in tests
+type Memory struct {
+	mutex         sync.Mutex
+	applications  map[string]*repository.Application
+	loadBalancers map[string]*repository.LoadBalancer
+	blockchains   map[string]*repository.Blockchain
+	redirects     []*repository.Redirect
+	payPlans      map[repository.PayPlanType]*repository.PayPlan
+	labels        map[types.EntityType]map[string]map[string]string
+	filters       map[string]*types.ApplicationFilter
+	auditLog      []*types.AuditLogEntry
+}
+
+// NewMemory returns a Memory holding only payPlans
+func NewMemory(payPlans ...*repository.PayPlan) *Memory {
+	m := &Memory{
+		applications:  map[string]*repository.Application{},
+		loadBalancers: map[string]*repository.LoadBalancer{},
+		blockchains:   map[string]*repository.Blockchain{},
+		payPlans:      map[repository.PayPlanType]*repository.PayPlan{},
+		labels:        map[types.EntityType]map[string]map[string]string{},
+		filters:       map[string]*types.ApplicationFilter{},
+	}
+
+	for _, plan := range payPlans {
+		m.payPlans[plan.PlanType] = clone(plan)
+	}
+
+	return m
+}
+
+// clone returns a deep copy of value, so callers never share the entities Memory holds
+func clone[T any](value *T) *T {
+	data, err := json.Marshal(value)
+	if err != nil {
+		panic(err)
+	}
+
+	var copied T
+
+	err = json.Unmarshal(data, &copied)
+	if err != nil {
+		panic(err)
+	}
+
+	return &copied
+}
+
+func newID() (string, error) {
+	id := make([]byte, idLength/2)
+
+	_, err := rand.Read(id)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(id), nil
+}
+
+// validPayPlan returns true if planType is empty or a pay plan held by m
+func (m *Memory) validPayPlan(planType repository.PayPlanType) bool {
+	return planType == "" || m.payPlans[planType] != nil
+}
+
+// ReadApplications returns all the applications
+func (m *Memory) ReadApplications() ([]*repository.Application, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	applications := make([]*repository.Application, 0, len(m.applications))
+	for _, app := range m.applications {
+		applications = append(applications, clone(app))
+	}
+
+	return applications, nil
+}
+
+// ReadBlockchains returns all the blockchains
+func (m *Memory) ReadBlockchains() ([]*repository.Blockchain, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	blockchains := make([]*repository.Blockchain, 0, len(m.blockchains))
+	for _, blockchain := range m.blockchains {
+		blockchains = append(blockchains, clone(blockchain))
+	}
+
+	return blockchains, nil
+}
+
+// ReadLoadBalancers returns all the load balancers
+func (m *Memory) ReadLoadBalancers() ([]*repository.LoadBalancer, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	loadBalancers := make([]*repository.LoadBalancer, 0, len(m.loadBalancers))
+	for _, lb := range m.loadBalancers {
+		loadBalancers = append(loadBalancers, clone(lb))
+	}
+
+	return loadBalancers, nil
+}
+
+// ReadPayPlans returns all the pay plans
+func (m *Memory) ReadPayPlans() ([]*repository.PayPlan, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	payPlans := make([]*repository.PayPlan, 0, len(m.payPlans))
+	for _, plan := range m.payPlans {
+		payPlans = append(payPlans, clone(plan))
+	}
+
+	return payPlans, nil
+}
+
+// ReadRedirects returns all the redirects
+func (m *Memory) ReadRedirects() ([]*repository.Redirect, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	redirects := make([]*repository.Redirect, 0, len(m.redirects))
+	for _, redirect := range m.redirects {
+		redirects = append(redirects, clone(redirect))
+	}
+
+	return redirects, nil
+}
+
+// ReadLoadBalancerApplicationOrders returns the IDs of the applications of every load balancer in their order
+func (m *Memory) ReadLoadBalancerApplicationOrders() (map[string][]string, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	orders := make(map[string][]string, len(m.loadBalancers))
+	for id, lb := range m.loadBalancers {
+		if len(lb.ApplicationIDs) > 0 {
+			orders[id] = append([]string{}, lb.ApplicationIDs...)
+		}
+	}
+
+	return orders, nil
+}
+
+// ReadLabels returns the labels of every labeled entity
+func (m *Memory) ReadLabels() ([]*types.EntityLabels, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	var entityLabels []*types.EntityLabels
+
+	for entityType, entities := range m.labels {
+		for entityID, labels := range entities {
+			copied := make(map[string]string, len(labels))
+			for key, value := range labels {
+				copied[key] = value
+			}
+
+			entityLabels = append(entityLabels, &types.EntityLabels{EntityType: entityType, EntityID: entityID, Labels: copied})
+		}
+	}
+
+	return entityLabels, nil
+}
+
+// ReadApplicationFilters returns every named filter of applications
+func (m *Memory) ReadApplicationFilters() ([]*types.ApplicationFilter, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	filters := make([]*types.ApplicationFilter, 0, len(m.filters))
+	for _, filter := range m.filters {
+		filters = append(filters, clone(filter))
+	}
+
+	sort.Slice(filters, func(i, j int) bool { return filters[i].Name < filters[j].Name })
+
+	return filters, nil
+}
+
+// AuditLog returns the entries written to the audit log, in order
+func (m *Memory) AuditLog() []*types.AuditLogEntry {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	entries := make([]*types.AuditLogEntry, 0, len(m.auditLog))
+	for _, entry := range m.auditLog {
+		entries = append(entries, clone(entry))
+	}
+
+	return entries
+}
+
+// NotificationChannel returns nil, Memory sends no notifications of its changes
+func (m *Memory) NotificationChannel() <-chan *repository.Notification {
+	return nil
+}
+
+// WriteLoadBalancer saves input load balancer, with a new ID
+func (m *Memory) WriteLoadBalancer(ctx context.Context, loadBalancer *repository.LoadBalancer) (*repository.LoadBalancer, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	seen := map[string]bool{}
+	for _, appID := range loadBalancer.ApplicationIDs {
+		if m.applications[appID] == nil {
+			return nil, ErrNotFound
+		}
+
+		if seen[appID] {
+			return nil, ErrDuplicated
+		}
+
+		seen[appID] = true
+	}
+
+	id, err := newID()
+	if err != nil {
+		return nil, err
+	}
+
+	loadBalancer.ID = id
+	loadBalancer.CreatedAt = time.Now()
+	loadBalancer.UpdatedAt = loadBalancer.CreatedAt
+
+	saved := clone(loadBalancer)
+	saved.Applications = nil
+
+	m.loadBalancers[id] = saved
+
+	return loadBalancer, nil
+}
+
+// UpdateLoadBalancer updates the name and sticky options of the load balancer, the ones set in options
+func (m *Memory) UpdateLoadBalancer(ctx context.Context, id string, options *repository.UpdateLoadBalancer) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if id == "" {
+		return ErrMissingID
+	}
+
+	if options == nil {
+		return ErrNoFieldsToUpdate
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	lb := m.loadBalancers[id]
+	if lb == nil {
+		return nil
+	}
+
+	if options.Name != "" {
+		lb.Name = options.Name
+	}
+	if options.StickyOptions != nil {
+		lb.StickyOptions = *clone(options.StickyOptions)
+	}
+
+	lb.UpdatedAt = time.Now()
+
+	return nil
+}
+
+// RemoveLoadBalancer removes the load balancer from its user
+func (m *Memory) RemoveLoadBalancer(ctx context.Context, id string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if id == "" {
+		return ErrMissingID
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if lb := m.loadBalancers[id]; lb != nil {
+		lb.UserID = ""
+		lb.UpdatedAt = time.Now()
+	}
+
+	return nil
+}
+
+// WriteApplication saves input application, with a new ID
+func (m *Memory) WriteApplication(ctx context.Context, app *repository.Application) (*repository.Application, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if !repository.ValidAppStatuses[app.Status] {
+		return nil, ErrInvalidAppStatus
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if !repository.ValidPayPlanTypes[app.PayPlanType] || !m.validPayPlan(app.PayPlanType) {
+		return nil, ErrInvalidPayPlanType
+	}
+
+	id, err := newID()
+	if err != nil {
+		return nil, err
+	}
+
+	app.ID = id
+	app.CreatedAt = time.Now()
+	app.UpdatedAt = app.CreatedAt
+
+	m.applications[id] = clone(app)
+
+	return app, nil
+}
+
+// UpdateApplication updates the fields of the application set in options
+func (m *Memory) UpdateApplication(ctx context.Context, id string, options *repository.UpdateApplication) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if id == "" {
+		return ErrMissingID
+	}
+
+	if options == nil {
+		return ErrNoFieldsToUpdate
+	}
+
+	if !repository.ValidAppStatuses[options.Status] {
+		return ErrInvalidAppStatus
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if !repository.ValidPayPlanTypes[options.PayPlanType] || !m.validPayPlan(options.PayPlanType) {
+		return ErrInvalidPayPlanType
+	}
+
+	app := m.applications[id]
+	if app == nil {
+		return nil
+	}
+
+	if options.Name != "" {
+		app.Name = options.Name
+	}
+	if options.Status != "" {
+		app.Status = options.Status
+	}
+	if options.PayPlanType != "" {
+		app.PayPlanType = options.PayPlanType
+	}
+	if !options.FirstDateSurpassed.IsZero() {
+		app.FirstDateSurpassed = options.FirstDateSurpassed
+	}
+	if options.GatewaySettings != nil {
+		app.GatewaySettings = *clone(options.GatewaySettings)
+	}
+	if options.NotificationSettings != nil {
+		app.NotificationSettings = *options.NotificationSettings
+	}
+
+	app.UpdatedAt = time.Now()
+
+	return nil
+}
+
+// UpdateFirstDateSurpassed sets the first date surpassed of the applications
+func (m *Memory) UpdateFirstDateSurpassed(ctx context.Context, firstDateSurpassed *repository.UpdateFirstDateSurpassed) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	updatedAt := time.Now()
+
+	for _, id := range firstDateSurpassed.ApplicationIDs {
+		if app := m.applications[id]; app != nil {
+			app.FirstDateSurpassed = firstDateSurpassed.FirstDateSurpassed
+			app.UpdatedAt = updatedAt
+		}
+	}
+
+	return nil
+}
+
+// RemoveApplication sets the application as awaiting grace period
+func (m *Memory) RemoveApplication(ctx context.Context, id string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if id == "" {
+		return ErrMissingID
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if app := m.applications[id]; app != nil {
+		app.Status = repository.AwaitingGracePeriod
+		app.UpdatedAt = time.Now()
+	}
+
+	return nil
+}
+
+// WriteBlockchain saves input blockchain, with the ID it has
+func (m *Memory) WriteBlockchain(ctx context.Context, blockchain *repository.Blockchain) (*repository.Blockchain, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if m.blockchains[blockchain.ID] != nil {
+		return nil, ErrDuplicated
+	}
+
+	blockchain.CreatedAt = time.Now()
+	blockchain.UpdatedAt = blockchain.CreatedAt
+
+	saved := clone(blockchain)
+	saved.Redirects = nil
+
+	m.blockchains[blockchain.ID] = saved
+
+	return blockchain, nil
+}
+
+// WriteRedirect saves input redirect, with a new ID
+func (m *Memory) WriteRedirect(ctx context.Context, redirect *repository.Redirect) (*repository.Redirect, error) {
+	saved, err := m.WriteRedirects(ctx, []*repository.Redirect{redirect})
+	if err != nil {
+		return nil, err
+	}
+
+	*redirect = *saved[0]
+
+	return redirect, nil
+}
+
+// WriteRedirects saves all the redirects or none of them, returns the redirects as saved
+func (m *Memory) WriteRedirects(ctx context.Context, redirects []*repository.Redirect) ([]*repository.Redirect, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	type domainKey struct{ blockchainID, domain string }
+
+	domains := map[domainKey]bool{}
+	for _, redirect := range m.redirects {
+		domains[domainKey{redirect.BlockchainID, redirect.Domain}] = true
+	}
+
+	now := time.Now()
+
+	saved := make([]*repository.Redirect, 0, len(redirects))
+
+	for _, redirect := range redirects {
+		if m.blockchains[redirect.BlockchainID] == nil {
+			return nil, ErrNotFound
+		}
+
+		key := domainKey{redirect.BlockchainID, redirect.Domain}
+		if domains[key] {
+			return nil, ErrDuplicated
+		}
+
+		domains[key] = true
+
+		id, err := newID()
+		if err != nil {
+			return nil, err
+		}
+
+		savedRedirect := *redirect
+		savedRedirect.ID = id
+		savedRedirect.CreatedAt = now
+		savedRedirect.UpdatedAt = now
+
+		saved = append(saved, &savedRedirect)
+	}
+
+	for _, redirect := range saved {
+		m.redirects = append(m.redirects, clone(redirect))
+	}
+
+	return saved, nil
+}
+
+// WritePayPlan saves the new pay plan
+func (m *Memory) WritePayPlan(ctx context.Context, plan *repository.PayPlan) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if plan.PlanType == "" {
+		return ErrMissingID
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if m.payPlans[plan.PlanType] != nil {
+		return ErrDuplicated
+	}
+
+	m.payPlans[plan.PlanType] = clone(plan)
+
+	return nil
+}
+
+// UpdatePayPlanDailyLimit sets the daily limit of the pay plan of planType
+func (m *Memory) UpdatePayPlanDailyLimit(ctx context.Context, planType repository.PayPlanType, dailyLimit int) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if planType == "" {
+		return ErrMissingID
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if plan := m.payPlans[planType]; plan != nil {
+		plan.DailyLimit = dailyLimit
+	}
+
+	return nil
+}
+
+// RemovePayPlan deletes the pay plan of planType, unless applications have it
+func (m *Memory) RemovePayPlan(ctx context.Context, planType repository.PayPlanType) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if planType == "" {
+		return ErrMissingID
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	for _, app := range m.applications {
+		if app.PayPlanType == planType {
+			return ErrReferenced
+		}
+	}
+
+	delete(m.payPlans, planType)
+
+	return nil
+}
+
+// ActivateBlockchain sets the active state of the blockchain
+func (m *Memory) ActivateBlockchain(ctx context.Context, id string, active bool) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if id == "" {
+		return ErrMissingID
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if blockchain := m.blockchains[id]; blockchain != nil {
+		blockchain.Active = active
+		blockchain.UpdatedAt = time.Now()
+	}
+
+	return nil
+}
+
+// UpdateApplicationsStatus sets status to all the applications in ids
+func (m *Memory) UpdateApplicationsStatus(ctx context.Context, ids []string, status repository.AppStatus) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if len(ids) == 0 {
+		return ErrMissingID
+	}
+
+	if status == "" || !types.ValidAppStatus(status) {
+		return ErrInvalidAppStatus
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	updatedAt := time.Now()
+
+	for _, id := range ids {
+		if app := m.applications[id]; app != nil {
+			app.Status = status
+			app.UpdatedAt = updatedAt
+		}
+	}
+
+	return nil
+}
+
+// WriteAuditLogEntry saves input entry in the audit log
+func (m *Memory) WriteAuditLogEntry(ctx context.Context, entry *types.AuditLogEntry) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if entry.EntityID == "" {
+		return ErrMissingID
+	}
+
+	if entry.Action == "" {
+		return ErrMissingAuditAction
+	}
+
+	if entry.CreatedAt.IsZero() {
+		entry.CreatedAt = time.Now()
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.auditLog = append(m.auditLog, clone(entry))
+
+	return nil
+}
+
+// SetLoadBalancerApplications replaces the applications of the load balancer if they are still at version,
+// returns a *types.LoadBalancerAppsConflictError otherwise
+func (m *Memory) SetLoadBalancerApplications(ctx context.Context, lbID string, appIDs []string, version string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if lbID == "" {
+		return ErrMissingID
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	lb := m.loadBalancers[lbID]
+	if lb == nil {
+		return ErrNotFound
+	}
+
+	if currentVersion := types.LoadBalancerAppsVersion(lb.ApplicationIDs); currentVersion != version {
+		return &types.LoadBalancerAppsConflictError{ApplicationIDs: append([]string{}, lb.ApplicationIDs...), Version: currentVersion}
+	}
+
+	seen := map[string]bool{}
+	ids := make([]string, 0, len(appIDs))
+
+	for _, appID := range appIDs {
+		if m.applications[appID] == nil {
+			return ErrNotFound
+		}
+
+		if !seen[appID] {
+			seen[appID] = true
+			ids = append(ids, appID)
+		}
+	}
+
+	lb.ApplicationIDs = ids
+
+	return nil
+}
+
+// WriteLabels replaces the labels of the entity, removing them if labels is empty
+func (m *Memory) WriteLabels(ctx context.Context, entityType types.EntityType, entityID string, labels map[string]string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if entityID == "" {
+		return ErrMissingID
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if len(labels) == 0 {
+		delete(m.labels[entityType], entityID)
+		return nil
+	}
+
+	if m.labels[entityType] == nil {
+		m.labels[entityType] = map[string]map[string]string{}
+	}
+
+	copied := make(map[string]string, len(labels))
+	for key, value := range labels {
+		copied[key] = value
+	}
+
+	m.labels[entityType][entityID] = copied
+
+	return nil
+}
+
+// WriteApplicationFilter saves filter, replacing the filter with the same name
+func (m *Memory) WriteApplicationFilter(ctx context.Context, filter *types.ApplicationFilter) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if filter.Name == "" {
+		return ErrMissingID
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.filters[filter.Name] = clone(filter)
+
+	return nil
+}
+
+// RemoveApplicationFilter removes the filter with given name
+func (m *Memory) RemoveApplicationFilter(ctx context.Context, name string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if name == "" {
+		return ErrMissingID
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	delete(m.filters, name)
+
+	return nil
+}
+
+// RemoveBlockchain permanently deletes the blockchain with given id along with its redirects
+func (m *Memory) RemoveBlockchain(ctx context.Context, id string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if id == "" {
+		return ErrMissingID
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	redirects := m.redirects[:0]
+	for _, redirect := range m.redirects {
+		if redirect.BlockchainID != id {
+			redirects = append(redirects, redirect)
+		}
+	}
+
+	m.redirects = redirects
+
+	delete(m.blockchains, id)
+
+	return nil
+}
+
+// RemoveRedirect deletes the redirect with given id, removing a missing redirect is not an error
+func (m *Memory) RemoveRedirect(ctx context.Context, id string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if id == "" {
+		return ErrMissingID
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	redirects := m.redirects[:0]
+	for _, redirect := range m.redirects {
+		if redirect.ID != id {
+			redirects = append(redirects, redirect)
+		}
+	}
+
+	m.redirects = redirects
+
+	return nil
+}
+
+// UpdateBlockchainSettings sets the operational settings of the blockchain with given id
+func (m *Memory) UpdateBlockchainSettings(ctx context.Context, id string, settings *types.BlockchainSettings) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if id == "" {
+		return ErrMissingID
+	}
+
+	if settings == nil {
+		return ErrNoFieldsToUpdate
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if blockchain := m.blockchains[id]; blockchain != nil {
+		blockchain.LogLimitBlocks = settings.LogLimitBlocks
+		blockchain.RequestTimeout = settings.RequestTimeout
+		blockchain.EnforceResult = settings.EnforceResult
+		blockchain.UpdatedAt = time.Now()
+	}
+
+	return nil
+}
+
+// DeleteLoadBalancer permanently deletes the load balancer with given id, whatever its state,
+// along with the applications in orphanAppIDs that no other load balancer holds
+// returns the IDs of the deleted applications
+func (m *Memory) DeleteLoadBalancer(ctx context.Context, id string, orphanAppIDs []string) ([]string, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if id == "" {
+		return nil, ErrMissingID
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	held := map[string]bool{}
+	for lbID, lb := range m.loadBalancers {
+		if lbID == id {
+			continue
+		}
+
+		for _, appID := range lb.ApplicationIDs {
+			held[appID] = true
+		}
+	}
+
+	var deleted []string
+
+	for _, appID := range orphanAppIDs {
+		if m.applications[appID] != nil && !held[appID] {
+			deleted = append(deleted, appID)
+		}
+	}
+
+	m.deleteApplications(deleted)
+	m.deleteLoadBalancers([]string{id})
+
+	return deleted, nil
+}
+
+// PurgeUser permanently deletes the applications and load balancers in appIDs and lbIDs, along with the ones
+// still owned by the user purge holds the ID of, reporting them in purge along with the remaining applications
+// whose contact is one of emails, then saves entry with the purge as its data
+// entry is checked first, so nothing is purged if it cannot be saved, as a database transaction is rolled back
+func (m *Memory) PurgeUser(ctx context.Context, purge *types.UserPurge, appIDs, lbIDs, emails []string, entry *types.AuditLogEntry) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if purge.UserID == "" || entry.EntityID == "" {
+		return ErrMissingID
+	}
+
+	if entry.Action == "" {
+		return ErrMissingAuditAction
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	userID := purge.UserID
+
+	listed := map[string]bool{}
+	for _, id := range appIDs {
+		listed[id] = true
+	}
+
+	for id, app := range m.applications {
+		if listed[id] || app.UserID == userID {
+			purge.DeletedApplicationIDs = append(purge.DeletedApplicationIDs, id)
+		}
+	}
+
+	listed = map[string]bool{}
+	for _, id := range lbIDs {
+		listed[id] = true
+	}
+
+	for id, lb := range m.loadBalancers {
+		if listed[id] || lb.UserID == userID {
+			purge.DeletedLoadBalancerIDs = append(purge.DeletedLoadBalancerIDs, id)
+		}
+	}
+
+	m.deleteApplications(purge.DeletedApplicationIDs)
+	m.deleteLoadBalancers(purge.DeletedLoadBalancerIDs)
+
+	purgedEmails := map[string]bool{}
+	for _, email := range emails {
+		purgedEmails[email] = true
+	}
+
+	for id, app := range m.applications {
+		if app.ContactEmail != "" && purgedEmails[app.ContactEmail] {
+			purge.SharedContactApplicationIDs = append(purge.SharedContactApplicationIDs, id)
+		}
+	}
+
+	rawData, err := json.Marshal(purge)
+	if err != nil {
+		return err
+	}
+
+	entry.Data = rawData
+
+	if entry.CreatedAt.IsZero() {
+		entry.CreatedAt = time.Now()
+	}
+
+	m.auditLog = append(m.auditLog, clone(entry))
+
+	return nil
+}
+
+// deleteApplications deletes the applications in ids along with their labels and load balancer memberships
+func (m *Memory) deleteApplications(ids []string) {
+	deleted := map[string]bool{}
+	for _, id := range ids {
+		deleted[id] = true
+
+		delete(m.applications, id)
+		delete(m.labels[types.EntityApplication], id)
+	}
+
+	for _, lb := range m.loadBalancers {
+		appIDs := lb.ApplicationIDs[:0]
+		for _, appID := range lb.ApplicationIDs {
+			if !deleted[appID] {
+				appIDs = append(appIDs, appID)
+			}
+		}
+
+		lb.ApplicationIDs = appIDs
+	}
+}
+
+// deleteLoadBalancers deletes the load balancers in ids along with their labels
+func (m *Memory) deleteLoadBalancers(ids []string) {
+	for _, id := range ids {
+		delete(m.loadBalancers, id)
+		delete(m.labels[types.EntityLoadBalancer], id)
+	}
+}
+
+// BackfillUpdatedAt sets the missing updated at of the entities in ids to their created at, or now if missing too
+// returns the number of entities updated
+func (m *Memory) BackfillUpdatedAt(ctx context.Context, entityType types.EntityType, ids []string) (int64, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	if len(ids) == 0 {
+		return 0, ErrMissingID
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	backfill := func(createdAt time.Time, updatedAt *time.Time) bool {
+		if !updatedAt.IsZero() {
+			return false
+		}
+
+		*updatedAt = createdAt
+		if createdAt.IsZero() {
+			*updatedAt = time.Now()
+		}
+
+		return true
+	}
+
+	var updated int64
+
+	for _, id := range ids {
+		switch entityType {
+		case types.EntityApplication:
+			if app := m.applications[id]; app != nil && backfill(app.CreatedAt, &app.UpdatedAt) {
+				updated++
+			}
+		case types.EntityLoadBalancer:
+			if lb := m.loadBalancers[id]; lb != nil && backfill(lb.CreatedAt, &lb.UpdatedAt) {
+				updated++
+			}
+		default:
+			return 0, ErrUnsupportedEntity
+		}
+	}
+
+	return updated, nil
+}
@@ -0,0 +1,74 @@
+package writertest
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/stretchr/testify/require"
+)
+
+func testUpdatePayPlanDailyLimit(t *testing.T, backend Backend) {
+	c := require.New(t)
+
+	plan := readPayPlan(t, backend, repository.FreetierV0)
+	c.NotNil(plan)
+
+	// the plan may be shared with other tests, it is left as found
+	t.Cleanup(func() {
+		c.NoError(backend.UpdatePayPlanDailyLimit(context.Background(), repository.FreetierV0, plan.DailyLimit))
+	})
+
+	c.NoError(backend.UpdatePayPlanDailyLimit(context.Background(), repository.FreetierV0, plan.DailyLimit+1))
+
+	c.Equal(plan.DailyLimit+1, readPayPlan(t, backend, repository.FreetierV0).DailyLimit)
+	c.NotNil(readPayPlan(t, backend, repository.PayAsYouGoV0))
+
+	c.Error(backend.UpdatePayPlanDailyLimit(context.Background(), "", 1))
+}
+
+// newTestPlanType returns a random pay plan type, so the plans of a test never clash with the ones of the backend
+func newTestPlanType(t *testing.T) repository.PayPlanType {
+	return repository.PayPlanType("TEST_" + strings.ToUpper(newTestID(t)))
+}
+
+func testWritePayPlan(t *testing.T, backend Backend) {
+	c := require.New(t)
+
+	planType := newTestPlanType(t)
+
+	c.NoError(backend.WritePayPlan(context.Background(), &repository.PayPlan{PlanType: planType, DailyLimit: 1000000}))
+
+	t.Cleanup(func() {
+		c.NoError(backend.RemovePayPlan(context.Background(), planType))
+	})
+
+	c.Equal(1000000, readPayPlan(t, backend, planType).DailyLimit)
+
+	// a pay plan type is saved once
+	c.Error(backend.WritePayPlan(context.Background(), &repository.PayPlan{PlanType: planType, DailyLimit: 1}))
+	c.Equal(1000000, readPayPlan(t, backend, planType).DailyLimit)
+
+	c.Error(backend.WritePayPlan(context.Background(), &repository.PayPlan{DailyLimit: 1}))
+}
+
+func testRemovePayPlan(t *testing.T, backend Backend) {
+	c := require.New(t)
+
+	planType := newTestPlanType(t)
+
+	c.NoError(backend.WritePayPlan(context.Background(), &repository.PayPlan{PlanType: planType, DailyLimit: 1000000}))
+	c.NoError(backend.RemovePayPlan(context.Background(), planType))
+
+	c.Nil(readPayPlan(t, backend, planType))
+	c.NotNil(readPayPlan(t, backend, repository.FreetierV0))
+
+	// the pay plans of applications are kept
+	writeTestApplication(t, backend, newTestID(t))
+
+	c.Error(backend.RemovePayPlan(context.Background(), repository.FreetierV0))
+	c.NotNil(readPayPlan(t, backend, repository.FreetierV0))
+
+	c.Error(backend.RemovePayPlan(context.Background(), ""))
+}
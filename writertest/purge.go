@@ -0,0 +1,63 @@
+package writertest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pokt-foundation/pocket-http-db/types"
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/stretchr/testify/require"
+)
+
+func testPurgeUser(t *testing.T, backend Backend) {
+	c := require.New(t)
+
+	userID := newTestID(t)
+	otherUserID := newTestID(t)
+
+	owned := writeTestApplication(t, backend, userID)
+	lb := writeTestLoadBalancer(t, backend, userID, owned.ID)
+
+	// entities moved away from the user are purged when listed
+	listed := writeTestApplication(t, backend, otherUserID)
+	kept := writeTestApplication(t, backend, otherUserID)
+
+	contacted, err := backend.WriteApplication(context.Background(), &repository.Application{
+		UserID:       otherUserID,
+		ContactEmail: userID + "@example.com",
+		Status:       repository.InService,
+		PayPlanType:  repository.FreetierV0,
+	})
+	c.NoError(err)
+
+	purge := &types.UserPurge{UserID: userID}
+	entry := &types.AuditLogEntry{EntityType: types.EntityUser, EntityID: userID, Action: types.AuditActionPurge}
+
+	err = backend.PurgeUser(context.Background(), purge, []string{listed.ID}, nil, []string{userID + "@example.com"}, entry)
+	c.NoError(err)
+	c.Contains(string(entry.Data), owned.ID)
+
+	c.Equal(userID, purge.UserID)
+	c.ElementsMatch([]string{owned.ID, listed.ID}, purge.DeletedApplicationIDs)
+	c.Equal([]string{lb.ID}, purge.DeletedLoadBalancerIDs)
+	c.Equal([]string{contacted.ID}, purge.SharedContactApplicationIDs)
+
+	c.Nil(readApplication(t, backend, owned.ID))
+	c.Nil(readApplication(t, backend, listed.ID))
+	c.Nil(readLoadBalancer(t, backend, lb.ID))
+	c.NotNil(readApplication(t, backend, kept.ID))
+
+	// the contact email can be shared by the other user, it is reported but kept
+	shared := readApplication(t, backend, contacted.ID)
+	c.NotNil(shared)
+	c.Equal(userID+"@example.com", shared.ContactEmail)
+
+	err = backend.PurgeUser(context.Background(), &types.UserPurge{}, nil, nil, nil, entry)
+	c.Error(err)
+
+	// nothing is purged when the audit entry cannot be saved
+	err = backend.PurgeUser(context.Background(), &types.UserPurge{UserID: otherUserID}, nil, nil, nil,
+		&types.AuditLogEntry{EntityType: types.EntityUser, EntityID: otherUserID})
+	c.Error(err)
+	c.NotNil(readApplication(t, backend, kept.ID))
+}
@@ -0,0 +1,178 @@
+// Package writertest checks that implementations of service.Writer behave like the Postgres one, so the service
+// runs the same on any backend passing Run. Memory is the in-memory reference the suite is checked against
+package writertest
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"testing"
+
+	"github.com/pokt-foundation/pocket-http-db/service"
+	"github.com/pokt-foundation/portal-api-go/repository"
+	"github.com/stretchr/testify/require"
+)
+
+// Backend is a Writer implementation under test, reading back what it writes
+// backends implementing cache.LabelReader and cache.ApplicationFilterReader get their labels and filters checked too
+type Backend interface {
+	service.Writer
+	ReadApplications() ([]*repository.Application, error)
+	ReadBlockchains() ([]*repository.Blockchain, error)
+	ReadLoadBalancers() ([]*repository.LoadBalancer, error)
+	ReadPayPlans() ([]*repository.PayPlan, error)
+	ReadRedirects() ([]*repository.Redirect, error)
+}
+
+// NewBackend returns the backend a test runs on, holding at least the FreetierV0 and PayAsYouGoV0 pay plans
+// a backend may be shared by tests, each one only looks at the entities it writes
+type NewBackend func(t *testing.T) Backend
+
+// conformanceTests are run by Run in order, by name
+var conformanceTests = []struct {
+	name string
+	run  func(t *testing.T, backend Backend)
+}{
+	{"WriteApplication", testWriteApplication},
+	{"UpdateApplication", testUpdateApplication},
+	{"UpdateFirstDateSurpassed", testUpdateFirstDateSurpassed},
+	{"RemoveApplication", testRemoveApplication},
+	{"UpdateApplicationsStatus", testUpdateApplicationsStatus},
+	{"WriteLoadBalancer", testWriteLoadBalancer},
+	{"UpdateLoadBalancer", testUpdateLoadBalancer},
+	{"RemoveLoadBalancer", testRemoveLoadBalancer},
+	{"SetLoadBalancerApplications", testSetLoadBalancerApplications},
+	{"LoadBalancerApplicationOrder", testLoadBalancerApplicationOrder},
+	{"DeleteLoadBalancer", testDeleteLoadBalancer},
+	{"WriteBlockchain", testWriteBlockchain},
+	{"ActivateBlockchain", testActivateBlockchain},
+	{"RemoveBlockchain", testRemoveBlockchain},
+	{"UpdateBlockchainSettings", testUpdateBlockchainSettings},
+	{"WriteRedirect", testWriteRedirect},
+	{"WriteRedirects", testWriteRedirects},
+	{"RemoveRedirect", testRemoveRedirect},
+	{"UpdatePayPlanDailyLimit", testUpdatePayPlanDailyLimit},
+	{"WritePayPlan", testWritePayPlan},
+	{"RemovePayPlan", testRemovePayPlan},
+	{"WriteLabels", testWriteLabels},
+	{"ApplicationFilters", testApplicationFilters},
+	{"PurgeUser", testPurgeUser},
+	{"CancelledContext", testCancelledContext},
+}
+
+// Run runs every conformance test as a subtest of t, on a backend returned by newBackend
+func Run(t *testing.T, newBackend NewBackend) {
+	for _, test := range conformanceTests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			test.run(t, newBackend(t))
+		})
+	}
+}
+
+// newTestID returns a random ID, so the entities of a test never clash with the ones already in the backend
+func newTestID(t *testing.T) string {
+	id := make([]byte, 12)
+
+	_, err := rand.Read(id)
+	require.NoError(t, err)
+
+	return hex.EncodeToString(id)
+}
+
+func readApplication(t *testing.T, backend Backend, id string) *repository.Application {
+	apps, err := backend.ReadApplications()
+	require.NoError(t, err)
+
+	for _, app := range apps {
+		if app.ID == id {
+			return app
+		}
+	}
+
+	return nil
+}
+
+func readUserApplications(t *testing.T, backend Backend, userID string) []*repository.Application {
+	apps, err := backend.ReadApplications()
+	require.NoError(t, err)
+
+	var userApps []*repository.Application
+
+	for _, app := range apps {
+		if app.UserID == userID {
+			userApps = append(userApps, app)
+		}
+	}
+
+	return userApps
+}
+
+func readLoadBalancer(t *testing.T, backend Backend, id string) *repository.LoadBalancer {
+	lbs, err := backend.ReadLoadBalancers()
+	require.NoError(t, err)
+
+	for _, lb := range lbs {
+		if lb.ID == id {
+			return lb
+		}
+	}
+
+	return nil
+}
+
+func readUserLoadBalancers(t *testing.T, backend Backend, userID string) []*repository.LoadBalancer {
+	lbs, err := backend.ReadLoadBalancers()
+	require.NoError(t, err)
+
+	var userLBs []*repository.LoadBalancer
+
+	for _, lb := range lbs {
+		if lb.UserID == userID {
+			userLBs = append(userLBs, lb)
+		}
+	}
+
+	return userLBs
+}
+
+func readBlockchain(t *testing.T, backend Backend, id string) *repository.Blockchain {
+	blockchains, err := backend.ReadBlockchains()
+	require.NoError(t, err)
+
+	for _, blockchain := range blockchains {
+		if blockchain.ID == id {
+			return blockchain
+		}
+	}
+
+	return nil
+}
+
+func readBlockchainRedirects(t *testing.T, backend Backend, blockchainID string) []*repository.Redirect {
+	redirects, err := backend.ReadRedirects()
+	require.NoError(t, err)
+
+	var blockchainRedirects []*repository.Redirect
+
+	for _, redirect := range redirects {
+		if redirect.BlockchainID == blockchainID {
+			blockchainRedirects = append(blockchainRedirects, redirect)
+		}
+	}
+
+	return blockchainRedirects
+}
+
+func readPayPlan(t *testing.T, backend Backend, planType repository.PayPlanType) *repository.PayPlan {
+	plans, err := backend.ReadPayPlans()
+	require.NoError(t, err)
+
+	for _, plan := range plans {
+		if plan.PlanType == planType {
+			return plan
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,23 @@
+package writertest
+
+import (
+	"testing"
+
+	"github.com/pokt-foundation/pocket-http-db/cache"
+	"github.com/pokt-foundation/portal-api-go/repository"
+)
+
+var (
+	_ cache.Reader                  = &Memory{}
+	_ cache.LabelReader             = &Memory{}
+	_ cache.ApplicationFilterReader = &Memory{}
+)
+
+func TestMemory_Conformance(t *testing.T) {
+	Run(t, func(t *testing.T) Backend {
+		return NewMemory(
+			&repository.PayPlan{PlanType: repository.FreetierV0, DailyLimit: 250000},
+			&repository.PayPlan{PlanType: repository.PayAsYouGoV0, DailyLimit: 0},
+		)
+	})
+}